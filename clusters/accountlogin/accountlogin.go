@@ -0,0 +1,85 @@
+// Code generated by symbols/gen. DO NOT EDIT.
+
+// Package accountlogin is typed client of AccountLogin cluster (0x050e).
+package accountlogin
+
+import (
+	"context"
+
+	"github.com/finnigja/gomat"
+	"github.com/finnigja/gomat/clusters"
+)
+
+// ClusterId is id of AccountLogin cluster.
+const ClusterId = 0x050e
+
+// ids of commands
+const (
+	CommandGetSetupPIN = 0x00
+	CommandLogin       = 0x02
+	CommandLogout      = 0x03
+)
+
+// ids of response commands
+const (
+	ResponseGetSetupPIN = 0x01
+)
+
+// ids of events
+const (
+	EventLoggedOut = 0x00
+)
+
+// GetSetupPINRequest holds fields of GetSetupPIN command.
+type GetSetupPINRequest struct {
+	TempAccountIdentifier string `tlv:"0"`
+}
+
+// GetSetupPIN invokes GetSetupPIN command and returns fields of GetSetupPINResponse.
+func GetSetupPIN(ctx context.Context, messenger gomat.Messenger, endpoint uint16, request GetSetupPINRequest) (GetSetupPINResponse, error) {
+	var response GetSetupPINResponse
+	result, err := clusters.Invoke(ctx, messenger, endpoint, ClusterId, CommandGetSetupPIN, request, true)
+	if err != nil {
+		return response, err
+	}
+	err = clusters.DecodeResponse(result, ResponseGetSetupPIN, &response)
+	return response, err
+}
+
+// GetSetupPINResponse holds fields of GetSetupPINResponse response command.
+type GetSetupPINResponse struct {
+	SetupPIN string `tlv:"0"`
+}
+
+// LoginRequest holds fields of Login command.
+type LoginRequest struct {
+	TempAccountIdentifier string `tlv:"0"`
+	SetupPIN              string `tlv:"1"`
+}
+
+// Login invokes Login command.
+func Login(ctx context.Context, messenger gomat.Messenger, endpoint uint16, request LoginRequest) error {
+	_, err := clusters.Invoke(ctx, messenger, endpoint, ClusterId, CommandLogin, request, true)
+	return err
+}
+
+// Logout invokes Logout command.
+func Logout(ctx context.Context, messenger gomat.Messenger, endpoint uint16) error {
+	_, err := clusters.Invoke(ctx, messenger, endpoint, ClusterId, CommandLogout, nil, true)
+	return err
+}
+
+// LoggedOutEvent holds fields of LoggedOut event.
+type LoggedOutEvent struct {
+	Node uint64 `tlv:"0"`
+}
+
+// DecodeLoggedOutEvent decodes LoggedOut event from report.
+func DecodeLoggedOutEvent(report gomat.EventReport) (LoggedOutEvent, error) {
+	return clusters.DecodeEvent[LoggedOutEvent](report, ClusterId, EventLoggedOut)
+}
+
+// SubscribeLoggedOutEvent adds LoggedOut event of endpoint to subscription s. callback receives decoded events.
+func SubscribeLoggedOutEvent(s *gomat.Subscription, endpoint uint16, callback func(event LoggedOutEvent)) {
+	clusters.SubscribeEvent(s, endpoint, ClusterId, EventLoggedOut, callback)
+}
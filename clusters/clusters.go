@@ -0,0 +1,197 @@
+// Package clusters contains helpers used by generated cluster packages (clusters/onoff, clusters/levelcontrol, ...).
+// Packages are generated by symbols/gen from the same data as symbols/info.go.
+//
+//	err := onoff.Toggle(ctx, &channel, 1)
+//	name, err := basicinformation.ReadVendorName(ctx, &channel, 0)
+package clusters
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/finnigja/gomat"
+	"github.com/finnigja/gomat/mattertlv"
+)
+
+// TimedTimeout is timeout (ms) of TimedRequest sent before commands and writes which must be timed.
+var TimedTimeout uint16 = 6000
+
+// EncodeFields encodes struct with fields of command into format of Command.Fields.
+// nil request encodes command without fields.
+func EncodeFields(request any) ([]byte, error) {
+	if request == nil {
+		return nil, nil
+	}
+	data, err := mattertlv.Marshal(request)
+	if err != nil {
+		return nil, err
+	}
+	if len(data) < 2 || data[0] != mattertlv.CONTAINER_STRUCT {
+		return nil, fmt.Errorf("fields of command must be struct")
+	}
+	// members of anonymous structure without its control byte and end of container
+	return data[1 : len(data)-1], nil
+}
+
+// Invoke invokes command with fields encoded from request struct (see EncodeFields) and returns its result.
+// Error is returned also when device responds with failure status.
+func Invoke(ctx context.Context, messenger gomat.Messenger, endpoint uint16, cluster, command uint32, request any, timed bool) (gomat.CommandResult, error) {
+	fields, err := EncodeFields(request)
+	if err != nil {
+		return gomat.CommandResult{}, err
+	}
+	invoke := gomat.InvokeRequest{
+		Commands: []gomat.Command{{Endpoint: endpoint, Cluster: cluster, Command: command, Fields: fields}},
+	}
+	if timed {
+		invoke.Timeout = TimedTimeout
+	}
+	results, err := gomat.InvokeContext(ctx, messenger, invoke)
+	if err != nil {
+		return gomat.CommandResult{}, err
+	}
+	return results[0], results[0].Err()
+}
+
+// DecodeResponse decodes fields of response command into struct pointed by v.
+// It fails when device did not respond with expected response command.
+func DecodeResponse(result gomat.CommandResult, response uint32, v any) error {
+	if result.Fields == nil || result.Command != response {
+		return fmt.Errorf("expected response command 0x%x, received 0x%x", response, result.Command)
+	}
+	if len(result.Fields.GetChild()) == 0 {
+		return nil
+	}
+	return mattertlv.UnmarshalItem(result.Fields, v)
+}
+
+// ReadAttribute reads attribute and decodes its value into T.
+func ReadAttribute[T any](ctx context.Context, messenger gomat.Messenger, endpoint uint16, cluster, attribute uint32) (T, error) {
+	var out T
+	report, err := gomat.ReadContext(ctx, messenger, gomat.ReadRequest{
+		Attributes:     []gomat.AttributePath{gomat.NewAttributePath(int(endpoint), int(cluster), int(attribute))},
+		FabricFiltered: true,
+	})
+	if err != nil {
+		return out, err
+	}
+	a := report.Attribute(endpoint, cluster, attribute)
+	if a == nil {
+		return out, fmt.Errorf("attribute 0x%x of cluster 0x%x was not reported", attribute, cluster)
+	}
+	err = a.Err()
+	if err != nil {
+		return out, err
+	}
+	err = mattertlv.UnmarshalItem(&a.Value, &out)
+	return out, err
+}
+
+// WriteAttribute encodes value using mattertlv.Marshal and writes it into attribute.
+func WriteAttribute(ctx context.Context, messenger gomat.Messenger, endpoint uint16, cluster, attribute uint32, value any, timed bool) error {
+	data, err := mattertlv.Marshal(value)
+	if err != nil {
+		return err
+	}
+	request := gomat.WriteRequest{
+		Attributes: []gomat.WriteAttribute{{Endpoint: endpoint, Cluster: cluster, Attribute: attribute, Value: data}},
+	}
+	if timed {
+		request.Timeout = TimedTimeout
+	}
+	statuses, err := gomat.WriteContext(ctx, messenger, request)
+	if err != nil {
+		return err
+	}
+	for _, status := range statuses {
+		err = status.Err()
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// SubscribeAttribute adds attribute to request of subscription s. callback receives decoded reported values.
+// Reports which can't be decoded are passed to s.OnError. Must be called before s.Run.
+func SubscribeAttribute[T any](s *gomat.Subscription, endpoint uint16, cluster, attribute uint32, callback func(value T)) {
+	s.Request.Attributes = append(s.Request.Attributes, gomat.NewAttributePath(int(endpoint), int(cluster), int(attribute)))
+	previous := s.OnAttribute
+	s.OnAttribute = func(report gomat.AttributeReport) {
+		if previous != nil {
+			previous(report)
+		}
+		if report.Endpoint != endpoint || report.Cluster != cluster || report.Attribute != attribute {
+			return
+		}
+		var value T
+		err := report.Err()
+		if err == nil {
+			err = mattertlv.UnmarshalItem(&report.Value, &value)
+		}
+		if err != nil {
+			if s.OnError != nil {
+				s.OnError(err)
+			}
+			return
+		}
+		callback(value)
+	}
+}
+
+// DecodeEvent decodes data of event report into T.
+func DecodeEvent[T any](report gomat.EventReport, cluster, event uint32) (T, error) {
+	var out T
+	if report.Cluster != cluster || report.Event != event {
+		return out, fmt.Errorf("expected event 0x%x of cluster 0x%x, received 0x%x of 0x%x", event, cluster, report.Event, report.Cluster)
+	}
+	err := report.Err()
+	if err != nil {
+		return out, err
+	}
+	err = mattertlv.UnmarshalItem(&report.Data, &out)
+	return out, err
+}
+
+// SubscribeEvent adds event to request of subscription s. callback receives decoded events.
+// Reports which can't be decoded are passed to s.OnError. Must be called before s.Run.
+func SubscribeEvent[T any](s *gomat.Subscription, endpoint uint16, cluster, event uint32, callback func(event T)) {
+	s.Request.Events = append(s.Request.Events, gomat.NewEventPath(int(endpoint), int(cluster), int(event)))
+	previous := s.OnEvent
+	s.OnEvent = func(report gomat.EventReport) {
+		if previous != nil {
+			previous(report)
+		}
+		if report.Endpoint != endpoint || report.Cluster != cluster || report.Event != event {
+			return
+		}
+		value, err := DecodeEvent[T](report, cluster, event)
+		if err != nil {
+			if s.OnError != nil {
+				s.OnError(err)
+			}
+			return
+		}
+		callback(value)
+	}
+}
+
+// ReadFeatureMap reads global FeatureMap attribute of cluster.
+func ReadFeatureMap(ctx context.Context, messenger gomat.Messenger, endpoint uint16, cluster uint32) (uint32, error) {
+	return ReadAttribute[uint32](ctx, messenger, endpoint, cluster, 0xfffc)
+}
+
+// ReadClusterRevision reads global ClusterRevision attribute of cluster.
+func ReadClusterRevision(ctx context.Context, messenger gomat.Messenger, endpoint uint16, cluster uint32) (uint16, error) {
+	return ReadAttribute[uint16](ctx, messenger, endpoint, cluster, 0xfffd)
+}
+
+// ReadAttributeList reads global AttributeList attribute of cluster.
+func ReadAttributeList(ctx context.Context, messenger gomat.Messenger, endpoint uint16, cluster uint32) ([]uint32, error) {
+	return ReadAttribute[[]uint32](ctx, messenger, endpoint, cluster, 0xfffb)
+}
+
+// ReadAcceptedCommandList reads global AcceptedCommandList attribute of cluster.
+func ReadAcceptedCommandList(ctx context.Context, messenger gomat.Messenger, endpoint uint16, cluster uint32) ([]uint32, error) {
+	return ReadAttribute[[]uint32](ctx, messenger, endpoint, cluster, 0xfff9)
+}
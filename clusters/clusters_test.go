@@ -0,0 +1,126 @@
+package clusters_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/finnigja/gomat"
+	"github.com/finnigja/gomat/clusters/basicinformation"
+	"github.com/finnigja/gomat/clusters/generalcommissioning"
+	"github.com/finnigja/gomat/clusters/onoff"
+	"github.com/finnigja/gomat/mattertlv"
+)
+
+// fakeDevice records sent messages and answers with prepared IM messages.
+type fakeDevice struct {
+	sent    []mattertlv.TlvItem
+	replies []gomat.DecodedGeneric
+}
+
+func (d *fakeDevice) Send(data []byte) error {
+	// skip protocol header without acknowledgement
+	item, err := mattertlv.Decode(data[6:])
+	d.sent = append(d.sent, item)
+	return err
+}
+
+func (d *fakeDevice) Receive() (gomat.DecodedGeneric, error) {
+	return d.ReceiveContext(context.Background())
+}
+
+func (d *fakeDevice) ReceiveContext(ctx context.Context) (gomat.DecodedGeneric, error) {
+	out := d.replies[0]
+	d.replies = d.replies[1:]
+	return out, nil
+}
+
+func (d *fakeDevice) reply(opcode gomat.Opcode, tlv *mattertlv.TLVBuffer) {
+	item, _ := mattertlv.Decode(tlv.Bytes())
+	d.replies = append(d.replies, gomat.DecodedGeneric{
+		ProtocolHeader: gomat.ProtocolMessageHeader{ProtocolId: gomat.ProtocolIdInteraction, Opcode: opcode},
+		Tlv:            item,
+	})
+}
+
+// invokeResponse prepares InvokeResponse with fields of response command written by fields.
+func (d *fakeDevice) invokeResponse(cluster, command uint32, fields func(tlv *mattertlv.TLVBuffer)) {
+	var tlv mattertlv.TLVBuffer
+	tlv.WriteAnonStruct()
+	tlv.WriteBool(0, false)
+	tlv.WriteArray(1)
+	tlv.WriteAnonStruct()
+	tlv.WriteStruct(0)
+	tlv.WriteList(0)
+	tlv.WriteUInt16(0, 0)
+	tlv.WriteUInt32(1, cluster)
+	tlv.WriteUInt32(2, command)
+	tlv.WriteStructEnd()
+	tlv.WriteStruct(1)
+	fields(&tlv)
+	tlv.WriteStructEnd()
+	tlv.WriteStructEnd()
+	tlv.WriteStructEnd()
+	tlv.WriteStructEnd()
+	tlv.WriteStructEnd()
+	d.reply(gomat.INTERACTION_OPCODE_INVOKE_RSP, &tlv)
+}
+
+func TestInvoke(t *testing.T) {
+	var device fakeDevice
+	device.invokeResponse(onoff.ClusterId, onoff.CommandToggle, func(tlv *mattertlv.TLVBuffer) {})
+	err := onoff.Toggle(context.Background(), &device, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	path := device.sent[0].GetItemRec([]int{2, 0, 0})
+	if path.GetItemWithTag(0).GetInt() != 1 || path.GetItemWithTag(1).GetInt() != 6 || path.GetItemWithTag(2).GetInt() != 2 {
+		t.Fatalf("unexpected path %s", mattertlv.FormatText(path))
+	}
+
+	device.sent = nil
+	device.invokeResponse(generalcommissioning.ClusterId, generalcommissioning.ResponseArmFailSafe, func(tlv *mattertlv.TLVBuffer) {
+		tlv.WriteUInt8(0, 4)
+		tlv.WriteUTF8String(1, "busy")
+	})
+	response, err := generalcommissioning.ArmFailSafe(context.Background(), &device, 0, generalcommissioning.ArmFailSafeRequest{ExpiryLengthSeconds: 60, Breadcrumb: 1})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if response.ErrorCode != generalcommissioning.CommissioningErrorEnumBusyWithOtherAdmin || response.DebugText != "busy" {
+		t.Fatalf("unexpected response %+v", response)
+	}
+	fields := device.sent[0].GetItemRec([]int{2, 0, 1})
+	if fields.GetItemWithTag(0).GetInt() != 60 || fields.GetItemWithTag(1).GetInt() != 1 {
+		t.Fatalf("unexpected fields %s", mattertlv.FormatText(fields))
+	}
+}
+
+func TestReadAttribute(t *testing.T) {
+	var device fakeDevice
+	var tlv mattertlv.TLVBuffer
+	tlv.WriteAnonStruct()
+	tlv.WriteArray(1)
+	tlv.WriteAnonStruct()
+	tlv.WriteStruct(1)
+	tlv.WriteUInt32(0, 1)
+	tlv.WriteList(1)
+	tlv.WriteUInt16(2, 0)
+	tlv.WriteUInt32(3, basicinformation.ClusterId)
+	tlv.WriteUInt32(4, basicinformation.AttributeVendorName)
+	tlv.WriteStructEnd()
+	tlv.WriteUTF8String(2, "vendor")
+	tlv.WriteStructEnd()
+	tlv.WriteStructEnd()
+	tlv.WriteStructEnd()
+	tlv.WriteBool(4, true)
+	tlv.WriteStructEnd()
+	device.reply(gomat.INTERACTION_OPCODE_REPORT_DATA, &tlv)
+
+	name, err := basicinformation.ReadVendorName(context.Background(), &device, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if name != "vendor" {
+		t.Fatalf("unexpected value %q", name)
+	}
+}
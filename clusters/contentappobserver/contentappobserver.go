@@ -0,0 +1,55 @@
+// Code generated by symbols/gen. DO NOT EDIT.
+
+// Package contentappobserver is typed client of ContentAppObserver cluster (0x0510).
+package contentappobserver
+
+import (
+	"context"
+
+	"github.com/finnigja/gomat"
+	"github.com/finnigja/gomat/clusters"
+)
+
+// ClusterId is id of ContentAppObserver cluster.
+const ClusterId = 0x0510
+
+// ids of commands
+const (
+	CommandContentAppMessage = 0x00
+)
+
+// ids of response commands
+const (
+	ResponseContentAppMessage = 0x01
+)
+
+type StatusEnum uint8
+
+const (
+	StatusEnumSuccess        StatusEnum = 0
+	StatusEnumUnexpectedData StatusEnum = 1
+)
+
+// ContentAppMessageRequest holds fields of ContentAppMessage command.
+type ContentAppMessageRequest struct {
+	Data         string `tlv:"0"`
+	EncodingHint string `tlv:"1"`
+}
+
+// ContentAppMessage invokes ContentAppMessage command and returns fields of ContentAppMessageResponse.
+func ContentAppMessage(ctx context.Context, messenger gomat.Messenger, endpoint uint16, request ContentAppMessageRequest) (ContentAppMessageResponse, error) {
+	var response ContentAppMessageResponse
+	result, err := clusters.Invoke(ctx, messenger, endpoint, ClusterId, CommandContentAppMessage, request, false)
+	if err != nil {
+		return response, err
+	}
+	err = clusters.DecodeResponse(result, ResponseContentAppMessage, &response)
+	return response, err
+}
+
+// ContentAppMessageResponse holds fields of ContentAppMessageResponse response command.
+type ContentAppMessageResponse struct {
+	Status       StatusEnum `tlv:"0"`
+	Data         string     `tlv:"1"`
+	EncodingHint string     `tlv:"2"`
+}
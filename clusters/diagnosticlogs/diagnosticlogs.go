@@ -0,0 +1,75 @@
+// Code generated by symbols/gen. DO NOT EDIT.
+
+// Package diagnosticlogs is typed client of DiagnosticLogs cluster (0x0032).
+package diagnosticlogs
+
+import (
+	"context"
+
+	"github.com/finnigja/gomat"
+	"github.com/finnigja/gomat/clusters"
+)
+
+// ClusterId is id of DiagnosticLogs cluster.
+const ClusterId = 0x0032
+
+// ids of commands
+const (
+	CommandRetrieveLogsRequest = 0x00
+)
+
+// ids of response commands
+const (
+	ResponseRetrieveLogs = 0x01
+)
+
+type IntentEnum uint8
+
+const (
+	IntentEnumEndUserSupport IntentEnum = 0
+	IntentEnumNetworkDiag    IntentEnum = 1
+	IntentEnumCrashLogs      IntentEnum = 2
+)
+
+type StatusEnum uint8
+
+const (
+	StatusEnumSuccess   StatusEnum = 0
+	StatusEnumExhausted StatusEnum = 1
+	StatusEnumNoLogs    StatusEnum = 2
+	StatusEnumBusy      StatusEnum = 3
+	StatusEnumDenied    StatusEnum = 4
+)
+
+type TransferProtocolEnum uint8
+
+const (
+	TransferProtocolEnumResponsePayload TransferProtocolEnum = 0
+	TransferProtocolEnumBDX             TransferProtocolEnum = 1
+)
+
+// RetrieveLogsRequestFields holds fields of RetrieveLogsRequest command.
+type RetrieveLogsRequestFields struct {
+	Intent                 IntentEnum           `tlv:"0"`
+	RequestedProtocol      TransferProtocolEnum `tlv:"1"`
+	TransferFileDesignator string               `tlv:"2"`
+}
+
+// RetrieveLogsRequest invokes RetrieveLogsRequest command and returns fields of RetrieveLogsResponse.
+func RetrieveLogsRequest(ctx context.Context, messenger gomat.Messenger, endpoint uint16, request RetrieveLogsRequestFields) (RetrieveLogsResponse, error) {
+	var response RetrieveLogsResponse
+	result, err := clusters.Invoke(ctx, messenger, endpoint, ClusterId, CommandRetrieveLogsRequest, request, false)
+	if err != nil {
+		return response, err
+	}
+	err = clusters.DecodeResponse(result, ResponseRetrieveLogs, &response)
+	return response, err
+}
+
+// RetrieveLogsResponse holds fields of RetrieveLogsResponse response command.
+type RetrieveLogsResponse struct {
+	Status        StatusEnum `tlv:"0"`
+	LogContent    []byte     `tlv:"1"`
+	UTCTimeStamp  uint64     `tlv:"2"`
+	TimeSinceBoot uint64     `tlv:"3"`
+}
@@ -0,0 +1,62 @@
+// Code generated by symbols/gen. DO NOT EDIT.
+
+// Package flowmeasurement is typed client of FlowMeasurement cluster (0x0404).
+package flowmeasurement
+
+import (
+	"context"
+
+	"github.com/finnigja/gomat"
+	"github.com/finnigja/gomat/clusters"
+)
+
+// ClusterId is id of FlowMeasurement cluster.
+const ClusterId = 0x0404
+
+// ids of attributes
+const (
+	AttributeMeasuredValue    = 0x0000
+	AttributeMinMeasuredValue = 0x0001
+	AttributeMaxMeasuredValue = 0x0002
+	AttributeTolerance        = 0x0003
+)
+
+// ReadMeasuredValue reads MeasuredValue attribute.
+func ReadMeasuredValue(ctx context.Context, messenger gomat.Messenger, endpoint uint16) (*uint16, error) {
+	return clusters.ReadAttribute[*uint16](ctx, messenger, endpoint, ClusterId, AttributeMeasuredValue)
+}
+
+// SubscribeMeasuredValue adds MeasuredValue attribute of endpoint to subscription s. callback receives reported values.
+func SubscribeMeasuredValue(s *gomat.Subscription, endpoint uint16, callback func(value *uint16)) {
+	clusters.SubscribeAttribute(s, endpoint, ClusterId, AttributeMeasuredValue, callback)
+}
+
+// ReadMinMeasuredValue reads MinMeasuredValue attribute.
+func ReadMinMeasuredValue(ctx context.Context, messenger gomat.Messenger, endpoint uint16) (*uint16, error) {
+	return clusters.ReadAttribute[*uint16](ctx, messenger, endpoint, ClusterId, AttributeMinMeasuredValue)
+}
+
+// SubscribeMinMeasuredValue adds MinMeasuredValue attribute of endpoint to subscription s. callback receives reported values.
+func SubscribeMinMeasuredValue(s *gomat.Subscription, endpoint uint16, callback func(value *uint16)) {
+	clusters.SubscribeAttribute(s, endpoint, ClusterId, AttributeMinMeasuredValue, callback)
+}
+
+// ReadMaxMeasuredValue reads MaxMeasuredValue attribute.
+func ReadMaxMeasuredValue(ctx context.Context, messenger gomat.Messenger, endpoint uint16) (*uint16, error) {
+	return clusters.ReadAttribute[*uint16](ctx, messenger, endpoint, ClusterId, AttributeMaxMeasuredValue)
+}
+
+// SubscribeMaxMeasuredValue adds MaxMeasuredValue attribute of endpoint to subscription s. callback receives reported values.
+func SubscribeMaxMeasuredValue(s *gomat.Subscription, endpoint uint16, callback func(value *uint16)) {
+	clusters.SubscribeAttribute(s, endpoint, ClusterId, AttributeMaxMeasuredValue, callback)
+}
+
+// ReadTolerance reads Tolerance attribute.
+func ReadTolerance(ctx context.Context, messenger gomat.Messenger, endpoint uint16) (uint16, error) {
+	return clusters.ReadAttribute[uint16](ctx, messenger, endpoint, ClusterId, AttributeTolerance)
+}
+
+// SubscribeTolerance adds Tolerance attribute of endpoint to subscription s. callback receives reported values.
+func SubscribeTolerance(s *gomat.Subscription, endpoint uint16, callback func(value uint16)) {
+	clusters.SubscribeAttribute(s, endpoint, ClusterId, AttributeTolerance, callback)
+}
@@ -2,17 +2,26 @@ package main
 
 import (
 	"bytes"
+	"context"
+	_ "embed"
 	"encoding/hex"
+	"encoding/json"
+	"errors"
 	"fmt"
 	"log"
 	"math/rand"
 	"net"
+	"os"
+	"os/signal"
 	"os/user"
 	"path/filepath"
+	"sort"
 	"strconv"
 	"strings"
 
 	"github.com/finnigja/gomat"
+	"github.com/finnigja/gomat/clusters/colorcontrol"
+	"github.com/finnigja/gomat/clusters/onoff"
 	"github.com/finnigja/gomat/discover"
 	"github.com/finnigja/gomat/mattertlv"
 	"github.com/finnigja/gomat/onboarding_payload"
@@ -20,6 +29,9 @@ import (
 	"github.com/spf13/cobra"
 )
 
+//go:embed yeelight.xml
+var yeelight_xml []byte
+
 func getBasePath() (string, error) {
 	usr, err := user.Current()
 	if err != nil {
@@ -59,7 +71,7 @@ func command_list_fabrics(cmd *cobra.Command) {
 	to_send := gomat.EncodeIMReadRequest(0, 0x3e, 1)
 	channel.Send(to_send)
 
-	resp, err := channel.Receive()
+	resp, err := gomat.ReceiveReport(&channel)
 	if err != nil {
 		panic(err)
 	}
@@ -90,25 +102,7 @@ func command_list_fabrics(cmd *cobra.Command) {
 		}
 		fmt.Println("---------------------------------")
 	}
-	dict := map[string]string{
-		".0":             "Root",
-		".0.1":           "AttributeReports",
-		".0.1.0":         "AttributeReportIB",
-		".0.1.0.1":       "AttributeData",
-		".0.1.0.1.0":     "Version",
-		".0.1.0.1.1":     "Path",
-		".0.1.0.1.1.2":   "Endpoint",
-		".0.1.0.1.1.3":   "Cluster",
-		".0.1.0.1.1.4":   "Attribute",
-		".0.1.0.1.2":     "Data",
-		".0.1.0.1.2.0":   "Fabrics",
-		".0.1.0.1.2.0.1": "RootPublicKey",
-		".0.1.0.1.2.0.2": "VendorId",
-		".0.1.0.1.2.0.3": "FabricId",
-		".0.1.0.1.2.0.4": "NodeId",
-		".0.1.0.1.2.0.5": "Label",
-	}
-	resp.Tlv.DumpWithDict(0, "", dict)
+	fmt.Print(gomat.FormatMessage(resp))
 }
 
 func command_list_device_types(cmd *cobra.Command) {
@@ -122,7 +116,7 @@ func command_list_device_types(cmd *cobra.Command) {
 	to_send := gomat.EncodeIMReadRequest(0, symbols.CLUSTER_ID_Descriptor, symbols.ATTRIBUTE_ID_Descriptor_DeviceTypeList)
 	channel.Send(to_send)
 
-	resp, err := channel.Receive()
+	resp, err := gomat.ReceiveReport(&channel)
 	if err != nil {
 		panic(err)
 	}
@@ -130,22 +124,7 @@ func command_list_device_types(cmd *cobra.Command) {
 		panic("did not receive report data message")
 	}
 
-	dict := map[string]string{
-		".0":             "Root",
-		".0.1":           "AttributeReports",
-		".0.1.0":         "AttributeReportIB",
-		".0.1.0.1":       "AttributeData",
-		".0.1.0.1.0":     "Version",
-		".0.1.0.1.1":     "Path",
-		".0.1.0.1.1.2":   "Endpoint",
-		".0.1.0.1.1.3":   "Cluster",
-		".0.1.0.1.1.4":   "Attribute",
-		".0.1.0.1.2":     "Data",
-		".0.1.0.1.2.0":   "DeviceType",
-		".0.1.0.1.2.0.0": "DeviceType",
-		".0.1.0.1.2.0.1": "Revision",
-	}
-	resp.Tlv.DumpWithDict(0, "", dict)
+	fmt.Print(gomat.FormatMessage(resp))
 }
 
 func command_list_supported_clusters(cmd *cobra.Command, args []string) {
@@ -163,7 +142,7 @@ func command_list_supported_clusters(cmd *cobra.Command, args []string) {
 	to_send := gomat.EncodeIMReadRequest(uint16(endpoint), symbols.CLUSTER_ID_Descriptor, symbols.ATTRIBUTE_ID_Descriptor_ServerList)
 	channel.Send(to_send)
 
-	resp, err := channel.Receive()
+	resp, err := gomat.ReceiveReport(&channel)
 	if err != nil {
 		panic(err)
 	}
@@ -171,28 +150,7 @@ func command_list_supported_clusters(cmd *cobra.Command, args []string) {
 		panic("did not receive report data message")
 	}
 
-	dict := map[string]string{
-		".0":           "Root",
-		".0.1":         "AttributeReports",
-		".0.1.0":       "AttributeReportIB",
-		".0.1.0.1":     "AttributeData",
-		".0.1.0.1.0":   "Version",
-		".0.1.0.1.1":   "Path",
-		".0.1.0.1.1.2": "Endpoint",
-		".0.1.0.1.1.3": "Cluster",
-		".0.1.0.1.1.4": "Attribute",
-		".0.1.0.1.2":   "Data",
-		".0.1.0.1.2.0": "ClusterId",
-	}
-	resp.Tlv.DumpWithDict(0, "", dict)
-	clusters := resp.Tlv.GetItemRec([]int{1, 0, 1, 2})
-	if clusters == nil {
-		panic("clusters not found")
-	}
-	for _, c := range clusters.GetChild() {
-		name := symbols.ClusterNameMap[c.GetInt()]
-		fmt.Printf("0x%x %s\n", c.GetInt(), name)
-	}
+	fmt.Print(gomat.FormatMessage(resp))
 }
 
 func command_list_interfaces(cmd *cobra.Command, args []string) {
@@ -206,33 +164,14 @@ func command_list_interfaces(cmd *cobra.Command, args []string) {
 	to_send := gomat.EncodeIMReadRequest(0, symbols.CLUSTER_ID_GeneralDiagnostics, symbols.ATTRIBUTE_ID_GeneralDiagnostics_NetworkInterfaces)
 	channel.Send(to_send)
 
-	resp, err := channel.Receive()
+	resp, err := gomat.ReceiveReport(&channel)
 	if err != nil {
 		panic(err)
 	}
 	if resp.ProtocolHeader.Opcode != gomat.INTERACTION_OPCODE_REPORT_DATA {
 		panic("did not receive report data message")
 	}
-	dict := map[string]string{
-		".0":             "Root",
-		".0.1":           "AttributeReports",
-		".0.1.0":         "AttributeReportIB",
-		".0.1.0.1":       "AttributeData",
-		".0.1.0.1.0":     "Version",
-		".0.1.0.1.1":     "Path",
-		".0.1.0.1.1.2":   "Endpoint",
-		".0.1.0.1.1.3":   "Cluster",
-		".0.1.0.1.1.4":   "Attribute",
-		".0.1.0.1.2":     "Data",
-		".0.1.0.1.2.0":   "Interface",
-		".0.1.0.1.2.0.0": "Name",
-		".0.1.0.1.2.0.1": "IsOperational",
-		".0.1.0.1.2.0.4": "HWAddress",
-		".0.1.0.1.2.0.5": "ipv4Address",
-		".0.1.0.1.2.0.6": "ipv6Address",
-		".0.1.0.1.2.0.7": "type",
-	}
-	resp.Tlv.DumpWithDict(0, "", dict)
+	fmt.Print(gomat.FormatMessage(resp))
 }
 
 func command_get_logs(cmd *cobra.Command, args []string) {
@@ -262,7 +201,7 @@ func command_get_logs(cmd *cobra.Command, args []string) {
 		panic("did not receive report data message")
 	}
 
-	resp.Tlv.Dump(1)
+	fmt.Print(gomat.FormatMessage(resp))
 }
 
 func command_open_commissioning(cmd *cobra.Command, args []string) {
@@ -294,105 +233,330 @@ func command_open_commissioning(cmd *cobra.Command, args []string) {
 	tlv.WriteUInt32(3, uint32(iterations)) // iterations
 	tlv.WriteOctetString(4, salt)          // salt
 
-	var exchange uint16 = uint16(rand.Intn(0xffff))
-	to_send := gomat.EncodeIMTimedRequest(exchange, 6000)
-	channel.Send(to_send)
-	resp, err := channel.Receive()
+	// OpenCommissioningWindow must be timed invoke
+	results, err := gomat.Invoke(&channel, gomat.InvokeRequest{
+		Commands: []gomat.Command{{
+			Endpoint: 0,
+			Cluster:  symbols.CLUSTER_ID_AdministratorCommissioning,
+			Command:  symbols.COMMAND_ID_AdministratorCommissioning_OpenCommissioningWindow,
+			Fields:   tlv.Bytes(),
+		}},
+		Timeout: 6000,
+	})
 	if err != nil {
 		panic(err)
 	}
-	if resp.ProtocolHeader.Opcode == gomat.INTERACTION_OPCODE_STATUS_RSP {
-		status := resp.Tlv.GetItemWithTag(0)
-		if status != nil {
-			if status.GetInt() != 0 {
-				panic(fmt.Sprintf("TimedRequest failed with status: %d\n", status.GetInt()))
+
+	err = results[0].Err()
+	var status_error *gomat.StatusError
+	switch {
+	case err == nil:
+		log.Println("open commissioning success")
+	case errors.As(err, &status_error) && status_error.HasClusterStatus:
+		switch status_error.ClusterStatus {
+		case 2:
+			log.Println("failed with busy (2)")
+		case 3:
+			log.Println("failed with pake parameter error (3)")
+		case 4:
+			log.Println("failed with window not open (4)")
+		default:
+			log.Printf("failed with unknown code 0x%x\n", status_error.ClusterStatus)
+		}
+	default:
+		log.Printf("failed: %s\n", err.Error())
+	}
+}
+
+// pathArg parses element of path given on command line, "*" is wildcard.
+func pathArg(arg string) int {
+	if arg == "*" {
+		return gomat.Wildcard
+	}
+	value, _ := strconv.ParseInt(arg, 0, 64)
+	return int(value)
+}
+
+// lookupArg parses element of path given on command line as number or name found by find, "*" is wildcard.
+func lookupArg(arg string, find func(name string) (int, bool)) (int, bool) {
+	if arg == "*" {
+		return gomat.Wildcard, true
+	}
+	if value, err := strconv.ParseInt(arg, 0, 64); err == nil {
+		return int(value), true
+	}
+	return find(arg)
+}
+
+// clusterArg parses cluster given by name or number.
+func clusterArg(arg string) int {
+	cluster, ok := lookupArg(arg, symbols.FindCluster)
+	if !ok {
+		panic(fmt.Sprintf("unknown cluster %s", arg))
+	}
+	return cluster
+}
+
+// attributeArg parses attribute of cluster given by name or number.
+func attributeArg(cluster int, arg string) int {
+	attribute, ok := lookupArg(arg, func(name string) (int, bool) { return symbols.FindAttribute(cluster, name) })
+	if !ok {
+		panic(fmt.Sprintf("unknown attribute %s", arg))
+	}
+	return attribute
+}
+
+// commandArg parses command of cluster given by name or number.
+func commandArg(cluster int, arg string) int {
+	command, ok := lookupArg(arg, func(name string) (int, bool) { return symbols.FindCommand(cluster, name) })
+	if !ok {
+		panic(fmt.Sprintf("unknown command %s", arg))
+	}
+	return command
+}
+
+// eventArg parses event of cluster given by name or number.
+func eventArg(cluster int, arg string) int {
+	event, ok := lookupArg(arg, func(name string) (int, bool) { return symbols.FindEvent(cluster, name) })
+	if !ok {
+		panic(fmt.Sprintf("unknown event %s", arg))
+	}
+	return event
+}
+
+// sortedNames returns names of map sorted alphabetically.
+func sortedNames(names map[int]string) []string {
+	out := []string{}
+	for _, name := range names {
+		out = append(out, name)
+	}
+	sort.Strings(out)
+	return out
+}
+
+// completePath returns shell completion of arguments [endpoint] [cluster] [element], names returns names
+// of elements of cluster (attributes, commands or events).
+func completePath(names func(cluster int) []string) func(*cobra.Command, []string, string) ([]string, cobra.ShellCompDirective) {
+	return func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+		loadClusters(cmd)
+		switch len(args) {
+		case 1:
+			return sortedNames(symbols.ClusterNameMap), cobra.ShellCompDirectiveNoFileComp
+		case 2:
+			if cluster, ok := lookupArg(args[1], symbols.FindCluster); ok {
+				return names(cluster), cobra.ShellCompDirectiveNoFileComp
 			}
-		} else {
-			log.Printf("TimedRequest status parse failed %v\n", resp.Payload)
 		}
-	} else {
-		resp.ProtocolHeader.Dump()
-		panic("unexpected opcode")
+		return nil, cobra.ShellCompDirectiveNoFileComp
+	}
+}
+
+func attributeNames(cluster int) []string {
+	global := map[int]string{}
+	for id, f := range symbols.GlobalAttributes {
+		global[id] = f.Name
 	}
+	return append(sortedNames(symbols.AttributeNameMap[cluster]), sortedNames(global)...)
+}
 
-	to_send = gomat.EncodeIMInvokeRequest(0, symbols.CLUSTER_ID_AdministratorCommissioning, symbols.COMMAND_ID_AdministratorCommissioning_OpenCommissioningWindow, tlv.Bytes(), true, exchange)
-	channel.Send(to_send)
+func commandNames(cluster int) []string {
+	return sortedNames(symbols.CommandNameMap[cluster])
+}
 
-	resp, err = channel.Receive()
+func eventNames(cluster int) []string {
+	return sortedNames(symbols.EventNameMap[cluster])
+}
+
+// loadClusters registers definitions of clusters given by --clusters flag.
+func loadClusters(cmd *cobra.Command) {
+	paths, _ := cmd.Flags().GetStringSlice("clusters")
+	for _, path := range paths {
+		err := symbols.LoadFile(path)
+		if err != nil {
+			panic(err)
+		}
+	}
+}
+
+// printValue prints TLV element in format given by --output flag: dump, json (chip-tool notation) or text (TLV text notation).
+func printValue(item *mattertlv.TlvItem, format string) {
+	switch format {
+	case "json":
+		js, err := mattertlv.TlvToJson(item)
+		if err != nil {
+			panic(err)
+		}
+		fmt.Printf("  %s\n", js)
+	case "text":
+		fmt.Printf("  %s\n", mattertlv.FormatText(item))
+	default:
+		item.Dump(2)
+	}
+}
+
+// parsePayload converts payload given on command line into TLV. Payload is either JSON in chip-tool
+// notation ({"0:UINT": 1}) or TLV text notation ({0: 1u8}).
+func parsePayload(payload string) []byte {
+	var encoded []byte
+	var err error
+	if strings.HasPrefix(strings.TrimSpace(payload), "{") && json.Valid([]byte(payload)) {
+		encoded, err = mattertlv.JsonToTlv([]byte(payload))
+	} else {
+		encoded, err = mattertlv.ParseText(payload)
+	}
 	if err != nil {
 		panic(err)
 	}
+	return encoded
+}
 
-	if resp.ProtocolHeader.Opcode != gomat.INTERACTION_OPCODE_INVOKE_RSP {
-		panic("did not receive report data message")
+// namedJson returns true when payload is JSON which does not use chip-tool notation of keys ("0:UINT").
+// Such payload uses names of fields and is encoded according to data types of cluster.
+func namedJson(payload string) bool {
+	var value any
+	if json.Unmarshal([]byte(payload), &value) != nil {
+		return false
+	}
+	if object, ok := value.(map[string]any); ok {
+		for key := range object {
+			if strings.Contains(key, ":") {
+				return false
+			}
+		}
 	}
+	return true
+}
 
-	final_result := gomat.ParseImInvokeResponse(&resp.Tlv)
-	switch final_result {
-	case 0:
-		log.Println("open commissioning success")
-	case 2:
-		log.Println("failed with busy (2)")
-	case 3:
-		log.Println("failed with pake parameter error (3)")
-	case 4:
-		log.Println("failed with window not open (4)")
-	default:
-		log.Printf("failed with unknown code 0x%x\n", final_result)
+// payloadFields converts payload of command into TLV encoded command fields.
+func payloadFields(cluster, command int, payload string) []byte {
+	if strings.HasPrefix(strings.TrimSpace(payload), "{") && namedJson(payload) {
+		fields, err := gomat.EncodeCommandFields(cluster, command, []byte(payload))
+		if err != nil {
+			panic(err)
+		}
+		return fields
 	}
+	encoded := parsePayload(payload)
+	if len(encoded) < 2 || encoded[0] != mattertlv.CONTAINER_STRUCT {
+		panic("payload of command must be structure")
+	}
+	// fields are members of structure without its start and end
+	return encoded[1 : len(encoded)-1]
 }
 
-func test_subscribe(cmd *cobra.Command, args []string) {
+func command_write(cmd *cobra.Command, args []string) {
+	timed, _ := cmd.Flags().GetUint16("timed")
+	cluster := clusterArg(args[1])
+	attribute := gomat.WriteAttribute{
+		Endpoint:  uint16(pathArg(args[0])),
+		Cluster:   uint32(cluster),
+		Attribute: uint32(attributeArg(cluster, args[2])),
+	}
+	if namedJson(args[3]) && symbols.AttributeType(int(attribute.Cluster), int(attribute.Attribute)) != nil {
+		value, err := gomat.EncodeAttributeValue(int(attribute.Cluster), int(attribute.Attribute), []byte(args[3]))
+		if err != nil {
+			panic(err)
+		}
+		attribute.Value = value
+	} else {
+		attribute.Value = parsePayload(args[3])
+	}
 	fabric := createBasicFabricFromCmd(cmd)
 	channel, err := connectDeviceFromCmd(fabric, cmd)
 	if err != nil {
 		panic(err)
 	}
-
-	endpoint, _ := strconv.ParseInt(args[0], 0, 16)
-	cluster, _ := strconv.ParseInt(args[1], 0, 16)
-	event, _ := strconv.ParseInt(args[2], 0, 16)
-	to_send := gomat.EncodeIMSubscribeRequest(uint16(endpoint), uint32(cluster), uint32(event))
-	channel.Send(to_send)
-
-	resp, err := channel.Receive()
+	defer channel.Close()
+	statuses, err := gomat.Write(&channel, gomat.WriteRequest{
+		Attributes: []gomat.WriteAttribute{attribute},
+		Timeout:    timed,
+	})
 	if err != nil {
 		panic(err)
 	}
-	if resp.ProtocolHeader.Opcode != gomat.INTERACTION_OPCODE_REPORT_DATA {
-		log.Println("unexpected message")
-		resp.ProtocolHeader.Dump()
-	} else {
-		resp.Tlv.DumpWithDict(0, "", report_data_dictionary)
+	for _, s := range statuses {
+		fmt.Printf("endpoint:%d cluster:0x%x attribute:0x%x status:%s\n", s.Endpoint, s.Cluster, s.Attribute, s.Status)
+		if s.HasClusterStatus {
+			fmt.Printf("cluster status: 0x%02x\n", s.ClusterStatus)
+		}
 	}
+}
 
-	sr := gomat.EncodeIMStatusResponse(resp.ProtocolHeader.ExchangeId, 1)
-	channel.Send(sr)
-	for {
-		r, err := channel.Receive()
-		if err != nil {
-			log.Println("it is ok to see timeout on following line")
-			log.Println(err)
-			continue
-		}
-		if r.ProtocolHeader.Opcode == gomat.INTERACTION_OPCODE_SUBSC_RSP {
-			log.Println("subscribe response")
-			continue
-		}
-		if r.ProtocolHeader.Opcode == gomat.INTERACTION_OPCODE_STATUS_RSP {
-			log.Println("status response")
+func command_invoke(cmd *cobra.Command, args []string) {
+	timed, _ := cmd.Flags().GetUint16("timed")
+	output, _ := cmd.Flags().GetString("output")
+	cluster := clusterArg(args[1])
+	command := gomat.Command{
+		Endpoint: uint16(pathArg(args[0])),
+		Cluster:  uint32(cluster),
+		Command:  uint32(commandArg(cluster, args[2])),
+	}
+	if len(args) > 3 {
+		command.Fields = payloadFields(int(command.Cluster), int(command.Command), args[3])
+	}
+	fabric := createBasicFabricFromCmd(cmd)
+	channel, err := connectDeviceFromCmd(fabric, cmd)
+	if err != nil {
+		panic(err)
+	}
+	defer channel.Close()
+	results, err := gomat.Invoke(&channel, gomat.InvokeRequest{
+		Commands: []gomat.Command{command},
+		Timeout:  timed,
+	})
+	if err != nil {
+		panic(err)
+	}
+	for _, r := range results {
+		if r.Fields == nil {
+			fmt.Printf("status: %s\n", r.Status)
+			if r.HasClusterStatus {
+				fmt.Printf("cluster status: 0x%02x\n", r.ClusterStatus)
+			}
 			continue
 		}
-		if r.ProtocolHeader.Opcode == gomat.INTERACTION_OPCODE_REPORT_DATA {
-			fmt.Printf("EVENT:\n")
-			r.Tlv.DumpWithDict(0, "", report_data_dictionary)
-			sr = gomat.EncodeIMStatusResponse(r.ProtocolHeader.ExchangeId, 0)
-			channel.Send(sr)
-		} else {
-			log.Printf("unexpected opcode %x\n", r.ProtocolHeader.Opcode)
-			r.ProtocolHeader.Dump()
-		}
+		fmt.Printf("response command: 0x%x\n", r.Command)
+		printValue(r.Fields, output)
+	}
+}
+
+func test_subscribe(cmd *cobra.Command, args []string) {
+	// ctrl-c terminates subscription
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+	cluster := clusterArg(args[1])
+	event_path := gomat.NewEventPath(pathArg(args[0]), cluster, eventArg(cluster, args[2]))
+	fabric := createBasicFabricFromCmd(cmd)
+	min_interval, _ := cmd.Flags().GetUint16("min-interval")
+	max_interval, _ := cmd.Flags().GetUint16("max-interval")
+
+	event_path.Urgent = true
+	subscription := gomat.Subscription{
+		Request: gomat.SubscribeRequest{
+			Events:      []gomat.EventPath{event_path},
+			MinInterval: min_interval,
+			MaxInterval: max_interval,
+		},
+		Connect: func(ctx context.Context) (gomat.SecureChannel, error) {
+			return connectDeviceFromCmd(fabric, cmd)
+		},
+		OnEstablished: func(subscription_id uint32, max_interval uint16) {
+			log.Printf("subscription %d established, max interval %ds\n", subscription_id, max_interval)
+		},
+		OnEvent: func(e gomat.EventReport) {
+			if err := e.Err(); err != nil {
+				fmt.Printf("EVENT error: %s\n", err.Error())
+				return
+			}
+			fmt.Printf("EVENT endpoint:%d cluster:0x%x event:0x%x number:%d priority:%d\n", e.Endpoint, e.Cluster, e.Event, e.EventNumber, e.Priority)
+			e.Data.Dump(2)
+		},
+		OnError: func(err error) {
+			log.Printf("subscription failed: %s, resubscribing\n", err.Error())
+		},
 	}
+	subscription.Run(ctx)
+	log.Println("subscription cancelled")
 }
 
 func createBasicFabric(id uint64) *gomat.Fabric {
@@ -403,6 +567,7 @@ func createBasicFabric(id uint64) *gomat.Fabric {
 		panic(err)
 	}
 	fabric := gomat.NewFabric(id, cert_manager)
+	fabric.ResumptionStore = gomat.NewFileResumptionStore(basePath)
 	return fabric
 }
 
@@ -415,42 +580,36 @@ func createBasicFabricFromCmd(cmd *cobra.Command) *gomat.Fabric {
 	return createBasicFabric(id)
 }
 
+// connectDeviceFromCmd connects device selected by flags. Device is looked up using mdns unless both ip
+// and transport are given, and TCP is used when device advertises it. --transport udp|tcp overrides advertisement.
 func connectDeviceFromCmd(fabric *gomat.Fabric, cmd *cobra.Command) (gomat.SecureChannel, error) {
 	ip, _ := cmd.Flags().GetString("ip")
 	device_id, _ := cmd.Flags().GetUint64("device-id")
 	controller_id, _ := cmd.Flags().GetUint64("controller-id")
-
-	secure_channel, err := gomat.StartSecureChannel(net.ParseIP(ip), 5540, 55555)
+	transport_name, _ := cmd.Flags().GetString("transport")
+	transport, err := gomat.ParseTransportType(transport_name)
 	if err != nil {
-		panic(err)
+		return gomat.SecureChannel{}, err
+	}
+	if tcp, _ := cmd.Flags().GetBool("tcp"); tcp {
+		transport = gomat.TransportTcp
 	}
-	secure_channel, err = gomat.SigmaExchange(fabric, controller_id, device_id, secure_channel)
-	return secure_channel, err
-}
 
-var report_data_dictionary = map[string]string{
-	".0":           "Root",
-	".0.0":         "SubscriptionID",
-	".0.1":         "AttributeReports",
-	".0.2":         "EventReports",
-	".0.2.0":       "EventReport",
-	".0.2.0.0":     "EventStatus",
-	".0.2.0.1":     "EventData",
-	".0.2.0.1.0":   "Path",
-	".0.2.0.1.0.0": "Node",
-	".0.2.0.1.0.1": "Endpoint",
-	".0.2.0.1.0.2": "Cluster",
-	".0.2.0.1.0.3": "Event",
-	".0.2.0.1.0.4": "Urgent",
-	".0.2.0.1.1":   "EventNumber",
-	".0.2.0.1.2":   "Priority",
-	".0.2.0.1.3":   "EpochTimestamp",
-	".0.2.0.1.4":   "SystemTimestamp",
-	".0.2.0.1.5":   "DeltaEpochTimestamp",
-	".0.2.0.1.6":   "DeltaTimestamp",
-	".0.2.0.1.7":   "Data",
-	".0.3":         "MoreChunkedMessages",
-	".0.4":         "SuppressResponse",
+	if len(ip) == 0 || transport == gomat.TransportAuto {
+		iface, _ := cmd.Flags().GetString("interface")
+		name := fmt.Sprintf("%s-%016X._matter._tcp.local.", strings.ToUpper(hex.EncodeToString(fabric.CompressedFabric())), device_id)
+		for _, device := range discover.DiscoverComissioned(iface, false, name) {
+			if len(ip) > 0 {
+				device.Addrs = []net.IP{net.ParseIP(ip)}
+			}
+			return gomat.ConnectDiscoveredDevice(device, fabric, device_id, controller_id, transport)
+		}
+		if len(ip) == 0 {
+			return gomat.SecureChannel{}, fmt.Errorf("device %s not discovered", name)
+		}
+		log.Printf("device %s not discovered, using udp\n", name)
+	}
+	return gomat.ConnectDeviceWithTransport(net.ParseIP(ip), 5540, fabric, device_id, controller_id, transport)
 }
 
 func main() {
@@ -459,6 +618,14 @@ func main() {
 		Short: "matter manager",
 	}
 	rootCmd.PersistentFlags().StringP("fabric", "f", "0x110", "fabric identifier")
+	rootCmd.PersistentFlags().StringSliceP("clusters", "", nil, "load definitions of additional clusters from xml or json file or directory")
+	rootCmd.PersistentPreRun = func(cmd *cobra.Command, args []string) {
+		loadClusters(cmd)
+	}
+	err := symbols.LoadXml(yeelight_xml)
+	if err != nil {
+		panic(err)
+	}
 
 	var commandCmd = &cobra.Command{
 		Use: "cmd",
@@ -466,6 +633,10 @@ func main() {
 	commandCmd.PersistentFlags().Uint64P("device-id", "", 2, "device id")
 	commandCmd.PersistentFlags().Uint64P("controller-id", "", 9, "controller id")
 	commandCmd.PersistentFlags().StringP("ip", "i", "", "ip address")
+	commandCmd.PersistentFlags().StringP("transport", "", "auto", "transport used to connect device: auto (tcp when device advertises it), udp or tcp")
+	commandCmd.PersistentFlags().StringP("interface", "", "", "network interface used to discover device")
+	commandCmd.PersistentFlags().BoolP("tcp", "", false, "connect using tcp")
+	commandCmd.PersistentFlags().MarkDeprecated("tcp", "use --transport tcp")
 
 	commandCmd.AddCommand(&cobra.Command{
 		Use: "list_fabrics",
@@ -514,18 +685,12 @@ func main() {
 			if err != nil {
 				panic(err)
 			}
-			to_send := gomat.EncodeIMInvokeRequest(1, symbols.CLUSTER_ID_OnOff, symbols.COMMAND_ID_OnOff_Off, []byte{}, false, uint16(rand.Intn(0xffff)))
-			channel.Send(to_send)
-
-			resp, err := channel.Receive()
+			defer channel.Close()
+			err = onoff.Off(context.Background(), &channel, 1)
 			if err != nil {
 				panic(err)
 			}
-			status, err := resp.Tlv.GetIntRec([]int{1, 0, 1, 1, 0})
-			if err != nil {
-				panic(err)
-			}
-			fmt.Printf("result status: %d\n", status)
+			fmt.Println("result status: SUCCESS")
 		},
 	})
 
@@ -537,18 +702,12 @@ func main() {
 			if err != nil {
 				panic(err)
 			}
-			to_send := gomat.EncodeIMInvokeRequest(1, symbols.CLUSTER_ID_OnOff, symbols.COMMAND_ID_OnOff_On, []byte{}, false, uint16(rand.Intn(0xffff)))
-			channel.Send(to_send)
-
-			resp, err := channel.Receive()
+			defer channel.Close()
+			err = onoff.On(context.Background(), &channel, 1)
 			if err != nil {
 				panic(err)
 			}
-			status, err := resp.Tlv.GetIntRec([]int{1, 0, 1, 1, 0})
-			if err != nil {
-				panic(err)
-			}
-			fmt.Printf("result status: %d\n", status)
+			fmt.Println("result status: SUCCESS")
 		},
 	})
 
@@ -572,22 +731,16 @@ func main() {
 			if err != nil {
 				panic(err)
 			}
-			var tlv mattertlv.TLVBuffer
-			tlv.WriteUInt8(0, byte(hue))        // hue
-			tlv.WriteUInt8(1, byte(saturation)) // saturation
-			tlv.WriteUInt8(2, byte(time))       // time
-			to_send := gomat.EncodeIMInvokeRequest(1, 0x300, 6, tlv.Bytes(), false, uint16(rand.Intn(0xffff)))
-			channel.Send(to_send)
-
-			resp, err := channel.Receive()
-			if err != nil {
-				panic(err)
-			}
-			status, err := resp.Tlv.GetIntRec([]int{1, 0, 1, 1, 0})
+			defer channel.Close()
+			err = colorcontrol.MoveToHueAndSaturation(context.Background(), &channel, 1, colorcontrol.MoveToHueAndSaturationRequest{
+				Hue:            uint8(hue),
+				Saturation:     uint8(saturation),
+				TransitionTime: uint16(time),
+			})
 			if err != nil {
 				panic(err)
 			}
-			fmt.Printf("result status: %d\n", status)
+			fmt.Println("result status: SUCCESS")
 		},
 		Args: cobra.MinimumNArgs(3),
 	})
@@ -602,7 +755,7 @@ func main() {
 			if err != nil {
 				panic(err)
 			}
-			var tlv mattertlv.TLVBuffer
+			defer channel.Close()
 			b := bytes.NewBuffer([]byte{})
 			for x := 0; x < 5; x++ {
 				for y := 0; y < 5; y++ {
@@ -612,57 +765,102 @@ func main() {
 					b.WriteByte(byte(y * 40))
 				}
 			}
-			tlv.WriteOctetString(0, b.Bytes())
-			to_send := gomat.EncodeIMInvokeRequest(2, 0x1312fc03, 0x13120007, tlv.Bytes(), false, uint16(rand.Intn(0xffff)))
-			channel.Send(to_send)
-
-			resp, err := channel.Receive()
+			// cluster is defined in yeelight.xml registered in main
+			cluster, _ := symbols.FindCluster("YeelightCube")
+			command, _ := symbols.FindCommand(cluster, "SetPixels")
+			fields, err := gomat.EncodeCommandFields(cluster, command, []byte(fmt.Sprintf(`{"Pixels": "%x"}`, b.Bytes())))
 			if err != nil {
 				panic(err)
 			}
-			status, err := resp.Tlv.GetIntRec([]int{1, 0, 1, 1, 0})
+			results, err := gomat.Invoke(&channel, gomat.InvokeRequest{
+				Commands: []gomat.Command{{Endpoint: 2, Cluster: uint32(cluster), Command: uint32(command), Fields: fields}},
+			})
 			if err != nil {
 				panic(err)
 			}
-			fmt.Printf("result status: %d\n", status)
+			fmt.Printf("result status: %s\n", results[0].Status)
 		},
 	})
 
-	commandCmd.AddCommand(&cobra.Command{
-		Use: "read",
+	readCmd := &cobra.Command{
+		Use:   "read [endpoint] [cluster] [attribute]",
+		Short: "read attributes, cluster and attribute are given by name or id, any of path elements can be * (wildcard)",
+		Example: `read 1 0x6 '*'
+read 1 OnOff OnOff`,
 		Run: func(cmd *cobra.Command, args []string) {
+			cluster := clusterArg(args[1])
+			path := gomat.NewAttributePath(pathArg(args[0]), cluster, attributeArg(cluster, args[2]))
 			fabric := createBasicFabricFromCmd(cmd)
 			channel, err := connectDeviceFromCmd(fabric, cmd)
 			if err != nil {
 				panic(err)
 			}
 
-			endpoint, _ := strconv.ParseInt(args[0], 0, 16)
-			cluster, _ := strconv.ParseInt(args[1], 0, 16)
-			attr, _ := strconv.ParseInt(args[2], 0, 16)
-
-			to_send := gomat.EncodeIMReadRequest(uint16(endpoint), uint32(cluster), uint32(attr))
-			channel.Send(to_send)
-
-			resp, err := channel.Receive()
+			output, _ := cmd.Flags().GetString("output")
+			result, err := gomat.Read(&channel, gomat.ReadRequest{
+				Attributes:     []gomat.AttributePath{path},
+				FabricFiltered: true,
+			})
 			if err != nil {
 				panic(err)
 			}
-			if (resp.ProtocolHeader.ProtocolId == gomat.ProtocolIdInteraction) &&
-				(resp.ProtocolHeader.Opcode == gomat.INTERACTION_OPCODE_REPORT_DATA) {
-				resp.Tlv.Dump(0)
+			for _, a := range result.Attributes {
+				fmt.Printf("endpoint:%d cluster:0x%x attribute:0x%x", a.Endpoint, a.Cluster, a.Attribute)
+				if a.Status != gomat.StatusSuccess {
+					fmt.Printf(" status:%s\n", a.Status)
+					continue
+				}
+				fmt.Printf(" version:%d\n", a.DataVersion)
+				printValue(&a.Value, output)
 			}
 			channel.Close()
 		},
-		Args: cobra.MinimumNArgs(3),
-	})
-
-	commandCmd.AddCommand(&cobra.Command{
-		Use:     "subscribe [endpoint] [cluster] [event]",
-		Example: "subscribe 1 0x101 1",
-		Run:     test_subscribe,
-		Args:    cobra.MinimumNArgs(3),
-	})
+		Args:              cobra.MinimumNArgs(3),
+		ValidArgsFunction: completePath(attributeNames),
+	}
+	readCmd.Flags().StringP("output", "o", "dump", "format of values: dump, json or text")
+	commandCmd.AddCommand(readCmd)
+
+	invokeCmd := &cobra.Command{
+		Use:   "invoke [endpoint] [cluster] [command] [payload]",
+		Short: "invoke command given by name or id, payload is TLV text notation, JSON in chip-tool notation or JSON with names of fields",
+		Example: `invoke 1 0x300 6 '{0: 150u8, 1: 200u8, 2: 10u16, 3: 0u8, 4: 0u8}'
+invoke 1 0x300 6 '{"0:UINT": 150, "1:UINT": 200, "2:UINT": 10, "3:UINT": 0, "4:UINT": 0}'
+invoke 1 ColorControl MoveToHueAndSaturation '{"Hue": 150, "Saturation": 200, "TransitionTime": 10, "OptionsMask": 0, "OptionsOverride": 0}'
+invoke 1 LevelControl MoveToLevel '{"Level": 100, "TransitionTime": 10, "OptionsMask": 0, "OptionsOverride": 0}'`,
+		Run:               command_invoke,
+		Args:              cobra.MinimumNArgs(3),
+		ValidArgsFunction: completePath(commandNames),
+	}
+	invokeCmd.Flags().Uint16P("timed", "", 0, "send as timed request with this timeout in ms")
+	invokeCmd.Flags().StringP("output", "o", "dump", "format of response: dump, json or text")
+	commandCmd.AddCommand(invokeCmd)
+
+	writeCmd := &cobra.Command{
+		Use:   "write [endpoint] [cluster] [attribute] [value]",
+		Short: "write attribute given by name or id, value is TLV text notation, JSON in chip-tool notation or JSON using data type of attribute",
+		Example: `write 1 0x8 0x11 20u8
+write 0 BasicInformation NodeLabel '"kitchen"'
+write 1 0x8 0x11 '{"value:UINT": 20}'`,
+		Run:               command_write,
+		Args:              cobra.MinimumNArgs(4),
+		ValidArgsFunction: completePath(attributeNames),
+	}
+	writeCmd.Flags().Uint16P("timed", "", 0, "send as timed request with this timeout in ms")
+	commandCmd.AddCommand(writeCmd)
+
+	subscribeCmd := &cobra.Command{
+		Use:   "subscribe [endpoint] [cluster] [event]",
+		Short: "subscribe events, cluster and event are given by name or id, any of path elements can be * (wildcard)",
+		Example: `subscribe 1 0x101 1
+subscribe 0 BasicInformation StartUp`,
+		Run:               test_subscribe,
+		Args:              cobra.MinimumNArgs(3),
+		ValidArgsFunction: completePath(eventNames),
+	}
+	subscribeCmd.Flags().Uint16P("min-interval", "", 10, "min interval in seconds")
+	subscribeCmd.Flags().Uint16P("max-interval", "", 50, "max interval in seconds")
+	commandCmd.AddCommand(subscribeCmd)
 
 	rootCmd.AddCommand(commandCmd)
 
@@ -685,7 +883,9 @@ func main() {
 				panic(err)
 			}
 			//commision(fabric, discover_with_qr(qr).addrs[1], 123456)
-			err = gomat.Commission(fabric, net.ParseIP(ip), pinn, controller_id, device_id)
+			ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+			defer stop()
+			err = gomat.CommissionContext(ctx, fabric, net.ParseIP(ip), pinn, controller_id, device_id)
 			if err != nil {
 				panic(err)
 			}
@@ -821,7 +1021,10 @@ func main() {
 			qrtext, _ := cmd.Flags().GetString("qr")
 			devices := discover.DiscoverAllComissionable(device, disable_ipv6)
 			if len(qrtext) > 0 {
-				qr := onboarding_payload.DecodeQrText(qrtext)
+				qr, err := onboarding_payload.DecodeQrText(qrtext)
+				if err != nil {
+					panic(err)
+				}
 				devices = filter_devices(devices, qr)
 			}
 			for _, device := range devices {
@@ -839,7 +1042,10 @@ func main() {
 		Short: "decode text representation of qr code",
 		Run: func(cmd *cobra.Command, args []string) {
 			qrtext := args[0]
-			qr := onboarding_payload.DecodeQrText(qrtext)
+			qr, err := onboarding_payload.DecodeQrText(qrtext)
+			if err != nil {
+				panic(err)
+			}
 			qr.Dump()
 		},
 		Args: cobra.MinimumNArgs(1),
@@ -849,7 +1055,10 @@ func main() {
 		Short: "decode manual pairing code",
 		Run: func(cmd *cobra.Command, args []string) {
 			text := args[0]
-			content := onboarding_payload.DecodeManualPairingCode(text)
+			content, err := onboarding_payload.DecodeManualPairingCode(text)
+			if err != nil {
+				panic(err)
+			}
 			fmt.Printf("passcode: %d\n", content.Passcode)
 			fmt.Printf("discriminator4: %d\n", content.Discriminator4)
 		},
@@ -21,6 +21,7 @@ type DiscoveredDevice struct {
 	Host      string
 	Type      DiscoveredType
 	Addrs     []net.IP
+	Port      int
 	PH        string
 	CM        string
 	VP        string
@@ -28,6 +29,15 @@ type DiscoveredDevice struct {
 	ProductId int
 	D         string
 	DN        string
+	SII       int // session idle interval in milliseconds, 0 when not advertised
+	SAI       int // session active interval in milliseconds, 0 when not advertised
+	SAT       int // session active threshold in milliseconds, 0 when not advertised
+	T         int // TCP support bitmap (T txt key), 0 when not advertised
+}
+
+// SupportsTcp returns true when device advertises that it accepts TCP connections.
+func (d DiscoveredDevice) SupportsTcp() bool {
+	return (d.T & 4) != 0
 }
 
 func (d DiscoveredDevice) Dump() {
@@ -35,6 +45,15 @@ func (d DiscoveredDevice) Dump() {
 	fmt.Printf("host: %s\n", d.Host)
 	fmt.Printf("DN:   %s\n", d.DN)
 	fmt.Printf("addreses: %v\n", d.Addrs)
+	if d.Port != 0 {
+		fmt.Printf("port: %d\n", d.Port)
+	}
+	if d.SII != 0 || d.SAI != 0 {
+		fmt.Printf("SII: %d SAI: %d SAT: %d\n", d.SII, d.SAI, d.SAT)
+	}
+	if d.T != 0 {
+		fmt.Printf("T:    %d (tcp server: %t)\n", d.T, d.SupportsTcp())
+	}
 	if d.Type != DiscoveredTypeCommissioned {
 		fmt.Printf("PH: %s\n", d.PH)
 		fmt.Printf("CM: %s\n", d.CM)
@@ -58,6 +77,28 @@ func parseVP(vp string) (int, int) {
 	return vid, pid
 }
 
+// parseNumericTxt extracts retransmission parameters and TCP support advertised in txt record.
+func parseNumericTxt(txt string, dev *DiscoveredDevice) {
+	key, value, found := strings.Cut(txt, "=")
+	if !found {
+		return
+	}
+	number, err := strconv.Atoi(value)
+	if err != nil {
+		return
+	}
+	switch key {
+	case "SII":
+		dev.SII = number
+	case "SAI":
+		dev.SAI = number
+	case "SAT":
+		dev.SAT = number
+	case "T":
+		dev.T = number
+	}
+}
+
 func Discover(iface string) ([]DiscoveredDevice, error) {
 	entriesCh := make(chan *mdns.ServiceEntry, 4)
 	defer close(entriesCh)
@@ -79,6 +120,7 @@ func Discover(iface string) ([]DiscoveredDevice, error) {
 				Name:  entry.Name,
 				Host:  entry.Host,
 				Addrs: addrs,
+				Port:  entry.Port,
 			}
 			for _, s := range entry.InfoFields {
 				if strings.HasPrefix(s, "PH=") {
@@ -97,6 +139,7 @@ func Discover(iface string) ([]DiscoveredDevice, error) {
 				if strings.HasPrefix(s, "DN=") {
 					dev.DN = s[3:]
 				}
+				parseNumericTxt(s, &dev)
 			}
 			devices = append(devices, dev)
 		}
@@ -139,6 +182,7 @@ func Discover2(iface string, service string, disableipv6 bool) (map[string]Disco
 				Name:  entry.Name,
 				Host:  entry.Host,
 				Addrs: addrs,
+				Port:  entry.Port,
 			}
 			for _, s := range entry.InfoFields {
 				if strings.HasPrefix(s, "PH=") {
@@ -157,6 +201,7 @@ func Discover2(iface string, service string, disableipv6 bool) (map[string]Disco
 				if strings.HasPrefix(s, "DN=") {
 					dev.DN = s[3:]
 				}
+				parseNumericTxt(s, &dev)
 			}
 			devices[entry.Host] = dev
 		}
@@ -8,18 +8,19 @@
 package main
 
 import (
+	"context"
 	"math/rand"
 	"net"
 	"os"
 
 	"github.com/finnigja/gomat"
-	"github.com/finnigja/gomat/mattertlv"
+	"github.com/finnigja/gomat/clusters/colorcontrol"
 	"github.com/finnigja/gomat/symbols"
 )
 
 func bootstrap_ca(fabric_id, admin_user uint64) {
 	os.Mkdir("pem", 0700)
-	cm := gomat.NewFileCertManager(fabric_id)
+	cm := gomat.NewFileCertManager(fabric_id, "pem")
 	cm.BootstrapCa()
 	cm.Load()
 	if err := cm.CreateUser(admin_user); err != nil {
@@ -28,7 +29,7 @@ func bootstrap_ca(fabric_id, admin_user uint64) {
 }
 
 func loadFabric(fabric_id uint64) *gomat.Fabric {
-	cm := gomat.NewFileCertManager(fabric_id)
+	cm := gomat.NewFileCertManager(fabric_id, "pem")
 	cm.Load()
 	return gomat.NewFabric(fabric_id, cm)
 }
@@ -64,32 +65,16 @@ func sendOnCommand(secure_channel *gomat.SecureChannel) {
 	}
 }
 
+// sendColorCommand uses typed package generated for color control cluster instead of encoding TLV by hand
 func sendColorCommand(secure_channel *gomat.SecureChannel) {
-	var tlv mattertlv.TLVBuffer
-	tlv.WriteUInt8(0, 100) // hue
-	tlv.WriteUInt8(1, 200) // saturation
-	tlv.WriteUInt8(2, 10)  // time
-	color_command := gomat.EncodeIMInvokeRequest(
-		1,                               // endpoint
-		symbols.CLUSTER_ID_ColorControl, // color control cluster
-		symbols.COMMAND_ID_ColorControl_MoveToHueAndSaturation,
-		tlv.Bytes(),
-		false, uint16(rand.Uint32()))
-
-	secure_channel.Send(color_command)
-
-	// process command response
-	response, err := secure_channel.Receive()
+	err := colorcontrol.MoveToHueAndSaturation(context.Background(), secure_channel, 1, colorcontrol.MoveToHueAndSaturationRequest{
+		Hue:            100,
+		Saturation:     200,
+		TransitionTime: 10,
+	})
 	if err != nil {
 		panic(err)
 	}
-	if response.ProtocolHeader.Opcode != gomat.INTERACTION_OPCODE_INVOKE_RSP {
-		panic("unexpected message")
-	}
-	if gomat.ParseImInvokeResponse(&response.Tlv) != 0 {
-		response.Tlv.Dump(0)
-		panic("response was not OK")
-	}
 }
 
 func main() {
@@ -0,0 +1,263 @@
+package gomat
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"log"
+	"sync"
+	"time"
+)
+
+// UnsolicitedHandler is called for first message of exchange initiated by remote node.
+// Handler runs in own goroutine and can use exchange to respond or to receive further messages.
+type UnsolicitedHandler func(exchange *Exchange, msg DecodedGeneric)
+
+type handlerKey struct {
+	protocol ProtocolId
+	opcode   Opcode
+}
+
+// ExchangeManager multiplexes concurrent exchanges over one secure channel.
+// It runs background reader which delivers every incoming message to its exchange
+// using exchange id and initiator flag. Messages which start new exchange
+// are passed to handler registered for their protocol and opcode.
+// Once manager is created, SecureChannel.Receive must not be used.
+type ExchangeManager struct {
+	channel       SecureChannel
+	mutex         sync.Mutex
+	exchanges     map[exchangeKey]*Exchange
+	handlers      map[handlerKey]UnsolicitedHandler
+	next_exchange uint16
+	done          chan struct{}
+	err           error
+}
+
+// Exchange is context of one exchange - request/response sequence between two nodes.
+type Exchange struct {
+	manager *ExchangeManager
+	key     exchangeKey
+	queue   []DecodedGeneric
+	notify  chan struct{}
+	closed  bool
+}
+
+// Messenger sends and receives messages of one conversation.
+// It is implemented by SecureChannel and Exchange.
+type Messenger interface {
+	Send(data []byte) error
+	Receive() (DecodedGeneric, error)
+	ReceiveContext(ctx context.Context) (DecodedGeneric, error)
+}
+
+// NewExchangeManager creates exchange manager for established secure channel and starts background reader.
+// Manager answers MsgCounterSyncReq of peer unless other handler is registered for it.
+func NewExchangeManager(channel SecureChannel) *ExchangeManager {
+	em := &ExchangeManager{
+		channel:       channel,
+		exchanges:     map[exchangeKey]*Exchange{},
+		handlers:      map[handlerKey]UnsolicitedHandler{},
+		next_exchange: uint16(randomIntn(0xffff)),
+		done:          make(chan struct{}),
+	}
+	em.handlers[handlerKey{protocol: ProtocolIdSecureChannel, opcode: SEC_CHAN_OPCODE_MSG_COUNTER_SYNC_REQ}] = respondCounterSync
+	go em.reader()
+	return em
+}
+
+// Channel returns secure channel used by manager.
+func (em *ExchangeManager) Channel() *SecureChannel {
+	return &em.channel
+}
+
+// RegisterHandler registers handler for exchanges initiated by remote node
+// with message of specified protocol and opcode.
+func (em *ExchangeManager) RegisterHandler(protocol ProtocolId, opcode Opcode, handler UnsolicitedHandler) {
+	em.mutex.Lock()
+	defer em.mutex.Unlock()
+	em.handlers[handlerKey{protocol: protocol, opcode: opcode}] = handler
+}
+
+// UnregisterHandler removes handler registered for protocol and opcode.
+// Following messages which start new exchange with them are dropped.
+func (em *ExchangeManager) UnregisterHandler(protocol ProtocolId, opcode Opcode) {
+	em.mutex.Lock()
+	defer em.mutex.Unlock()
+	delete(em.handlers, handlerKey{protocol: protocol, opcode: opcode})
+}
+
+// NewExchange allocates new exchange initiated by local node.
+func (em *ExchangeManager) NewExchange() *Exchange {
+	em.mutex.Lock()
+	defer em.mutex.Unlock()
+	for {
+		em.next_exchange++
+		key := exchangeKey{id: em.next_exchange, initiator: true}
+		if _, used := em.exchanges[key]; used {
+			continue
+		}
+		return em.addExchange(key)
+	}
+}
+
+func (em *ExchangeManager) addExchange(key exchangeKey) *Exchange {
+	ex := &Exchange{
+		manager: em,
+		key:     key,
+		notify:  make(chan struct{}, 1),
+	}
+	em.exchanges[key] = ex
+	return ex
+}
+
+// Close stops background reader and closes secure channel.
+func (em *ExchangeManager) Close() {
+	em.channel.Close()
+	<-em.done
+}
+
+// Done returns channel which is closed when background reader terminates.
+func (em *ExchangeManager) Done() <-chan struct{} {
+	return em.done
+}
+
+// Err returns error which terminated background reader.
+func (em *ExchangeManager) Err() error {
+	em.mutex.Lock()
+	defer em.mutex.Unlock()
+	return em.err
+}
+
+func (em *ExchangeManager) reader() {
+	defer close(em.done)
+	for {
+		em.channel.transport.SetDeadline(time.Time{})
+		data, err := em.channel.transport.Receive()
+		if err != nil {
+			em.mutex.Lock()
+			em.err = err
+			em.mutex.Unlock()
+			return
+		}
+		msg, err := em.channel.decode(data)
+		if err != nil {
+			log.Printf("can't decode received message: %s\n", err.Error())
+			continue
+		}
+		if !em.channel.processReceived(&msg) {
+			continue
+		}
+		em.dispatch(msg)
+	}
+}
+
+func (em *ExchangeManager) dispatch(msg DecodedGeneric) {
+	prot := msg.ProtocolHeader
+	key := exchangeKey{
+		id:        prot.ExchangeId,
+		initiator: (prot.exchangeFlags & exchangeFlagsInitiator) == 0,
+	}
+	em.mutex.Lock()
+	ex, ok := em.exchanges[key]
+	if ok {
+		ex.deliver(msg)
+		em.mutex.Unlock()
+		return
+	}
+	if key.initiator {
+		// response within exchange which was already closed
+		em.mutex.Unlock()
+		return
+	}
+	handler, ok := em.handlers[handlerKey{protocol: prot.ProtocolId, opcode: prot.Opcode}]
+	if !ok {
+		em.mutex.Unlock()
+		log.Printf("unsolicited message without handler protocol:%d opcode:0x%x\n", prot.ProtocolId, prot.Opcode)
+		return
+	}
+	ex = em.addExchange(key)
+	em.mutex.Unlock()
+	go handler(ex, msg)
+}
+
+// deliver queues message for exchange. Manager mutex must be held.
+func (ex *Exchange) deliver(msg DecodedGeneric) {
+	ex.queue = append(ex.queue, msg)
+	select {
+	case ex.notify <- struct{}{}:
+	default:
+	}
+}
+
+// Id returns exchange identifier.
+func (ex *Exchange) Id() uint16 {
+	return ex.key.id
+}
+
+// Send sends Protocol Message within exchange.
+// Exchange id and initiator flag of encoded message are replaced by values of this exchange.
+func (ex *Exchange) Send(data []byte) error {
+	var prot ProtocolMessageHeader
+	buffer := bytes.NewBuffer(data)
+	prot.Decode(buffer)
+	prot.ExchangeId = ex.key.id
+	if ex.key.initiator {
+		prot.exchangeFlags |= exchangeFlagsInitiator
+	} else {
+		prot.exchangeFlags &^= exchangeFlagsInitiator
+	}
+	var out bytes.Buffer
+	prot.Encode(&out)
+	out.Write(buffer.Bytes())
+	return ex.manager.channel.Send(out.Bytes())
+}
+
+// Receive waits for next message of exchange.
+func (ex *Exchange) Receive() (DecodedGeneric, error) {
+	return ex.ReceiveContext(context.Background())
+}
+
+// ReceiveContext is Receive which can be cancelled using ctx.
+// It waits until deadline of ctx or, when ctx does not have deadline, for time derived
+// from MRP parameters of remote node.
+func (ex *Exchange) ReceiveContext(ctx context.Context) (DecodedGeneric, error) {
+	em := ex.manager
+	timer := time.NewTimer(time.Until(em.channel.receiveDeadline(ctx)))
+	defer timer.Stop()
+	for {
+		em.mutex.Lock()
+		if len(ex.queue) > 0 {
+			msg := ex.queue[0]
+			ex.queue = ex.queue[1:]
+			em.mutex.Unlock()
+			return msg, nil
+		}
+		closed := ex.closed
+		em.mutex.Unlock()
+		if closed {
+			return DecodedGeneric{}, fmt.Errorf("exchange %d closed", ex.key.id)
+		}
+		select {
+		case <-ex.notify:
+		case <-em.done:
+			return DecodedGeneric{}, fmt.Errorf("exchange manager stopped: %w", em.Err())
+		case <-ctx.Done():
+			return DecodedGeneric{}, ctx.Err()
+		case <-timer.C:
+			return DecodedGeneric{}, em.channel.timeoutError(fmt.Errorf("exchange %d: timeout", ex.key.id))
+		}
+	}
+}
+
+// Close releases exchange. Messages received later within this exchange are dropped.
+func (ex *Exchange) Close() {
+	em := ex.manager
+	em.mutex.Lock()
+	defer em.mutex.Unlock()
+	ex.closed = true
+	delete(em.exchanges, ex.key)
+	select {
+	case ex.notify <- struct{}{}:
+	default:
+	}
+}
@@ -0,0 +1,198 @@
+package gomat
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+)
+
+func newManagerPair() (*ExchangeManager, *ExchangeManager) {
+	a, b := NewMemoryTransportPair(LinkConditions{})
+	return NewExchangeManager(newSecureChannel(a, fastMrp())), NewExchangeManager(newSecureChannel(b, fastMrp()))
+}
+
+func testMessage(exchange uint16, flags byte, protocol ProtocolId, opcode Opcode) DecodedGeneric {
+	var msg DecodedGeneric
+	msg.ProtocolHeader = ProtocolMessageHeader{
+		exchangeFlags: flags,
+		Opcode:        opcode,
+		ExchangeId:    exchange,
+		ProtocolId:    protocol,
+	}
+	return msg
+}
+
+func exchangeCount(em *ExchangeManager) int {
+	em.mutex.Lock()
+	defer em.mutex.Unlock()
+	return len(em.exchanges)
+}
+
+func TestExchangeAllocation(t *testing.T) {
+	em, peer := newManagerPair()
+	defer em.Close()
+	defer peer.Close()
+
+	seen := map[uint16]bool{}
+	for n := 0; n < 100; n++ {
+		ex := em.NewExchange()
+		if seen[ex.Id()] {
+			t.Fatalf("exchange id %d allocated twice", ex.Id())
+		}
+		seen[ex.Id()] = true
+		if !ex.key.initiator {
+			t.Fatalf("local exchange without initiator flag")
+		}
+	}
+	if exchangeCount(em) != 100 {
+		t.Fatalf("manager holds %d exchanges, want 100", exchangeCount(em))
+	}
+
+	// id used by exchange initiated by peer does not block same id of local exchange
+	em.mutex.Lock()
+	em.next_exchange = 500
+	em.addExchange(exchangeKey{id: 501, initiator: false})
+	em.addExchange(exchangeKey{id: 502, initiator: true})
+	em.mutex.Unlock()
+	if ex := em.NewExchange(); ex.Id() != 501 {
+		t.Fatalf("allocated exchange %d, want 501", ex.Id())
+	}
+	if ex := em.NewExchange(); ex.Id() != 503 {
+		t.Fatalf("allocated exchange %d, want 503", ex.Id())
+	}
+}
+
+func TestExchangeDispatch(t *testing.T) {
+	em, peer := newManagerPair()
+	defer em.Close()
+	defer peer.Close()
+
+	handled := make(chan DecodedGeneric, 1)
+	peer.RegisterHandler(ProtocolIdInteraction, INTERACTION_OPCODE_INVOKE_REQ, func(ex *Exchange, msg DecodedGeneric) {
+		handled <- msg
+		ex.Send(EncodeIMStatusResponse(0, 0))
+		ex.Close()
+	})
+
+	ex := em.NewExchange()
+	defer ex.Close()
+	ex.Send(EncodeIMInvokeRequest(1, 6, 2, []byte{}, false, 0))
+	select {
+	case msg := <-handled:
+		if msg.ProtocolHeader.ExchangeId != ex.Id() {
+			t.Fatalf("handler got exchange %d, want %d", msg.ProtocolHeader.ExchangeId, ex.Id())
+		}
+	case <-time.After(time.Second):
+		t.Fatalf("handler was not called")
+	}
+	resp, err := ex.Receive()
+	if err != nil {
+		t.Fatalf("response not received %s", err.Error())
+	}
+	if resp.ProtocolHeader.Opcode != INTERACTION_OPCODE_STATUS_RSP {
+		t.Fatalf("unexpected opcode 0x%x", resp.ProtocolHeader.Opcode)
+	}
+}
+
+func TestExchangeDispatchToExisting(t *testing.T) {
+	em, peer := newManagerPair()
+	defer em.Close()
+	defer peer.Close()
+
+	called := false
+	em.RegisterHandler(ProtocolIdInteraction, INTERACTION_OPCODE_REPORT_DATA, func(ex *Exchange, msg DecodedGeneric) {
+		called = true
+	})
+	ex := em.NewExchange()
+	// message without initiator flag belongs to exchange initiated by local node
+	em.dispatch(testMessage(ex.Id(), 0, ProtocolIdInteraction, INTERACTION_OPCODE_REPORT_DATA))
+	msg, err := ex.Receive()
+	if err != nil {
+		t.Fatalf("message not delivered %s", err.Error())
+	}
+	if msg.ProtocolHeader.Opcode != INTERACTION_OPCODE_REPORT_DATA {
+		t.Fatalf("unexpected opcode 0x%x", msg.ProtocolHeader.Opcode)
+	}
+	if called {
+		t.Fatalf("handler called for message of existing exchange")
+	}
+}
+
+func TestExchangeUnsolicitedDrop(t *testing.T) {
+	em, peer := newManagerPair()
+	defer em.Close()
+	defer peer.Close()
+
+	// no handler for opcode
+	em.dispatch(testMessage(77, exchangeFlagsInitiator, ProtocolIdInteraction, INTERACTION_OPCODE_WRITE_REQ))
+	// response within exchange which local node does not know
+	em.dispatch(testMessage(78, 0, ProtocolIdInteraction, INTERACTION_OPCODE_WRITE_RSP))
+	if exchangeCount(em) != 0 {
+		t.Fatalf("exchange created for dropped message")
+	}
+
+	handled := make(chan *Exchange, 1)
+	em.RegisterHandler(ProtocolIdInteraction, INTERACTION_OPCODE_WRITE_REQ, func(ex *Exchange, msg DecodedGeneric) {
+		handled <- ex
+	})
+	em.dispatch(testMessage(77, exchangeFlagsInitiator, ProtocolIdInteraction, INTERACTION_OPCODE_WRITE_REQ))
+	select {
+	case ex := <-handled:
+		if ex.key.initiator || ex.Id() != 77 {
+			t.Fatalf("unexpected exchange %v", ex.key)
+		}
+	case <-time.After(time.Second):
+		t.Fatalf("handler was not called")
+	}
+	if exchangeCount(em) != 1 {
+		t.Fatalf("exchange of handler was not registered")
+	}
+}
+
+func TestExchangeClose(t *testing.T) {
+	em, peer := newManagerPair()
+	defer em.Close()
+	defer peer.Close()
+
+	ex := em.NewExchange()
+	result := make(chan error, 1)
+	go func() {
+		_, err := ex.ReceiveContext(context.Background())
+		result <- err
+	}()
+	time.Sleep(10 * time.Millisecond)
+	ex.Close()
+	select {
+	case err := <-result:
+		if err == nil || !strings.Contains(err.Error(), "closed") {
+			t.Fatalf("unexpected result of receive %v", err)
+		}
+	case <-time.After(time.Second):
+		t.Fatalf("receive was not interrupted by close")
+	}
+	if exchangeCount(em) != 0 {
+		t.Fatalf("closed exchange is still registered")
+	}
+	em.dispatch(testMessage(ex.Id(), 0, ProtocolIdInteraction, INTERACTION_OPCODE_STATUS_RSP))
+	if exchangeCount(em) != 0 {
+		t.Fatalf("message of closed exchange created exchange")
+	}
+}
+
+func TestExchangeManagerClose(t *testing.T) {
+	em, peer := newManagerPair()
+	defer peer.Close()
+
+	ex := em.NewExchange()
+	em.Close()
+	select {
+	case <-em.Done():
+	default:
+		t.Fatalf("reader still running")
+	}
+	_, err := ex.Receive()
+	if err == nil {
+		t.Fatalf("receive succeeded after manager was closed")
+	}
+}
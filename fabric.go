@@ -11,9 +11,11 @@ import (
 
 // Fabric structure represents matter Fabric.
 // Its main parameters are Id of fabric and certificate manager.
+// When ResumptionStore is set, CASE sessions are resumed using state of previous sessions.
 type Fabric struct {
 	id                 uint64
 	CertificateManager CertificateManager
+	ResumptionStore    ResumptionStore
 	ipk                []byte
 }
 
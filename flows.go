@@ -1,32 +1,43 @@
 package gomat
 
 import (
+	"context"
 	"crypto/ecdh"
 	"crypto/ecdsa"
-	"crypto/rand"
 	"crypto/x509"
 	"fmt"
 	"log"
-	randm "math/rand"
 	"net"
 
+	"github.com/finnigja/gomat/discover"
 	"github.com/finnigja/gomat/mattertlv"
 )
 
 // Spake2pExchange establishes secure session using PASE (Passcode-Authenticated Session Establishment).
 // This uses SPAKE2+ protocol
-func Spake2pExchange(pin int, udp *udpChannel) (SecureChannel, error) {
-	exchange := uint16(randm.Intn(0xffff))
-	secure_channel := SecureChannel{
-		Udp:     udp,
-		session: 0,
-		Counter: uint32(randm.Intn(0xffffffff)),
-	}
+func Spake2pExchange(pin int, channel Transport) (SecureChannel, error) {
+	return Spake2pExchangeContext(context.Background(), pin, channel)
+}
 
-	pbkdf_request := pBKDFParamRequest(exchange)
+// Spake2pExchangeContext is Spake2pExchange which can be cancelled and limited by deadline of ctx.
+func Spake2pExchangeContext(ctx context.Context, pin int, channel Transport) (SecureChannel, error) {
+	exchange := uint16(randomIntn(0xffff))
+	secure_channel := newSecureChannel(channel, DefaultMrpParameters())
+	unsecured_state := secure_channel.state
+	defer unsecured_state.stop()
+
+	session_channel, local_session := openSessionChannel(channel)
+	established := false
+	defer func() {
+		if !established && session_channel != channel {
+			session_channel.Close()
+		}
+	}()
+
+	pbkdf_request := pBKDFParamRequest(exchange, local_session)
 	secure_channel.Send(pbkdf_request)
 
-	pbkdf_responseS, err := secure_channel.Receive()
+	pbkdf_responseS, err := secure_channel.ReceiveContext(ctx)
 	if err != nil {
 		return SecureChannel{}, fmt.Errorf("pbkdf response not received: %s", err.Error())
 	}
@@ -42,6 +53,8 @@ func Spake2pExchange(pin int, udp *udpChannel) (SecureChannel, error) {
 	if err != nil {
 		return SecureChannel{}, fmt.Errorf("can't get pbkdf_response_session")
 	}
+	mrp_params := parseSessionParameters(pbkdf_responseS.Tlv.GetItemWithTag(5), secure_channel.MrpParameters())
+	secure_channel.SetMrpParameters(mrp_params)
 
 	sctx := NewSpaceCtx()
 	sctx.Gen_w(pin, pbkdf_response_salt, int(pbkdf_response_iterations))
@@ -51,7 +64,7 @@ func Spake2pExchange(pin int, udp *udpChannel) (SecureChannel, error) {
 	pake1 := pake1ParamRequest(exchange, sctx.X.As_bytes())
 	secure_channel.Send(pake1)
 
-	pake2s, err := secure_channel.Receive()
+	pake2s, err := secure_channel.ReceiveContext(ctx)
 	if err != nil {
 		return SecureChannel{}, fmt.Errorf("pake2 not received: %s", err.Error())
 	}
@@ -74,87 +87,167 @@ func Spake2pExchange(pin int, udp *udpChannel) (SecureChannel, error) {
 	pake3 := pake3ParamRequest(exchange, sctx.cA)
 	secure_channel.Send(pake3)
 
-	status_report, err := secure_channel.Receive()
+	status_report, err := secure_channel.ReceiveContext(ctx)
 	if err != nil {
 		return SecureChannel{}, err
 	}
-	if status_report.StatusReport.ProtocolCode != 0 {
-		return SecureChannel{}, fmt.Errorf("pake3 is not success code: %d", status_report.StatusReport.ProtocolCode)
+	if err := status_report.StatusReport.Err(); err != nil {
+		return SecureChannel{}, fmt.Errorf("pake3 failed: %w", err)
 	}
+	secure_channel.flushAcks()
 
 	secure_channel = SecureChannel{
-		Udp:         udp,
+		transport:   session_channel,
 		decrypt_key: sctx.decrypt_key,
 		encrypt_key: sctx.encrypt_key,
 		remote_node: []byte{0, 0, 0, 0, 0, 0, 0, 0},
 		local_node:  []byte{0, 0, 0, 0, 0, 0, 0, 0},
 		session:     int(pbkdf_response_session),
+		state:       newChannelState(mrp_params),
 	}
+	registerSession(session_channel, local_session, secure_channel)
+	established = true
 
 	return secure_channel, nil
 }
 
 // SigmaExhange establishes secure session using CASE (Certificate Authenticated Session Establishment)
 func SigmaExchange(fabric *Fabric, controller_id uint64, device_id uint64, secure_channel SecureChannel) (SecureChannel, error) {
+	return SigmaExchangeContext(context.Background(), fabric, controller_id, device_id, secure_channel)
+}
 
-	controller_privkey, _ := ecdh.P256().GenerateKey(rand.Reader)
+// SigmaExchangeContext is SigmaExchange which can be cancelled and limited by deadline of ctx.
+func SigmaExchangeContext(ctx context.Context, fabric *Fabric, controller_id uint64, device_id uint64, secure_channel SecureChannel) (SecureChannel, error) {
+
+	controller_privkey, _ := ecdh.P256().GenerateKey(randReader)
+	unsecured_channel := secure_channel.transport
+	session_channel, local_session := openSessionChannel(unsecured_channel)
+	established := false
+	defer func() {
+		if !established && session_channel != unsecured_channel {
+			session_channel.Close()
+		}
+	}()
 	sigma_context := sigmaContext{
 		session_privkey: controller_privkey,
-		exchange:        uint16(randm.Intn(0xffff)),
+		exchange:        uint16(randomIntn(0xffff)),
+		local_session:   local_session,
+	}
+	store := fabric.ResumptionStore
+	if store != nil {
+		if record, ok := store.LoadResumption(fabric.id, controller_id, device_id); ok {
+			sigma_context.resumption = &record
+		}
+	}
+	err := sigma_context.genSigma1(fabric, device_id)
+	if err != nil {
+		return SecureChannel{}, err
 	}
-	sigma_context.genSigma1(fabric, device_id)
 	sigma1 := genSigma1Req2(sigma_context.sigma1payload, sigma_context.exchange)
 	secure_channel.Send(sigma1)
 
-	var err error
-	sigma_context.sigma2dec, err = secure_channel.Receive()
+	sigma_context.sigma2dec, err = secure_channel.ReceiveContext(ctx)
 	if err != nil {
 		return SecureChannel{}, err
 	}
 	if (sigma_context.sigma2dec.ProtocolHeader.ProtocolId == ProtocolIdSecureChannel) &&
 		(sigma_context.sigma2dec.ProtocolHeader.Opcode == SEC_CHAN_OPCODE_STATUS_REP) {
-		return SecureChannel{}, fmt.Errorf("sigma2 not received. status: %x %x", sigma_context.sigma2dec.StatusReport.GeneralCode,
-			sigma_context.sigma2dec.StatusReport.ProtocolCode)
-	}
-	if sigma_context.sigma2dec.ProtocolHeader.Opcode != 0x31 {
+		err = sigma_context.sigma2dec.StatusReport.Err()
+		if err == nil {
+			err = fmt.Errorf("unexpected success status report")
+		}
+		return SecureChannel{}, fmt.Errorf("sigma2 not received: %w", err)
+	}
+	var mrp_params MrpParameters
+	switch {
+	case sigma_context.sigma2dec.ProtocolHeader.Opcode == SEC_CHAN_OPCODE_SIGMA2_RESUME && sigma_context.resumption != nil:
+		// device accepted resumption of previous session
+		err = sigma_context.sigma2Resume(sigma_context.sigma2dec)
+		if err != nil {
+			return SecureChannel{}, err
+		}
+		mrp_params = parseSessionParameters(sigma_context.sigma2dec.Tlv.GetItemWithTag(4), secure_channel.MrpParameters())
+		secure_channel.Send(statusReportGen(StatusReportElements{}, sigma_context.exchange))
+		err = secure_channel.awaitAcks(ctx)
+		if err != nil {
+			return SecureChannel{}, err
+		}
+	case sigma_context.sigma2dec.ProtocolHeader.Opcode == SEC_CHAN_OPCODE_SIGMA2:
+		mrp_params = parseSessionParameters(sigma_context.sigma2dec.Tlv.GetItemWithTag(5), secure_channel.MrpParameters())
+		secure_channel.SetMrpParameters(mrp_params)
+		err = sigmaFull(ctx, fabric, controller_id, &sigma_context, secure_channel)
+		if err != nil {
+			return SecureChannel{}, err
+		}
+	default:
 		return SecureChannel{}, fmt.Errorf("sigma2 not received")
 	}
+	if store != nil && sigma_context.resumption_id != nil {
+		record := ResumptionRecord{
+			ResumptionId: sigma_context.resumption_id,
+			SharedSecret: sigma_context.shared_secret,
+		}
+		if err := store.StoreResumption(fabric.id, controller_id, device_id, record); err != nil {
+			log.Printf("can't store resumption state: %s\n", err.Error())
+		}
+	} else if store != nil && sigma_context.resumption != nil {
+		// device did not accept old state and new one is not known
+		store.DeleteResumption(fabric.id, controller_id, device_id)
+	}
+	secure_channel.flushAcks()
+	secure_channel.state.stop()
 
+	secure_channel = SecureChannel{
+		transport:   session_channel,
+		decrypt_key: sigma_context.r2ikey,
+		encrypt_key: sigma_context.i2rkey,
+		remote_node: id_to_bytes(device_id),
+		local_node:  id_to_bytes(controller_id),
+		session:     sigma_context.session,
+		state:       newChannelState(mrp_params),
+	}
+	registerSession(session_channel, local_session, secure_channel)
+	established = true
+	return secure_channel, nil
+}
+
+// sigmaFull completes CASE using certificates after Sigma2 was received.
+func sigmaFull(ctx context.Context, fabric *Fabric, controller_id uint64, sigma_context *sigmaContext, secure_channel SecureChannel) error {
+	var err error
 	sigma_context.controller_key, err = fabric.CertificateManager.GetPrivkey(controller_id)
 	if err != nil {
-		return SecureChannel{}, err
+		return err
 	}
 	controller_cert, err := fabric.CertificateManager.GetCertificate(controller_id)
 	if err != nil {
-		return SecureChannel{}, err
+		return err
 	}
 	sigma_context.controller_matter_certificate = SerializeCertificateIntoMatter(fabric, controller_cert)
 
 	to_send, err := sigma_context.sigma3(fabric)
 	if err != nil {
-		return SecureChannel{}, err
+		return err
 	}
 	secure_channel.Send(to_send)
 
-	sigma_result, err := secure_channel.Receive()
+	sigma_result, err := secure_channel.ReceiveContext(ctx)
 	if err != nil {
-		return SecureChannel{}, err
+		return err
 	}
 	if sigma_result.ProtocolHeader.Opcode != SEC_CHAN_OPCODE_STATUS_REP {
-		return SecureChannel{}, fmt.Errorf("unexpected message (opcode:0x%x)", sigma_result.ProtocolHeader.Opcode)
+		return fmt.Errorf("unexpected message (opcode:0x%x)", sigma_result.ProtocolHeader.Opcode)
 	}
-	if !sigma_result.StatusReport.IsOk() {
-		return SecureChannel{}, fmt.Errorf("sigma result is not ok %d %d %d",
-			sigma_result.StatusReport.GeneralCode,
-			sigma_result.StatusReport.ProtocolId, sigma_result.StatusReport.ProtocolCode)
+	if err := sigma_result.StatusReport.Err(); err != nil {
+		return fmt.Errorf("sigma result is not ok: %w", err)
 	}
-
-	secure_channel.decrypt_key = sigma_context.r2ikey
-	secure_channel.encrypt_key = sigma_context.i2rkey
-	secure_channel.remote_node = id_to_bytes(device_id)
-	secure_channel.local_node = id_to_bytes(controller_id)
-	secure_channel.session = sigma_context.session
-	return secure_channel, nil
+	resumption_id, err := sigma_context.sigma2ResumptionId(fabric)
+	if err != nil {
+		// session is usable, only resumption will not be possible
+		log.Printf("can't get resumption id: %s\n", err.Error())
+		return nil
+	}
+	sigma_context.resumption_id = resumption_id
+	return nil
 }
 
 // Commission performs commissioning procedure on device with device_ip ip address
@@ -163,37 +256,59 @@ func SigmaExchange(fabric *Fabric, controller_id uint64, device_id uint64, secur
 //   - controller_id is identifier of node whioch will be owner/admin of this device
 //   - device_id_id is identifier of "new" device
 func Commission(fabric *Fabric, device_ip net.IP, pin int, controller_id, device_id uint64) error {
+	return CommissionContext(context.Background(), fabric, device_ip, pin, controller_id, device_id)
+}
 
-	channel, err := startUdpChannel(device_ip, 5540, 55555)
+// CommissionContext is Commission which can be cancelled and limited by deadline of ctx.
+func CommissionContext(ctx context.Context, fabric *Fabric, device_ip net.IP, pin int, controller_id, device_id uint64) error {
+	sm, err := DefaultSessionManager()
 	if err != nil {
 		return err
 	}
-	secure_channel := SecureChannel{
-		Udp: channel,
-	}
-	defer secure_channel.Close()
+	return sm.CommissionContext(ctx, fabric, device_ip, pin, controller_id, device_id)
+}
+
+// Commission performs commissioning procedure on device with device_ip ip address
+// using sessions of this session manager. See Commission function for parameters.
+func (sm *SessionManager) Commission(fabric *Fabric, device_ip net.IP, pin int, controller_id, device_id uint64) error {
+	return sm.CommissionContext(context.Background(), fabric, device_ip, pin, controller_id, device_id)
+}
 
-	secure_channel, err = Spake2pExchange(pin, channel)
+// CommissionContext is Commission which can be cancelled and limited by deadline of ctx.
+func (sm *SessionManager) CommissionContext(ctx context.Context, fabric *Fabric, device_ip net.IP, pin int, controller_id, device_id uint64) error {
+
+	channel, err := sm.unsecuredChannel(device_ip, 5540)
+	if err != nil {
+		return err
+	}
+	secure_channel, err := Spake2pExchangeContext(ctx, pin, channel)
+	channel.Close()
 	if err != nil {
 		return err
 	}
+	defer secure_channel.Close()
 
 	// send csr request
 	var tlvb mattertlv.TLVBuffer
 	tlvb.WriteOctetString(0, CreateRandomBytes(32))
-	to_send := EncodeIMInvokeRequest(0, 0x3e, 4, tlvb.Bytes(), false, uint16(randm.Intn(0xffff)))
-	secure_channel.Send(to_send)
-
-	csr_resp, err := secure_channel.Receive()
+	csr_resp, err := invokeCommand(ctx, &secure_channel, Command{Endpoint: 0, Cluster: 0x3e, Command: 4, Fields: tlvb.Bytes()})
 	if err != nil {
 		return err
 	}
-
-	nocsr := csr_resp.Tlv.GetOctetStringRec([]int{1, 0, 0, 1, 0})
+	if err := csr_resp.Err(); err != nil {
+		return fmt.Errorf("CSRRequest failed: %w", err)
+	}
+	if csr_resp.Fields == nil {
+		return fmt.Errorf("CSRResponse not received")
+	}
+	nocsr := csr_resp.Fields.GetOctetStringRec([]int{0})
 	if len(nocsr) == 0 {
 		return fmt.Errorf("nocsr not received")
 	}
-	tlv2 := mattertlv.Decode(nocsr)
+	tlv2, err := mattertlv.Decode(nocsr)
+	if err != nil {
+		return fmt.Errorf("can't decode nocsr: %w", err)
+	}
 	csr := tlv2.GetOctetStringRec([]int{1})
 	csrp, err := x509.ParseCertificateRequest(csr)
 	if err != nil {
@@ -203,16 +318,12 @@ func Commission(fabric *Fabric, device_ip net.IP, pin int, controller_id, device
 	//AddTrustedRootCertificate
 	var tlv4 mattertlv.TLVBuffer
 	tlv4.WriteOctetString(0, SerializeCertificateIntoMatter(fabric, fabric.CertificateManager.GetCaCertificate()))
-	to_send = EncodeIMInvokeRequest(0, 0x3e, 0xb, tlv4.Bytes(), false, uint16(randm.Intn(0xffff)))
-	secure_channel.Send(to_send)
-
-	resp, err := secure_channel.Receive()
+	resp, err := invokeCommand(ctx, &secure_channel, Command{Endpoint: 0, Cluster: 0x3e, Command: 0xb, Fields: tlv4.Bytes()})
 	if err != nil {
 		return err
 	}
-	resp_status := ParseImInvokeResponse(&resp.Tlv)
-	if resp_status != 0 {
-		return fmt.Errorf("unexpected status to AddTrustedRootCertificate %d", resp_status)
+	if err := resp.Err(); err != nil {
+		return fmt.Errorf("AddTrustedRootCertificate failed: %w", err)
 	}
 
 	//noc_x509 := sign_cert(csrp, 2, "user")
@@ -227,59 +338,94 @@ func Commission(fabric *Fabric, device_ip net.IP, pin int, controller_id, device
 	tlv5.WriteOctetString(2, fabric.ipk) //ipk
 	tlv5.WriteUInt64(3, controller_id)   // admin subject !
 	tlv5.WriteUInt16(4, 101)             // admin vendorid ??
-	to_send = EncodeIMInvokeRequest(0, 0x3e, 0x6, tlv5.Bytes(), false, uint16(randm.Intn(0xffff)))
-
-	secure_channel.Send(to_send)
-
-	resp, err = secure_channel.Receive()
+	resp, err = invokeCommand(ctx, &secure_channel, Command{Endpoint: 0, Cluster: 0x3e, Command: 0x6, Fields: tlv5.Bytes()})
 	if err != nil {
 		return err
 	}
-	resp_status_add_noc, err := resp.Tlv.GetIntRec([]int{1, 0, 0, 1, 0})
+	if err := resp.Err(); err != nil {
+		return fmt.Errorf("AddNOC failed: %w", err)
+	}
+	if resp.Fields == nil {
+		return fmt.Errorf("NOCResponse not received")
+	}
+	resp_status_add_noc, err := resp.Fields.GetIntRec([]int{0})
 	if err != nil {
 		return fmt.Errorf("error during AddNOC %s", err.Error())
 	}
 	if resp_status_add_noc != 0 {
-		return fmt.Errorf("unexpected status to AddNOC %d", resp_status_add_noc)
+		return fmt.Errorf("AddNOC failed: %w", responseStatusError(resp, resp_status_add_noc))
 	}
 
-	secure_channel.decrypt_key = []byte{}
-	secure_channel.encrypt_key = []byte{}
-	secure_channel.session = 0
-
-	secure_channel, err = SigmaExchange(fabric, controller_id, device_id, secure_channel)
+	channel, err = sm.unsecuredChannel(device_ip, 5540)
+	if err != nil {
+		return err
+	}
+	unsecured_channel := newSecureChannel(channel, secure_channel.MrpParameters())
+	case_channel, err := SigmaExchangeContext(ctx, fabric, controller_id, device_id, unsecured_channel)
+	channel.Close()
 	if err != nil {
 		return err
 	}
+	defer case_channel.Close()
 
 	//commissioning complete
-	to_send = EncodeIMInvokeRequest(0, 0x30, 4, []byte{}, false, uint16(randm.Intn(0xffff)))
-	secure_channel.Send(to_send)
-
-	respx, err := secure_channel.Receive()
+	respx, err := invokeCommand(ctx, &case_channel, Command{Endpoint: 0, Cluster: 0x30, Command: 4})
 	if err != nil {
 		return err
 	}
-	commissioning_result, err := respx.Tlv.GetIntRec([]int{1, 0, 0, 1, 0})
+	if err := respx.Err(); err != nil {
+		return fmt.Errorf("CommissioningComplete failed: %w", err)
+	}
+	if respx.Fields == nil {
+		return fmt.Errorf("CommissioningCompleteResponse not received")
+	}
+	commissioning_result, err := respx.Fields.GetIntRec([]int{0})
 	if err != nil {
 		return err
 	}
-	if commissioning_result == 0 {
-		log.Printf("commissioning OK\n")
-	} else {
-		log.Printf("commissioning error: %d\n", commissioning_result)
+	if commissioning_result != 0 {
+		return fmt.Errorf("CommissioningComplete failed: %w", responseStatusError(respx, commissioning_result))
 	}
+	log.Printf("commissioning OK\n")
 	return nil
 }
 
+// ConnectDevice establishes CASE session with commissioned device using default session manager.
 func ConnectDevice(device_ip net.IP, port int, fabric *Fabric, device_id, admin_id uint64) (SecureChannel, error) {
-	var secure_channel SecureChannel
-	var err error
-	if secure_channel, err = StartSecureChannel(device_ip, port, 55555); err != nil {
+	return ConnectDeviceWithTransportContext(context.Background(), device_ip, port, fabric, device_id, admin_id, TransportUdp)
+}
+
+// ConnectDeviceContext is ConnectDevice which can be cancelled and limited by deadline of ctx.
+func ConnectDeviceContext(ctx context.Context, device_ip net.IP, port int, fabric *Fabric, device_id, admin_id uint64) (SecureChannel, error) {
+	return ConnectDeviceWithTransportContext(ctx, device_ip, port, fabric, device_id, admin_id, TransportUdp)
+}
+
+// ConnectDeviceWithTransport establishes CASE session with commissioned device over selected transport.
+// ConnectDiscoveredDevice selects transport using TCP support advertised by device.
+func ConnectDeviceWithTransport(device_ip net.IP, port int, fabric *Fabric, device_id, admin_id uint64, transport_type TransportType) (SecureChannel, error) {
+	return ConnectDeviceWithTransportContext(context.Background(), device_ip, port, fabric, device_id, admin_id, transport_type)
+}
+
+// ConnectDeviceWithTransportContext is ConnectDeviceWithTransport which can be cancelled and limited by deadline of ctx.
+func ConnectDeviceWithTransportContext(ctx context.Context, device_ip net.IP, port int, fabric *Fabric, device_id, admin_id uint64, transport_type TransportType) (SecureChannel, error) {
+	sm, err := DefaultSessionManager()
+	if err != nil {
 		return SecureChannel{}, err
 	}
-	if secure_channel, err = SigmaExchange(fabric, admin_id, device_id, secure_channel); err != nil {
+	return sm.ConnectDeviceWithTransportContext(ctx, device_ip, port, fabric, device_id, admin_id, transport_type)
+}
+
+// ConnectDiscoveredDevice establishes CASE session with commissioned device found using discover package
+// and default session manager. TransportAuto selects TCP when device advertises TCP server.
+func ConnectDiscoveredDevice(device discover.DiscoveredDevice, fabric *Fabric, device_id, admin_id uint64, transport_type TransportType) (SecureChannel, error) {
+	return ConnectDiscoveredDeviceContext(context.Background(), device, fabric, device_id, admin_id, transport_type)
+}
+
+// ConnectDiscoveredDeviceContext is ConnectDiscoveredDevice which can be cancelled and limited by deadline of ctx.
+func ConnectDiscoveredDeviceContext(ctx context.Context, device discover.DiscoveredDevice, fabric *Fabric, device_id, admin_id uint64, transport_type TransportType) (SecureChannel, error) {
+	sm, err := DefaultSessionManager()
+	if err != nil {
 		return SecureChannel{}, err
 	}
-	return secure_channel, nil
+	return sm.ConnectDiscoveredDeviceContext(ctx, device, fabric, device_id, admin_id, transport_type)
 }
@@ -0,0 +1,537 @@
+package gomat
+
+import (
+	"encoding/hex"
+	"fmt"
+	"net"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/finnigja/gomat/mattertlv"
+	"github.com/finnigja/gomat/symbols"
+)
+
+// imKind tells how element of Interaction Model message is printed.
+type imKind int
+
+const (
+	imValue          imKind = iota // plain value or container described by members or entry
+	imStatus                       // IM status code
+	imAttributePath                // AttributePathIB
+	imEventPath                    // EventPathIB
+	imCommandPath                  // CommandPathIB
+	imResponsePath                 // CommandPathIB of response command
+	imClusterPath                  // ClusterPathIB
+	imAttributeData                // value of attribute addressed by path in same container
+	imEventData                    // fields of event addressed by path in same container
+	imRequestFields                // fields of command addressed by path in same container
+	imResponseFields               // fields of response command addressed by path in same container
+)
+
+// imElement describes element of Interaction Model message.
+type imElement struct {
+	name    string
+	kind    imKind
+	members map[int]*imElement // members of structure or list
+	entry   *imElement         // entries of array
+}
+
+func imLeaf(name string, kind imKind) *imElement {
+	return &imElement{name: name, kind: kind}
+}
+
+func imStruct(name string, members map[int]*imElement) *imElement {
+	return &imElement{name: name, members: members}
+}
+
+func imArray(name string, entry *imElement) *imElement {
+	return &imElement{name: name, entry: entry}
+}
+
+// imMessage describes message. Every message carries InteractionModelRevision.
+func imMessage(name string, members map[int]*imElement) *imElement {
+	members[0xff] = imLeaf("InteractionModelRevision", imValue)
+	return imStruct(name, members)
+}
+
+var (
+	imAttributePathIB = imLeaf("Path", imAttributePath)
+	imEventPathIB     = imLeaf("Path", imEventPath)
+	imCommandPathIB   = imLeaf("Path", imCommandPath)
+
+	imStatusIB = imStruct("Status", map[int]*imElement{
+		0: imLeaf("Status", imStatus),
+		1: imLeaf("ClusterStatus", imValue),
+	})
+	imAttributeStatusIB = imStruct("AttributeStatus", map[int]*imElement{
+		0: imAttributePathIB,
+		1: imStatusIB,
+	})
+	imAttributeDataIB = imStruct("AttributeData", map[int]*imElement{
+		0: imLeaf("DataVersion", imValue),
+		1: imAttributePathIB,
+		2: imLeaf("Data", imAttributeData),
+	})
+	imEventStatusIB = imStruct("EventStatus", map[int]*imElement{
+		0: imEventPathIB,
+		1: imStatusIB,
+	})
+	imEventDataIB = imStruct("EventData", map[int]*imElement{
+		0: imEventPathIB,
+		1: imLeaf("EventNumber", imValue),
+		2: imLeaf("Priority", imValue),
+		3: imLeaf("EpochTimestamp", imValue),
+		4: imLeaf("SystemTimestamp", imValue),
+		5: imLeaf("DeltaEpochTimestamp", imValue),
+		6: imLeaf("DeltaSystemTimestamp", imValue),
+		7: imLeaf("Data", imEventData),
+	})
+	imCommandStatusIB = imStruct("CommandStatus", map[int]*imElement{
+		0: imCommandPathIB,
+		1: imStatusIB,
+		2: imLeaf("CommandRef", imValue),
+	})
+	imDataVersionFilterIB = imStruct("DataVersionFilter", map[int]*imElement{
+		0: imLeaf("Path", imClusterPath),
+		1: imLeaf("DataVersion", imValue),
+	})
+	imEventFilterIB = imStruct("EventFilter", map[int]*imElement{
+		0: imLeaf("Node", imValue),
+		1: imLeaf("EventMin", imValue),
+	})
+)
+
+func imCommandDataIB(fields imKind) *imElement {
+	path := imCommandPathIB
+	if fields == imResponseFields {
+		path = imLeaf("Path", imResponsePath)
+	}
+	return imStruct("CommandData", map[int]*imElement{
+		0: path,
+		1: imLeaf("Fields", fields),
+		2: imLeaf("CommandRef", imValue),
+	})
+}
+
+// imMessages describes Interaction Model messages by opcode.
+var imMessages = map[Opcode]*imElement{
+	INTERACTION_OPCODE_STATUS_RSP: imMessage("StatusResponse", map[int]*imElement{
+		0: imLeaf("Status", imStatus),
+	}),
+	INTERACTION_OPCODE_READ_REQ: imMessage("ReadRequest", map[int]*imElement{
+		0: imArray("AttributeRequests", imAttributePathIB),
+		1: imArray("EventRequests", imEventPathIB),
+		2: imArray("EventFilters", imEventFilterIB),
+		3: imLeaf("IsFabricFiltered", imValue),
+		4: imArray("DataVersionFilters", imDataVersionFilterIB),
+	}),
+	INTERACTION_OPCODE_SUBSC_REQ: imMessage("SubscribeRequest", map[int]*imElement{
+		0: imLeaf("KeepSubscriptions", imValue),
+		1: imLeaf("MinIntervalFloor", imValue),
+		2: imLeaf("MaxIntervalCeiling", imValue),
+		3: imArray("AttributeRequests", imAttributePathIB),
+		4: imArray("EventRequests", imEventPathIB),
+		5: imArray("EventFilters", imEventFilterIB),
+		7: imLeaf("IsFabricFiltered", imValue),
+		8: imArray("DataVersionFilters", imDataVersionFilterIB),
+	}),
+	INTERACTION_OPCODE_SUBSC_RSP: imMessage("SubscribeResponse", map[int]*imElement{
+		0: imLeaf("SubscriptionId", imValue),
+		2: imLeaf("MaxInterval", imValue),
+	}),
+	INTERACTION_OPCODE_REPORT_DATA: imMessage("ReportData", map[int]*imElement{
+		0: imLeaf("SubscriptionId", imValue),
+		1: imArray("AttributeReports", imStruct("AttributeReport", map[int]*imElement{
+			0: imAttributeStatusIB,
+			1: imAttributeDataIB,
+		})),
+		2: imArray("EventReports", imStruct("EventReport", map[int]*imElement{
+			0: imEventStatusIB,
+			1: imEventDataIB,
+		})),
+		3: imLeaf("MoreChunkedMessages", imValue),
+		4: imLeaf("SuppressResponse", imValue),
+	}),
+	INTERACTION_OPCODE_WRITE_REQ: imMessage("WriteRequest", map[int]*imElement{
+		0: imLeaf("SuppressResponse", imValue),
+		1: imLeaf("TimedRequest", imValue),
+		2: imArray("WriteRequests", imAttributeDataIB),
+		3: imLeaf("MoreChunkedMessages", imValue),
+	}),
+	INTERACTION_OPCODE_WRITE_RSP: imMessage("WriteResponse", map[int]*imElement{
+		0: imArray("WriteResponses", imAttributeStatusIB),
+	}),
+	INTERACTION_OPCODE_INVOKE_REQ: imMessage("InvokeRequest", map[int]*imElement{
+		0: imLeaf("SuppressResponse", imValue),
+		1: imLeaf("TimedRequest", imValue),
+		2: imArray("InvokeRequests", imCommandDataIB(imRequestFields)),
+	}),
+	INTERACTION_OPCODE_INVOKE_RSP: imMessage("InvokeResponse", map[int]*imElement{
+		0: imLeaf("SuppressResponse", imValue),
+		1: imArray("InvokeResponses", imStruct("InvokeResponse", map[int]*imElement{
+			0: imCommandDataIB(imResponseFields),
+			1: imCommandStatusIB,
+		})),
+		2: imLeaf("MoreChunkedMessages", imValue),
+	}),
+	INTERACTION_OPCODE_TIMED_REQ: imMessage("TimedRequest", map[int]*imElement{
+		0: imLeaf("Timeout", imValue),
+	}),
+}
+
+// imPath is cluster and attribute, event or command addressed by path of IB.
+type imPath struct {
+	cluster int
+	id      int
+	valid   bool
+}
+
+type imPrinter struct {
+	out strings.Builder
+}
+
+// FormatMessage returns human readable form of message. Interaction Model messages are printed using FormatIM,
+// other messages as header followed by TLV with numeric tags.
+func FormatMessage(msg DecodedGeneric) string {
+	if msg.ProtocolHeader.ProtocolId == ProtocolIdInteraction {
+		return FormatIM(msg.ProtocolHeader.Opcode, &msg.Tlv)
+	}
+	var p imPrinter
+	fmt.Fprintf(&p.out, "protocol:%d opcode:0x%x\n", msg.ProtocolHeader.ProtocolId, msg.ProtocolHeader.Opcode)
+	if msg.Tlv.Type == mattertlv.TypeList {
+		p.unknown(&msg.Tlv, 2, "Data")
+	}
+	return p.out.String()
+}
+
+// FormatIM returns human readable form of Interaction Model message with given opcode.
+// Elements of message are named by IM specification, endpoints, clusters, attributes, commands and events
+// are named using symbols and values of attributes and fields of commands and events are printed
+// according to their data types - struct members by name, enums and bitmaps symbolically.
+// Elements without known description are printed with numeric tags in TLV text notation.
+func FormatIM(opcode Opcode, tlv *mattertlv.TlvItem) string {
+	var p imPrinter
+	schema, ok := imMessages[opcode]
+	if !ok {
+		p.unknown(tlv, 0, fmt.Sprintf("opcode 0x%x", opcode))
+	} else {
+		p.element(tlv, schema, 0, imPath{})
+	}
+	return p.out.String()
+}
+
+func (p *imPrinter) line(pad int, name string, value string) {
+	p.out.WriteString(strings.Repeat(" ", pad))
+	p.out.WriteString(name)
+	p.out.WriteString(":")
+	if value != "" {
+		p.out.WriteString(" ")
+		p.out.WriteString(value)
+	}
+	p.out.WriteString("\n")
+}
+
+// tagName returns name of element without description - tag number or index in array.
+func tagName(item *mattertlv.TlvItem, index int) string {
+	if item.TagKind() == mattertlv.TagAnonymous {
+		return fmt.Sprintf("[%d]", index)
+	}
+	return strconv.Itoa(item.Tag)
+}
+
+// unknown prints element without description.
+func (p *imPrinter) unknown(item *mattertlv.TlvItem, pad int, name string) {
+	if item.Type != mattertlv.TypeList {
+		p.line(pad, name, mattertlv.FormatText(item))
+		return
+	}
+	p.line(pad, name, "")
+	for n, child := range item.GetChild() {
+		p.unknown(&child, pad+2, tagName(&child, n))
+	}
+}
+
+func (p *imPrinter) element(item *mattertlv.TlvItem, schema *imElement, pad int, path imPath) {
+	switch schema.kind {
+	case imStatus:
+		p.line(pad, schema.name, Status(item.GetInt()).String())
+	case imAttributePath, imEventPath, imCommandPath, imResponsePath, imClusterPath:
+		p.line(pad, schema.name, formatPath(item, schema.kind))
+	case imAttributeData:
+		var field *symbols.Field
+		if path.valid {
+			field = symbols.AttributeType(path.cluster, path.id)
+		}
+		p.value(item, pad, schema.name, field, path.cluster)
+	case imEventData, imRequestFields, imResponseFields:
+		var fields []symbols.Field
+		known := false
+		if types, ok := symbols.ClusterTypesMap[path.cluster]; ok && path.valid {
+			switch schema.kind {
+			case imEventData:
+				var event symbols.EventInfo
+				event, known = types.Events[path.id]
+				fields = event.Fields
+			case imRequestFields:
+				var command symbols.CommandInfo
+				command, known = types.Commands[path.id]
+				fields = command.Fields
+			case imResponseFields:
+				var command symbols.CommandInfo
+				command, known = types.Responses[path.id]
+				fields = command.Fields
+			}
+		}
+		if !known || item.Type != mattertlv.TypeList {
+			p.unknown(item, pad, schema.name)
+			return
+		}
+		p.fields(item, pad, schema.name, fields, path.cluster)
+	default:
+		if item.Type != mattertlv.TypeList || (schema.members == nil && schema.entry == nil) {
+			p.line(pad, schema.name, formatScalar(item, "", 0))
+			return
+		}
+		p.line(pad, schema.name, "")
+		children := item.GetChild()
+		if schema.entry != nil {
+			for n := range children {
+				p.element(&children[n], schema.entry, pad+2, path)
+			}
+			return
+		}
+		for n := range children {
+			if member, ok := schema.members[children[n].Tag]; ok && member.kind >= imAttributePath && member.kind <= imResponsePath {
+				path = pathIds(&children[n], member.kind)
+			}
+		}
+		for n := range children {
+			member, ok := schema.members[children[n].Tag]
+			if !ok || children[n].TagKind() != mattertlv.TagContext {
+				p.unknown(&children[n], pad+2, tagName(&children[n], n))
+				continue
+			}
+			p.element(&children[n], member, pad+2, path)
+		}
+	}
+}
+
+// fields prints members of structure described by fields.
+func (p *imPrinter) fields(item *mattertlv.TlvItem, pad int, name string, fields []symbols.Field, cluster int) {
+	p.line(pad, name, "")
+	children := item.GetChild()
+	for n := range children {
+		child := &children[n]
+		var field *symbols.Field
+		for f := range fields {
+			if fields[f].Id == child.Tag && child.TagKind() == mattertlv.TagContext {
+				field = &fields[f]
+				break
+			}
+		}
+		if field == nil {
+			p.unknown(child, pad+2, tagName(child, n))
+			continue
+		}
+		p.value(child, pad+2, field.Name, field, cluster)
+	}
+}
+
+// value prints data model value of type described by field. Field is nil when type is not known.
+func (p *imPrinter) value(item *mattertlv.TlvItem, pad int, name string, field *symbols.Field, cluster int) {
+	if field == nil {
+		p.unknown(item, pad, name)
+		return
+	}
+	if item.IsNull() {
+		p.line(pad, name, "null")
+		return
+	}
+	if field.List && item.Type == mattertlv.TypeList {
+		entry := *field
+		entry.List = false
+		entry.Nullable = false
+		p.line(pad, name, "")
+		children := item.GetChild()
+		for n := range children {
+			p.value(&children[n], pad+2, fmt.Sprintf("[%d]", n), &entry, cluster)
+		}
+		return
+	}
+	if types, ok := symbols.ClusterTypesMap[cluster]; ok && item.Type == mattertlv.TypeList {
+		if st, ok := types.Structs[field.Type]; ok {
+			p.fields(item, pad, name, st.Fields, cluster)
+			return
+		}
+	}
+	if item.Type == mattertlv.TypeList {
+		p.unknown(item, pad, name)
+		return
+	}
+	p.line(pad, name, formatScalar(item, field.Type, cluster))
+}
+
+// formatScalar returns value of element which is not container. Type is data model type used to render
+// ids, enums, bitmaps and addresses symbolically, it may be empty.
+func formatScalar(item *mattertlv.TlvItem, typ string, cluster int) string {
+	switch item.Type {
+	case mattertlv.TypeNull:
+		return "null"
+	case mattertlv.TypeBool:
+		return strconv.FormatBool(item.GetBool())
+	case mattertlv.TypeFloat:
+		return strconv.FormatFloat(item.GetFloat(), 'g', -1, 64)
+	case mattertlv.TypeUTF8String:
+		return strconv.Quote(item.GetString())
+	case mattertlv.TypeOctetString:
+		data := item.GetOctetString()
+		switch typ {
+		case "ipadr", "ipv4adr", "ipv6adr":
+			if len(data) == net.IPv4len || len(data) == net.IPv6len {
+				return net.IP(data).String()
+			}
+		case "hwadr":
+			return net.HardwareAddr(data).String()
+		}
+		return hex.EncodeToString(data)
+	case mattertlv.TypeInt:
+		if item.IsSigned() {
+			return strconv.FormatInt(item.GetInt64(), 10)
+		}
+		return formatUint(item.GetUint64(), typ, cluster)
+	}
+	return mattertlv.FormatText(item)
+}
+
+// named returns name followed by value in parentheses or just value when name is not known.
+func named(name string, value string) string {
+	if name == "" {
+		return value
+	}
+	return fmt.Sprintf("%s (%s)", name, value)
+}
+
+func formatUint(v uint64, typ string, cluster int) string {
+	hexv := fmt.Sprintf("0x%x", v)
+	switch typ {
+	case "cluster-id":
+		return named(symbols.ClusterNameMap[int(v)], hexv)
+	case "attrib-id":
+		name := symbols.AttributeNameMap[cluster][int(v)]
+		if global, ok := symbols.GlobalAttributes[int(v)]; ok {
+			name = global.Name
+		}
+		return named(name, hexv)
+	case "command-id":
+		return named(commandName(cluster, int(v), false), hexv)
+	case "event-id":
+		return named(symbols.EventName(cluster, int(v)), hexv)
+	case "vendor-id", "devtype-id", "fabric-id", "node-id", "subject-id",
+		"bitmap8", "bitmap16", "bitmap32", "bitmap64":
+		return hexv
+	}
+	types, ok := symbols.ClusterTypesMap[cluster]
+	if !ok {
+		return strconv.FormatUint(v, 10)
+	}
+	if enum, ok := types.Enums[typ]; ok {
+		return named(enum[int(v)], strconv.FormatUint(v, 10))
+	}
+	if bitmap, ok := types.Bitmaps[typ]; ok {
+		bits := []int{}
+		for bit := range bitmap {
+			if v&(1<<bit) != 0 {
+				bits = append(bits, bit)
+			}
+		}
+		sort.Ints(bits)
+		names := []string{}
+		for _, bit := range bits {
+			names = append(names, bitmap[bit])
+		}
+		return named(strings.Join(names, "|"), hexv)
+	}
+	return strconv.FormatUint(v, 10)
+}
+
+// commandName returns name of command or empty string when it is not known.
+// Requests and responses may share id, response tells which of them is preferred.
+func commandName(cluster int, command int, response bool) string {
+	first, second := symbols.CommandNameMap, symbols.ResponseNameMap
+	if response {
+		first, second = second, first
+	}
+	if name, ok := first[cluster][command]; ok {
+		return name
+	}
+	return second[cluster][command]
+}
+
+// pathIds returns cluster and attribute, event or command id of path.
+func pathIds(item *mattertlv.TlvItem, kind imKind) imPath {
+	tags := map[imKind][2]int{
+		imAttributePath: {3, 4},
+		imEventPath:     {2, 3},
+		imCommandPath:   {1, 2},
+		imResponsePath:  {1, 2},
+	}[kind]
+	cluster := item.GetItemWithTag(tags[0])
+	id := item.GetItemWithTag(tags[1])
+	if cluster == nil || id == nil {
+		return imPath{}
+	}
+	return imPath{cluster: cluster.GetInt(), id: id.GetInt(), valid: true}
+}
+
+// formatPath returns path IB in form "endpoint 1 cluster OnOff (0x6) attribute OnOff (0x0)".
+// Missing path elements are wildcards and are printed as *.
+func formatPath(item *mattertlv.TlvItem, kind imKind) string {
+	type part struct {
+		name string
+		tag  int
+	}
+	var parts []part
+	switch kind {
+	case imAttributePath:
+		parts = []part{{"node", 1}, {"endpoint", 2}, {"cluster", 3}, {"attribute", 4}, {"list index", 5}}
+	case imEventPath:
+		parts = []part{{"node", 0}, {"endpoint", 1}, {"cluster", 2}, {"event", 3}, {"urgent", 4}}
+	case imCommandPath, imResponsePath:
+		parts = []part{{"endpoint", 0}, {"cluster", 1}, {"command", 2}}
+	case imClusterPath:
+		parts = []part{{"node", 0}, {"endpoint", 1}, {"cluster", 2}}
+	}
+	cluster := -1
+	out := []string{}
+	for _, pt := range parts {
+		element := item.GetItemWithTag(pt.tag)
+		if element == nil {
+			// node, list index and urgent flag are optional, other elements are wildcards when missing
+			if pt.name == "endpoint" || pt.name == "cluster" || pt.name == "attribute" || pt.name == "event" || pt.name == "command" {
+				out = append(out, pt.name+" *")
+			}
+			continue
+		}
+		value := formatScalar(element, "", 0)
+		switch pt.name {
+		case "node":
+			value = fmt.Sprintf("0x%x", element.GetUint64())
+		case "cluster":
+			cluster = element.GetInt()
+			value = formatUint(element.GetUint64(), "cluster-id", 0)
+		case "attribute":
+			value = formatUint(element.GetUint64(), "attrib-id", cluster)
+		case "event":
+			value = formatUint(element.GetUint64(), "event-id", cluster)
+		case "command":
+			value = named(commandName(cluster, element.GetInt(), kind == imResponsePath), fmt.Sprintf("0x%x", element.GetUint64()))
+		case "list index":
+			if element.IsNull() {
+				value = "append"
+			}
+		}
+		out = append(out, pt.name+" "+value)
+	}
+	return strings.Join(out, " ")
+}
@@ -0,0 +1,145 @@
+package gomat
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/finnigja/gomat/mattertlv"
+	"github.com/finnigja/gomat/symbols"
+)
+
+func TestFormatIM(t *testing.T) {
+	// ReportData with list of fabrics and status of unsupported attribute
+	var tlv mattertlv.TLVBuffer
+	tlv.WriteAnonStruct()
+	tlv.WriteArray(1)
+	tlv.WriteAnonStruct()
+	tlv.WriteStruct(1)
+	tlv.WriteUInt32(0, 7)
+	tlv.WriteList(1)
+	tlv.WriteUInt16(2, 0)
+	tlv.WriteUInt32(3, 0x3e)
+	tlv.WriteUInt32(4, 1)
+	tlv.WriteStructEnd()
+	tlv.WriteArray(2)
+	tlv.WriteAnonStruct()
+	tlv.WriteUInt16(2, 0xfff1)
+	tlv.WriteUTF8String(5, "home")
+	tlv.WriteUInt8(9, 1)
+	tlv.WriteStructEnd()
+	tlv.WriteStructEnd()
+	tlv.WriteStructEnd()
+	tlv.WriteStructEnd()
+	tlv.WriteAnonStruct()
+	tlv.WriteStruct(0)
+	tlv.WriteList(0)
+	tlv.WriteUInt16(2, 1)
+	tlv.WriteUInt32(3, 0x33)
+	tlv.WriteUInt32(4, 0)
+	tlv.WriteStructEnd()
+	tlv.WriteStruct(1)
+	tlv.WriteUInt8(0, uint8(StatusUnsupportedAttribute))
+	tlv.WriteStructEnd()
+	tlv.WriteStructEnd()
+	tlv.WriteStructEnd()
+	tlv.WriteStructEnd()
+	tlv.WriteUInt8(0xff, 11)
+	tlv.WriteStructEnd()
+	report, err := mattertlv.Decode(tlv.Bytes())
+	if err != nil {
+		t.Fatal(err)
+	}
+	out := FormatIM(INTERACTION_OPCODE_REPORT_DATA, &report)
+	for _, line := range []string{
+		"ReportData:\n",
+		"        Path: endpoint 0 cluster OperationalCredentials (0x3e) attribute Fabrics (0x1)\n",
+		"            VendorID: 0xfff1\n",
+		"            Label: \"home\"\n",
+		"            9: 1u8\n",
+		"          Status: UNSUPPORTED_ATTRIBUTE (0x86)\n",
+		"  InteractionModelRevision: 11\n",
+	} {
+		if !strings.Contains(out, line) {
+			t.Fatalf("%q not found in\n%s", line, out)
+		}
+	}
+
+	// InvokeRequest with enum and bitmap fields
+	fields := []byte{0x24, 0, 100, 0x34, 1, 0x24, 2, 1, 0x24, 3, 3}
+	msg := EncodeIMInvokeCommands([]Command{{Endpoint: 1, Cluster: 8, Command: 0, Fields: fields}}, false, 1)
+	invoke, err := mattertlv.Decode(msg[6:])
+	if err != nil {
+		t.Fatal(err)
+	}
+	out = FormatIM(INTERACTION_OPCODE_INVOKE_REQ, &invoke)
+	for _, line := range []string{
+		"      Path: endpoint 1 cluster LevelControl (0x8) command MoveToLevel (0x0)\n",
+		"        Level: 100\n",
+		"        TransitionTime: null\n",
+		"        OptionsOverride: ExecuteIfOff|CoupleColorTempToLevel (0x3)\n",
+	} {
+		if !strings.Contains(out, line) {
+			t.Fatalf("%q not found in\n%s", line, out)
+		}
+	}
+}
+
+func TestEncodeCommandFields(t *testing.T) {
+	fields, err := EncodeCommandFields(8, 0, []byte(`{"Level": 100, "TransitionTime": null, "OptionsMask": "ExecuteIfOff",
+		"OptionsOverride": ["ExecuteIfOff", "CoupleColorTempToLevel"]}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := []byte{0x24, 0, 100, 0x34, 1, 0x24, 2, 1, 0x24, 3, 3}
+	if !bytes.Equal(fields, expected) {
+		t.Fatalf("unexpected fields %x", fields)
+	}
+	_, err = EncodeCommandFields(8, 0, []byte(`{"Level": 300}`))
+	if err == nil {
+		t.Fatal("value out of range was accepted")
+	}
+	value, err := EncodeAttributeValue(0x28, 5, []byte(`"kitchen"`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(value, append([]byte{0x0c, 7}, "kitchen"...)) {
+		t.Fatalf("unexpected value %x", value)
+	}
+}
+
+func TestRegisteredCluster(t *testing.T) {
+	loadTestCluster(t, 0xfff1fc03, `<cluster id="0xFFF1FC03" name="Vendor Lights" revision="1">
+  <commands>
+    <command id="0xFFF10007" name="SetPixels" direction="commandToServer" response="Y">
+      <field id="0" name="Pixels" type="octstr"/>
+    </command>
+  </commands>
+</cluster>`)
+	cluster, ok := symbols.FindCluster("VendorLights")
+	if !ok || cluster != 0xfff1fc03 {
+		t.Fatalf("cluster not registered")
+	}
+	if id, ok := symbols.FindCluster("vendorlights"); !ok || id != cluster || symbols.ClusterIdMap["VendorLights"] != cluster {
+		t.Fatalf("cluster not found by name")
+	}
+	command, _ := symbols.FindCommand(cluster, "SetPixels")
+	fields, err := EncodeCommandFields(cluster, command, []byte(`{"Pixels": "ff0a"}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	msg := EncodeIMInvokeCommands([]Command{{Endpoint: 2, Cluster: uint32(cluster), Command: uint32(command), Fields: fields}}, false, 1)
+	invoke, err := mattertlv.Decode(msg[6:])
+	if err != nil {
+		t.Fatal(err)
+	}
+	out := FormatIM(INTERACTION_OPCODE_INVOKE_REQ, &invoke)
+	for _, line := range []string{
+		"      Path: endpoint 2 cluster VendorLights (0xfff1fc03) command SetPixels (0xfff10007)\n",
+		"        Pixels: ff0a\n",
+	} {
+		if !strings.Contains(out, line) {
+			t.Fatalf("%q not found in\n%s", line, out)
+		}
+	}
+}
@@ -0,0 +1,223 @@
+package gomat
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+
+	"github.com/finnigja/gomat/mattertlv"
+)
+
+// Command is one command of invoke request.
+type Command struct {
+	Endpoint uint16
+	Cluster  uint32
+	Command  uint32
+	Fields   []byte // TLV encoded fields of command, same as payload of EncodeIMInvokeRequest
+}
+
+// InvokeRequest describes Interaction Model Invoke Request.
+// Device must support batch commands (Matter 1.3) when request contains more than one command.
+type InvokeRequest struct {
+	Commands []Command
+	Timeout  uint16 // when not 0 invoke is timed interaction and TimedRequest with this timeout (ms) is sent first
+}
+
+// CommandResult is result of one invoked command. Device responds either with status
+// or with response command (for example CSRResponse) which carries fields.
+type CommandResult struct {
+	Endpoint         uint16
+	Cluster          uint32
+	Command          uint32 // id of response command or of invoked command when device responded with status
+	Ref              uint16 // CommandRef of invoked command
+	Status           Status
+	ClusterStatus    uint8 // cluster specific status code, valid when HasClusterStatus is set
+	HasClusterStatus bool
+	Fields           *mattertlv.TlvItem // fields of response command, nil when device responded with status
+}
+
+// Err returns StatusError when device reported failure of command.
+func (r CommandResult) Err() error {
+	return statusError(r.Status, r.ClusterStatus, r.HasClusterStatus, &StatusPath{Kind: ElementCommand, Endpoint: r.Endpoint, Cluster: r.Cluster, Id: r.Command})
+}
+
+// responseStatusError returns StatusError for response command which carries cluster specific
+// error code in its fields instead of status (for example NOCResponse).
+func responseStatusError(result CommandResult, code uint64) error {
+	return &StatusError{
+		Status:           StatusFailure,
+		ClusterStatus:    uint8(code),
+		HasClusterStatus: true,
+		Path:             &StatusPath{Kind: ElementCommand, Endpoint: result.Endpoint, Cluster: result.Cluster, Id: result.Command},
+	}
+}
+
+// EncodeIMInvokeCommands encodes Interaction Model Invoke Request message with all commands.
+// When there is more than one command, CommandRef of each command is its index.
+// When timed is set, message must follow TimedRequest (EncodeIMTimedRequest) sent in same exchange.
+func EncodeIMInvokeCommands(commands []Command, timed bool, exchange uint16) []byte {
+	var tlv mattertlv.TLVBuffer
+	tlv.WriteAnonStruct()
+	tlv.WriteBool(0, false)
+	tlv.WriteBool(1, timed)
+	tlv.WriteArray(2)
+	for n, command := range commands {
+		tlv.WriteAnonStruct()
+		tlv.WriteList(0)
+		tlv.WriteUInt(0, mattertlv.TYPE_UINT_2, uint64(command.Endpoint))
+		tlv.WriteUInt(1, mattertlv.TYPE_UINT_4, uint64(command.Cluster))
+		tlv.WriteUInt(2, mattertlv.TYPE_UINT_4, uint64(command.Command))
+		tlv.WriteStructEnd()
+		tlv.WriteStruct(1)
+		tlv.WriteRaw(command.Fields)
+		tlv.WriteStructEnd()
+		if len(commands) > 1 {
+			tlv.WriteUInt16(2, uint16(n))
+		}
+		tlv.WriteStructEnd()
+	}
+	tlv.WriteStructEnd()
+	tlv.WriteUInt(0xff, mattertlv.TYPE_UINT_1, 10)
+	tlv.WriteStructEnd()
+
+	var buffer bytes.Buffer
+	prot := ProtocolMessageHeader{
+		exchangeFlags: 5,
+		Opcode:        INTERACTION_OPCODE_INVOKE_REQ,
+		ExchangeId:    exchange,
+		ProtocolId:    ProtocolIdInteraction,
+	}
+	prot.Encode(&buffer)
+	buffer.Write(tlv.Bytes())
+
+	return buffer.Bytes()
+}
+
+func parseCommandPath(path *mattertlv.TlvItem, result *CommandResult) {
+	if endpoint := path.GetItemWithTag(0); endpoint != nil {
+		result.Endpoint = uint16(endpoint.GetInt())
+	}
+	if cluster := path.GetItemWithTag(1); cluster != nil {
+		result.Cluster = uint32(cluster.GetInt())
+	}
+	if command := path.GetItemWithTag(2); command != nil {
+		result.Command = uint32(command.GetInt())
+	}
+}
+
+// ParseInvokeResponse parses IM InvokeResponse TLV into result of every command.
+func ParseInvokeResponse(resp *mattertlv.TlvItem) []CommandResult {
+	out := []CommandResult{}
+	responses := resp.GetItemWithTag(1)
+	if responses == nil {
+		return out
+	}
+	for _, item := range responses.GetChild() {
+		var result CommandResult
+		var ib *mattertlv.TlvItem
+		if command := item.GetItemWithTag(0); command != nil {
+			ib = command
+			if fields := command.GetItemWithTag(1); fields != nil {
+				f := *fields
+				result.Fields = &f
+			} else {
+				result.Fields = &mattertlv.TlvItem{Tag: 1, Type: mattertlv.TypeList}
+			}
+		} else if status := item.GetItemWithTag(1); status != nil {
+			ib = status
+			if status_ib := status.GetItemWithTag(1); status_ib != nil {
+				result.Status, result.ClusterStatus, result.HasClusterStatus = parseStatusIB(status_ib)
+			}
+		} else {
+			continue
+		}
+		if path := ib.GetItemWithTag(0); path != nil {
+			parseCommandPath(path, &result)
+		}
+		if ref := ib.GetItemWithTag(2); ref != nil {
+			result.Ref = uint16(ref.GetInt())
+		}
+		out = append(out, result)
+	}
+	return out
+}
+
+// Invoke invokes commands and returns their results in order of request.Commands.
+// Response split by device into several messages is reassembled.
+// Messenger is SecureChannel or Exchange.
+func Invoke(messenger Messenger, request InvokeRequest) ([]CommandResult, error) {
+	return InvokeContext(context.Background(), messenger, request)
+}
+
+// InvokeContext is Invoke which can be cancelled and limited by deadline of ctx.
+func InvokeContext(ctx context.Context, messenger Messenger, request InvokeRequest) ([]CommandResult, error) {
+	if len(request.Commands) == 0 {
+		return nil, fmt.Errorf("no command to invoke")
+	}
+	exchange := uint16(randomIntn(0xffff))
+	timed := request.Timeout > 0
+	if timed {
+		err := timedRequest(ctx, messenger, exchange, request.Timeout)
+		if err != nil {
+			return nil, err
+		}
+	}
+	err := messenger.Send(EncodeIMInvokeCommands(request.Commands, timed, exchange))
+	if err != nil {
+		return nil, err
+	}
+
+	results := make([]CommandResult, len(request.Commands))
+	received := make([]bool, len(request.Commands))
+	for {
+		resp, err := messenger.ReceiveContext(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if resp.ProtocolHeader.ProtocolId == ProtocolIdInteraction && resp.ProtocolHeader.Opcode == INTERACTION_OPCODE_STATUS_RSP {
+			err = statusResponseError(resp, "InvokeRequest")
+			if err == nil {
+				err = fmt.Errorf("InvokeRequest was answered by StatusResponse")
+			}
+			return nil, err
+		}
+		if resp.ProtocolHeader.ProtocolId != ProtocolIdInteraction || resp.ProtocolHeader.Opcode != INTERACTION_OPCODE_INVOKE_RSP {
+			return nil, fmt.Errorf("unexpected response to InvokeRequest (protocol:%d opcode:0x%x)", resp.ProtocolHeader.ProtocolId, resp.ProtocolHeader.Opcode)
+		}
+		for _, result := range ParseInvokeResponse(&resp.Tlv) {
+			index := int(result.Ref)
+			if len(request.Commands) == 1 {
+				// CommandRef is optional when request contains single command
+				index = 0
+			}
+			if index >= len(results) {
+				return nil, fmt.Errorf("response to unknown CommandRef %d", result.Ref)
+			}
+			results[index] = result
+			received[index] = true
+		}
+		if !tlvFlag(&resp.Tlv, 2) {
+			break
+		}
+		// more chunks follow
+		err = messenger.Send(EncodeIMStatusResponse(resp.ProtocolHeader.ExchangeId, exchangeFlagsInitiator))
+		if err != nil {
+			return nil, err
+		}
+	}
+	for n := range received {
+		if !received[n] {
+			return nil, fmt.Errorf("no response to command %d", n)
+		}
+	}
+	return results, nil
+}
+
+// invokeCommand invokes single command and returns its result.
+func invokeCommand(ctx context.Context, messenger Messenger, command Command) (CommandResult, error) {
+	results, err := InvokeContext(ctx, messenger, InvokeRequest{Commands: []Command{command}})
+	if err != nil {
+		return CommandResult{}, err
+	}
+	return results[0], nil
+}
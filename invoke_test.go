@@ -0,0 +1,173 @@
+package gomat
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/finnigja/gomat/mattertlv"
+)
+
+func testCommands(count int) []Command {
+	out := []Command{}
+	for n := 0; n < count; n++ {
+		var fields mattertlv.TLVBuffer
+		fields.WriteUInt8(0, uint8(n))
+		out = append(out, Command{Endpoint: 1, Cluster: 6, Command: uint32(n), Fields: fields.Bytes()})
+	}
+	return out
+}
+
+// decodeInvokeRequest returns CommandDataIBs of encoded InvokeRequest.
+func decodeInvokeRequest(t *testing.T, encoded []byte) (ProtocolMessageHeader, []mattertlv.TlvItem) {
+	buffer := bytes.NewBuffer(encoded)
+	var prot ProtocolMessageHeader
+	prot.Decode(buffer)
+	request, err := mattertlv.Decode(buffer.Bytes())
+	if err != nil {
+		t.Fatal(err)
+	}
+	commands := request.GetItemWithTag(2)
+	if commands == nil {
+		t.Fatalf("InvokeRequests missing")
+	}
+	return prot, commands.GetChild()
+}
+
+func TestEncodeIMInvokeCommands(t *testing.T) {
+	_, commands := decodeInvokeRequest(t, EncodeIMInvokeCommands(testCommands(3), false, 1))
+	if len(commands) != 3 {
+		t.Fatalf("%d CommandDataIBs, want 3", len(commands))
+	}
+	refs := map[int]bool{}
+	for n, command := range commands {
+		ref := command.GetItemWithTag(2)
+		if ref == nil {
+			t.Fatalf("command %d has no CommandRef", n)
+		}
+		if refs[ref.GetInt()] {
+			t.Fatalf("CommandRef %d is not unique", ref.GetInt())
+		}
+		refs[ref.GetInt()] = true
+		if id := command.GetItemRec([]int{0, 2}); id == nil || id.GetInt() != n {
+			t.Fatalf("command %d has wrong path", n)
+		}
+		if field := command.GetItemRec([]int{1, 0}); field == nil || field.GetInt() != n {
+			t.Fatalf("command %d has wrong fields", n)
+		}
+	}
+
+	_, commands = decodeInvokeRequest(t, EncodeIMInvokeCommands(testCommands(1), false, 1))
+	if len(commands) != 1 || commands[0].GetItemWithTag(2) != nil {
+		t.Fatalf("single command has CommandRef")
+	}
+}
+
+// commandResponseGen writes InvokeResponseIB. Device responds by command with fields when status is Success,
+// otherwise by CommandStatusIB.
+func commandResponseGen(tlv *mattertlv.TLVBuffer, command uint32, ref uint16, status Status) {
+	tlv.WriteAnonStruct()
+	if status == StatusSuccess {
+		tlv.WriteStruct(0)
+	} else {
+		tlv.WriteStruct(1)
+	}
+	tlv.WriteList(0)
+	tlv.WriteUInt16(0, 1)
+	tlv.WriteUInt32(1, 6)
+	tlv.WriteUInt32(2, command)
+	tlv.WriteStructEnd()
+	tlv.WriteStruct(1)
+	if status == StatusSuccess {
+		tlv.WriteUInt32(0, 0x100+command)
+	} else {
+		tlv.WriteUInt8(0, uint8(status))
+		tlv.WriteUInt8(1, 7)
+	}
+	tlv.WriteStructEnd()
+	tlv.WriteUInt16(2, ref)
+	tlv.WriteStructEnd()
+	tlv.WriteStructEnd()
+}
+
+// invokeResponseGen creates InvokeResponse message. responses writes InvokeResponseIBs.
+func invokeResponseGen(t *testing.T, more bool, responses func(tlv *mattertlv.TLVBuffer)) DecodedGeneric {
+	var tlv mattertlv.TLVBuffer
+	tlv.WriteAnonStruct()
+	tlv.WriteBool(0, false)
+	tlv.WriteArray(1)
+	responses(&tlv)
+	tlv.WriteStructEnd()
+	if more {
+		tlv.WriteBool(2, true)
+	}
+	tlv.WriteUInt8(0xff, 11)
+	tlv.WriteStructEnd()
+	item, err := mattertlv.Decode(tlv.Bytes())
+	if err != nil {
+		t.Fatal(err)
+	}
+	msg := testMessage(9, 0, ProtocolIdInteraction, INTERACTION_OPCODE_INVOKE_RSP)
+	msg.Tlv = item
+	return msg
+}
+
+func TestInvokeChunkedResponse(t *testing.T) {
+	// responses come out of order and are split into two messages
+	messenger := scriptedMessenger(
+		invokeResponseGen(t, true, func(tlv *mattertlv.TLVBuffer) {
+			commandResponseGen(tlv, 2, 2, StatusConstraintError)
+			commandResponseGen(tlv, 0, 0, StatusSuccess)
+		}),
+		invokeResponseGen(t, false, func(tlv *mattertlv.TLVBuffer) {
+			commandResponseGen(tlv, 1, 1, StatusSuccess)
+		}),
+	)
+	results, err := Invoke(messenger, InvokeRequest{Commands: testCommands(3)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(results) != 3 {
+		t.Fatalf("%d results, want 3", len(results))
+	}
+	for n, result := range results[:2] {
+		if result.Ref != uint16(n) || result.Err() != nil || result.Fields == nil {
+			t.Fatalf("unexpected result of command %d %+v", n, result)
+		}
+		if field := result.Fields.GetItemWithTag(0); field == nil || field.GetInt() != 0x100+n {
+			t.Fatalf("result of command %d has fields of other command", n)
+		}
+	}
+	if failed := results[2]; failed.Ref != 2 || failed.Command != 2 || failed.Status != StatusConstraintError || failed.ClusterStatus != 7 || failed.Fields != nil {
+		t.Fatalf("unexpected result of failed command %+v", failed)
+	}
+
+	// first chunk is acknowledged by StatusResponse, which is last sent message
+	if len(messenger.sent) != 2 {
+		t.Fatalf("%d messages sent, want InvokeRequest and StatusResponse", len(messenger.sent))
+	}
+	request, _ := decodeInvokeRequest(t, messenger.sent[0])
+	var status ProtocolMessageHeader
+	status.Decode(bytes.NewBuffer(messenger.sent[1]))
+	if status.Opcode != INTERACTION_OPCODE_STATUS_RSP || status.ExchangeId != 9 || request.Opcode != INTERACTION_OPCODE_INVOKE_REQ {
+		t.Fatalf("chunk is not acknowledged by StatusResponse")
+	}
+}
+
+func TestInvokeMissingResponse(t *testing.T) {
+	messenger := scriptedMessenger(invokeResponseGen(t, false, func(tlv *mattertlv.TLVBuffer) {
+		commandResponseGen(tlv, 0, 0, StatusSuccess)
+		commandResponseGen(tlv, 2, 2, StatusSuccess)
+	}))
+	_, err := Invoke(messenger, InvokeRequest{Commands: testCommands(3)})
+	if err == nil || !strings.Contains(err.Error(), "no response to command 1") {
+		t.Fatalf("missing response not reported: %v", err)
+	}
+
+	messenger = scriptedMessenger(invokeResponseGen(t, false, func(tlv *mattertlv.TLVBuffer) {
+		commandResponseGen(tlv, 0, 5, StatusSuccess)
+	}))
+	if _, err := Invoke(messenger, InvokeRequest{Commands: testCommands(2)}); err == nil {
+		t.Fatalf("response to unknown CommandRef accepted")
+	}
+}
@@ -0,0 +1,399 @@
+package mattertlv
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// JSON representation of TLV follows notation of chip-tool payloads. Structure is JSON object whose
+// members have keys "<context tag>:<type>", for example {"0:UINT": 1, "1:STRING": "abc"}. Types are
+//
+//	UINT INT                   integers, values which don't fit into 53 bits are JSON strings
+//	FLOAT DOUBLE               32 and 64 bit floats, NaN, Infinity and -Infinity are JSON strings
+//	BOOL STRING NULL           bool, UTF-8 string and null
+//	BYTES                      octet string as base64 (JsonToTlv also accepts "hex:0a0b")
+//	STRUCT                     JSON object
+//	ARRAY-<type>               JSON array of elements of type, ARRAY-? when array is empty
+//
+// JSON does not keep size of integers, so JsonToTlv encodes UINT and INT using smallest size which holds value.
+// Notation is extended by LIST-<type> for TLV lists and by sized types U8 U16 U32 U64 I8 I16 I32 I64
+// accepted by JsonToTlv for payloads which need integer of exact size.
+// Element which is not structure is represented as object with single member "value:<type>".
+
+// largest integer which JSON number holds without loss of precision
+const jsonMaxSafeInt = 1<<53 - 1
+
+// jsonTypeName returns type of element used in JSON key.
+func jsonTypeName(item *TlvItem) (string, error) {
+	switch tp := item.matterType; {
+	case tp <= 3:
+		return "INT", nil
+	case tp <= 7:
+		return "UINT", nil
+	case tp == 8 || tp == 9:
+		return "BOOL", nil
+	case tp == 0xa:
+		return "FLOAT", nil
+	case tp == 0xb:
+		return "DOUBLE", nil
+	case tp <= 0xf:
+		return "STRING", nil
+	case tp <= 0x13:
+		return "BYTES", nil
+	case tp == 0x14:
+		return "NULL", nil
+	case tp == CONTAINER_STRUCT:
+		return "STRUCT", nil
+	case tp == CONTAINER_ARRAY || tp == CONTAINER_LIST:
+		prefix := "ARRAY-"
+		if tp == CONTAINER_LIST {
+			prefix = "LIST-"
+		}
+		element, err := jsonElementType(item.valueList)
+		if err != nil {
+			return "", err
+		}
+		return prefix + element, nil
+	}
+	return "", fmt.Errorf("unknown element type 0x%x", item.matterType)
+}
+
+// jsonElementType returns common type of array elements.
+func jsonElementType(items []TlvItem) (string, error) {
+	if len(items) == 0 {
+		return "?", nil
+	}
+	out := ""
+	for n := range items {
+		name, err := jsonTypeName(&items[n])
+		if err != nil {
+			return "", err
+		}
+		if (out != "") && (name != out) {
+			return "", fmt.Errorf("array contains elements of types %s and %s", out, name)
+		}
+		out = name
+	}
+	return out, nil
+}
+
+func writeJsonValue(out *bytes.Buffer, item *TlvItem) error {
+	switch item.Type {
+	case TypeNull:
+		out.WriteString("null")
+	case TypeBool:
+		out.WriteString(strconv.FormatBool(item.valueBool))
+	case TypeInt:
+		if item.IsSigned() {
+			v := item.GetInt64()
+			if v > jsonMaxSafeInt || v < -jsonMaxSafeInt {
+				fmt.Fprintf(out, "\"%d\"", v)
+			} else {
+				fmt.Fprintf(out, "%d", v)
+			}
+		} else if item.valueInt > jsonMaxSafeInt {
+			fmt.Fprintf(out, "\"%d\"", item.valueInt)
+		} else {
+			fmt.Fprintf(out, "%d", item.valueInt)
+		}
+	case TypeFloat:
+		v := item.valueFloat
+		switch {
+		case math.IsNaN(v):
+			out.WriteString("\"NaN\"")
+		case math.IsInf(v, 1):
+			out.WriteString("\"Infinity\"")
+		case math.IsInf(v, -1):
+			out.WriteString("\"-Infinity\"")
+		case item.matterType == 0xa:
+			out.WriteString(strconv.FormatFloat(v, 'g', -1, 32))
+		default:
+			out.WriteString(strconv.FormatFloat(v, 'g', -1, 64))
+		}
+	case TypeUTF8String:
+		encoded, _ := json.Marshal(item.valueString)
+		out.Write(encoded)
+	case TypeOctetString:
+		out.WriteString("\"" + base64.StdEncoding.EncodeToString(item.valueOctetString) + "\"")
+	case TypeList:
+		if item.matterType == CONTAINER_STRUCT {
+			return writeJsonStruct(out, item)
+		}
+		out.WriteString("[")
+		for n := range item.valueList {
+			if n > 0 {
+				out.WriteString(",")
+			}
+			err := writeJsonValue(out, &item.valueList[n])
+			if err != nil {
+				return err
+			}
+		}
+		out.WriteString("]")
+	}
+	return nil
+}
+
+func writeJsonStruct(out *bytes.Buffer, item *TlvItem) error {
+	out.WriteString("{")
+	for n := range item.valueList {
+		member := &item.valueList[n]
+		if member.tagKind != TagContext {
+			return fmt.Errorf("member of structure does not have context tag")
+		}
+		name, err := jsonTypeName(member)
+		if err != nil {
+			return err
+		}
+		if n > 0 {
+			out.WriteString(",")
+		}
+		fmt.Fprintf(out, "\"%d:%s\":", member.Tag, name)
+		err = writeJsonValue(out, member)
+		if err != nil {
+			return err
+		}
+	}
+	out.WriteString("}")
+	return nil
+}
+
+// TlvToJson converts TLV element into JSON. Structure members must have context tags.
+func TlvToJson(item *TlvItem) ([]byte, error) {
+	var out bytes.Buffer
+	if item.matterType == CONTAINER_STRUCT {
+		err := writeJsonStruct(&out, item)
+		if err != nil {
+			return nil, err
+		}
+		return out.Bytes(), nil
+	}
+	name, err := jsonTypeName(item)
+	if err != nil {
+		return nil, err
+	}
+	fmt.Fprintf(&out, "{\"value:%s\":", name)
+	err = writeJsonValue(&out, item)
+	if err != nil {
+		return nil, err
+	}
+	out.WriteString("}")
+	return out.Bytes(), nil
+}
+
+// jsonMember is member of JSON object with parsed key.
+type jsonMember struct {
+	tag   uint64
+	typ   string
+	value any
+}
+
+// jsonMembers parses keys of JSON object and sorts members by tag.
+func jsonMembers(object map[string]any) ([]jsonMember, error) {
+	out := []jsonMember{}
+	for key, value := range object {
+		tag, typ, found := strings.Cut(key, ":")
+		if !found {
+			return nil, fmt.Errorf("key %s is not in form tag:type", key)
+		}
+		number, err := strconv.ParseUint(tag, 0, 8)
+		if err != nil {
+			return nil, fmt.Errorf("invalid tag of key %s", key)
+		}
+		out = append(out, jsonMember{tag: number, typ: typ, value: value})
+	}
+	sort.Slice(out, func(a, b int) bool {
+		return out[a].tag < out[b].tag
+	})
+	return out, nil
+}
+
+// JsonToTlv converts JSON (as produced by TlvToJson) into TLV encoded anonymous element.
+func JsonToTlv(data []byte) ([]byte, error) {
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+	var root any
+	err := decoder.Decode(&root)
+	if err != nil {
+		return nil, err
+	}
+	object, ok := root.(map[string]any)
+	if !ok {
+		return nil, fmt.Errorf("JSON object expected")
+	}
+	var b TLVBuffer
+	if len(object) == 1 {
+		for key, value := range object {
+			if typ, found := strings.CutPrefix(key, "value:"); found {
+				err = b.writeJson(AnonymousTag(), typ, value)
+				if err != nil {
+					return nil, err
+				}
+				return b.Bytes(), nil
+			}
+		}
+	}
+	err = b.writeJson(AnonymousTag(), "STRUCT", object)
+	if err != nil {
+		return nil, err
+	}
+	return b.Bytes(), nil
+}
+
+// jsonInteger converts JSON number or string (decimal or 0x prefixed) into integer.
+func jsonInteger(value any, signed bool, bits int) (int64, uint64, error) {
+	var text string
+	switch v := value.(type) {
+	case json.Number:
+		text = v.String()
+	case string:
+		text = v
+	default:
+		return 0, 0, fmt.Errorf("integer expected, got %v", value)
+	}
+	if signed {
+		v, err := strconv.ParseInt(text, 0, bits)
+		return v, 0, err
+	}
+	v, err := strconv.ParseUint(text, 0, bits)
+	return 0, v, err
+}
+
+func jsonFloat(value any, bits int) (float64, error) {
+	switch v := value.(type) {
+	case json.Number:
+		return strconv.ParseFloat(v.String(), bits)
+	case string:
+		switch v {
+		case "NaN":
+			return math.NaN(), nil
+		case "Infinity":
+			return math.Inf(1), nil
+		case "-Infinity":
+			return math.Inf(-1), nil
+		}
+		return strconv.ParseFloat(v, bits)
+	}
+	return 0, fmt.Errorf("float expected, got %v", value)
+}
+
+// writeJson encodes JSON value of type typ.
+func (b *TLVBuffer) writeJson(tag Tag, typ string, value any) error {
+	switch typ {
+	case "UINT", "INT":
+		v, u, err := jsonInteger(value, typ == "INT", 64)
+		if err != nil {
+			return err
+		}
+		if typ == "INT" {
+			return b.WriteTagged(tag, smallestInt(v))
+		}
+		return b.WriteTagged(tag, smallestUint(u))
+	case "I8", "I16", "I32", "I64", "U8", "U16", "U32", "U64":
+		bits, _ := strconv.Atoi(typ[1:])
+		v, u, err := jsonInteger(value, typ[0] == 'I', bits)
+		if err != nil {
+			return err
+		}
+		if typ[0] == 'I' {
+			return b.WriteTagged(tag, sizedInt(v, bits))
+		}
+		return b.WriteTagged(tag, sizedUint(u, bits))
+	case "FLOAT":
+		v, err := jsonFloat(value, 32)
+		if err != nil {
+			return err
+		}
+		return b.WriteTagged(tag, float32(v))
+	case "DOUBLE":
+		v, err := jsonFloat(value, 64)
+		if err != nil {
+			return err
+		}
+		return b.WriteTagged(tag, v)
+	case "BOOL":
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("bool expected, got %v", value)
+		}
+		return b.WriteTagged(tag, v)
+	case "STRING":
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("string expected, got %v", value)
+		}
+		return b.WriteTagged(tag, v)
+	case "BYTES":
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("base64 string expected, got %v", value)
+		}
+		var data []byte
+		var err error
+		if hexdata, found := strings.CutPrefix(v, "hex:"); found {
+			data, err = hex.DecodeString(hexdata)
+		} else {
+			data, err = base64.StdEncoding.DecodeString(v)
+		}
+		if err != nil {
+			return err
+		}
+		return b.WriteTagged(tag, data)
+	case "NULL":
+		if value != nil {
+			return fmt.Errorf("null expected, got %v", value)
+		}
+		return b.WriteTagged(tag, nil)
+	case "STRUCT":
+		object, ok := value.(map[string]any)
+		if !ok {
+			return fmt.Errorf("object expected, got %v", value)
+		}
+		members, err := jsonMembers(object)
+		if err != nil {
+			return err
+		}
+		b.WriteTaggedContainer(tag, CONTAINER_STRUCT)
+		for _, m := range members {
+			err = b.writeJson(ContextTag(byte(m.tag)), m.typ, m.value)
+			if err != nil {
+				return err
+			}
+		}
+		b.WriteStructEnd()
+		return nil
+	}
+	if element, found := strings.CutPrefix(typ, "ARRAY-"); found {
+		return b.writeJsonArray(tag, CONTAINER_ARRAY, element, value)
+	}
+	if element, found := strings.CutPrefix(typ, "LIST-"); found {
+		return b.writeJsonArray(tag, CONTAINER_LIST, element, value)
+	}
+	return fmt.Errorf("unknown type %s", typ)
+}
+
+func (b *TLVBuffer) writeJsonArray(tag Tag, container byte, element string, value any) error {
+	items, ok := value.([]any)
+	if !ok {
+		return fmt.Errorf("array expected, got %v", value)
+	}
+	if (element == "?") && (len(items) > 0) {
+		return fmt.Errorf("type of array elements is not known")
+	}
+	b.WriteTaggedContainer(tag, container)
+	for _, item := range items {
+		err := b.writeJson(AnonymousTag(), element, item)
+		if err != nil {
+			return err
+		}
+	}
+	b.WriteStructEnd()
+	return nil
+}
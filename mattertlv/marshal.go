@@ -0,0 +1,314 @@
+package mattertlv
+
+import (
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+// Marshaler is implemented by types which encode themselves into TLV.
+// MarshalTLV must write exactly one element with given tag.
+type Marshaler interface {
+	MarshalTLV(b *TLVBuffer, tag Tag) error
+}
+
+// Unmarshaler is implemented by types which decode themselves from TLV element.
+type Unmarshaler interface {
+	UnmarshalTLV(item *TlvItem) error
+}
+
+var marshalerType = reflect.TypeOf((*Marshaler)(nil)).Elem()
+var unmarshalerType = reflect.TypeOf((*Unmarshaler)(nil)).Elem()
+
+// field is struct field with tlv struct tag.
+type field struct {
+	index     int
+	tag       byte
+	omitempty bool
+	list      bool
+}
+
+// structFields parses struct tags of struct type t. Field is encoded when it has tag in form
+//
+//	`tlv:"<context tag>[,omitempty][,list]"`
+//
+// omitempty skips zero value (nil pointer, empty slice, ...), list encodes slice as list instead of array.
+func structFields(t reflect.Type) ([]field, error) {
+	out := []field{}
+	for n := 0; n < t.NumField(); n++ {
+		sf := t.Field(n)
+		spec, ok := sf.Tag.Lookup("tlv")
+		if !ok || spec == "-" {
+			continue
+		}
+		parts := strings.Split(spec, ",")
+		tag, err := strconv.ParseUint(parts[0], 0, 8)
+		if err != nil {
+			return nil, fmt.Errorf("invalid tlv tag of field %s: %w", sf.Name, err)
+		}
+		if !sf.IsExported() {
+			return nil, fmt.Errorf("tlv tag on unexported field %s", sf.Name)
+		}
+		f := field{index: n, tag: byte(tag)}
+		for _, option := range parts[1:] {
+			switch option {
+			case "omitempty":
+				f.omitempty = true
+			case "list":
+				f.list = true
+			default:
+				return nil, fmt.Errorf("unknown tlv option %s of field %s", option, sf.Name)
+			}
+		}
+		out = append(out, f)
+	}
+	return out, nil
+}
+
+// Marshal encodes v as anonymous TLV element.
+// Structs are encoded as TLV structures with members described by tlv struct tags, slices and arrays
+// as TLV arrays, []byte as octet string, nil pointer as null. Integers are encoded using size of their Go type.
+func Marshal(v any) ([]byte, error) {
+	var b TLVBuffer
+	err := b.WriteValue(AnonymousTag(), v)
+	if err != nil {
+		return nil, err
+	}
+	return b.Bytes(), nil
+}
+
+// WriteValue encodes v with tag using same rules as Marshal.
+func (b *TLVBuffer) WriteValue(tag Tag, v any) error {
+	return b.writeValue(tag, reflect.ValueOf(v), false)
+}
+
+func (b *TLVBuffer) writeValue(tag Tag, v reflect.Value, list bool) error {
+	if !v.IsValid() {
+		return b.WriteTagged(tag, nil)
+	}
+	if v.Type().Implements(marshalerType) {
+		if v.Kind() == reflect.Pointer && v.IsNil() {
+			return b.WriteTagged(tag, nil)
+		}
+		return v.Interface().(Marshaler).MarshalTLV(b, tag)
+	}
+	if v.CanAddr() && v.Addr().Type().Implements(marshalerType) {
+		return v.Addr().Interface().(Marshaler).MarshalTLV(b, tag)
+	}
+	switch v.Kind() {
+	case reflect.Pointer, reflect.Interface:
+		if v.IsNil() {
+			return b.WriteTagged(tag, nil)
+		}
+		return b.writeValue(tag, v.Elem(), list)
+	case reflect.Struct:
+		fields, err := structFields(v.Type())
+		if err != nil {
+			return err
+		}
+		b.WriteTaggedContainer(tag, CONTAINER_STRUCT)
+		for _, f := range fields {
+			fv := v.Field(f.index)
+			if f.omitempty && fv.IsZero() {
+				continue
+			}
+			err = b.writeValue(ContextTag(f.tag), fv, f.list)
+			if err != nil {
+				return err
+			}
+		}
+		b.WriteStructEnd()
+		return nil
+	case reflect.Slice, reflect.Array:
+		if v.Type().Elem().Kind() == reflect.Uint8 {
+			data := make([]byte, v.Len())
+			reflect.Copy(reflect.ValueOf(data), v)
+			return b.WriteTagged(tag, data)
+		}
+		if list {
+			b.WriteTaggedContainer(tag, CONTAINER_LIST)
+		} else {
+			b.WriteTaggedContainer(tag, CONTAINER_ARRAY)
+		}
+		for n := 0; n < v.Len(); n++ {
+			err := b.writeValue(AnonymousTag(), v.Index(n), false)
+			if err != nil {
+				return err
+			}
+		}
+		b.WriteStructEnd()
+		return nil
+	case reflect.Bool:
+		return b.WriteTagged(tag, v.Bool())
+	case reflect.Int8:
+		return b.WriteTagged(tag, int8(v.Int()))
+	case reflect.Int16:
+		return b.WriteTagged(tag, int16(v.Int()))
+	case reflect.Int32:
+		return b.WriteTagged(tag, int32(v.Int()))
+	case reflect.Int, reflect.Int64:
+		return b.WriteTagged(tag, v.Int())
+	case reflect.Uint8:
+		return b.WriteTagged(tag, uint8(v.Uint()))
+	case reflect.Uint16:
+		return b.WriteTagged(tag, uint16(v.Uint()))
+	case reflect.Uint32:
+		return b.WriteTagged(tag, uint32(v.Uint()))
+	case reflect.Uint, reflect.Uint64, reflect.Uintptr:
+		return b.WriteTagged(tag, v.Uint())
+	case reflect.Float32:
+		return b.WriteTagged(tag, float32(v.Float()))
+	case reflect.Float64:
+		return b.WriteTagged(tag, v.Float())
+	case reflect.String:
+		return b.WriteTagged(tag, v.String())
+	}
+	return fmt.Errorf("can't marshal %s", v.Type())
+}
+
+// Unmarshal decodes TLV data into value pointed by v. See UnmarshalItem.
+func Unmarshal(data []byte, v any) error {
+	item, err := Decode(data)
+	if err != nil {
+		return err
+	}
+	return UnmarshalItem(&item, v)
+}
+
+// UnmarshalItem stores decoded TLV element into value pointed by v.
+// Members of TLV structure are matched with struct fields by context tags, unknown members are ignored.
+// Null is accepted only by pointers which are set to nil, absent member leaves field untouched.
+func UnmarshalItem(item *TlvItem, v any) error {
+	rv := reflect.ValueOf(v)
+	if rv.Kind() != reflect.Pointer || rv.IsNil() {
+		return fmt.Errorf("unmarshal target must be non-nil pointer")
+	}
+	return unmarshalValue(item, rv.Elem())
+}
+
+func unmarshalValue(item *TlvItem, v reflect.Value) error {
+	if v.Kind() != reflect.Pointer && v.CanAddr() && v.Addr().Type().Implements(unmarshalerType) {
+		return v.Addr().Interface().(Unmarshaler).UnmarshalTLV(item)
+	}
+	if v.Kind() == reflect.Pointer {
+		if item.Type == TypeNull {
+			v.Set(reflect.Zero(v.Type()))
+			return nil
+		}
+		if v.IsNil() {
+			v.Set(reflect.New(v.Type().Elem()))
+		}
+		return unmarshalValue(item, v.Elem())
+	}
+	if item.Type == TypeNull {
+		return fmt.Errorf("null can't be stored into %s", v.Type())
+	}
+	switch v.Kind() {
+	case reflect.Struct:
+		if item.Type != TypeList || item.matterType != CONTAINER_STRUCT {
+			return typeError(item, v)
+		}
+		fields, err := structFields(v.Type())
+		if err != nil {
+			return err
+		}
+		for n := range item.valueList {
+			member := &item.valueList[n]
+			if member.tagKind != TagContext {
+				continue
+			}
+			for _, f := range fields {
+				if int(f.tag) == member.Tag {
+					err = unmarshalValue(member, v.Field(f.index))
+					if err != nil {
+						return fmt.Errorf("%s.%s: %w", v.Type().Name(), v.Type().Field(f.index).Name, err)
+					}
+				}
+			}
+		}
+		return nil
+	case reflect.Slice:
+		if v.Type().Elem().Kind() == reflect.Uint8 {
+			if item.Type != TypeOctetString {
+				return typeError(item, v)
+			}
+			data := reflect.MakeSlice(v.Type(), len(item.valueOctetString), len(item.valueOctetString))
+			reflect.Copy(data, reflect.ValueOf(item.valueOctetString))
+			v.Set(data)
+			return nil
+		}
+		if item.Type != TypeList || item.matterType == CONTAINER_STRUCT {
+			return typeError(item, v)
+		}
+		out := reflect.MakeSlice(v.Type(), len(item.valueList), len(item.valueList))
+		for n := range item.valueList {
+			err := unmarshalValue(&item.valueList[n], out.Index(n))
+			if err != nil {
+				return err
+			}
+		}
+		v.Set(out)
+		return nil
+	case reflect.Array:
+		if v.Type().Elem().Kind() == reflect.Uint8 {
+			if item.Type != TypeOctetString || len(item.valueOctetString) != v.Len() {
+				return typeError(item, v)
+			}
+			reflect.Copy(v, reflect.ValueOf(item.valueOctetString))
+			return nil
+		}
+		if item.Type != TypeList || item.matterType == CONTAINER_STRUCT || len(item.valueList) != v.Len() {
+			return typeError(item, v)
+		}
+		for n := range item.valueList {
+			err := unmarshalValue(&item.valueList[n], v.Index(n))
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	case reflect.Bool:
+		if item.Type != TypeBool {
+			return typeError(item, v)
+		}
+		v.SetBool(item.valueBool)
+		return nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		if item.Type != TypeInt {
+			return typeError(item, v)
+		}
+		value := item.GetInt64()
+		if (!item.IsSigned() && value < 0) || v.OverflowInt(value) {
+			return fmt.Errorf("value %s overflows %s", item.valueToString(), v.Type())
+		}
+		v.SetInt(value)
+		return nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		if item.Type != TypeInt {
+			return typeError(item, v)
+		}
+		if (item.IsSigned() && item.GetInt64() < 0) || v.OverflowUint(item.valueInt) {
+			return fmt.Errorf("value %s overflows %s", item.valueToString(), v.Type())
+		}
+		v.SetUint(item.valueInt)
+		return nil
+	case reflect.Float32, reflect.Float64:
+		if item.Type != TypeFloat {
+			return typeError(item, v)
+		}
+		v.SetFloat(item.valueFloat)
+		return nil
+	case reflect.String:
+		if item.Type != TypeUTF8String {
+			return typeError(item, v)
+		}
+		v.SetString(item.valueString)
+		return nil
+	}
+	return fmt.Errorf("can't unmarshal into %s", v.Type())
+}
+
+func typeError(item *TlvItem, v reflect.Value) error {
+	return fmt.Errorf("TLV element of type 0x%x can't be stored into %s", item.matterType, v.Type())
+}
@@ -0,0 +1,413 @@
+package mattertlv
+
+import (
+	"encoding/binary"
+	"fmt"
+	"io"
+	"math"
+)
+
+// TlvReader walks TLV encoded data element by element without building TlvItem tree.
+// Values of strings are slices of original data - they are valid as long as data is not modified.
+//
+//	r := NewTlvReader(data)
+//	for r.Next() == nil {
+//		if r.IsContainer() {
+//			r.EnterContainer()
+//			...
+//			r.ExitContainer()
+//		}
+//	}
+//
+// Next returns io.EOF at end of container (or end of data on top level).
+type TlvReader struct {
+	data  []byte
+	pos   int // offset of next element
+	depth int // number of entered containers
+
+	// current element
+	valid   bool
+	at_end  bool // Next reached end of current container
+	ctrl    byte
+	tag     Tag
+	value   uint64 // integer value, bits of float, length of string
+	payload []byte // value of string
+}
+
+// NewTlvReader creates reader of TLV encoded data. Call Next to read first element.
+func NewTlvReader(data []byte) *TlvReader {
+	return &TlvReader{data: data}
+}
+
+func (r *TlvReader) truncated(n int) error {
+	return fmt.Errorf("truncated TLV: %d bytes needed, %d available", n, len(r.data)-r.pos)
+}
+
+// readUint reads little endian unsigned integer of size 1, 2, 4 or 8 bytes.
+func (r *TlvReader) readUint(size int) (uint64, error) {
+	if r.pos+size > len(r.data) {
+		return 0, r.truncated(size)
+	}
+	b := r.data[r.pos:]
+	r.pos += size
+	switch size {
+	case 1:
+		return uint64(b[0]), nil
+	case 2:
+		return uint64(binary.LittleEndian.Uint16(b)), nil
+	case 4:
+		return uint64(binary.LittleEndian.Uint32(b)), nil
+	}
+	return binary.LittleEndian.Uint64(b), nil
+}
+
+func (r *TlvReader) readTag(tagctrl byte) error {
+	size := tagSizes[tagctrl]
+	if r.pos+size > len(r.data) {
+		return r.truncated(size)
+	}
+	b := r.data[r.pos : r.pos+size]
+	r.pos += size
+	r.tag = Tag{Kind: tagKinds[tagctrl]}
+	if tagctrl >= 6 {
+		r.tag.Vendor = binary.LittleEndian.Uint16(b[0:2])
+		r.tag.Profile = binary.LittleEndian.Uint16(b[2:4])
+		b = b[4:]
+	}
+	switch len(b) {
+	case 1:
+		r.tag.Number = uint32(b[0])
+	case 2:
+		r.tag.Number = uint32(binary.LittleEndian.Uint16(b))
+	case 4:
+		r.tag.Number = binary.LittleEndian.Uint32(b)
+	}
+	return nil
+}
+
+// skipContainer skips members of current container which was not entered.
+func (r *TlvReader) skipContainer() error {
+	level := 1
+	for level > 0 {
+		if r.pos >= len(r.data) {
+			return fmt.Errorf("unterminated TLV container")
+		}
+		end, err := r.readElement()
+		if err != nil {
+			return err
+		}
+		if end {
+			r.pos++
+			level--
+		} else if r.IsContainer() {
+			level++
+		}
+	}
+	return nil
+}
+
+// Next moves to next element of current container. It returns io.EOF at end of container.
+func (r *TlvReader) Next() error {
+	if r.at_end {
+		return io.EOF
+	}
+	if r.valid && r.IsContainer() {
+		err := r.skipContainer()
+		if err != nil {
+			return err
+		}
+	}
+	r.valid = false
+	if r.pos >= len(r.data) {
+		if r.depth > 0 {
+			return fmt.Errorf("unterminated TLV container")
+		}
+		r.at_end = true
+		return io.EOF
+	}
+	end, err := r.readElement()
+	if err != nil {
+		return err
+	}
+	if end {
+		if r.depth == 0 {
+			return fmt.Errorf("unexpected end of container")
+		}
+		// marker is consumed by ExitContainer
+		r.at_end = true
+		return io.EOF
+	}
+	r.valid = true
+	return nil
+}
+
+// readElement reads header and value of element at current position. End of container marker
+// is not consumed, true is returned instead.
+func (r *TlvReader) readElement() (bool, error) {
+	fb := r.data[r.pos]
+	tp := fb & 0x1f
+	tagctrl := fb >> 5
+	if tp == 0x18 {
+		if tagctrl != 0 {
+			return false, fmt.Errorf("end of container with tag")
+		}
+		return true, nil
+	}
+	r.pos++
+	err := r.readTag(tagctrl)
+	if err != nil {
+		return false, err
+	}
+	r.ctrl = tp
+	r.payload = nil
+	switch {
+	case tp <= 7:
+		r.value, err = r.readUint(1 << (tp & 3))
+	case tp == 8 || tp == 9 || tp == 0x14:
+		r.value = 0
+	case tp == 0xa:
+		r.value, err = r.readUint(4)
+	case tp == 0xb:
+		r.value, err = r.readUint(8)
+	case tp <= 0x13:
+		r.value, err = r.readUint(1 << (tp & 3))
+		if err != nil {
+			break
+		}
+		if r.value > uint64(len(r.data)-r.pos) {
+			return false, fmt.Errorf("truncated TLV: string of length %d, %d bytes available", r.value, len(r.data)-r.pos)
+		}
+		r.payload = r.data[r.pos : r.pos+int(r.value) : r.pos+int(r.value)]
+		r.pos += int(r.value)
+	case tp <= 0x17:
+	default:
+		return false, fmt.Errorf("unknown TLV element type 0x%x", tp)
+	}
+	return false, err
+}
+
+// EnterContainer moves into current element which must be structure, array or list.
+// Next then iterates its members.
+func (r *TlvReader) EnterContainer() error {
+	if !r.valid || !r.IsContainer() {
+		return fmt.Errorf("current element is not container")
+	}
+	if r.depth >= maxDepth {
+		return fmt.Errorf("TLV containers nested deeper than %d", maxDepth)
+	}
+	r.depth++
+	r.valid = false
+	return nil
+}
+
+// ExitContainer skips remaining members of entered container and moves after its end.
+// Next then continues with element which follows container.
+func (r *TlvReader) ExitContainer() error {
+	if r.depth == 0 {
+		return fmt.Errorf("no container entered")
+	}
+	for !r.at_end {
+		err := r.Next()
+		if err != nil && err != io.EOF {
+			return err
+		}
+	}
+	r.pos++ // end of container marker
+	r.depth--
+	r.at_end = false
+	r.valid = false
+	return nil
+}
+
+// Depth returns number of entered containers.
+func (r *TlvReader) Depth() int {
+	return r.depth
+}
+
+// Tag returns tag of current element.
+func (r *TlvReader) Tag() Tag {
+	return r.tag
+}
+
+// ElementType returns type of current element.
+func (r *TlvReader) ElementType() ElementType {
+	switch tp := r.ctrl; {
+	case tp <= 7:
+		return TypeInt
+	case tp == 8 || tp == 9:
+		return TypeBool
+	case tp <= 0xb:
+		return TypeFloat
+	case tp <= 0xf:
+		return TypeUTF8String
+	case tp <= 0x13:
+		return TypeOctetString
+	case tp == 0x14:
+		return TypeNull
+	}
+	return TypeList
+}
+
+// ContainerType returns CONTAINER_STRUCT, CONTAINER_ARRAY or CONTAINER_LIST when current element
+// is container, 0 otherwise.
+func (r *TlvReader) ContainerType() byte {
+	if r.IsContainer() {
+		return r.ctrl
+	}
+	return 0
+}
+
+// IsContainer returns true when current element is structure, array or list.
+func (r *TlvReader) IsContainer() bool {
+	return r.ctrl >= CONTAINER_STRUCT && r.ctrl <= CONTAINER_LIST
+}
+
+// IsSigned returns true when current element is signed integer.
+func (r *TlvReader) IsSigned() bool {
+	return r.ctrl <= 3
+}
+
+// IsNull returns true when current element is null.
+func (r *TlvReader) IsNull() bool {
+	return r.ctrl == 0x14
+}
+
+func (r *TlvReader) typeError(expected string) error {
+	return fmt.Errorf("TLV element of type 0x%x is not %s", r.ctrl, expected)
+}
+
+// Uint returns value of current unsigned integer element. Non-negative signed integers are accepted too.
+func (r *TlvReader) Uint() (uint64, error) {
+	if r.ctrl > 7 {
+		return 0, r.typeError("integer")
+	}
+	if r.IsSigned() {
+		v, _ := r.Int()
+		if v < 0 {
+			return 0, fmt.Errorf("negative value %d", v)
+		}
+		return uint64(v), nil
+	}
+	return r.value, nil
+}
+
+// Int returns value of current integer element.
+func (r *TlvReader) Int() (int64, error) {
+	if r.ctrl > 7 {
+		return 0, r.typeError("integer")
+	}
+	if !r.IsSigned() {
+		if r.value > math.MaxInt64 {
+			return 0, fmt.Errorf("value %d overflows int64", r.value)
+		}
+		return int64(r.value), nil
+	}
+	shift := 64 - 8*(1<<r.ctrl)
+	return int64(r.value<<shift) >> shift, nil
+}
+
+// Bool returns value of current boolean element.
+func (r *TlvReader) Bool() (bool, error) {
+	if r.ctrl != 8 && r.ctrl != 9 {
+		return false, r.typeError("bool")
+	}
+	return r.ctrl == 9, nil
+}
+
+// Float returns value of current floating point element.
+func (r *TlvReader) Float() (float64, error) {
+	switch r.ctrl {
+	case 0xa:
+		return float64(math.Float32frombits(uint32(r.value))), nil
+	case 0xb:
+		return math.Float64frombits(r.value), nil
+	}
+	return 0, r.typeError("float")
+}
+
+// Bytes returns value of current octet or UTF-8 string element. Returned slice points into data of reader.
+func (r *TlvReader) Bytes() ([]byte, error) {
+	if r.ctrl < 0xc || r.ctrl > 0x13 {
+		return nil, r.typeError("string")
+	}
+	return r.payload, nil
+}
+
+// String returns value of current UTF-8 string element.
+func (r *TlvReader) String() (string, error) {
+	if r.ctrl < 0xc || r.ctrl > 0xf {
+		return "", r.typeError("UTF-8 string")
+	}
+	return string(r.payload), nil
+}
+
+// decoder builds TlvItem tree using TlvReader. Members of containers are collected in scratch
+// slices reused for every container on same depth so that each container allocates its members once.
+type decoder struct {
+	reader  *TlvReader
+	scratch [][]TlvItem
+}
+
+// item converts current element of reader into TlvItem.
+func (d *decoder) item() (TlvItem, error) {
+	r := d.reader
+	out := TlvItem{
+		Tag:        int(r.tag.Number),
+		Type:       r.ElementType(),
+		matterType: r.ctrl,
+		tagKind:    r.tag.Kind,
+		tagVendor:  r.tag.Vendor,
+		tagProfile: r.tag.Profile,
+	}
+	switch out.Type {
+	case TypeInt:
+		out.valueInt = r.value
+		if r.IsSigned() {
+			v, _ := r.Int()
+			out.valueInt = uint64(v)
+		}
+	case TypeBool:
+		out.valueBool, _ = r.Bool()
+	case TypeFloat:
+		out.valueFloat, _ = r.Float()
+	case TypeUTF8String:
+		out.valueString = string(r.payload)
+	case TypeOctetString:
+		out.valueOctetString = append([]byte{}, r.payload...)
+	case TypeList:
+		err := r.EnterContainer()
+		if err != nil {
+			return out, err
+		}
+		depth := r.Depth()
+		for len(d.scratch) <= depth {
+			d.scratch = append(d.scratch, nil)
+		}
+		members := d.scratch[depth][:0]
+		for {
+			err = r.Next()
+			if err == io.EOF {
+				break
+			}
+			if err != nil {
+				return out, err
+			}
+			member, err := d.item()
+			if err != nil {
+				return out, err
+			}
+			members = append(members, member)
+		}
+		if len(members) > 0 {
+			out.valueList = make([]TlvItem, len(members))
+			copy(out.valueList, members)
+		}
+		clear(members)
+		d.scratch[depth] = members[:0]
+		err = r.ExitContainer()
+		if err != nil {
+			return out, err
+		}
+	}
+	return out, nil
+}
@@ -0,0 +1,437 @@
+package mattertlv
+
+import (
+	"encoding/hex"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// Text notation of TLV is compact human readable form used for payloads given on command line:
+//
+//	{0: 150u8, 1: -5i16, 2: 1.5f32, 3: "abc", 4: hex:0a0b, 5: [1, 2], 6: null, 7: true}
+//
+// Structure is {tag: value, ...}, array is [value, ...] and list is list[value or tag: value, ...].
+// Integers have suffix u8 u16 u32 u64 i8 i16 i32 i64, integer without suffix is encoded using smallest
+// size which holds it. Floats have suffix f32 or f64, float without suffix is f64.
+// Octet string is hex:<hex digits>. Tags are context tags, other forms are written as common(n),
+// implicit(n) and fq(vendor,profile,n).
+
+// FormatText returns element in text notation.
+func FormatText(item *TlvItem) string {
+	var out strings.Builder
+	formatText(&out, item)
+	return out.String()
+}
+
+func formatTag(out *strings.Builder, item *TlvItem) {
+	switch item.tagKind {
+	case TagContext:
+		fmt.Fprintf(out, "%d: ", item.Tag)
+	case TagCommonProfile:
+		fmt.Fprintf(out, "common(%d): ", item.Tag)
+	case TagImplicitProfile:
+		fmt.Fprintf(out, "implicit(%d): ", item.Tag)
+	case TagFullyQualified:
+		fmt.Fprintf(out, "fq(0x%04x,0x%04x,%d): ", item.tagVendor, item.tagProfile, item.Tag)
+	}
+}
+
+func formatText(out *strings.Builder, item *TlvItem) {
+	switch item.Type {
+	case TypeNull:
+		out.WriteString("null")
+	case TypeBool:
+		out.WriteString(strconv.FormatBool(item.valueBool))
+	case TypeInt:
+		out.WriteString(item.valueToString())
+		out.WriteString([]string{"i8", "i16", "i32", "i64", "u8", "u16", "u32", "u64"}[item.matterType])
+	case TypeFloat:
+		bits := 64
+		if item.matterType == 0xa {
+			bits = 32
+		}
+		switch {
+		case math.IsNaN(item.valueFloat):
+			out.WriteString("nan")
+		case math.IsInf(item.valueFloat, 1):
+			out.WriteString("inf")
+		case math.IsInf(item.valueFloat, -1):
+			out.WriteString("-inf")
+		default:
+			text := strconv.FormatFloat(item.valueFloat, 'g', -1, bits)
+			if !strings.ContainsAny(text, ".e") {
+				text += ".0"
+			}
+			out.WriteString(text)
+		}
+		fmt.Fprintf(out, "f%d", bits)
+	case TypeUTF8String:
+		out.WriteString(strconv.Quote(item.valueString))
+	case TypeOctetString:
+		out.WriteString("hex:" + hex.EncodeToString(item.valueOctetString))
+	case TypeList:
+		open, end := "[", "]"
+		switch item.matterType {
+		case CONTAINER_STRUCT:
+			open, end = "{", "}"
+		case CONTAINER_LIST:
+			open = "list["
+		}
+		out.WriteString(open)
+		for n := range item.valueList {
+			if n > 0 {
+				out.WriteString(", ")
+			}
+			formatTag(out, &item.valueList[n])
+			formatText(out, &item.valueList[n])
+		}
+		out.WriteString(end)
+	}
+}
+
+// textParser parses text notation into TLVBuffer.
+type textParser struct {
+	text string
+	pos  int
+	out  TLVBuffer
+}
+
+// ParseText converts element in text notation into TLV encoded anonymous element.
+func ParseText(text string) ([]byte, error) {
+	p := textParser{text: text}
+	err := p.value(AnonymousTag())
+	if err != nil {
+		return nil, err
+	}
+	p.skipSpace()
+	if p.pos < len(p.text) {
+		return nil, p.errorf("unexpected %q after value", p.text[p.pos:])
+	}
+	return p.out.Bytes(), nil
+}
+
+func (p *textParser) errorf(format string, args ...any) error {
+	return fmt.Errorf("position %d: %s", p.pos, fmt.Sprintf(format, args...))
+}
+
+func (p *textParser) skipSpace() {
+	for p.pos < len(p.text) && strings.IndexByte(" \t\r\n", p.text[p.pos]) >= 0 {
+		p.pos++
+	}
+}
+
+// peek returns next non-space character or 0 at end of text.
+func (p *textParser) peek() byte {
+	p.skipSpace()
+	if p.pos >= len(p.text) {
+		return 0
+	}
+	return p.text[p.pos]
+}
+
+func (p *textParser) expect(c byte) error {
+	if p.peek() != c {
+		return p.errorf("%q expected", c)
+	}
+	p.pos++
+	return nil
+}
+
+// token returns word made of letters, digits and characters used in numbers.
+func (p *textParser) token() string {
+	p.skipSpace()
+	start := p.pos
+	for p.pos < len(p.text) {
+		c := p.text[p.pos]
+		if (c >= '0' && c <= '9') || (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z') || strings.IndexByte("+-._", c) >= 0 {
+			p.pos++
+			continue
+		}
+		break
+	}
+	return p.text[start:p.pos]
+}
+
+func (p *textParser) number(text string, bits int) (uint64, error) {
+	v, err := strconv.ParseUint(text, 0, bits)
+	if err != nil {
+		return 0, p.errorf("invalid number %s", text)
+	}
+	return v, nil
+}
+
+// tag parses tag followed by colon.
+func (p *textParser) tag() (Tag, error) {
+	word := p.token()
+	var tag Tag
+	switch word {
+	case "common", "implicit", "fq":
+		if err := p.expect('('); err != nil {
+			return tag, err
+		}
+		numbers := []uint64{}
+		for {
+			bits := 32
+			if word == "fq" && len(numbers) < 2 {
+				bits = 16
+			}
+			n, err := p.number(p.token(), bits)
+			if err != nil {
+				return tag, err
+			}
+			numbers = append(numbers, n)
+			if p.peek() != ',' {
+				break
+			}
+			p.pos++
+		}
+		if err := p.expect(')'); err != nil {
+			return tag, err
+		}
+		switch {
+		case word == "common" && len(numbers) == 1:
+			tag = CommonProfileTag(uint32(numbers[0]))
+		case word == "implicit" && len(numbers) == 1:
+			tag = ImplicitProfileTag(uint32(numbers[0]))
+		case word == "fq" && len(numbers) == 3:
+			tag = FullyQualifiedTag(uint16(numbers[0]), uint16(numbers[1]), uint32(numbers[2]))
+		default:
+			return tag, p.errorf("invalid number of arguments of %s tag", word)
+		}
+	default:
+		n, err := p.number(word, 8)
+		if err != nil {
+			return tag, err
+		}
+		tag = ContextTag(byte(n))
+	}
+	return tag, p.expect(':')
+}
+
+// members parses members of container until end character.
+func (p *textParser) members(end byte, tagged bool, optional_tag bool) error {
+	for {
+		if p.peek() == end {
+			p.pos++
+			return nil
+		}
+		tag := AnonymousTag()
+		if tagged || (optional_tag && p.hasTag()) {
+			var err error
+			tag, err = p.tag()
+			if err != nil {
+				return err
+			}
+		}
+		err := p.value(tag)
+		if err != nil {
+			return err
+		}
+		switch p.peek() {
+		case ',':
+			p.pos++
+		case end:
+		default:
+			return p.errorf("',' or %q expected", end)
+		}
+	}
+}
+
+// hasTag reports whether next list item starts with tag.
+func (p *textParser) hasTag() bool {
+	save := p.pos
+	defer func() { p.pos = save }()
+	_, err := p.tag()
+	return err == nil
+}
+
+func (p *textParser) value(tag Tag) error {
+	switch c := p.peek(); c {
+	case 0:
+		return p.errorf("value expected")
+	case '{':
+		p.pos++
+		p.out.WriteTaggedContainer(tag, CONTAINER_STRUCT)
+		if err := p.members('}', true, false); err != nil {
+			return err
+		}
+		p.out.WriteStructEnd()
+		return nil
+	case '[':
+		p.pos++
+		p.out.WriteTaggedContainer(tag, CONTAINER_ARRAY)
+		if err := p.members(']', false, false); err != nil {
+			return err
+		}
+		p.out.WriteStructEnd()
+		return nil
+	case '"':
+		end := p.pos + 1
+		for end < len(p.text) && p.text[end] != '"' {
+			if p.text[end] == '\\' {
+				end++
+			}
+			end++
+		}
+		if end >= len(p.text) {
+			return p.errorf("unterminated string")
+		}
+		s, err := strconv.Unquote(p.text[p.pos : end+1])
+		if err != nil {
+			return p.errorf("invalid string")
+		}
+		p.pos = end + 1
+		return p.out.WriteTagged(tag, s)
+	}
+	start := p.pos
+	word := p.token()
+	switch {
+	case word == "list":
+		p.out.WriteTaggedContainer(tag, CONTAINER_LIST)
+		if err := p.expect('['); err != nil {
+			return err
+		}
+		if err := p.members(']', false, true); err != nil {
+			return err
+		}
+		p.out.WriteStructEnd()
+		return nil
+	case word == "hex":
+		if err := p.expect(':'); err != nil {
+			return err
+		}
+		digits := p.token()
+		data, err := hex.DecodeString(digits)
+		if err != nil {
+			return p.errorf("invalid hex %s", digits)
+		}
+		return p.out.WriteTagged(tag, data)
+	case word == "true" || word == "false":
+		return p.out.WriteTagged(tag, word == "true")
+	case word == "null":
+		return p.out.WriteTagged(tag, nil)
+	case word == "":
+		return p.errorf("unexpected %q", p.text[p.pos])
+	}
+	value, err := p.scalar(word)
+	if err != nil {
+		p.pos = start
+		return err
+	}
+	return p.out.WriteTagged(tag, value)
+}
+
+// scalar converts number with optional type suffix into Go value accepted by WriteTagged.
+func (p *textParser) scalar(word string) (any, error) {
+	for _, suffix := range []string{"u8", "u16", "u32", "u64", "i8", "i16", "i32", "i64", "f32", "f64"} {
+		text, found := strings.CutSuffix(word, suffix)
+		if !found || text == "" || strings.HasPrefix(text, "0x") && suffix[0] == 'f' {
+			continue
+		}
+		bits, _ := strconv.Atoi(suffix[1:])
+		switch suffix[0] {
+		case 'u':
+			v, err := strconv.ParseUint(text, 0, bits)
+			if err != nil {
+				return nil, p.errorf("invalid %s value %s", suffix, text)
+			}
+			return sizedUint(v, bits), nil
+		case 'i':
+			v, err := strconv.ParseInt(text, 0, bits)
+			if err != nil {
+				return nil, p.errorf("invalid %s value %s", suffix, text)
+			}
+			return sizedInt(v, bits), nil
+		case 'f':
+			v, err := parseFloat(text, bits)
+			if err != nil {
+				return nil, p.errorf("invalid %s value %s", suffix, text)
+			}
+			if bits == 32 {
+				return float32(v), nil
+			}
+			return v, nil
+		}
+	}
+	if strings.ContainsAny(word, ".") || word == "nan" || word == "inf" || word == "-inf" ||
+		(!strings.HasPrefix(word, "0x") && strings.ContainsAny(word, "eE")) {
+		v, err := parseFloat(word, 64)
+		if err != nil {
+			return nil, p.errorf("invalid float %s", word)
+		}
+		return v, nil
+	}
+	if v, err := strconv.ParseUint(word, 0, 64); err == nil {
+		return smallestUint(v), nil
+	}
+	if v, err := strconv.ParseInt(word, 0, 64); err == nil {
+		return smallestInt(v), nil
+	}
+	return nil, p.errorf("invalid value %s", word)
+}
+
+// smallestUint returns value as smallest Go unsigned integer which holds it.
+func smallestUint(v uint64) any {
+	switch {
+	case v <= math.MaxUint8:
+		return uint8(v)
+	case v <= math.MaxUint16:
+		return uint16(v)
+	case v <= math.MaxUint32:
+		return uint32(v)
+	}
+	return v
+}
+
+// smallestInt returns value as smallest Go signed integer which holds it.
+func smallestInt(v int64) any {
+	switch {
+	case v >= math.MinInt8 && v <= math.MaxInt8:
+		return int8(v)
+	case v >= math.MinInt16 && v <= math.MaxInt16:
+		return int16(v)
+	case v >= math.MinInt32 && v <= math.MaxInt32:
+		return int32(v)
+	}
+	return v
+}
+
+// sizedUint returns value as Go unsigned integer of size bits.
+func sizedUint(v uint64, bits int) any {
+	switch bits {
+	case 8:
+		return uint8(v)
+	case 16:
+		return uint16(v)
+	case 32:
+		return uint32(v)
+	}
+	return v
+}
+
+// sizedInt returns value as Go signed integer of size bits.
+func sizedInt(v int64, bits int) any {
+	switch bits {
+	case 8:
+		return int8(v)
+	case 16:
+		return int16(v)
+	case 32:
+		return int32(v)
+	}
+	return v
+}
+
+func parseFloat(text string, bits int) (float64, error) {
+	switch text {
+	case "nan":
+		return math.NaN(), nil
+	case "inf":
+		return math.Inf(1), nil
+	case "-inf":
+		return math.Inf(-1), nil
+	}
+	return strconv.ParseFloat(text, bits)
+}
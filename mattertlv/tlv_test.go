@@ -3,6 +3,9 @@ package mattertlv
 import (
 	"encoding/hex"
 	"fmt"
+	"io"
+	"math"
+	"strings"
 	"testing"
 )
 
@@ -17,7 +20,10 @@ func TestBasicEmptyAnonStruct(t *testing.T) {
 		t.Fatalf("incorrect %s", hex.EncodeToString(encoded))
 	}
 
-	decoded := Decode(encoded)
+	decoded, err := Decode(encoded)
+	if err != nil {
+		t.Fatalf("decode failed: %s", err.Error())
+	}
 	if len(decoded.GetChild()) != 0 {
 		t.Fatalf("empty struct test failed")
 	}
@@ -39,7 +45,10 @@ func TestBasic(t *testing.T) {
 
 	encoded := encoder.Bytes()
 
-	decoded := Decode(encoded)
+	decoded, err := Decode(encoded)
+	if err != nil {
+		t.Fatalf("decode failed: %s", err.Error())
+	}
 	if len(decoded.GetChild()) != 8 {
 		t.Fatalf("empty struct test failed")
 	}
@@ -93,7 +102,10 @@ func TestRec(t *testing.T) {
 		t.Fatal("invalid encode")
 	}
 
-	decoded := Decode(encoded)
+	decoded, err := Decode(encoded)
+	if err != nil {
+		t.Fatalf("decode failed: %s", err.Error())
+	}
 
 	i, err := decoded.GetIntRec([]int{2, 0, 3})
 	if err != nil {
@@ -124,3 +136,575 @@ func TestRec(t *testing.T) {
 		t.Fatal("incorrect value")
 	}
 }
+
+func TestSignedAndFloat(t *testing.T) {
+	var encoder TLVBuffer
+
+	encoder.WriteAnonStruct()
+	encoder.WriteInt8(1, -17)
+	encoder.WriteInt16(2, -1234)
+	encoder.WriteInt32(3, -123456)
+	encoder.WriteInt64(4, -0x123456789a)
+	encoder.WriteFloat32(5, 17.9)
+	encoder.WriteFloat64(6, -2.5)
+	encoder.WriteNull(7)
+	encoder.WriteUTF8String(8, "hello")
+	encoder.WriteStructEnd()
+
+	encoded := encoder.Bytes()
+	if hex.EncodeToString(encoded) != "152001ef21022efb2203c01dfeff23046687a9cbedffffff2a0533338f412b0600000000000004c034072c080568656c6c6f18" {
+		t.Fatalf("incorrect encoding %s", hex.EncodeToString(encoded))
+	}
+
+	decoded, err := Decode(encoded)
+	if err != nil {
+		t.Fatalf("decode failed: %s", err.Error())
+	}
+	if decoded.GetItemWithTag(1).GetInt64() != -17 || !decoded.GetItemWithTag(1).IsSigned() {
+		t.Fatalf("incorrect int8")
+	}
+	if decoded.GetItemWithTag(2).GetInt() != -1234 {
+		t.Fatalf("incorrect int16")
+	}
+	if decoded.GetItemWithTag(3).GetInt64() != -123456 {
+		t.Fatalf("incorrect int32")
+	}
+	if decoded.GetItemWithTag(4).GetInt64() != -0x123456789a {
+		t.Fatalf("incorrect int64")
+	}
+	if float32(decoded.GetItemWithTag(5).GetFloat()) != float32(17.9) {
+		t.Fatalf("incorrect float")
+	}
+	if decoded.GetItemWithTag(6).GetFloat() != -2.5 {
+		t.Fatalf("incorrect double")
+	}
+	if !decoded.GetItemWithTag(7).IsNull() {
+		t.Fatalf("incorrect null")
+	}
+	if decoded.GetItemWithTag(8).GetString() != "hello" {
+		t.Fatalf("incorrect string")
+	}
+}
+
+func TestLongStrings(t *testing.T) {
+	long := strings.Repeat("x", 300)
+	var encoder TLVBuffer
+	encoder.WriteAnonStruct()
+	encoder.WriteUTF8String(1, long)
+	encoder.WriteOctetString(2, []byte(long))
+	encoder.WriteStructEnd()
+
+	encoded := encoder.Bytes()
+	if hex.EncodeToString(encoded[:4]) != "152d012c" {
+		t.Fatalf("incorrect encoding %s", hex.EncodeToString(encoded[:4]))
+	}
+	decoded, err := Decode(encoded)
+	if err != nil {
+		t.Fatalf("decode failed: %s", err.Error())
+	}
+	if decoded.GetItemWithTag(1).GetString() != long {
+		t.Fatalf("incorrect string")
+	}
+	if string(decoded.GetItemWithTag(2).GetOctetString()) != long {
+		t.Fatalf("incorrect octet string")
+	}
+
+	// octet string with 4 byte length
+	decoded, err = Decode([]byte{0x12, 3, 0, 0, 0, 1, 2, 3})
+	if err != nil {
+		t.Fatalf("decode failed: %s", err.Error())
+	}
+	if hex.EncodeToString(decoded.GetOctetString()) != "010203" {
+		t.Fatalf("incorrect octet string with 4 byte length")
+	}
+}
+
+func TestProfileTags(t *testing.T) {
+	var encoder TLVBuffer
+	encoder.WriteTaggedContainer(AnonymousTag(), CONTAINER_STRUCT)
+	encoder.WriteTagged(CommonProfileTag(1), uint8(42))
+	encoder.WriteTagged(ImplicitProfileTag(0x12345678), int16(-2))
+	encoder.WriteTagged(FullyQualifiedTag(0xfff1, 0xdeed, 1), uint8(42))
+	encoder.WriteTagged(FullyQualifiedTag(0xfff1, 0xdeed, 0xaa55feed), true)
+	encoder.WriteStructEnd()
+
+	encoded := encoder.Bytes()
+	if hex.EncodeToString(encoded) != "154401002aa178563412feffc4f1ffedde01002ae9f1ffeddeedfe55aa18" {
+		t.Fatalf("incorrect encoding %s", hex.EncodeToString(encoded))
+	}
+
+	decoded, err := Decode(encoded)
+	if err != nil {
+		t.Fatalf("decode failed: %s", err.Error())
+	}
+	items := decoded.GetChild()
+	if len(items) != 4 {
+		t.Fatalf("incorrect item count %d", len(items))
+	}
+	if items[0].TagKind() != TagCommonProfile || items[0].Tag != 1 || items[0].GetInt() != 42 {
+		t.Fatalf("incorrect common profile tag")
+	}
+	if items[1].TagKind() != TagImplicitProfile || items[1].Tag != 0x12345678 || items[1].GetInt() != -2 {
+		t.Fatalf("incorrect implicit profile tag")
+	}
+	if items[2].FullTag() != FullyQualifiedTag(0xfff1, 0xdeed, 1) {
+		t.Fatalf("incorrect fully qualified tag")
+	}
+	if items[3].FullTag() != FullyQualifiedTag(0xfff1, 0xdeed, 0xaa55feed) || !items[3].GetBool() {
+		t.Fatalf("incorrect fully qualified tag")
+	}
+}
+
+func TestMalformed(t *testing.T) {
+	deep := []byte{}
+	for n := 0; n < 100; n++ {
+		deep = append(deep, 0x16)
+	}
+	inputs := map[string][]byte{
+		"empty":            {},
+		"unknown type":     {0x19},
+		"truncated int":    {0x25, 0x01, 0x12},
+		"truncated tag":    {0xc4, 0xf1, 0xff},
+		"truncated string": {0x2c, 0x01, 0x05, 'a', 'b'},
+		"long length":      {0x12, 0xff, 0xff, 0xff, 0xff, 1, 2},
+		"unterminated":     {0x15, 0x24, 0x01, 0x02},
+		"end only":         {0x18},
+		"deep":             deep,
+	}
+	for name, input := range inputs {
+		_, err := Decode(input)
+		if err == nil {
+			t.Fatalf("%s: error expected", name)
+		}
+	}
+}
+
+func FuzzDecode(f *testing.F) {
+	var encoder TLVBuffer
+	encoder.WriteAnonStruct()
+	encoder.WriteUInt8(1, 5)
+	encoder.WriteInt16(2, -5)
+	encoder.WriteFloat64(3, 1.5)
+	encoder.WriteUTF8String(4, "abc")
+	encoder.WriteArray(5)
+	encoder.WriteAnonList()
+	encoder.WriteNull(1)
+	encoder.WriteStructEnd()
+	encoder.WriteStructEnd()
+	encoder.WriteTagged(FullyQualifiedTag(1, 2, 3), true)
+	encoder.WriteStructEnd()
+	f.Add(encoder.Bytes())
+	f.Add([]byte{0x15, 0x18})
+	f.Add([]byte{0x16, 0x16, 0x18})
+	f.Fuzz(func(t *testing.T, data []byte) {
+		// reader must not panic also when containers are skipped
+		r := NewTlvReader(data)
+		for r.Next() == nil {
+		}
+		decoded, err := Decode(data)
+		if err != nil {
+			return
+		}
+		var buf strings.Builder
+		decoded.DumpToString(&buf, 0)
+	})
+}
+
+type testTemperature int16
+
+func (t testTemperature) MarshalTLV(b *TLVBuffer, tag Tag) error {
+	return b.WriteTagged(tag, int16(t*100))
+}
+
+func (t *testTemperature) UnmarshalTLV(item *TlvItem) error {
+	*t = testTemperature(item.GetInt64() / 100)
+	return nil
+}
+
+type testInner struct {
+	Name  string `tlv:"0"`
+	Value int16  `tlv:"1"`
+}
+
+type testOuter struct {
+	Id          uint16          `tlv:"1"`
+	Key         []byte          `tlv:"2"`
+	Inner       testInner       `tlv:"3"`
+	Items       []uint16        `tlv:"4,list"`
+	Entries     []testInner     `tlv:"5"`
+	Optional    *uint32         `tlv:"6,omitempty"`
+	Nullable    *uint32         `tlv:"7"`
+	Temperature testTemperature `tlv:"8"`
+	Ignored     int
+}
+
+func TestMarshal(t *testing.T) {
+	seven := uint32(7)
+	in := testOuter{
+		Id:          0x1234,
+		Key:         []byte{1, 2},
+		Inner:       testInner{Name: "a", Value: -1},
+		Items:       []uint16{},
+		Entries:     []testInner{{Name: "b", Value: 2}},
+		Optional:    &seven,
+		Temperature: 21,
+		Ignored:     5,
+	}
+	encoded, err := Marshal(in)
+	if err != nil {
+		t.Fatalf("marshal failed %s", err.Error())
+	}
+
+	var expected TLVBuffer
+	expected.WriteAnonStruct()
+	expected.WriteUInt16(1, 0x1234)
+	expected.WriteOctetString(2, []byte{1, 2})
+	expected.WriteStruct(3)
+	expected.WriteUTF8String(0, "a")
+	expected.WriteInt16(1, -1)
+	expected.WriteStructEnd()
+	expected.WriteList(4)
+	expected.WriteStructEnd()
+	expected.WriteArray(5)
+	expected.WriteAnonStruct()
+	expected.WriteUTF8String(0, "b")
+	expected.WriteInt16(1, 2)
+	expected.WriteStructEnd()
+	expected.WriteStructEnd()
+	expected.WriteUInt32(6, 7)
+	expected.WriteNull(7)
+	expected.WriteInt16(8, 2100)
+	expected.WriteStructEnd()
+	if hex.EncodeToString(encoded) != hex.EncodeToString(expected.Bytes()) {
+		t.Fatalf("incorrect encoding %s", hex.EncodeToString(encoded))
+	}
+
+	var out testOuter
+	out.Nullable = &seven
+	err = Unmarshal(encoded, &out)
+	if err != nil {
+		t.Fatalf("unmarshal failed %s", err.Error())
+	}
+	if out.Optional == nil || *out.Optional != 7 || out.Nullable != nil {
+		t.Fatalf("unmarshal of pointers failed %+v", out)
+	}
+	in.Ignored = 0
+	in.Optional = nil
+	out.Optional = nil
+	if fmt.Sprintf("%+v", in) != fmt.Sprintf("%+v", out) {
+		t.Fatalf("unmarshal mismatch %+v", out)
+	}
+
+	var small struct {
+		Id uint8 `tlv:"1"`
+	}
+	if Unmarshal(encoded, &small) == nil {
+		t.Fatalf("overflow not detected")
+	}
+}
+
+func TestJson(t *testing.T) {
+	var encoder TLVBuffer
+	encoder.WriteAnonStruct()
+	encoder.WriteUInt8(0, 1)
+	encoder.WriteUTF8String(1, "abc")
+	encoder.WriteInt16(2, -300)
+	encoder.WriteOctetString(3, []byte{1, 2})
+	encoder.WriteArray(4)
+	encoder.WriteAnonStruct()
+	encoder.WriteBool(0, true)
+	encoder.WriteStructEnd()
+	encoder.WriteStructEnd()
+	encoder.WriteArray(5)
+	encoder.WriteStructEnd()
+	encoder.WriteNull(6)
+	encoder.WriteUInt64(7, 0xffffffffffffffff)
+	encoder.WriteFloat32(8, 1.5)
+	encoder.WriteStructEnd()
+
+	decoded, err := Decode(encoder.Bytes())
+	if err != nil {
+		t.Fatalf("decode failed: %s", err.Error())
+	}
+	js, err := TlvToJson(&decoded)
+	if err != nil {
+		t.Fatalf("conversion failed: %s", err.Error())
+	}
+	expected := `{"0:UINT":1,"1:STRING":"abc","2:INT":-300,"3:BYTES":"AQI=","4:ARRAY-STRUCT":[{"0:BOOL":true}],"5:ARRAY-?":[],"6:NULL":null,"7:UINT":"18446744073709551615","8:FLOAT":1.5}`
+	if string(js) != expected {
+		t.Fatalf("incorrect json %s", string(js))
+	}
+	back, err := JsonToTlv(js)
+	if err != nil {
+		t.Fatalf("conversion failed: %s", err.Error())
+	}
+	if hex.EncodeToString(back) != hex.EncodeToString(encoder.Bytes()) {
+		t.Fatalf("incorrect tlv %s", hex.EncodeToString(back))
+	}
+
+	back, err = JsonToTlv([]byte(`{"value:ARRAY-U16": [1, 2]}`))
+	if err != nil {
+		t.Fatalf("conversion failed: %s", err.Error())
+	}
+	if hex.EncodeToString(back) != "16050100050200"+"18" {
+		t.Fatalf("incorrect tlv %s", hex.EncodeToString(back))
+	}
+	if _, err = JsonToTlv([]byte(`{"0:U8": 256}`)); err == nil {
+		t.Fatalf("overflow not detected")
+	}
+	if _, err = JsonToTlv([]byte(`{"0:UINT": -1}`)); err == nil {
+		t.Fatalf("negative UINT accepted")
+	}
+
+	var mixed TLVBuffer
+	mixed.WriteAnonArray()
+	mixed.WriteTagged(AnonymousTag(), uint8(1))
+	mixed.WriteTagged(AnonymousTag(), int8(-1))
+	mixed.WriteStructEnd()
+	decoded, _ = Decode(mixed.Bytes())
+	if _, err = TlvToJson(&decoded); err == nil {
+		t.Fatalf("array of UINT and INT accepted")
+	}
+}
+
+func TestJsonChipTool(t *testing.T) {
+	// payload of chip-tool any command-by-id for MoveToHueAndSaturation
+	back, err := JsonToTlv([]byte(`{ "0:UINT": 150, "1:UINT": 200, "2:UINT": 10, "3:UINT": 0, "4:UINT": 0 }`))
+	if err != nil {
+		t.Fatalf("conversion failed: %s", err.Error())
+	}
+	if hex.EncodeToString(back) != "152400962401c824020a24030024040018" {
+		t.Fatalf("incorrect tlv %s", hex.EncodeToString(back))
+	}
+
+	// payload in form printed by chip-tool with nested structure, signed and 64 bit values
+	payload := `{"0:STRUCT":{"0:UINT":7,"1:STRING":"ABC","2:ARRAY-INT":[-1,300]},"1:BYTES":"AAEC","2:FLOAT":0.5,"3:DOUBLE":"Infinity","4:UINT":"18446744073709551615","5:INT":-70000,"6:NULL":null,"7:BOOL":false}`
+	back, err = JsonToTlv([]byte(payload))
+	if err != nil {
+		t.Fatalf("conversion failed: %s", err.Error())
+	}
+	var expected TLVBuffer
+	expected.WriteAnonStruct()
+	expected.WriteStruct(0)
+	expected.WriteUInt8(0, 7)
+	expected.WriteUTF8String(1, "ABC")
+	expected.WriteArray(2)
+	expected.WriteTagged(AnonymousTag(), int8(-1))
+	expected.WriteTagged(AnonymousTag(), int16(300))
+	expected.WriteStructEnd()
+	expected.WriteStructEnd()
+	expected.WriteOctetString(1, []byte{0, 1, 2})
+	expected.WriteFloat32(2, 0.5)
+	expected.WriteFloat64(3, math.Inf(1))
+	expected.WriteUInt64(4, 0xffffffffffffffff)
+	expected.WriteInt32(5, -70000)
+	expected.WriteNull(6)
+	expected.WriteBool(7, false)
+	expected.WriteStructEnd()
+	if hex.EncodeToString(back) != hex.EncodeToString(expected.Bytes()) {
+		t.Fatalf("incorrect tlv %s", hex.EncodeToString(back))
+	}
+	decoded, err := Decode(back)
+	if err != nil {
+		t.Fatalf("decode failed: %s", err.Error())
+	}
+	js, err := TlvToJson(&decoded)
+	if err != nil {
+		t.Fatalf("conversion failed: %s", err.Error())
+	}
+	if string(js) != payload {
+		t.Fatalf("incorrect json %s", string(js))
+	}
+}
+
+func TestText(t *testing.T) {
+	encoded, err := ParseText(`{0: 150u8, 1: 200, 2: 10u16, 3: "a\"bc", 4: hex:0a0b, 5: [1, -2], 6: null, 7: true, 8: 1.5f32, 9: list[2: 1u16, 3u8], 10: {}, 0x0b: -70000}`)
+	if err != nil {
+		t.Fatalf("parse failed %s", err.Error())
+	}
+	var expected TLVBuffer
+	expected.WriteAnonStruct()
+	expected.WriteUInt8(0, 150)
+	expected.WriteUInt8(1, 200)
+	expected.WriteUInt16(2, 10)
+	expected.WriteUTF8String(3, "a\"bc")
+	expected.WriteOctetString(4, []byte{0xa, 0xb})
+	expected.WriteArray(5)
+	expected.WriteTagged(AnonymousTag(), uint8(1))
+	expected.WriteTagged(AnonymousTag(), int8(-2))
+	expected.WriteStructEnd()
+	expected.WriteNull(6)
+	expected.WriteBool(7, true)
+	expected.WriteFloat32(8, 1.5)
+	expected.WriteList(9)
+	expected.WriteUInt16(2, 1)
+	expected.WriteTagged(AnonymousTag(), uint8(3))
+	expected.WriteStructEnd()
+	expected.WriteStruct(10)
+	expected.WriteStructEnd()
+	expected.WriteInt32(11, -70000)
+	expected.WriteStructEnd()
+	if hex.EncodeToString(encoded) != hex.EncodeToString(expected.Bytes()) {
+		t.Fatalf("incorrect encoding %s", hex.EncodeToString(encoded))
+	}
+
+	decoded, err := Decode(encoded)
+	if err != nil {
+		t.Fatalf("decode failed: %s", err.Error())
+	}
+	text := FormatText(&decoded)
+	if text != `{0: 150u8, 1: 200u8, 2: 10u16, 3: "a\"bc", 4: hex:0a0b, 5: [1u8, -2i8], 6: null, 7: true, 8: 1.5f32, 9: list[2: 1u16, 3u8], 10: {}, 11: -70000i32}` {
+		t.Fatalf("incorrect text %s", text)
+	}
+	again, err := ParseText(text)
+	if err != nil || hex.EncodeToString(again) != hex.EncodeToString(encoded) {
+		t.Fatalf("text does not parse into same TLV")
+	}
+
+	for _, invalid := range []string{"", "{0: 1", "{0 1}", "300u8", "[1,,2]", "{256: 1}", `"abc`, "1 2"} {
+		if _, err := ParseText(invalid); err == nil {
+			t.Fatalf("error expected for %s", invalid)
+		}
+	}
+}
+
+func TestReader(t *testing.T) {
+	var encoder TLVBuffer
+	encoder.WriteAnonStruct()
+	encoder.WriteUInt16(1, 0x1234)
+	encoder.WriteArray(2)
+	encoder.WriteAnonStruct()
+	encoder.WriteOctetString(1, []byte{1, 2, 3})
+	encoder.WriteStructEnd()
+	encoder.WriteStructEnd()
+	encoder.WriteInt8(3, -3)
+	encoder.WriteUTF8String(4, "abc")
+	encoder.WriteStructEnd()
+	data := encoder.Bytes()
+
+	r := NewTlvReader(data)
+	if r.Next() != nil || r.ContainerType() != CONTAINER_STRUCT {
+		t.Fatalf("structure expected")
+	}
+	if r.EnterContainer() != nil {
+		t.Fatalf("enter failed")
+	}
+	if r.Next() != nil || r.Tag() != ContextTag(1) {
+		t.Fatalf("element 1 expected")
+	}
+	if v, err := r.Uint(); err != nil || v != 0x1234 {
+		t.Fatalf("incorrect value of element 1")
+	}
+	// array is skipped without entering
+	if r.Next() != nil || r.ContainerType() != CONTAINER_ARRAY {
+		t.Fatalf("array expected")
+	}
+	if r.Next() != nil || r.Tag() != ContextTag(3) {
+		t.Fatalf("element 3 expected")
+	}
+	if v, err := r.Int(); err != nil || v != -3 {
+		t.Fatalf("incorrect value of element 3")
+	}
+	if _, err := r.Bool(); err == nil {
+		t.Fatalf("type mismatch not detected")
+	}
+	if r.Next() != nil {
+		t.Fatalf("element 4 expected")
+	}
+	if v, err := r.Bytes(); err != nil || string(v) != "abc" || &v[0] != &data[len(data)-4] {
+		t.Fatalf("string is not slice of data")
+	}
+	if r.Next() != io.EOF {
+		t.Fatalf("end of structure expected")
+	}
+	if r.ExitContainer() != nil || r.Depth() != 0 {
+		t.Fatalf("exit failed")
+	}
+	if r.Next() != io.EOF {
+		t.Fatalf("end of data expected")
+	}
+
+	// exit skips remaining members
+	r = NewTlvReader(data)
+	r.Next()
+	r.EnterContainer()
+	r.Next()
+	if r.ExitContainer() != nil || r.Next() != io.EOF {
+		t.Fatalf("exit did not skip members")
+	}
+}
+
+// benchmarkReport returns ReportData message with many attribute reports.
+func benchmarkReport() []byte {
+	var encoder TLVBuffer
+	encoder.WriteAnonStruct()
+	encoder.WriteArray(1)
+	for n := 0; n < 200; n++ {
+		encoder.WriteAnonStruct()
+		encoder.WriteStruct(1)
+		encoder.WriteUInt32(0, uint32(n))
+		encoder.WriteList(1)
+		encoder.WriteUInt16(2, 1)
+		encoder.WriteUInt32(3, 0x28)
+		encoder.WriteUInt32(4, uint32(n))
+		encoder.WriteStructEnd()
+		encoder.WriteUTF8String(2, "attribute value string")
+		encoder.WriteStructEnd()
+		encoder.WriteStructEnd()
+	}
+	encoder.WriteStructEnd()
+	encoder.WriteUInt8(0xff, 10)
+	encoder.WriteStructEnd()
+	return encoder.Bytes()
+}
+
+func BenchmarkDecode(b *testing.B) {
+	data := benchmarkReport()
+	b.ReportAllocs()
+	for n := 0; n < b.N; n++ {
+		_, err := Decode(data)
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkReader(b *testing.B) {
+	data := benchmarkReport()
+	b.ReportAllocs()
+	for n := 0; n < b.N; n++ {
+		r := NewTlvReader(data)
+		count := 0
+		for {
+			err := r.Next()
+			if err == io.EOF {
+				if r.Depth() == 0 {
+					break
+				}
+				r.ExitContainer()
+				continue
+			}
+			if err != nil {
+				b.Fatal(err)
+			}
+			if r.IsContainer() {
+				r.EnterContainer()
+				continue
+			}
+			count++
+		}
+		if count != 200*5+1 {
+			b.Fatalf("incorrect count %d", count)
+		}
+	}
+}
+
+func BenchmarkGetItemRec(b *testing.B) {
+	decoded, _ := Decode(benchmarkReport())
+	b.ReportAllocs()
+	for n := 0; n < b.N; n++ {
+		if decoded.GetItemRec([]int{1, 0, 1, 1, 4}) == nil {
+			b.Fatal("not found")
+		}
+	}
+}
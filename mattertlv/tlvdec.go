@@ -1,8 +1,6 @@
 package mattertlv
 
 import (
-	"bytes"
-	"encoding/binary"
 	"encoding/hex"
 	"fmt"
 	"strings"
@@ -16,15 +14,21 @@ const TypeUTF8String ElementType = 3
 const TypeOctetString ElementType = 4
 const TypeList ElementType = 5
 const TypeNull ElementType = 6
+const TypeFloat ElementType = 7
 
 // TlvItem represents one TLV entry.
+// Tag contains tag number of context and profile tags, it is 0 for anonymous tag.
 type TlvItem struct {
 	Tag        int
 	Type       ElementType
 	matterType byte
+	tagKind    TagKind
+	tagVendor  uint16
+	tagProfile uint16
 
 	valueBool        bool
-	valueInt         uint64
+	valueInt         uint64 // signed values are stored sign extended
+	valueFloat       float64
 	valueString      string
 	valueOctetString []byte
 	valueList        []TlvItem
@@ -35,9 +39,14 @@ func (i TlvItem) GetChild() []TlvItem {
 	return i.valueList
 }
 
+// AppendChild adds entry at end of container entry.
+func (i *TlvItem) AppendChild(item TlvItem) {
+	i.valueList = append(i.valueList, item)
+}
+
 func (i TlvItem) GetItemWithTag(tag int) *TlvItem {
-	for n, item := range i.valueList {
-		if item.Tag == tag {
+	for n := range i.valueList {
+		if i.valueList[n].Tag == tag {
 			return &i.valueList[n]
 		}
 	}
@@ -53,7 +62,69 @@ func (i TlvItem) GetInt() int {
 func (i TlvItem) GetUint64() uint64 {
 	return uint64(i.valueInt)
 }
+
+// GetInt64 returns value of integer entry as int64. Signed integers are sign extended.
+func (i TlvItem) GetInt64() int64 {
+	return int64(i.valueInt)
+}
+
+// IsSigned returns true when entry is signed integer.
+func (i TlvItem) IsSigned() bool {
+	return (i.Type == TypeInt) && (i.matterType <= 3)
+}
+
+// GetFloat returns value of floating point entry. Single precision value is converted to float64.
+func (i TlvItem) GetFloat() float64 {
+	return i.valueFloat
+}
+
+// IsNull returns true when entry is null.
+func (i TlvItem) IsNull() bool {
+	return i.Type == TypeNull
+}
+
+// TagKind returns form of tag of entry.
+func (i TlvItem) TagKind() TagKind {
+	return i.tagKind
+}
+
+// FullTag returns tag of entry including vendor and profile of profile specific tags.
+func (i TlvItem) FullTag() Tag {
+	return Tag{
+		Kind:    i.tagKind,
+		Vendor:  i.tagVendor,
+		Profile: i.tagProfile,
+		Number:  uint32(i.Tag),
+	}
+}
+
+// valueToString returns value of primitive entry formatted for humans.
+func (i TlvItem) valueToString() string {
+	switch i.Type {
+	case TypeNull:
+		return "null"
+	case TypeInt:
+		if i.IsSigned() {
+			return fmt.Sprintf("%d", i.GetInt64())
+		}
+		return fmt.Sprintf("%d", i.valueInt)
+	case TypeFloat:
+		return fmt.Sprintf("%v", i.valueFloat)
+	case TypeBool:
+		return fmt.Sprintf("%v", i.valueBool)
+	case TypeUTF8String:
+		return i.valueString
+	case TypeOctetString:
+		return hex.EncodeToString(i.valueOctetString)
+	}
+	return ""
+}
+
+// GetOctetString returns value of octet string entry. For UTF-8 string entry it returns its bytes.
 func (i TlvItem) GetOctetString() []byte {
+	if i.Type == TypeUTF8String {
+		return []byte(i.valueString)
+	}
 	return i.valueOctetString
 }
 func (i TlvItem) GetString() string {
@@ -70,7 +141,9 @@ func (i TlvItem) Dump(pad int) {
 	case TypeNull:
 		fmt.Printf("null\n")
 	case TypeInt:
-		fmt.Printf("int val:%d\n", i.valueInt)
+		fmt.Printf("int val:%s\n", i.valueToString())
+	case TypeFloat:
+		fmt.Printf("float val:%s\n", i.valueToString())
 	case TypeBool:
 		fmt.Printf("bool val:%v\n", i.valueBool)
 	case TypeUTF8String:
@@ -95,8 +168,8 @@ func (i TlvItem) DumpToString(buf *strings.Builder, pad int) {
 	switch i.Type {
 	case TypeNull:
 		buf.WriteString("null\n")
-	case TypeInt:
-		buf.WriteString(fmt.Sprintf("%d\n", i.valueInt))
+	case TypeInt, TypeFloat:
+		buf.WriteString(i.valueToString() + "\n")
 	case TypeBool:
 		buf.WriteString(fmt.Sprintf("%v\n", i.valueBool))
 	case TypeUTF8String:
@@ -127,8 +200,8 @@ func (i TlvItem) DumpWithDict(pad int, path string, dictionary map[string]string
 	switch i.Type {
 	case TypeNull:
 		fmt.Printf("null\n")
-	case TypeInt:
-		fmt.Printf("%d\n", i.valueInt)
+	case TypeInt, TypeFloat:
+		fmt.Printf("%s\n", i.valueToString())
 	case TypeBool:
 		fmt.Printf("%v\n", i.valueBool)
 	case TypeUTF8String:
@@ -145,18 +218,21 @@ func (i TlvItem) DumpWithDict(pad int, path string, dictionary map[string]string
 	}
 }
 
+// GetItemRec returns entry found by following path of tags or nil when there is no such entry.
+// Returned entry points into tree of i (except for empty path), it is not a copy.
 func (i TlvItem) GetItemRec(tag []int) *TlvItem {
 	if len(tag) == 0 {
-		return &i
+		c := i
+		return &c
 	}
-	if i.Type == TypeList {
-		for _, d := range i.valueList {
-			if d.Tag == tag[0] {
-				return d.GetItemRec(tag[1:])
-			}
+	item := i.GetItemWithTag(tag[0])
+	for _, t := range tag[1:] {
+		if item == nil {
+			return nil
 		}
+		item = item.GetItemWithTag(t)
 	}
-	return nil
+	return item
 }
 
 func (i TlvItem) GetOctetStringRec(tag []int) []byte {
@@ -164,7 +240,7 @@ func (i TlvItem) GetOctetStringRec(tag []int) []byte {
 	if item == nil {
 		return []byte{}
 	} else {
-		return item.valueOctetString
+		return item.GetOctetString()
 	}
 }
 
@@ -177,134 +253,22 @@ func (i TlvItem) GetIntRec(tag []int) (uint64, error) {
 	}
 }
 
-func readByte(buf *bytes.Buffer) int {
-	tmp, err := buf.ReadByte()
-	if err != nil {
-		panic(err)
-	}
-	return int(tmp)
-}
-
-func readTag(tagctrl byte, item *TlvItem, buf *bytes.Buffer) {
-	if tagctrl == 1 {
-		item.Tag = readByte(buf)
-	}
-}
+// maxDepth limits nesting of containers accepted by Decode.
+const maxDepth = 32
 
-func decode(buf *bytes.Buffer, container *TlvItem) {
-	for buf.Len() > 0 {
-		current := TlvItem{}
-		fb, _ := buf.ReadByte()
-		tp := fb & 0x1f
-		tagctrl := fb >> 5
-		current.matterType = tp
-		switch tp {
-		case 0:
-			current.Type = TypeInt
-			readTag(tagctrl, &current, buf)
-			current.valueInt = uint64(readByte(buf))
-		case 1:
-			current.Type = TypeInt
-			readTag(tagctrl, &current, buf)
-			var tmp uint16
-			binary.Read(buf, binary.LittleEndian, tmp)
-			current.valueInt = uint64(tmp)
-		case 2:
-			current.Type = TypeInt
-			readTag(tagctrl, &current, buf)
-			var tmp uint32
-			binary.Read(buf, binary.LittleEndian, tmp)
-			current.valueInt = uint64(tmp)
-		case 3:
-			current.Type = TypeInt
-			readTag(tagctrl, &current, buf)
-			var tmp uint64
-			binary.Read(buf, binary.LittleEndian, tmp)
-			current.valueInt = uint64(tmp)
-		case 4:
-			current.Type = TypeInt
-			readTag(tagctrl, &current, buf)
-			current.valueInt = uint64(readByte(buf))
-		case 5:
-			current.Type = TypeInt
-			readTag(tagctrl, &current, buf)
-			var tmp uint16
-			binary.Read(buf, binary.LittleEndian, &tmp)
-			current.valueInt = uint64(tmp)
-		case 6:
-			current.Type = TypeInt
-			readTag(tagctrl, &current, buf)
-			var tmp uint32
-			binary.Read(buf, binary.LittleEndian, &tmp)
-			current.valueInt = uint64(tmp)
-		case 7:
-			current.Type = TypeInt
-			readTag(tagctrl, &current, buf)
-			var tmp uint64
-			binary.Read(buf, binary.LittleEndian, &tmp)
-			current.valueInt = uint64(tmp)
-		case 8:
-			current.Type = TypeBool
-			readTag(tagctrl, &current, buf)
-			current.valueBool = false
-		case 9:
-			current.Type = TypeBool
-			readTag(tagctrl, &current, buf)
-			current.valueBool = true
-		case 0xa:
-			panic("")
-		case 0xb:
-			panic("")
-		case 0xc:
-			current.Type = TypeUTF8String
-			readTag(tagctrl, &current, buf)
-			size := readByte(buf)
-			current.valueOctetString = make([]byte, size)
-			buf.Read(current.valueOctetString)
-			current.valueString = string(current.valueOctetString)
-		case 0x10:
-			current.Type = TypeOctetString
-			readTag(tagctrl, &current, buf)
-			size := readByte(buf)
-			current.valueOctetString = make([]byte, size)
-			buf.Read(current.valueOctetString)
-		case 0x11:
-			current.Type = TypeOctetString
-			readTag(tagctrl, &current, buf)
-			var size uint16
-			binary.Read(buf, binary.LittleEndian, &size)
-			current.valueOctetString = make([]byte, size)
-			buf.Read(current.valueOctetString)
-		case 0x14:
-			current.Type = TypeNull
-			readTag(tagctrl, &current, buf)
-		case 0x15:
-			current.Type = TypeList
-			readTag(tagctrl, &current, buf)
-			decode(buf, &current)
-		case 0x16:
-			current.Type = TypeList
-			readTag(tagctrl, &current, buf)
-			decode(buf, &current)
-		case 0x17:
-			current.Type = TypeList
-			readTag(tagctrl, &current, buf)
-			decode(buf, &current)
-		case 0x18:
-			return
-		default:
-			panic(fmt.Sprintf("unknown type %x", tp))
-		}
-		container.valueList = append(container.valueList, current)
-	}
-}
+// sizes of tag for every tag control value
+var tagSizes = []int{0, 1, 2, 4, 2, 4, 6, 8}
 
 // Decode decodes binary TLV into structure represented by TlvItem.
-func Decode(in []byte) TlvItem {
-	buf := bytes.NewBuffer(in)
-	root := &TlvItem{
-		Type: TypeList,
+// Only first element is decoded, data which follow it are ignored.
+func Decode(in []byte) (TlvItem, error) {
+	if len(in) == 0 {
+		return TlvItem{}, fmt.Errorf("empty TLV")
+	}
+	d := decoder{reader: NewTlvReader(in)}
+	err := d.reader.Next()
+	if err != nil {
+		return TlvItem{}, err
 	}
-	decode(buf, root)
-	return root.valueList[0]
+	return d.item()
 }
@@ -3,6 +3,7 @@ package mattertlv
 import (
 	"bytes"
 	"encoding/binary"
+	"fmt"
 )
 
 const TYPE_UINT_1 = 4
@@ -85,22 +86,7 @@ func (b *TLVBuffer) WriteUInt64(tag byte, val uint64) {
 }
 
 func (b *TLVBuffer) WriteOctetString(tag byte, data []byte) {
-	var ctrl byte
-	ctrl = 0x1 << 5
-	if len(data) > 0xff {
-		ctrl = ctrl | 0x11
-		b.data.WriteByte(ctrl)
-		b.data.WriteByte(tag)
-		var ln uint16
-		ln = uint16(len(data))
-		binary.Write(&b.data, binary.LittleEndian, ln)
-	} else {
-		ctrl = ctrl | 0x10
-		b.data.WriteByte(ctrl)
-		b.data.WriteByte(tag)
-		b.data.WriteByte(byte(len(data)))
-	}
-	b.data.Write(data)
+	b.writeString(ContextTag(tag), 0x10, data)
 }
 
 func (b *TLVBuffer) WriteBool(tag byte, val bool) {
@@ -115,6 +101,12 @@ func (b *TLVBuffer) WriteBool(tag byte, val bool) {
 	b.data.WriteByte(tag)
 }
 
+// WriteNull encodes null with specified tag
+func (b *TLVBuffer) WriteNull(tag byte) {
+	b.data.WriteByte(0x34)
+	b.data.WriteByte(tag)
+}
+
 // WriteAnonStruct encodes start of structure without tag
 func (b *TLVBuffer) WriteAnonStruct() {
 	b.data.WriteByte(0x15)
@@ -148,3 +140,227 @@ func (b *TLVBuffer) WriteList(tag byte) {
 func (b *TLVBuffer) WriteStructEnd() {
 	b.data.WriteByte(0x18)
 }
+
+const TYPE_INT_1 = 0
+const TYPE_INT_2 = 1
+const TYPE_INT_4 = 2
+const TYPE_INT_8 = 3
+
+// element types of containers used by WriteTaggedContainer
+const CONTAINER_STRUCT = 0x15
+const CONTAINER_ARRAY = 0x16
+const CONTAINER_LIST = 0x17
+
+// TagKind is form of tag.
+type TagKind int
+
+const (
+	TagAnonymous TagKind = iota
+	TagContext
+	TagCommonProfile
+	TagImplicitProfile
+	TagFullyQualified
+)
+
+// tag kind of every tag control value
+var tagKinds = []TagKind{TagAnonymous, TagContext, TagCommonProfile, TagCommonProfile,
+	TagImplicitProfile, TagImplicitProfile, TagFullyQualified, TagFullyQualified}
+
+// Tag is tag of any form. Vendor and Profile are used only by fully qualified tags.
+type Tag struct {
+	Kind    TagKind
+	Vendor  uint16
+	Profile uint16
+	Number  uint32
+}
+
+func AnonymousTag() Tag {
+	return Tag{Kind: TagAnonymous}
+}
+
+func ContextTag(number byte) Tag {
+	return Tag{Kind: TagContext, Number: uint32(number)}
+}
+
+func CommonProfileTag(number uint32) Tag {
+	return Tag{Kind: TagCommonProfile, Number: number}
+}
+
+func ImplicitProfileTag(number uint32) Tag {
+	return Tag{Kind: TagImplicitProfile, Number: number}
+}
+
+func FullyQualifiedTag(vendor uint16, profile uint16, number uint32) Tag {
+	return Tag{Kind: TagFullyQualified, Vendor: vendor, Profile: profile, Number: number}
+}
+
+// writeHeader encodes control byte with element type typ followed by tag.
+// Tags with number which fits into 16 bits use short form.
+func (b *TLVBuffer) writeHeader(tag Tag, typ byte) {
+	long := tag.Number > 0xffff
+	var ctrl byte
+	switch tag.Kind {
+	case TagContext:
+		ctrl = 1
+	case TagCommonProfile:
+		ctrl = 2
+	case TagImplicitProfile:
+		ctrl = 4
+	case TagFullyQualified:
+		ctrl = 6
+	}
+	if long && (tag.Kind >= TagCommonProfile) {
+		ctrl++
+	}
+	b.data.WriteByte(ctrl<<5 | typ)
+	switch tag.Kind {
+	case TagContext:
+		b.data.WriteByte(byte(tag.Number))
+	case TagFullyQualified:
+		binary.Write(&b.data, binary.LittleEndian, tag.Vendor)
+		binary.Write(&b.data, binary.LittleEndian, tag.Profile)
+		fallthrough
+	case TagCommonProfile, TagImplicitProfile:
+		if long {
+			binary.Write(&b.data, binary.LittleEndian, tag.Number)
+		} else {
+			binary.Write(&b.data, binary.LittleEndian, uint16(tag.Number))
+		}
+	}
+}
+
+// WriteInt encodes signed integer of size typ (TYPE_INT_1..TYPE_INT_8).
+func (b *TLVBuffer) WriteInt(tag byte, typ int, val int64) {
+	b.writeHeader(ContextTag(tag), byte(typ))
+	switch typ {
+	case TYPE_INT_1:
+		b.data.WriteByte(byte(val))
+	case TYPE_INT_2:
+		binary.Write(&b.data, binary.LittleEndian, int16(val))
+	case TYPE_INT_4:
+		binary.Write(&b.data, binary.LittleEndian, int32(val))
+	case TYPE_INT_8:
+		binary.Write(&b.data, binary.LittleEndian, val)
+	}
+}
+
+func (b *TLVBuffer) WriteInt8(tag byte, val int8) {
+	b.WriteInt(tag, TYPE_INT_1, int64(val))
+}
+
+func (b *TLVBuffer) WriteInt16(tag byte, val int16) {
+	b.WriteInt(tag, TYPE_INT_2, int64(val))
+}
+
+func (b *TLVBuffer) WriteInt32(tag byte, val int32) {
+	b.WriteInt(tag, TYPE_INT_4, int64(val))
+}
+
+func (b *TLVBuffer) WriteInt64(tag byte, val int64) {
+	b.WriteInt(tag, TYPE_INT_8, val)
+}
+
+// WriteFloat32 encodes single precision floating point number.
+func (b *TLVBuffer) WriteFloat32(tag byte, val float32) {
+	b.writeHeader(ContextTag(tag), 0xa)
+	binary.Write(&b.data, binary.LittleEndian, val)
+}
+
+// WriteFloat64 encodes double precision floating point number.
+func (b *TLVBuffer) WriteFloat64(tag byte, val float64) {
+	b.writeHeader(ContextTag(tag), 0xb)
+	binary.Write(&b.data, binary.LittleEndian, val)
+}
+
+// writeString encodes string element with base type typ (0xc UTF-8, 0x10 octets) and shortest length field.
+func (b *TLVBuffer) writeString(tag Tag, typ byte, data []byte) {
+	switch {
+	case len(data) <= 0xff:
+		b.writeHeader(tag, typ)
+		b.data.WriteByte(byte(len(data)))
+	case len(data) <= 0xffff:
+		b.writeHeader(tag, typ|1)
+		binary.Write(&b.data, binary.LittleEndian, uint16(len(data)))
+	case uint64(len(data)) <= 0xffffffff:
+		b.writeHeader(tag, typ|2)
+		binary.Write(&b.data, binary.LittleEndian, uint32(len(data)))
+	default:
+		b.writeHeader(tag, typ|3)
+		binary.Write(&b.data, binary.LittleEndian, uint64(len(data)))
+	}
+	b.data.Write(data)
+}
+
+// WriteUTF8String encodes UTF-8 string.
+func (b *TLVBuffer) WriteUTF8String(tag byte, val string) {
+	b.writeString(ContextTag(tag), 0xc, []byte(val))
+}
+
+// WriteAnonArray encodes start of array without tag
+func (b *TLVBuffer) WriteAnonArray() {
+	b.data.WriteByte(0x16)
+}
+
+// WriteTagged encodes value with tag of any form. Supported values are signed and unsigned integers
+// (size of encoded integer follows Go type), float32, float64, bool, string, []byte and nil which is encoded as null.
+func (b *TLVBuffer) WriteTagged(tag Tag, value any) error {
+	switch v := value.(type) {
+	case nil:
+		b.writeHeader(tag, 0x14)
+	case bool:
+		if v {
+			b.writeHeader(tag, 0x9)
+		} else {
+			b.writeHeader(tag, 0x8)
+		}
+	case int8:
+		b.writeHeader(tag, TYPE_INT_1)
+		b.data.WriteByte(byte(v))
+	case int16:
+		b.writeHeader(tag, TYPE_INT_2)
+		binary.Write(&b.data, binary.LittleEndian, v)
+	case int32:
+		b.writeHeader(tag, TYPE_INT_4)
+		binary.Write(&b.data, binary.LittleEndian, v)
+	case int64:
+		b.writeHeader(tag, TYPE_INT_8)
+		binary.Write(&b.data, binary.LittleEndian, v)
+	case int:
+		b.writeHeader(tag, TYPE_INT_8)
+		binary.Write(&b.data, binary.LittleEndian, int64(v))
+	case uint8:
+		b.writeHeader(tag, TYPE_UINT_1)
+		b.data.WriteByte(v)
+	case uint16:
+		b.writeHeader(tag, TYPE_UINT_2)
+		binary.Write(&b.data, binary.LittleEndian, v)
+	case uint32:
+		b.writeHeader(tag, TYPE_UINT_4)
+		binary.Write(&b.data, binary.LittleEndian, v)
+	case uint64:
+		b.writeHeader(tag, TYPE_UINT_8)
+		binary.Write(&b.data, binary.LittleEndian, v)
+	case uint:
+		b.writeHeader(tag, TYPE_UINT_8)
+		binary.Write(&b.data, binary.LittleEndian, uint64(v))
+	case float32:
+		b.writeHeader(tag, 0xa)
+		binary.Write(&b.data, binary.LittleEndian, v)
+	case float64:
+		b.writeHeader(tag, 0xb)
+		binary.Write(&b.data, binary.LittleEndian, v)
+	case string:
+		b.writeString(tag, 0xc, []byte(v))
+	case []byte:
+		b.writeString(tag, 0x10, v)
+	default:
+		return fmt.Errorf("unsupported type %T", value)
+	}
+	return nil
+}
+
+// WriteTaggedContainer encodes start of structure, array or list (CONTAINER_STRUCT, CONTAINER_ARRAY,
+// CONTAINER_LIST) with tag of any form. Container is terminated by WriteStructEnd.
+func (b *TLVBuffer) WriteTaggedContainer(tag Tag, container byte) {
+	b.writeHeader(tag, container)
+}
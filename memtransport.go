@@ -0,0 +1,193 @@
+package gomat
+
+import (
+	"math/rand"
+	"net"
+	"os"
+	"sync"
+	"time"
+)
+
+// LinkConditions describe impairments simulated by in-memory transport.
+// Zero value is perfect link which delivers every message immediately and in order.
+// Message held back for reordering is delivered after Latency+Jitter (at least 1ms) when no other message follows it.
+type LinkConditions struct {
+	Loss      float64       // probability that message is dropped
+	Duplicate float64       // probability that message is delivered twice
+	Reorder   float64       // probability that message is held back and delivered after following message
+	Latency   time.Duration // delay of every message
+	Jitter    time.Duration // random extra delay in range [0,Jitter)
+	Random    *rand.Rand    // source of randomness of simulation, nil means fixed seed
+}
+
+// memoryLink is state shared by both ends of in-memory pair.
+type memoryLink struct {
+	mutex      sync.Mutex
+	conditions LinkConditions
+	random     *rand.Rand
+}
+
+type memoryTransport struct {
+	link     *memoryLink
+	peer     *memoryTransport
+	mutex    sync.Mutex
+	queue    [][]byte
+	held     []byte // outgoing message held back to simulate reordering
+	hold_id  int    // identifies held message for its release timer
+	deadline time.Time
+	notify   chan struct{}
+	closed   chan struct{}
+	once     sync.Once
+}
+
+// NewMemoryTransportPair creates two connected in-memory transports. Message sent by one of them
+// is received by other one. Impairments of link are simulated according to conditions
+// using conditions.Random, so runs with same seed behave same way.
+func NewMemoryTransportPair(conditions LinkConditions) (Transport, Transport) {
+	link := &memoryLink{
+		conditions: conditions,
+		random:     conditions.Random,
+	}
+	if link.random == nil {
+		link.random = rand.New(rand.NewSource(1))
+	}
+	a := newMemoryTransport(link)
+	b := newMemoryTransport(link)
+	a.peer = b
+	b.peer = a
+	return a, b
+}
+
+func newMemoryTransport(link *memoryLink) *memoryTransport {
+	return &memoryTransport{
+		link:   link,
+		notify: make(chan struct{}, 1),
+		closed: make(chan struct{}),
+	}
+}
+
+func (t *memoryTransport) Send(data []byte) error {
+	select {
+	case <-t.closed:
+		return net.ErrClosed
+	default:
+	}
+	msg := make([]byte, len(data))
+	copy(msg, data)
+
+	link := t.link
+	c := link.conditions
+	link.mutex.Lock()
+	if c.Loss > 0 && link.random.Float64() < c.Loss {
+		link.mutex.Unlock()
+		return nil
+	}
+	deliver := [][]byte{msg}
+	if c.Duplicate > 0 && link.random.Float64() < c.Duplicate {
+		deliver = append(deliver, msg)
+	}
+	if c.Reorder > 0 && t.held == nil && link.random.Float64() < c.Reorder {
+		t.held = msg
+		t.hold_id++
+		hold_id := t.hold_id
+		link.mutex.Unlock()
+		// held message is delivered even when no other message follows
+		time.AfterFunc(max(c.Latency+c.Jitter, time.Millisecond), func() {
+			t.release(hold_id)
+		})
+		return nil
+	}
+	if t.held != nil {
+		deliver = append(deliver, t.held)
+		t.held = nil
+	}
+	delay := c.Latency
+	if c.Jitter > 0 {
+		delay += time.Duration(link.random.Int63n(int64(c.Jitter)))
+	}
+	link.mutex.Unlock()
+
+	peer := t.peer
+	if delay == 0 {
+		peer.enqueue(deliver)
+	} else {
+		time.AfterFunc(delay, func() {
+			peer.enqueue(deliver)
+		})
+	}
+	return nil
+}
+
+// release delivers held message unless it was already sent after following message.
+func (t *memoryTransport) release(hold_id int) {
+	t.link.mutex.Lock()
+	if t.held == nil || t.hold_id != hold_id {
+		t.link.mutex.Unlock()
+		return
+	}
+	msg := t.held
+	t.held = nil
+	t.link.mutex.Unlock()
+	t.peer.enqueue([][]byte{msg})
+}
+
+func (t *memoryTransport) enqueue(messages [][]byte) {
+	t.mutex.Lock()
+	t.queue = append(t.queue, messages...)
+	t.mutex.Unlock()
+	t.wake()
+}
+
+func (t *memoryTransport) wake() {
+	select {
+	case t.notify <- struct{}{}:
+	default:
+	}
+}
+
+func (t *memoryTransport) SetDeadline(deadline time.Time) {
+	t.mutex.Lock()
+	t.deadline = deadline
+	t.mutex.Unlock()
+	t.wake()
+}
+
+func (t *memoryTransport) Receive() ([]byte, error) {
+	for {
+		t.mutex.Lock()
+		if len(t.queue) > 0 {
+			msg := t.queue[0]
+			t.queue = t.queue[1:]
+			t.mutex.Unlock()
+			return msg, nil
+		}
+		deadline := t.deadline
+		t.mutex.Unlock()
+
+		var timeout <-chan time.Time
+		var timer *time.Timer
+		if !deadline.IsZero() {
+			timer = time.NewTimer(time.Until(deadline))
+			timeout = timer.C
+		}
+		select {
+		case <-t.notify:
+			stopTimer(timer)
+		case <-t.closed:
+			stopTimer(timer)
+			return []byte{}, net.ErrClosed
+		case <-timeout:
+			return []byte{}, os.ErrDeadlineExceeded
+		}
+	}
+}
+
+func (t *memoryTransport) Close() {
+	t.once.Do(func() {
+		close(t.closed)
+	})
+}
+
+func (t *memoryTransport) Stream() bool {
+	return false
+}
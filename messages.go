@@ -2,10 +2,9 @@ package gomat
 
 import (
 	"bytes"
-	"crypto/rand"
 	"encoding/binary"
 	"fmt"
-	randm "math/rand"
+	"io"
 
 	"github.com/finnigja/gomat/mattertlv"
 )
@@ -17,12 +16,18 @@ const ProtocolIdInteraction ProtocolId = 1
 
 type Opcode byte
 
+const SEC_CHAN_OPCODE_MSG_COUNTER_SYNC_REQ Opcode = 0x00
+const SEC_CHAN_OPCODE_MSG_COUNTER_SYNC_RSP Opcode = 0x01
 const SEC_CHAN_OPCODE_ACK Opcode = 0x10
 const SEC_CHAN_OPCODE_PBKDF_REQ Opcode = 0x20
 const SEC_CHAN_OPCODE_PBKDF_RESP Opcode = 0x21
 const SEC_CHAN_OPCODE_PAKE1 Opcode = 0x22
 const SEC_CHAN_OPCODE_PAKE2 Opcode = 0x23
 const SEC_CHAN_OPCODE_PAKE3 Opcode = 0x24
+const SEC_CHAN_OPCODE_SIGMA1 Opcode = 0x30
+const SEC_CHAN_OPCODE_SIGMA2 Opcode = 0x31
+const SEC_CHAN_OPCODE_SIGMA3 Opcode = 0x32
+const SEC_CHAN_OPCODE_SIGMA2_RESUME Opcode = 0x33
 const SEC_CHAN_OPCODE_STATUS_REP Opcode = 0x40
 
 const INTERACTION_OPCODE_STATUS_RSP Opcode = 0x1
@@ -30,12 +35,15 @@ const INTERACTION_OPCODE_READ_REQ Opcode = 0x2
 const INTERACTION_OPCODE_SUBSC_REQ Opcode = 0x3
 const INTERACTION_OPCODE_SUBSC_RSP Opcode = 0x4
 const INTERACTION_OPCODE_REPORT_DATA Opcode = 0x5
+const INTERACTION_OPCODE_WRITE_REQ Opcode = 0x6
+const INTERACTION_OPCODE_WRITE_RSP Opcode = 0x7
 const INTERACTION_OPCODE_INVOKE_REQ Opcode = 0x8
 const INTERACTION_OPCODE_INVOKE_RSP Opcode = 0x9
 const INTERACTION_OPCODE_TIMED_REQ Opcode = 0xa
 
 const exchangeFlagsInitiator = 1
 const exchangeFlagsAcknowledge = 2
+const exchangeFlagsReliable = 4
 
 type MessageHeader struct {
 	flags             byte
@@ -54,15 +62,32 @@ type ProtocolMessageHeader struct {
 	ackCounter    uint32
 }
 
-func (m *ProtocolMessageHeader) Decode(data *bytes.Buffer) {
-	m.exchangeFlags, _ = data.ReadByte()
-	opcode, _ := data.ReadByte()
+func (m *ProtocolMessageHeader) Decode(data *bytes.Buffer) error {
+	var err error
+	m.exchangeFlags, err = data.ReadByte()
+	if err != nil {
+		return err
+	}
+	opcode, err := data.ReadByte()
+	if err != nil {
+		return err
+	}
 	m.Opcode = Opcode(opcode)
-	binary.Read(data, binary.LittleEndian, &m.ExchangeId)
-	binary.Read(data, binary.LittleEndian, &m.ProtocolId)
+	err = binary.Read(data, binary.LittleEndian, &m.ExchangeId)
+	if err != nil {
+		return err
+	}
+	err = binary.Read(data, binary.LittleEndian, &m.ProtocolId)
+	if err != nil {
+		return err
+	}
 	if (m.exchangeFlags & 0x2) != 0 {
-		binary.Read(data, binary.LittleEndian, &m.ackCounter)
+		err = binary.Read(data, binary.LittleEndian, &m.ackCounter)
+		if err != nil {
+			return err
+		}
 	}
+	return nil
 }
 
 func (m *MessageHeader) Dump() {
@@ -87,6 +112,14 @@ func (m *ProtocolMessageHeader) Encode(data *bytes.Buffer) {
 	data.WriteByte(byte(m.Opcode))
 	binary.Write(data, binary.LittleEndian, uint16(m.ExchangeId))
 	binary.Write(data, binary.LittleEndian, uint16(m.ProtocolId))
+	if (m.exchangeFlags & exchangeFlagsAcknowledge) != 0 {
+		binary.Write(data, binary.LittleEndian, m.ackCounter)
+	}
+}
+
+// Reliable reports whether sender of message requested acknowledgement.
+func (m *ProtocolMessageHeader) Reliable() bool {
+	return (m.exchangeFlags & exchangeFlagsReliable) != 0
 }
 
 func (m *MessageHeader) calcMessageFlags() byte {
@@ -127,19 +160,28 @@ func (m *MessageHeader) Decode(data *bytes.Buffer) error {
 	if err != nil {
 		return err
 	}
-	binary.Read(data, binary.LittleEndian, &m.sessionId)
+	err = binary.Read(data, binary.LittleEndian, &m.sessionId)
+	if err != nil {
+		return err
+	}
 	m.securityFlags, err = data.ReadByte()
 	if err != nil {
 		return err
 	}
-	binary.Read(data, binary.LittleEndian, &m.messageCounter)
+	err = binary.Read(data, binary.LittleEndian, &m.messageCounter)
+	if err != nil {
+		return err
+	}
 	if (m.flags & 4) != 0 {
 		m.sourceNodeId = make([]byte, 8)
-		_, err := data.Read(m.sourceNodeId)
+		_, err := io.ReadFull(data, m.sourceNodeId)
 		if err != nil {
 			return err
 		}
 	}
+	if (m.flags & 3) == 3 {
+		return fmt.Errorf("reserved destination node id size")
+	}
 	if (m.flags & 3) != 0 {
 		dsiz := 0
 		if (m.flags & 3) == 1 {
@@ -149,7 +191,7 @@ func (m *MessageHeader) Decode(data *bytes.Buffer) error {
 		}
 		if dsiz != 0 {
 			m.destinationNodeId = make([]byte, dsiz)
-			_, err := data.Read(m.destinationNodeId)
+			_, err := io.ReadFull(data, m.destinationNodeId)
 			if err != nil {
 				return err
 			}
@@ -158,7 +200,7 @@ func (m *MessageHeader) Decode(data *bytes.Buffer) error {
 	return nil
 }
 
-func pBKDFParamRequest(exchange uint16) []byte {
+func pBKDFParamRequest(exchange uint16, local_session uint16) []byte {
 	var buffer bytes.Buffer
 
 	prot := ProtocolMessageHeader{
@@ -171,11 +213,11 @@ func pBKDFParamRequest(exchange uint16) []byte {
 	var tlvx mattertlv.TLVBuffer
 	tlvx.WriteAnonStruct()
 	initiator_random := make([]byte, 32)
-	rand.Read(initiator_random)
-	tlvx.WriteOctetString(0x1, initiator_random)       // initiator random
-	tlvx.WriteUInt(0x2, mattertlv.TYPE_UINT_2, 0x0001) //initator session-id
-	tlvx.WriteUInt(0x3, mattertlv.TYPE_UINT_1, 0x00)   // passcode id
-	tlvx.WriteBool(0x4, false)                         // has pbkdf
+	randReader.Read(initiator_random)
+	tlvx.WriteOctetString(0x1, initiator_random)                      // initiator random
+	tlvx.WriteUInt(0x2, mattertlv.TYPE_UINT_2, uint64(local_session)) //initator session-id
+	tlvx.WriteUInt(0x3, mattertlv.TYPE_UINT_1, 0x00)                  // passcode id
+	tlvx.WriteBool(0x4, false)                                        // has pbkdf
 	tlvx.WriteStructEnd()
 	buffer.Write(tlvx.Bytes())
 	return buffer.Bytes()
@@ -218,21 +260,22 @@ func pake3ParamRequest(exchange uint16, key []byte) []byte {
 	return buffer.Bytes()
 }
 
-func ackGen(p ProtocolMessageHeader, counter uint32) []byte {
+// ackGen encodes standalone acknowledgement of message with specified counter.
+func ackGen(exchange exchangeKey, counter uint32) []byte {
 	var buffer bytes.Buffer
 
 	var eflags byte = exchangeFlagsAcknowledge
-	if (p.exchangeFlags & exchangeFlagsInitiator) == 0 {
+	if exchange.initiator {
 		eflags |= exchangeFlagsInitiator
 	}
 	prot := ProtocolMessageHeader{
 		exchangeFlags: eflags,
 		Opcode:        SEC_CHAN_OPCODE_ACK,
-		ExchangeId:    p.ExchangeId,
+		ExchangeId:    exchange.id,
 		ProtocolId:    ProtocolIdSecureChannel,
+		ackCounter:    counter,
 	}
 	prot.Encode(&buffer)
-	binary.Write(&buffer, binary.LittleEndian, counter)
 	return buffer.Bytes()
 }
 
@@ -261,6 +304,14 @@ func (sr StatusReportElements) IsOk() bool {
 	return true
 }
 
+// Err returns StatusReportError describing report or nil when report is success.
+func (sr StatusReportElements) Err() error {
+	if sr.IsOk() {
+		return nil
+	}
+	return &StatusReportError{Report: sr}
+}
+
 type DecodedGeneric struct {
 	MessageHeader  MessageHeader
 	ProtocolHeader ProtocolMessageHeader
@@ -270,11 +321,14 @@ type DecodedGeneric struct {
 }
 
 func EncodeStatusReport(code StatusReportElements) []byte {
+	return statusReportGen(code, uint16(randomIntn(0xffff)))
+}
+
+// statusReportGen encodes status report sent by initiator within specified exchange.
+func statusReportGen(code StatusReportElements, exchange_id uint16) []byte {
 	var buffer bytes.Buffer
 	buffer.WriteByte(5)                                // flags
 	buffer.WriteByte(byte(SEC_CHAN_OPCODE_STATUS_REP)) // opcode
-	var exchange_id uint16
-	exchange_id = uint16(randm.Intn(0xffff))
 	binary.Write(&buffer, binary.LittleEndian, exchange_id)
 	var protocol_id uint16 = uint16(ProtocolIdSecureChannel)
 	binary.Write(&buffer, binary.LittleEndian, protocol_id)
@@ -412,9 +466,14 @@ func EncodeIMTimedRequest(exchange uint16, timeout uint16) []byte {
 
 // EncodeIMStatusResponse encodes success Interaction Model Invoke Response
 func EncodeIMStatusResponse(exchange_id uint16, iflag byte) []byte {
+	return imStatusResponseGen(exchange_id, iflag, 0)
+}
+
+// imStatusResponseGen encodes Interaction Model Status Response with specified status code.
+func imStatusResponseGen(exchange_id uint16, iflag byte, status byte) []byte {
 	var tlv mattertlv.TLVBuffer
 	tlv.WriteAnonStruct()
-	tlv.WriteUInt8(0, 0)
+	tlv.WriteUInt8(0, status)
 	tlv.WriteStructEnd()
 
 	var buffer bytes.Buffer
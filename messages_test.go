@@ -0,0 +1,38 @@
+package gomat
+
+import (
+	"bytes"
+	"testing"
+)
+
+func FuzzMessageHeaderDecode(f *testing.F) {
+	header := MessageHeader{
+		sessionId:         0x1234,
+		messageCounter:    5,
+		sourceNodeId:      []byte{1, 2, 3, 4, 5, 6, 7, 8},
+		destinationNodeId: []byte{1, 2},
+	}
+	var buf bytes.Buffer
+	header.Encode(&buf)
+	f.Add(buf.Bytes())
+	f.Add([]byte{0, 0, 0, 0, 1, 0, 0, 0})
+	f.Fuzz(func(t *testing.T, data []byte) {
+		var decoded MessageHeader
+		if decoded.Decode(bytes.NewBuffer(data)) != nil {
+			return
+		}
+		var encoded bytes.Buffer
+		decoded.Encode(&encoded)
+		var again MessageHeader
+		err := again.Decode(&encoded)
+		if err != nil {
+			t.Fatalf("decode of encoded header failed: %s", err.Error())
+		}
+		if again.sessionId != decoded.sessionId || again.securityFlags != decoded.securityFlags ||
+			again.messageCounter != decoded.messageCounter ||
+			!bytes.Equal(again.sourceNodeId, decoded.sourceNodeId) ||
+			!bytes.Equal(again.destinationNodeId, decoded.destinationNodeId) {
+			t.Fatalf("header changed by encode/decode")
+		}
+	})
+}
@@ -0,0 +1,346 @@
+package gomat
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sync"
+	"time"
+
+	"github.com/finnigja/gomat/mattertlv"
+)
+
+// MRP (Message Reliability Protocol) constants as defined by matter specification
+const mrpMaxTransmissions = 5
+const mrpBackoffBase = 1.6
+const mrpBackoffJitter = 0.25
+const mrpBackoffMargin = 1.1
+const mrpBackoffThreshold = 1
+const mrpStandaloneAckTimeout = 200 * time.Millisecond
+
+// time which remote node is expected to need to process request before it responds
+const mrpExpectedProcessingTime = 2 * time.Second
+
+// MrpParameters are session parameters of remote node which drive retransmission timing.
+// They can be learnt from SII/SAI/SAT dns-sd txt keys or from session establishment messages.
+type MrpParameters struct {
+	IdleInterval    time.Duration // SESSION_IDLE_INTERVAL (SII)
+	ActiveInterval  time.Duration // SESSION_ACTIVE_INTERVAL (SAI)
+	ActiveThreshold time.Duration // SESSION_ACTIVE_THRESHOLD (SAT)
+}
+
+// DefaultMrpParameters returns parameters which are used when remote node does not advertise own values.
+func DefaultMrpParameters() MrpParameters {
+	return MrpParameters{
+		IdleInterval:    500 * time.Millisecond,
+		ActiveInterval:  300 * time.Millisecond,
+		ActiveThreshold: 4000 * time.Millisecond,
+	}
+}
+
+// NewMrpParameters creates parameters from values in milliseconds as advertised using SII/SAI/SAT txt keys.
+// Zero value means that parameter was not advertised and default is used.
+func NewMrpParameters(sii, sai, sat int) MrpParameters {
+	out := DefaultMrpParameters()
+	if sii > 0 {
+		out.IdleInterval = time.Duration(sii) * time.Millisecond
+	}
+	if sai > 0 {
+		out.ActiveInterval = time.Duration(sai) * time.Millisecond
+	}
+	if sat > 0 {
+		out.ActiveThreshold = time.Duration(sat) * time.Millisecond
+	}
+	return out
+}
+
+// retransTimeout computes time to wait for acknowledgement after transmission number n (starting with 0).
+func (p MrpParameters) retransTimeout(n int, peer_active bool) time.Duration {
+	base := p.IdleInterval
+	if peer_active {
+		base = p.ActiveInterval
+	}
+	exp := n - mrpBackoffThreshold
+	if exp < 0 {
+		exp = 0
+	}
+	t := float64(base) * mrpBackoffMargin * math.Pow(mrpBackoffBase, float64(exp)) * (1 + randomFloat64()*mrpBackoffJitter)
+	return time.Duration(t)
+}
+
+// receiveTimeout computes time to wait for response when caller does not set deadline.
+// It covers all transmissions of message to idle peer and time which peer needs to process it.
+func (p MrpParameters) receiveTimeout() time.Duration {
+	var total float64
+	for n := 0; n < mrpMaxTransmissions; n++ {
+		exp := n - mrpBackoffThreshold
+		if exp < 0 {
+			exp = 0
+		}
+		total += float64(p.IdleInterval) * mrpBackoffMargin * math.Pow(mrpBackoffBase, float64(exp)) * (1 + mrpBackoffJitter)
+	}
+	return time.Duration(total) + mrpExpectedProcessingTime
+}
+
+// parseSessionParameters reads session-parameter-struct received during PASE or CASE.
+// Fields not present in struct keep value from defaults.
+func parseSessionParameters(item *mattertlv.TlvItem, defaults MrpParameters) MrpParameters {
+	out := defaults
+	if item == nil {
+		return out
+	}
+	if sii := item.GetItemWithTag(1); sii != nil {
+		out.IdleInterval = time.Duration(sii.GetInt()) * time.Millisecond
+	}
+	if sai := item.GetItemWithTag(2); sai != nil {
+		out.ActiveInterval = time.Duration(sai.GetInt()) * time.Millisecond
+	}
+	if sat := item.GetItemWithTag(3); sat != nil {
+		out.ActiveThreshold = time.Duration(sat.GetInt()) * time.Millisecond
+	}
+	return out
+}
+
+// exchangeKey identifies exchange within session.
+// Exchange id alone is not unique because both peers allocate exchange ids.
+type exchangeKey struct {
+	id        uint16
+	initiator bool // true when local node initiated exchange
+}
+
+type retransEntry struct {
+	exchange      exchangeKey
+	datagram      []byte
+	transmissions int
+	timer         *time.Timer
+}
+
+type pendingAck struct {
+	counter uint32
+	timer   *time.Timer
+}
+
+// channelState holds mutable state of secure channel.
+// It is shared by all copies of SecureChannel value.
+type channelState struct {
+	mutex   sync.Mutex
+	counter uint32
+	params  MrpParameters
+	last_rx time.Time
+	retrans map[uint32]*retransEntry
+	acks    map[exchangeKey]*pendingAck
+	lost    error           // unacknowledged message, cleared when reported or when later message is acknowledged
+	rx      *receptionState // created when first message is received
+}
+
+func newChannelState(params MrpParameters) *channelState {
+	return &channelState{
+		counter: uint32(randomIntn(0xffffffff)),
+		params:  params,
+		retrans: map[uint32]*retransEntry{},
+		acks:    map[exchangeKey]*pendingAck{},
+	}
+}
+
+func (st *channelState) nextCounter() uint32 {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.counter = st.counter + 1
+	return st.counter
+}
+
+func (st *channelState) peerActive() bool {
+	return time.Since(st.last_rx) < st.params.ActiveThreshold
+}
+
+// takeAck removes pending acknowledgement for exchange so it can be piggybacked on outgoing message.
+func (st *channelState) takeAck(exchange exchangeKey) (uint32, bool) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	ack, ok := st.acks[exchange]
+	if !ok {
+		return 0, false
+	}
+	ack.timer.Stop()
+	delete(st.acks, exchange)
+	return ack.counter, true
+}
+
+// track registers reliable message for retransmission until it is acknowledged.
+func (st *channelState) track(counter uint32, exchange exchangeKey, datagram []byte, t Transport) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	entry := &retransEntry{
+		exchange:      exchange,
+		datagram:      datagram,
+		transmissions: 1,
+	}
+	entry.timer = time.AfterFunc(st.params.retransTimeout(0, st.peerActive()), func() {
+		st.retransmit(counter, t)
+	})
+	st.retrans[counter] = entry
+}
+
+func (st *channelState) retransmit(counter uint32, t Transport) {
+	st.mutex.Lock()
+	entry, ok := st.retrans[counter]
+	if !ok {
+		st.mutex.Unlock()
+		return
+	}
+	if entry.transmissions >= mrpMaxTransmissions {
+		delete(st.retrans, counter)
+		st.lost = fmt.Errorf("message %d not acknowledged after %d transmissions", counter, entry.transmissions)
+		st.mutex.Unlock()
+		return
+	}
+	entry.timer = time.AfterFunc(st.params.retransTimeout(entry.transmissions, st.peerActive()), func() {
+		st.retransmit(counter, t)
+	})
+	entry.transmissions++
+	datagram := entry.datagram
+	st.mutex.Unlock()
+	t.Send(datagram)
+}
+
+func (st *channelState) acknowledged(counter uint32) {
+	entry, ok := st.retrans[counter]
+	if !ok {
+		return
+	}
+	entry.timer.Stop()
+	delete(st.retrans, counter)
+	st.lost = nil
+}
+
+// stop cancels all retransmissions and acknowledgements waiting for piggybacking.
+func (st *channelState) stop() {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	for counter, entry := range st.retrans {
+		entry.timer.Stop()
+		delete(st.retrans, counter)
+	}
+	for exchange, ack := range st.acks {
+		ack.timer.Stop()
+		delete(st.acks, exchange)
+	}
+}
+
+// processReceived performs MRP processing of received message - it verifies message counter against
+// reception state of peer, handles acknowledgements carried by message, schedules acknowledgement
+// of reliable message and filters duplicates. Duplicates are acknowledged again and dropped. Message with
+// counter behind window of unicast session is duplicate as well, only group sessions (GroupCounterTable)
+// drop such messages silently. It returns false when message must not be passed to application.
+func (sc *SecureChannel) processReceived(msg *DecodedGeneric) bool {
+	st := sc.state
+	prot := msg.ProtocolHeader
+	counter := msg.MessageHeader.messageCounter
+	exchange := exchangeKey{
+		id:        prot.ExchangeId,
+		initiator: (prot.exchangeFlags & exchangeFlagsInitiator) == 0,
+	}
+
+	// timer must not follow later changes of caller's SecureChannel variable
+	channel := *sc
+
+	st.mutex.Lock()
+	if st.rx == nil {
+		if len(sc.decrypt_key) > 0 {
+			st.rx = newReceptionState(receptionUnicast)
+		} else {
+			st.rx = newReceptionState(receptionUnencrypted)
+		}
+	}
+	// peer which missed acknowledgement keeps retransmitting, so message behind window is acknowledged too
+	duplicate := st.rx.check(counter) != counterNew
+	st.last_rx = time.Now()
+	if (prot.exchangeFlags & exchangeFlagsAcknowledge) != 0 {
+		st.acknowledged(prot.ackCounter)
+	}
+	ack_now := []uint32{}
+	if (prot.exchangeFlags&exchangeFlagsReliable) != 0 && !sc.transport.Stream() {
+		if duplicate {
+			ack_now = append(ack_now, counter)
+		} else {
+			if previous, ok := st.acks[exchange]; ok {
+				// only one acknowledgement can wait for piggybacking
+				previous.timer.Stop()
+				ack_now = append(ack_now, previous.counter)
+			}
+			st.acks[exchange] = &pendingAck{
+				counter: counter,
+				timer: time.AfterFunc(mrpStandaloneAckTimeout, func() {
+					channel.sendPendingAck(exchange, counter)
+				}),
+			}
+		}
+	}
+	st.mutex.Unlock()
+
+	for _, c := range ack_now {
+		sc.sendAck(exchange, c)
+	}
+	if duplicate {
+		return false
+	}
+	if (prot.ProtocolId == ProtocolIdSecureChannel) && (prot.Opcode == SEC_CHAN_OPCODE_ACK) {
+		return false
+	}
+	return true
+}
+
+// sendPendingAck sends standalone acknowledgement when it was not piggybacked in time.
+func (sc *SecureChannel) sendPendingAck(exchange exchangeKey, counter uint32) {
+	st := sc.state
+	st.mutex.Lock()
+	ack, ok := st.acks[exchange]
+	if !ok || ack.counter != counter {
+		st.mutex.Unlock()
+		return
+	}
+	delete(st.acks, exchange)
+	st.mutex.Unlock()
+	sc.sendAck(exchange, counter)
+}
+
+// flushAcks immediately sends all acknowledgements waiting for piggybacking.
+// It is used when no more messages will be sent within session.
+func (sc *SecureChannel) flushAcks() {
+	st := sc.state
+	st.mutex.Lock()
+	acks := map[exchangeKey]uint32{}
+	for exchange, ack := range st.acks {
+		ack.timer.Stop()
+		acks[exchange] = ack.counter
+		delete(st.acks, exchange)
+	}
+	st.mutex.Unlock()
+	for exchange, counter := range acks {
+		sc.sendAck(exchange, counter)
+	}
+}
+
+// awaitAcks receives messages until all reliable messages sent within channel are acknowledged.
+// It is used after last message of session establishment, which is not followed by any response.
+func (sc *SecureChannel) awaitAcks(ctx context.Context) error {
+	st := sc.state
+	deadline := sc.receiveDeadline(ctx)
+	for {
+		st.mutex.Lock()
+		pending := len(st.retrans)
+		st.mutex.Unlock()
+		if pending == 0 {
+			return nil
+		}
+		data, err := sc.receiveData(ctx, deadline)
+		if err != nil {
+			return sc.timeoutError(err)
+		}
+		msg, err := sc.decode(data)
+		if err != nil {
+			continue
+		}
+		sc.processReceived(&msg)
+	}
+}
@@ -0,0 +1,246 @@
+package gomat
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+// recordingTransport remembers every message sent through wrapped transport.
+type recordingTransport struct {
+	Transport
+	mutex sync.Mutex
+	sent  []recordedMessage
+}
+
+type recordedMessage struct {
+	at       time.Time
+	protocol ProtocolMessageHeader
+	counter  uint32
+}
+
+func (t *recordingTransport) Send(data []byte) error {
+	buf := bytes.NewBuffer(data)
+	var msg MessageHeader
+	msg.Decode(buf)
+	var prot ProtocolMessageHeader
+	prot.Decode(buf)
+	t.mutex.Lock()
+	t.sent = append(t.sent, recordedMessage{at: time.Now(), protocol: prot, counter: msg.messageCounter})
+	t.mutex.Unlock()
+	return t.Transport.Send(data)
+}
+
+func (t *recordingTransport) messages() []recordedMessage {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+	return append([]recordedMessage{}, t.sent...)
+}
+
+func pendingRetransmissions(sc SecureChannel) int {
+	sc.state.mutex.Lock()
+	defer sc.state.mutex.Unlock()
+	return len(sc.state.retrans)
+}
+
+// zeroReader makes retransmission jitter zero.
+type zeroReader struct{}
+
+func (zeroReader) Read(p []byte) (int, error) {
+	for n := range p {
+		p[n] = 0
+	}
+	return len(p), nil
+}
+
+func TestMrpRetransTimeout(t *testing.T) {
+	SetRandomSource(zeroReader{})
+	defer SetRandomSource(nil)
+	params := MrpParameters{
+		IdleInterval:    500 * time.Millisecond,
+		ActiveInterval:  300 * time.Millisecond,
+		ActiveThreshold: 4000 * time.Millisecond,
+	}
+	tests := []struct {
+		n      int
+		active bool
+		want   time.Duration
+	}{
+		{0, false, 550 * time.Millisecond},
+		{1, false, 550 * time.Millisecond},
+		{2, false, 880 * time.Millisecond},
+		{3, false, 1408 * time.Millisecond},
+		{4, false, 2252800 * time.Microsecond},
+		{0, true, 330 * time.Millisecond},
+		{2, true, 528 * time.Millisecond},
+	}
+	for _, test := range tests {
+		got := params.retransTimeout(test.n, test.active)
+		diff := got - test.want
+		if diff < -time.Microsecond || diff > time.Microsecond {
+			t.Errorf("retransTimeout(%d, %v) = %v, want %v", test.n, test.active, got, test.want)
+		}
+	}
+}
+
+func TestMrpRetransTimeoutJitter(t *testing.T) {
+	params := DefaultMrpParameters()
+	for n := 0; n < 100; n++ {
+		got := params.retransTimeout(2, false)
+		min := 880 * time.Millisecond
+		max := 1100 * time.Millisecond
+		if got < min || got > max {
+			t.Fatalf("retransTimeout %v out of range [%v,%v]", got, min, max)
+		}
+	}
+}
+
+func TestMrpGiveUp(t *testing.T) {
+	a, b := NewMemoryTransportPair(LinkConditions{Loss: 1})
+	rec := &recordingTransport{Transport: a}
+	sender := newSecureChannel(rec, fastMrp())
+	defer sender.Close()
+	defer b.Close()
+
+	sender.Send(pBKDFParamRequest(10, 20))
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	_, err := sender.ReceiveContext(ctx)
+	if err == nil {
+		t.Fatalf("receive did not fail")
+	}
+	if !strings.Contains(err.Error(), "not acknowledged after 5 transmissions") {
+		t.Fatalf("unexpected error %s", err.Error())
+	}
+	// lost message is reported only by first timeout
+	ctx2, cancel2 := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel2()
+	_, err = sender.ReceiveContext(ctx2)
+	if err == nil || strings.Contains(err.Error(), "not acknowledged") {
+		t.Fatalf("unexpected error of second receive %v", err)
+	}
+	sent := rec.messages()
+	if len(sent) != mrpMaxTransmissions {
+		t.Fatalf("message sent %d times, want %d", len(sent), mrpMaxTransmissions)
+	}
+	for n := 1; n < len(sent); n++ {
+		if sent[n].counter != sent[0].counter {
+			t.Fatalf("retransmission uses different counter %d", sent[n].counter)
+		}
+		// backoff grows with every transmission after first one
+		min := fastMrp().retransTimeout(n-1, true) * 4 / 5
+		if gap := sent[n].at.Sub(sent[n-1].at); gap < min {
+			t.Fatalf("retransmission %d after %v, expected at least %v", n, gap, min)
+		}
+	}
+	if pendingRetransmissions(sender) != 0 {
+		t.Fatalf("message still waits for retransmission")
+	}
+}
+
+func TestMrpPiggybackedAck(t *testing.T) {
+	a, b := NewMemoryTransportPair(LinkConditions{})
+	rec := &recordingTransport{Transport: b}
+	sender := newSecureChannel(a, fastMrp())
+	receiver := newSecureChannel(rec, fastMrp())
+	defer sender.Close()
+	defer receiver.Close()
+
+	sender.Send(pBKDFParamRequest(10, 20))
+	msg, err := receiver.Receive()
+	if err != nil {
+		t.Fatalf("message not received %s", err.Error())
+	}
+	receiver.Send(EncodeIMStatusResponse(msg.ProtocolHeader.ExchangeId, 0))
+	_, err = sender.Receive()
+	if err != nil {
+		t.Fatalf("response not received %s", err.Error())
+	}
+	if pendingRetransmissions(sender) != 0 {
+		t.Fatalf("piggybacked ack did not clear retransmission")
+	}
+	sent := rec.messages()
+	if len(sent) != 1 {
+		t.Fatalf("receiver sent %d messages, want 1", len(sent))
+	}
+	if sent[0].protocol.exchangeFlags&exchangeFlagsAcknowledge == 0 || sent[0].protocol.ackCounter != msg.MessageHeader.messageCounter {
+		t.Fatalf("ack was not piggybacked on response")
+	}
+}
+
+func TestMrpStandaloneAck(t *testing.T) {
+	a, b := NewMemoryTransportPair(LinkConditions{})
+	rec := &recordingTransport{Transport: b}
+	sender := newSecureChannel(a, MrpParameters{
+		IdleInterval:    time.Second,
+		ActiveInterval:  time.Second,
+		ActiveThreshold: 4000 * time.Millisecond,
+	})
+	receiver := newSecureChannel(rec, fastMrp())
+	defer sender.Close()
+	defer receiver.Close()
+
+	sender.Send(pBKDFParamRequest(10, 20))
+	msg, err := receiver.Receive()
+	if err != nil {
+		t.Fatalf("message not received %s", err.Error())
+	}
+	received := time.Now()
+	ctx, cancel := context.WithTimeout(context.Background(), 3*mrpStandaloneAckTimeout)
+	defer cancel()
+	_, err = sender.ReceiveContext(ctx)
+	if err == nil {
+		t.Fatalf("standalone ack was passed to application")
+	}
+	if pendingRetransmissions(sender) != 0 {
+		t.Fatalf("standalone ack did not clear retransmission")
+	}
+	sent := rec.messages()
+	if len(sent) != 1 || sent[0].protocol.Opcode != SEC_CHAN_OPCODE_ACK {
+		t.Fatalf("standalone ack not sent")
+	}
+	if sent[0].protocol.ackCounter != msg.MessageHeader.messageCounter {
+		t.Fatalf("ack of counter %d, want %d", sent[0].protocol.ackCounter, msg.MessageHeader.messageCounter)
+	}
+	if sent[0].at.Sub(received) < mrpStandaloneAckTimeout*4/5 {
+		t.Fatalf("standalone ack sent after %v, before ack timeout", sent[0].at.Sub(received))
+	}
+}
+
+func TestMrpStandaloneAckKeepsCounter(t *testing.T) {
+	a, b := NewMemoryTransportPair(LinkConditions{})
+	rec := &recordingTransport{Transport: b}
+	sender := newSecureChannel(a, fastMrp())
+	receiver := newSecureChannel(rec, fastMrp())
+	defer sender.Close()
+	defer receiver.Close()
+
+	// two reliable messages of same exchange - ack of first one is sent immediately
+	// when second one arrives and must not be replaced by ack of second one
+	sender.Send(pBKDFParamRequest(10, 20))
+	sender.Send(pBKDFParamRequest(10, 20))
+	first, err := receiver.Receive()
+	if err != nil {
+		t.Fatalf("message not received %s", err.Error())
+	}
+	second, err := receiver.Receive()
+	if err != nil {
+		t.Fatalf("message not received %s", err.Error())
+	}
+	sent := rec.messages()
+	if len(sent) != 1 {
+		t.Fatalf("receiver sent %d messages, want 1", len(sent))
+	}
+	if sent[0].protocol.ackCounter != first.MessageHeader.messageCounter {
+		t.Fatalf("ack of counter %d, want %d", sent[0].protocol.ackCounter, first.MessageHeader.messageCounter)
+	}
+	receiver.state.mutex.Lock()
+	pending := receiver.state.acks[exchangeKey{id: 10}]
+	receiver.state.mutex.Unlock()
+	if pending == nil || pending.counter != second.MessageHeader.messageCounter {
+		t.Fatalf("ack of second message is not pending")
+	}
+}
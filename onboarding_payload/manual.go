@@ -6,9 +6,17 @@ import (
 	"strings"
 )
 
-func DecodeManualPairingCode(in string) QrContent {
+// DecodeManualPairingCode decodes manual pairing code (11 or 21 digits, dashes are ignored).
+func DecodeManualPairingCode(in string) (QrContent, error) {
 	in = strings.Replace(in, "-", "", -1)
-	fmt.Printf("normalized code: %s\n", in)
+	if len(in) != 11 && len(in) != 21 {
+		return QrContent{}, fmt.Errorf("manual pairing code must have 11 or 21 digits")
+	}
+	for _, c := range in {
+		if c < '0' || c > '9' {
+			return QrContent{}, fmt.Errorf("invalid character %q in manual pairing code", c)
+		}
+	}
 	first_group := in[0:1]
 	second_group := in[1:6]
 	third_group := in[6:10]
@@ -21,5 +29,5 @@ func DecodeManualPairingCode(in string) QrContent {
 	return QrContent{
 		Passcode:       uint32(p),
 		Discriminator4: uint16(d),
-	}
+	}, nil
 }
@@ -0,0 +1,40 @@
+package onboarding_payload
+
+import (
+	"testing"
+)
+
+func TestDecode(t *testing.T) {
+	qr, err := DecodeQrText("MT:-24J0AFN00KA0648G00")
+	if err != nil {
+		t.Fatalf("qr decode failed %s", err.Error())
+	}
+	if qr.Vendor != 0xfff1 || qr.Product != 0x8001 || qr.Discriminator != 3840 || qr.Passcode != 20202021 {
+		t.Fatalf("incorrect qr content %+v", qr)
+	}
+	mc, err := DecodeManualPairingCode("3497-011-2332")
+	if err != nil {
+		t.Fatalf("manual code decode failed %s", err.Error())
+	}
+	if mc.Passcode != 20202021 || mc.Discriminator4 != 3840 {
+		t.Fatalf("incorrect manual code content %+v", mc)
+	}
+}
+
+func FuzzDecodeQrText(f *testing.F) {
+	f.Add("MT:-24J0AFN00KA0648G00")
+	f.Add("MT:")
+	f.Add("MT:Y.K90")
+	f.Fuzz(func(t *testing.T, in string) {
+		DecodeQrText(in)
+	})
+}
+
+func FuzzDecodeManualPairingCode(f *testing.F) {
+	f.Add("34970112332")
+	f.Add("749701123365521327694")
+	f.Add("3497-011-233")
+	f.Fuzz(func(t *testing.T, in string) {
+		DecodeManualPairingCode(in)
+	})
+}
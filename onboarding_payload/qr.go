@@ -7,13 +7,16 @@ import (
 
 const qr_alphabet = "0123456789ABCDEFGHIJKLMNOPQRSTUVWXYZ-."
 
-func a2n(a byte) uint32 {
+// qr payload contains 84 bits of data
+const qr_payload_bits = 84
+
+func a2n(a byte) (uint32, error) {
 	for i := 0; i < len(qr_alphabet); i++ {
 		if qr_alphabet[i] == a {
-			return uint32(i)
+			return uint32(i), nil
 		}
 	}
-	panic("")
+	return 0, fmt.Errorf("invalid character %q in qr text", a)
 }
 
 type bitBuffer struct {
@@ -76,7 +79,7 @@ func (bb *bitBuffer) reset_ptr() {
 	bb.current_byte = 0
 }
 
-func b38_decode(in string) bitBuffer {
+func b38_decode(in string) (bitBuffer, error) {
 	in_array := []string{}
 	for len(in) >= 5 {
 		in_array = append(in_array, in[:5])
@@ -90,8 +93,12 @@ func b38_decode(in string) bitBuffer {
 	for _, a := range in_array {
 		var b24 uint32
 		mult := 1
-		for _, n := range a {
-			b24 += a2n(byte(n)) * uint32(mult)
+		for n := 0; n < len(a); n++ {
+			v, err := a2n(a[n])
+			if err != nil {
+				return bitBuffer{}, err
+			}
+			b24 += v * uint32(mult)
 			mult *= 38
 		}
 		for i := 0; i < 3; i++ {
@@ -99,7 +106,7 @@ func b38_decode(in string) bitBuffer {
 			b24 = b24 >> 8
 		}
 	}
-	return bb
+	return bb, nil
 }
 
 type QrContent struct {
@@ -119,13 +126,20 @@ func (qr QrContent) Dump() {
 	fmt.Printf("discriminator: %d\n", qr.Discriminator)
 }
 
-func DecodeQrText(in string) QrContent {
+// DecodeQrText decodes text of onboarding qr code (starting with "MT:").
+func DecodeQrText(in string) (QrContent, error) {
 	if !strings.HasPrefix(in, "MT:") {
-		panic("wrong qr")
+		return QrContent{}, fmt.Errorf("qr text does not start with MT:")
 	}
 	in = in[3:]
 	var out QrContent
-	bb := b38_decode(in)
+	bb, err := b38_decode(in)
+	if err != nil {
+		return QrContent{}, err
+	}
+	if len(bb.bytes)*8 < qr_payload_bits {
+		return QrContent{}, fmt.Errorf("qr text too short")
+	}
 
 	bb.reset_ptr()
 	out.Version = byte(bb.get_number(3))
@@ -135,5 +149,5 @@ func DecodeQrText(in string) QrContent {
 	bb.get_number(8) // discovery capabilities
 	out.Discriminator = uint16(bb.get_number(12))
 	out.Passcode = uint32(bb.get_number(27))
-	return out
+	return out, nil
 }
@@ -0,0 +1,357 @@
+package gomat
+
+import (
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net"
+	"strconv"
+	"strings"
+
+	"github.com/finnigja/gomat/mattertlv"
+	"github.com/finnigja/gomat/symbols"
+)
+
+// kinds of TLV elements used to encode data model types
+const (
+	dmUint = iota
+	dmInt
+	dmBool
+	dmFloat
+	dmString
+	dmOctets
+)
+
+type dmType struct {
+	kind int
+	bits int
+}
+
+// dataModelTypes maps data model types to TLV elements.
+var dataModelTypes = map[string]dmType{
+	"bool": {dmBool, 0},
+
+	"uint8": {dmUint, 8}, "uint16": {dmUint, 16}, "uint24": {dmUint, 32}, "uint32": {dmUint, 32},
+	"uint40": {dmUint, 64}, "uint48": {dmUint, 64}, "uint56": {dmUint, 64}, "uint64": {dmUint, 64},
+	"int8": {dmInt, 8}, "int16": {dmInt, 16}, "int24": {dmInt, 32}, "int32": {dmInt, 32},
+	"int40": {dmInt, 64}, "int48": {dmInt, 64}, "int56": {dmInt, 64}, "int64": {dmInt, 64},
+	"enum8": {dmUint, 8}, "enum16": {dmUint, 16},
+	"bitmap8": {dmUint, 8}, "bitmap16": {dmUint, 16}, "bitmap32": {dmUint, 32}, "bitmap64": {dmUint, 64},
+	"single": {dmFloat, 32}, "double": {dmFloat, 64},
+
+	"string": {dmString, 0}, "char_string": {dmString, 0}, "long_char_string": {dmString, 0},
+	"octstr": {dmOctets, 0}, "long_octstr": {dmOctets, 0}, "ipadr": {dmOctets, 0}, "ipv4adr": {dmOctets, 0},
+	"ipv6adr": {dmOctets, 0}, "ipv6pre": {dmOctets, 0}, "hwadr": {dmOctets, 0},
+
+	"percent": {dmUint, 8}, "percent100ths": {dmUint, 16}, "temperature": {dmInt, 16},
+	"power-mW": {dmInt, 64}, "amperage-mA": {dmInt, 64}, "voltage-mV": {dmInt, 64}, "energy-mWh": {dmInt, 64},
+	"money": {dmInt, 64}, "priority": {dmUint, 8}, "status": {dmUint, 8},
+	"epoch-us": {dmUint, 64}, "epoch-s": {dmUint, 32}, "utc": {dmUint, 32}, "posix-ms": {dmUint, 64},
+	"systime-us": {dmUint, 64}, "systime-ms": {dmUint, 64}, "elapsed-s": {dmUint, 32},
+
+	"action-id": {dmUint, 8}, "attrib-id": {dmUint, 32}, "cluster-id": {dmUint, 32}, "command-id": {dmUint, 32},
+	"data-ver": {dmUint, 32}, "devtype-id": {dmUint, 32}, "endpoint-no": {dmUint, 16}, "entry-idx": {dmUint, 16},
+	"event-id": {dmUint, 32}, "event-no": {dmUint, 64}, "fabric-id": {dmUint, 64}, "fabric-idx": {dmUint, 8},
+	"field-id": {dmUint, 32}, "group-id": {dmUint, 16}, "namespace": {dmUint, 8}, "node-id": {dmUint, 64},
+	"subject-id": {dmUint, 64}, "tag": {dmUint, 8}, "trans-id": {dmUint, 32}, "vendor-id": {dmUint, 16},
+}
+
+// fieldEncoder encodes values decoded from JSON according to data types of cluster.
+type fieldEncoder struct {
+	b     mattertlv.TLVBuffer
+	types *symbols.ClusterTypes
+}
+
+// EncodeCommandFields encodes fields of command from JSON object which uses names of fields, for example
+// {"Level": 100, "TransitionTime": null, "OptionsMask": ["ExecuteIfOff"]}. Enums are given by name or number,
+// bitmaps by list of bit names, "A|B" or number, octet strings as hex, structs as objects and integers
+// also as strings ("0x10"). Data types are taken from symbols.ClusterTypesMap.
+// Result is suitable for Command.Fields.
+func EncodeCommandFields(cluster, command int, data []byte) ([]byte, error) {
+	types, ok := symbols.ClusterTypesMap[cluster]
+	if !ok {
+		return nil, fmt.Errorf("data types of cluster 0x%x are not known", cluster)
+	}
+	info, ok := types.Commands[command]
+	if !ok {
+		return nil, fmt.Errorf("fields of command 0x%x of cluster 0x%x are not known", command, cluster)
+	}
+	value, err := decodeJsonValue(data)
+	if err != nil {
+		return nil, err
+	}
+	object, ok := value.(map[string]any)
+	if !ok {
+		return nil, fmt.Errorf("fields of command must be JSON object")
+	}
+	e := fieldEncoder{types: types}
+	err = e.structure(mattertlv.AnonymousTag(), info.Name, info.Fields, object)
+	if err != nil {
+		return nil, err
+	}
+	out := e.b.Bytes()
+	// members of structure without its start and end
+	return out[1 : len(out)-1], nil
+}
+
+// EncodeAttributeValue encodes JSON value of attribute in same notation as EncodeCommandFields.
+// Result is suitable for WriteAttribute.Value.
+func EncodeAttributeValue(cluster, attribute int, data []byte) ([]byte, error) {
+	field := symbols.AttributeType(cluster, attribute)
+	if field == nil {
+		return nil, fmt.Errorf("type of attribute 0x%x of cluster 0x%x is not known", attribute, cluster)
+	}
+	value, err := decodeJsonValue(data)
+	if err != nil {
+		return nil, err
+	}
+	e := fieldEncoder{types: symbols.ClusterTypesMap[cluster]}
+	err = e.value(mattertlv.AnonymousTag(), *field, value)
+	if err != nil {
+		return nil, err
+	}
+	return e.b.Bytes(), nil
+}
+
+func decodeJsonValue(data []byte) (any, error) {
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+	var out any
+	err := decoder.Decode(&out)
+	return out, err
+}
+
+func (e *fieldEncoder) structure(tag mattertlv.Tag, name string, fields []symbols.Field, object map[string]any) error {
+	for key := range object {
+		found := false
+		for _, f := range fields {
+			found = found || f.Name == key
+		}
+		if !found {
+			return fmt.Errorf("%s has no field %s", name, key)
+		}
+	}
+	e.b.WriteTaggedContainer(tag, mattertlv.CONTAINER_STRUCT)
+	for _, f := range fields {
+		v, ok := object[f.Name]
+		if !ok {
+			continue
+		}
+		err := e.value(mattertlv.ContextTag(byte(f.Id)), f, v)
+		if err != nil {
+			return err
+		}
+	}
+	e.b.WriteStructEnd()
+	return nil
+}
+
+func (e *fieldEncoder) value(tag mattertlv.Tag, field symbols.Field, value any) error {
+	if value == nil {
+		if !field.Nullable {
+			return fmt.Errorf("%s is not nullable", field.Name)
+		}
+		return e.b.WriteTagged(tag, nil)
+	}
+	if field.List {
+		list, ok := value.([]any)
+		if !ok {
+			return fmt.Errorf("%s must be list", field.Name)
+		}
+		e.b.WriteTaggedContainer(tag, mattertlv.CONTAINER_ARRAY)
+		entry := field
+		entry.List = false
+		entry.Nullable = false
+		for n, v := range list {
+			entry.Name = fmt.Sprintf("%s[%d]", field.Name, n)
+			err := e.value(mattertlv.AnonymousTag(), entry, v)
+			if err != nil {
+				return err
+			}
+		}
+		e.b.WriteStructEnd()
+		return nil
+	}
+	if e.types != nil {
+		if st, ok := e.types.Structs[field.Type]; ok {
+			object, ok := value.(map[string]any)
+			if !ok {
+				return fmt.Errorf("%s must be object", field.Name)
+			}
+			return e.structure(tag, field.Name, st.Fields, object)
+		}
+		if enum, ok := e.types.Enums[field.Type]; ok {
+			return e.enum(tag, field.Name, enum, value)
+		}
+		if bitmap, ok := e.types.Bitmaps[field.Type]; ok {
+			return e.bitmap(tag, field.Name, bitmap, value)
+		}
+	}
+	t, ok := dataModelTypes[field.Type]
+	if !ok {
+		return fmt.Errorf("%s has unknown type %s", field.Name, field.Type)
+	}
+	switch t.kind {
+	case dmBool:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("%s must be bool", field.Name)
+		}
+		return e.b.WriteTagged(tag, v)
+	case dmString:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("%s must be string", field.Name)
+		}
+		return e.b.WriteTagged(tag, v)
+	case dmOctets:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("%s must be hex string", field.Name)
+		}
+		data, err := octets(field.Type, v)
+		if err != nil {
+			return fmt.Errorf("%s: %w", field.Name, err)
+		}
+		return e.b.WriteTagged(tag, data)
+	case dmFloat:
+		v, err := jsonNumber(value).Float64()
+		if err != nil {
+			return fmt.Errorf("%s must be number", field.Name)
+		}
+		if t.bits == 32 {
+			return e.b.WriteTagged(tag, float32(v))
+		}
+		return e.b.WriteTagged(tag, v)
+	case dmInt:
+		v, err := strconv.ParseInt(string(jsonNumber(value)), 0, t.bits)
+		if err != nil {
+			return fmt.Errorf("%s: %w", field.Name, err)
+		}
+		return e.b.WriteTagged(tag, sizedInt(v, t.bits))
+	}
+	return e.uint(tag, field.Name, value, t.bits)
+}
+
+// jsonNumber returns number or string value as json.Number, other values as empty string.
+func jsonNumber(value any) json.Number {
+	switch v := value.(type) {
+	case json.Number:
+		return v
+	case string:
+		return json.Number(v)
+	}
+	return ""
+}
+
+func (e *fieldEncoder) uint(tag mattertlv.Tag, name string, value any, bits int) error {
+	v, err := strconv.ParseUint(string(jsonNumber(value)), 0, bits)
+	if err != nil {
+		return fmt.Errorf("%s: %w", name, err)
+	}
+	return e.b.WriteTagged(tag, sizedUint(v, bits))
+}
+
+func (e *fieldEncoder) enum(tag mattertlv.Tag, name string, enum symbols.EnumInfo, value any) error {
+	bits := 8
+	for v := range enum {
+		if v > 0xff {
+			bits = 16
+		}
+	}
+	if s, ok := value.(string); ok {
+		for v, n := range enum {
+			if n == s {
+				return e.b.WriteTagged(tag, sizedUint(uint64(v), bits))
+			}
+		}
+		if _, err := strconv.ParseUint(s, 0, bits); err != nil {
+			return fmt.Errorf("%s: unknown value %s", name, s)
+		}
+	}
+	return e.uint(tag, name, value, bits)
+}
+
+func (e *fieldEncoder) bitmap(tag mattertlv.Tag, name string, bitmap symbols.BitmapInfo, value any) error {
+	bits := 8
+	for bit := range bitmap {
+		for bit >= bits {
+			bits *= 2
+		}
+	}
+	names := []string{}
+	switch v := value.(type) {
+	case []any:
+		for _, n := range v {
+			s, ok := n.(string)
+			if !ok {
+				return fmt.Errorf("%s: names of bits must be strings", name)
+			}
+			names = append(names, s)
+		}
+	case string:
+		if _, err := strconv.ParseUint(v, 0, bits); err == nil {
+			return e.uint(tag, name, value, bits)
+		}
+		names = strings.Split(v, "|")
+	default:
+		return e.uint(tag, name, value, bits)
+	}
+	var out uint64
+	for _, s := range names {
+		found := false
+		for bit, n := range bitmap {
+			if n == strings.TrimSpace(s) {
+				out |= 1 << bit
+				found = true
+			}
+		}
+		if !found {
+			return fmt.Errorf("%s: unknown bit %s", name, s)
+		}
+	}
+	return e.b.WriteTagged(tag, sizedUint(out, bits))
+}
+
+// octets converts hex string into octet string. Addresses can be given also in usual text form.
+func octets(typ string, value string) ([]byte, error) {
+	switch typ {
+	case "ipadr", "ipv4adr", "ipv6adr":
+		if ip := net.ParseIP(value); ip != nil {
+			if ip4 := ip.To4(); ip4 != nil && typ != "ipv6adr" {
+				return []byte(ip4), nil
+			}
+			return []byte(ip), nil
+		}
+	case "hwadr":
+		if mac, err := net.ParseMAC(value); err == nil {
+			return []byte(mac), nil
+		}
+	}
+	return hex.DecodeString(value)
+}
+
+func sizedUint(v uint64, bits int) any {
+	switch bits {
+	case 8:
+		return uint8(v)
+	case 16:
+		return uint16(v)
+	case 32:
+		return uint32(v)
+	}
+	return v
+}
+
+func sizedInt(v int64, bits int) any {
+	switch bits {
+	case 8:
+		return int8(v)
+	case 16:
+		return int16(v)
+	case 32:
+		return int32(v)
+	}
+	return v
+}
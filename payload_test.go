@@ -0,0 +1,127 @@
+package gomat
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/finnigja/gomat/symbols"
+)
+
+// loadTestCluster registers cluster defined by XML for duration of test. Ids of test clusters use
+// test vendor prefix 0xfff1, so they never replace cluster known to symbols.
+func loadTestCluster(t *testing.T, id int, xml string) {
+	t.Helper()
+	if _, ok := symbols.ClusterNameMap[id]; ok {
+		t.Fatalf("cluster 0x%x is already registered", id)
+	}
+	err := symbols.LoadXml([]byte(xml))
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() {
+		delete(symbols.ClusterIdMap, symbols.ClusterNameMap[id])
+		delete(symbols.ClusterNameMap, id)
+		delete(symbols.AttributeNameMap, id)
+		delete(symbols.AttributeIdMap, id)
+		delete(symbols.CommandNameMap, id)
+		delete(symbols.ResponseNameMap, id)
+		delete(symbols.CommandIdMap, id)
+		delete(symbols.EventNameMap, id)
+		delete(symbols.EventIdMap, id)
+		delete(symbols.ClusterTypesMap, id)
+	})
+}
+
+const payloadTestCluster = 0xfff1fc01
+
+func TestEncodeNamedValues(t *testing.T) {
+	loadTestCluster(t, payloadTestCluster, `<cluster id="0xFFF1FC01" name="Payload Test" revision="1">
+  <dataTypes>
+    <enum name="ModeEnum">
+      <item value="0" name="Off"/>
+      <item value="4" name="Heat"/>
+    </enum>
+    <bitmap name="FlagsBitmap">
+      <bitfield name="A" bit="0"/>
+      <bitfield name="B" bit="1"/>
+      <bitfield name="C" bit="3"/>
+    </bitmap>
+  </dataTypes>
+  <commands>
+    <command id="0xFFF10000" name="SetMode" direction="commandToServer" response="Y">
+      <field id="0" name="Mode" type="ModeEnum"/>
+      <field id="1" name="Flags" type="FlagsBitmap"/>
+    </command>
+  </commands>
+</cluster>`)
+	tests := []struct {
+		json string
+		want []byte
+	}{
+		{`{"Mode": "Heat", "Flags": "A|B"}`, []byte{0x24, 0, 4, 0x24, 1, 3}},
+		{`{"Mode": "Off", "Flags": "A | C"}`, []byte{0x24, 0, 0, 0x24, 1, 9}},
+		{`{"Mode": 4, "Flags": ["B", "C"]}`, []byte{0x24, 0, 4, 0x24, 1, 10}},
+		{`{"Mode": "0x4", "Flags": "0x80"}`, []byte{0x24, 0, 4, 0x24, 1, 0x80}},
+	}
+	for _, test := range tests {
+		fields, err := EncodeCommandFields(payloadTestCluster, 0xfff10000, []byte(test.json))
+		if err != nil {
+			t.Fatalf("%s: %s", test.json, err)
+		}
+		if !bytes.Equal(fields, test.want) {
+			t.Fatalf("%s: fields %x, want %x", test.json, fields, test.want)
+		}
+	}
+	for _, invalid := range []string{
+		`{"Mode": "Cool", "Flags": "A"}`,
+		`{"Mode": "Off", "Flags": "A|D"}`,
+		`{"Mode": "Off", "Flags": ["A", 1]}`,
+	} {
+		if _, err := EncodeCommandFields(payloadTestCluster, 0xfff10000, []byte(invalid)); err == nil {
+			t.Fatalf("%s accepted", invalid)
+		}
+	}
+}
+
+func TestEncodeAddresses(t *testing.T) {
+	loadTestCluster(t, payloadTestCluster, `<cluster id="0xFFF1FC01" name="Payload Test" revision="1">
+  <commands>
+    <command id="0xFFF10001" name="SetAddress" direction="commandToServer" response="Y">
+      <field id="0" name="Address" type="ipadr"/>
+      <field id="1" name="Address6" type="ipv6adr"/>
+      <field id="2" name="Hardware" type="hwadr"/>
+    </command>
+  </commands>
+</cluster>`)
+	v6 := []byte{0xfe, 0x80, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 1}
+	mapped := []byte{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0xff, 0xff, 10, 0, 0, 1}
+	tests := []struct {
+		json string
+		want [][]byte
+	}{
+		{`{"Address": "192.168.1.2", "Address6": "fe80::1", "Hardware": "aa:bb:cc:dd:ee:ff"}`,
+			[][]byte{{192, 168, 1, 2}, v6, {0xaa, 0xbb, 0xcc, 0xdd, 0xee, 0xff}}},
+		// ipv6adr keeps 16 bytes also for IPv4 address, hex form is accepted for all types
+		{`{"Address": "fe80::1", "Address6": "10.0.0.1", "Hardware": "00112233445566778899"}`,
+			[][]byte{v6, mapped, {0, 0x11, 0x22, 0x33, 0x44, 0x55, 0x66, 0x77, 0x88, 0x99}}},
+		{`{"Address": "c0a80102", "Address6": "fe800000000000000000000000000001", "Hardware": "AA-BB-CC-DD-EE-FF"}`,
+			[][]byte{{192, 168, 1, 2}, v6, {0xaa, 0xbb, 0xcc, 0xdd, 0xee, 0xff}}},
+	}
+	for _, test := range tests {
+		fields, err := EncodeCommandFields(payloadTestCluster, 0xfff10001, []byte(test.json))
+		if err != nil {
+			t.Fatalf("%s: %s", test.json, err)
+		}
+		want := []byte{}
+		for n, address := range test.want {
+			want = append(want, 0x30, byte(n), byte(len(address)))
+			want = append(want, address...)
+		}
+		if !bytes.Equal(fields, want) {
+			t.Fatalf("%s: fields %x, want %x", test.json, fields, want)
+		}
+	}
+	if _, err := EncodeCommandFields(payloadTestCluster, 0xfff10001, []byte(`{"Address": "192.168.1", "Address6": "::1", "Hardware": "aa"}`)); err == nil {
+		t.Fatalf("invalid address accepted")
+	}
+}
@@ -0,0 +1,51 @@
+package gomat
+
+import (
+	"crypto/rand"
+	"encoding/binary"
+	"io"
+	"sync"
+)
+
+var randomMutex sync.Mutex
+var randomSource io.Reader = rand.Reader
+
+// SetRandomSource replaces source of all randomness used by library - ephemeral keys, random values
+// of PASE and CASE, exchange and session ids, message counters and retransmission jitter.
+// It is intended for deterministic tests, for example with math/rand.New(math/rand.NewSource(seed)).
+// nil restores crypto/rand.
+func SetRandomSource(source io.Reader) {
+	randomMutex.Lock()
+	defer randomMutex.Unlock()
+	if source == nil {
+		source = rand.Reader
+	}
+	randomSource = source
+}
+
+type randomReader struct{}
+
+func (randomReader) Read(p []byte) (int, error) {
+	randomMutex.Lock()
+	defer randomMutex.Unlock()
+	return io.ReadFull(randomSource, p)
+}
+
+// randReader reads from current random source. It is passed to crypto functions.
+var randReader io.Reader = randomReader{}
+
+func randomUint64() uint64 {
+	var buf [8]byte
+	randReader.Read(buf[:])
+	return binary.LittleEndian.Uint64(buf[:])
+}
+
+// randomIntn returns random number in range [0,n).
+func randomIntn(n int) int {
+	return int(randomUint64() % uint64(n))
+}
+
+// randomFloat64 returns random number in range [0.0,1.0).
+func randomFloat64() float64 {
+	return float64(randomUint64()>>11) / (1 << 53)
+}
@@ -0,0 +1,362 @@
+package gomat
+
+import (
+	"bytes"
+	"context"
+
+	"github.com/finnigja/gomat/mattertlv"
+)
+
+// Wildcard can be passed to NewAttributePath and NewEventPath in place of endpoint, cluster,
+// attribute or event to address all of them.
+const Wildcard = -1
+
+// AttributePath addresses attributes in read or subscribe request. nil field is wildcard.
+type AttributePath struct {
+	Endpoint  *uint16
+	Cluster   *uint32
+	Attribute *uint32
+}
+
+// NewAttributePath creates AttributePath. Any of arguments can be Wildcard.
+func NewAttributePath(endpoint, cluster, attribute int) AttributePath {
+	var out AttributePath
+	if endpoint != Wildcard {
+		v := uint16(endpoint)
+		out.Endpoint = &v
+	}
+	if cluster != Wildcard {
+		v := uint32(cluster)
+		out.Cluster = &v
+	}
+	if attribute != Wildcard {
+		v := uint32(attribute)
+		out.Attribute = &v
+	}
+	return out
+}
+
+// EventPath addresses events in read or subscribe request. nil field is wildcard.
+type EventPath struct {
+	Endpoint *uint16
+	Cluster  *uint32
+	Event    *uint32
+	Urgent   bool // used only by subscriptions - event is reported without waiting for min interval
+}
+
+// NewEventPath creates EventPath. Any of arguments can be Wildcard.
+func NewEventPath(endpoint, cluster, event int) EventPath {
+	var out EventPath
+	if endpoint != Wildcard {
+		v := uint16(endpoint)
+		out.Endpoint = &v
+	}
+	if cluster != Wildcard {
+		v := uint32(cluster)
+		out.Cluster = &v
+	}
+	if event != Wildcard {
+		v := uint32(event)
+		out.Event = &v
+	}
+	return out
+}
+
+// DataVersionFilter asks device to skip attributes of cluster when its data version equals DataVersion.
+type DataVersionFilter struct {
+	Endpoint    uint16
+	Cluster     uint32
+	DataVersion uint32
+}
+
+// ReadRequest describes Interaction Model Read Request.
+type ReadRequest struct {
+	Attributes         []AttributePath
+	Events             []EventPath
+	EventMin           uint64 // when not 0 only events with event number >= EventMin are reported
+	DataVersionFilters []DataVersionFilter
+	FabricFiltered     bool // fabric scoped lists contain only entries of accessing fabric
+}
+
+func writeAttributePath(tlv *mattertlv.TLVBuffer, path AttributePath) {
+	tlv.WriteAnonList()
+	if path.Endpoint != nil {
+		tlv.WriteUInt16(2, *path.Endpoint)
+	}
+	if path.Cluster != nil {
+		tlv.WriteUInt32(3, *path.Cluster)
+	}
+	if path.Attribute != nil {
+		tlv.WriteUInt32(4, *path.Attribute)
+	}
+	tlv.WriteStructEnd()
+}
+
+func writeEventPath(tlv *mattertlv.TLVBuffer, path EventPath) {
+	tlv.WriteAnonList()
+	if path.Endpoint != nil {
+		tlv.WriteUInt16(1, *path.Endpoint)
+	}
+	if path.Cluster != nil {
+		tlv.WriteUInt32(2, *path.Cluster)
+	}
+	if path.Event != nil {
+		tlv.WriteUInt32(3, *path.Event)
+	}
+	if path.Urgent {
+		tlv.WriteBool(4, true)
+	}
+	tlv.WriteStructEnd()
+}
+
+func writeAttributePaths(tlv *mattertlv.TLVBuffer, tag byte, paths []AttributePath) {
+	if len(paths) == 0 {
+		return
+	}
+	tlv.WriteArray(tag)
+	for _, path := range paths {
+		writeAttributePath(tlv, path)
+	}
+	tlv.WriteStructEnd()
+}
+
+func writeEventPaths(tlv *mattertlv.TLVBuffer, tag byte, paths []EventPath) {
+	if len(paths) == 0 {
+		return
+	}
+	tlv.WriteArray(tag)
+	for _, path := range paths {
+		writeEventPath(tlv, path)
+	}
+	tlv.WriteStructEnd()
+}
+
+func writeEventFilter(tlv *mattertlv.TLVBuffer, tag byte, event_min uint64) {
+	if event_min == 0 {
+		return
+	}
+	tlv.WriteArray(tag)
+	tlv.WriteAnonStruct()
+	tlv.WriteUInt64(1, event_min)
+	tlv.WriteStructEnd()
+	tlv.WriteStructEnd()
+}
+
+func writeDataVersionFilters(tlv *mattertlv.TLVBuffer, tag byte, filters []DataVersionFilter) {
+	if len(filters) == 0 {
+		return
+	}
+	tlv.WriteArray(tag)
+	for _, filter := range filters {
+		tlv.WriteAnonStruct()
+		tlv.WriteList(0)
+		tlv.WriteUInt16(1, filter.Endpoint)
+		tlv.WriteUInt32(2, filter.Cluster)
+		tlv.WriteStructEnd()
+		tlv.WriteUInt32(1, filter.DataVersion)
+		tlv.WriteStructEnd()
+	}
+	tlv.WriteStructEnd()
+}
+
+// EncodeIMRead encodes Interaction Model Read Request message with paths of request.
+func EncodeIMRead(request ReadRequest, exchange uint16) []byte {
+	var tlv mattertlv.TLVBuffer
+	tlv.WriteAnonStruct()
+	writeAttributePaths(&tlv, 0, request.Attributes)
+	writeEventPaths(&tlv, 1, request.Events)
+	writeEventFilter(&tlv, 2, request.EventMin)
+	tlv.WriteBool(3, request.FabricFiltered)
+	writeDataVersionFilters(&tlv, 4, request.DataVersionFilters)
+	tlv.WriteUInt(0xff, mattertlv.TYPE_UINT_1, 10)
+	tlv.WriteStructEnd()
+
+	var buffer bytes.Buffer
+	prot := ProtocolMessageHeader{
+		exchangeFlags: 5,
+		Opcode:        INTERACTION_OPCODE_READ_REQ,
+		ExchangeId:    exchange,
+		ProtocolId:    ProtocolIdInteraction,
+	}
+	prot.Encode(&buffer)
+	buffer.Write(tlv.Bytes())
+
+	return buffer.Bytes()
+}
+
+// AttributeReport is value or status of one concrete attribute reported by device.
+type AttributeReport struct {
+	Endpoint         uint16
+	Cluster          uint32
+	Attribute        uint32
+	DataVersion      uint32
+	Value            mattertlv.TlvItem // valid when Status is success
+	Status           Status
+	ClusterStatus    uint8 // cluster specific status code, valid when HasClusterStatus is set
+	HasClusterStatus bool
+}
+
+// Err returns StatusError when device reported failure instead of value of attribute.
+func (r AttributeReport) Err() error {
+	return statusError(r.Status, r.ClusterStatus, r.HasClusterStatus, &StatusPath{Kind: ElementAttribute, Endpoint: r.Endpoint, Cluster: r.Cluster, Id: r.Attribute})
+}
+
+// EventReport is one event or status of event path reported by device.
+type EventReport struct {
+	Endpoint        uint16
+	Cluster         uint32
+	Event           uint32
+	EventNumber     uint64
+	Priority        int
+	EpochTimestamp  uint64 // microseconds since unix epoch, 0 when device uses system timestamp; delta timestamps are resolved
+	SystemTimestamp uint64 // milliseconds since boot of device; delta timestamps are resolved
+	Data            mattertlv.TlvItem
+	Status          Status
+}
+
+// Err returns StatusError when device reported failure instead of event.
+func (r EventReport) Err() error {
+	return statusError(r.Status, 0, false, &StatusPath{Kind: ElementEvent, Endpoint: r.Endpoint, Cluster: r.Cluster, Id: r.Event})
+}
+
+// ReportResult is parsed content of ReportData message.
+type ReportResult struct {
+	SubscriptionId uint32
+	Attributes     []AttributeReport
+	Events         []EventReport
+}
+
+// Attribute returns first report of attribute or nil when device did not report it.
+func (r ReportResult) Attribute(endpoint uint16, cluster uint32, attribute uint32) *AttributeReport {
+	for n, a := range r.Attributes {
+		if a.Endpoint == endpoint && a.Cluster == cluster && a.Attribute == attribute {
+			return &r.Attributes[n]
+		}
+	}
+	return nil
+}
+
+// ParseReport parses ReportData message, usually the one returned by ReceiveReport.
+func ParseReport(report *mattertlv.TlvItem) ReportResult {
+	var out ReportResult
+	if subscription := report.GetItemWithTag(reportTagSubscriptionId); subscription != nil {
+		out.SubscriptionId = uint32(subscription.GetUint64())
+	}
+	if reports := report.GetItemWithTag(reportTagAttributeReports); reports != nil {
+		var decoder pathDecoder
+		for _, item := range reports.GetChild() {
+			var r AttributeReport
+			var path *mattertlv.TlvItem
+			if data_ib := item.GetItemWithTag(1); data_ib != nil {
+				path = data_ib.GetItemWithTag(1)
+				if version := data_ib.GetItemWithTag(0); version != nil {
+					r.DataVersion = uint32(version.GetUint64())
+				}
+				if data := data_ib.GetItemWithTag(2); data != nil {
+					r.Value = *data
+				}
+			} else if status_ib := item.GetItemWithTag(0); status_ib != nil {
+				path = status_ib.GetItemWithTag(0)
+				if status := status_ib.GetItemWithTag(1); status != nil {
+					r.Status, r.ClusterStatus, r.HasClusterStatus = parseStatusIB(status)
+				}
+			} else {
+				continue
+			}
+			if path != nil {
+				p, _ := decoder.decode(path)
+				r.Endpoint = uint16(p.endpoint)
+				r.Cluster = uint32(p.cluster)
+				r.Attribute = uint32(p.attribute)
+			}
+			out.Attributes = append(out.Attributes, r)
+		}
+	}
+	if reports := report.GetItemWithTag(reportTagEventReports); reports != nil {
+		// delta timestamps are relative to timestamp of preceding event of report
+		var epoch, system uint64
+		for _, item := range reports.GetChild() {
+			var r EventReport
+			var path *mattertlv.TlvItem
+			if data_ib := item.GetItemWithTag(1); data_ib != nil {
+				path = data_ib.GetItemWithTag(0)
+				if number := data_ib.GetItemWithTag(1); number != nil {
+					r.EventNumber = number.GetUint64()
+				}
+				if priority := data_ib.GetItemWithTag(2); priority != nil {
+					r.Priority = priority.GetInt()
+				}
+				if timestamp := data_ib.GetItemWithTag(3); timestamp != nil {
+					epoch = timestamp.GetUint64()
+					r.EpochTimestamp = epoch
+				}
+				if timestamp := data_ib.GetItemWithTag(4); timestamp != nil {
+					system = timestamp.GetUint64()
+					r.SystemTimestamp = system
+				}
+				if delta := data_ib.GetItemWithTag(5); delta != nil {
+					epoch += delta.GetUint64()
+					r.EpochTimestamp = epoch
+				}
+				if delta := data_ib.GetItemWithTag(6); delta != nil {
+					system += delta.GetUint64()
+					r.SystemTimestamp = system
+				}
+				if data := data_ib.GetItemWithTag(7); data != nil {
+					r.Data = *data
+				}
+			} else if status_ib := item.GetItemWithTag(0); status_ib != nil {
+				path = status_ib.GetItemWithTag(0)
+				if status := status_ib.GetItemWithTag(1); status != nil {
+					r.Status, _, _ = parseStatusIB(status)
+				}
+			} else {
+				continue
+			}
+			if path != nil {
+				if endpoint := path.GetItemWithTag(1); endpoint != nil {
+					r.Endpoint = uint16(endpoint.GetInt())
+				}
+				if cluster := path.GetItemWithTag(2); cluster != nil {
+					r.Cluster = uint32(cluster.GetInt())
+				}
+				if event := path.GetItemWithTag(3); event != nil {
+					r.Event = uint32(event.GetInt())
+				}
+			}
+			out.Events = append(out.Events, r)
+		}
+	}
+	return out
+}
+
+// parseStatusIB returns status, cluster status and flag whether cluster status is present.
+func parseStatusIB(status *mattertlv.TlvItem) (Status, uint8, bool) {
+	var code Status
+	if s := status.GetItemWithTag(0); s != nil {
+		code = Status(s.GetInt())
+	}
+	if cs := status.GetItemWithTag(1); cs != nil {
+		return code, uint8(cs.GetInt()), true
+	}
+	return code, 0, false
+}
+
+// Read sends Read Request and returns parsed report. Report split into chunks is reassembled.
+// Messenger is SecureChannel or Exchange.
+func Read(messenger Messenger, request ReadRequest) (ReportResult, error) {
+	return ReadContext(context.Background(), messenger, request)
+}
+
+// ReadContext is Read which can be cancelled and limited by deadline of ctx.
+func ReadContext(ctx context.Context, messenger Messenger, request ReadRequest) (ReportResult, error) {
+	err := messenger.Send(EncodeIMRead(request, uint16(randomIntn(0xffff))))
+	if err != nil {
+		return ReportResult{}, err
+	}
+	report, err := ReceiveReportContext(ctx, messenger)
+	if err != nil {
+		return ReportResult{}, err
+	}
+	return ParseReport(&report.Tlv), nil
+}
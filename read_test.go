@@ -0,0 +1,99 @@
+package gomat
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/finnigja/gomat/mattertlv"
+)
+
+type testEvent struct {
+	number    uint64
+	timestamp byte // tag of timestamp field of EventDataIB (3-6)
+	value     uint64
+}
+
+func encodeEventReport(events []testEvent) []byte {
+	var tlv mattertlv.TLVBuffer
+	tlv.WriteAnonStruct()
+	tlv.WriteArray(reportTagEventReports)
+	for _, event := range events {
+		tlv.WriteAnonStruct()
+		tlv.WriteStruct(1) // EventDataIB
+		tlv.WriteList(0)
+		tlv.WriteUInt16(1, 1)
+		tlv.WriteUInt32(2, 0x28)
+		tlv.WriteUInt32(3, 0)
+		tlv.WriteStructEnd()
+		tlv.WriteUInt64(1, event.number)
+		tlv.WriteUInt8(2, 1)
+		tlv.WriteUInt64(event.timestamp, event.value)
+		tlv.WriteStruct(7)
+		tlv.WriteStructEnd()
+		tlv.WriteStructEnd()
+		tlv.WriteStructEnd()
+	}
+	tlv.WriteStructEnd()
+	tlv.WriteStructEnd()
+	return tlv.Bytes()
+}
+
+func TestParseReportEventTimestamps(t *testing.T) {
+	tests := []struct {
+		name   string
+		events []testEvent
+		epoch  []uint64
+		system []uint64
+	}{
+		{"absolute", []testEvent{{1, 3, 1000}, {2, 4, 50}}, []uint64{1000, 0}, []uint64{0, 50}},
+		{"delta epoch", []testEvent{{1, 3, 1700000000000000}, {2, 5, 250}, {3, 5, 1}}, []uint64{1700000000000000, 1700000000000250, 1700000000000251}, []uint64{0, 0, 0}},
+		{"delta system", []testEvent{{1, 4, 5000}, {2, 6, 10}, {3, 6, 0}}, []uint64{0, 0, 0}, []uint64{5000, 5010, 5010}},
+		{"delta after new absolute", []testEvent{{1, 4, 5000}, {2, 6, 10}, {3, 4, 100}, {4, 6, 7}}, []uint64{0, 0, 0, 0}, []uint64{5000, 5010, 100, 107}},
+	}
+	for _, test := range tests {
+		tlv, err := mattertlv.Decode(encodeEventReport(test.events))
+		if err != nil {
+			t.Fatalf("%s: can't decode report %s", test.name, err.Error())
+		}
+		result := ParseReport(&tlv)
+		if len(result.Events) != len(test.events) {
+			t.Fatalf("%s: parsed %d events, want %d", test.name, len(result.Events), len(test.events))
+		}
+		for n, event := range result.Events {
+			if event.EventNumber != test.events[n].number || event.Cluster != 0x28 || event.Endpoint != 1 {
+				t.Errorf("%s: event %d has unexpected path or number %+v", test.name, n, event)
+			}
+			if event.EpochTimestamp != test.epoch[n] || event.SystemTimestamp != test.system[n] {
+				t.Errorf("%s: event %d timestamps epoch:%d system:%d, want epoch:%d system:%d", test.name, n,
+					event.EpochTimestamp, event.SystemTimestamp, test.epoch[n], test.system[n])
+			}
+		}
+	}
+}
+
+// statusResponse creates IM StatusResponse message with status.
+func statusResponse(t *testing.T, exchange uint16, status Status) DecodedGeneric {
+	msg := testMessage(exchange, 0, ProtocolIdInteraction, INTERACTION_OPCODE_STATUS_RSP)
+	tlv, err := mattertlv.Decode(imStatusResponseGen(exchange, 0, byte(status))[6:])
+	if err != nil {
+		t.Fatal(err)
+	}
+	msg.Tlv = tlv
+	return msg
+}
+
+func TestReadStatusResponse(t *testing.T) {
+	request := ReadRequest{Attributes: []AttributePath{NewAttributePath(1, 6, 0)}}
+	_, err := Read(scriptedMessenger(statusResponse(t, 1, StatusBusy)), request)
+	if !errors.Is(err, StatusBusy) {
+		t.Fatalf("busy device not reported by StatusError: %v", err)
+	}
+	var status_err *StatusError
+	if !errors.As(err, &status_err) || status_err.Path != nil {
+		t.Fatalf("unexpected StatusError %v", err)
+	}
+
+	if _, err := Read(scriptedMessenger(statusResponse(t, 1, StatusSuccess)), request); err == nil {
+		t.Fatalf("StatusResponse accepted as report")
+	}
+}
@@ -0,0 +1,229 @@
+package gomat
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"sync"
+)
+
+// size of window of message counters tracked behind highest received counter
+const messageCounterWindow = 32
+
+var ErrDuplicateMessage = errors.New("duplicate message")
+var ErrCounterOutOfWindow = errors.New("message counter is behind window")
+var ErrCounterNotSynchronized = errors.New("message counter of peer is not synchronized")
+
+type counterPosition int
+
+const (
+	counterNew counterPosition = iota
+	counterDuplicate
+	counterOutOfWindow
+)
+
+type receptionMode int
+
+const (
+	receptionUnicast     receptionMode = iota // encrypted unicast - counter may roll over, counters behind window are dropped as duplicates
+	receptionUnencrypted                      // counter may roll over, counter behind window is accepted as new
+	receptionGroup                            // counter must not roll over, counters behind window are rejected
+)
+
+// receptionState is message reception state of peer as defined by matter specification.
+// It tracks highest received counter and which counters of window behind it were received.
+type receptionState struct {
+	mode   receptionMode
+	synced bool
+	max    uint32
+	bitmap uint32 // bit n is set when counter max-n-1 was received
+}
+
+func newReceptionState(mode receptionMode) *receptionState {
+	rs := &receptionState{mode: mode}
+	if mode == receptionUnicast {
+		// initial counters of secure sessions are in range 1..2^28, so state starts synchronized at 0
+		rs.synced = true
+	}
+	return rs
+}
+
+// synchronize sets highest counter of peer. All counters within window behind it are treated as received.
+func (rs *receptionState) synchronize(counter uint32) {
+	rs.synced = true
+	rs.max = counter
+	rs.bitmap = 0xffffffff
+}
+
+func (rs *receptionState) ahead(counter uint32) bool {
+	if rs.mode == receptionGroup {
+		return counter > rs.max
+	}
+	return (counter - rs.max) < (1 << 31)
+}
+
+func (rs *receptionState) position(counter uint32) counterPosition {
+	if !rs.synced {
+		return counterNew
+	}
+	if counter == rs.max {
+		return counterDuplicate
+	}
+	if rs.ahead(counter) {
+		return counterNew
+	}
+	offset := rs.max - counter
+	if offset <= messageCounterWindow {
+		if (rs.bitmap & (1 << (offset - 1))) != 0 {
+			return counterDuplicate
+		}
+		return counterNew
+	}
+	if rs.mode == receptionUnencrypted {
+		// peer may have restarted and started with new counter
+		return counterNew
+	}
+	return counterOutOfWindow
+}
+
+// commit records counter of accepted message.
+func (rs *receptionState) commit(counter uint32) {
+	if !rs.synced {
+		rs.synced = true
+		rs.max = counter
+		rs.bitmap = 0
+		return
+	}
+	if rs.ahead(counter) {
+		shift := counter - rs.max
+		if shift > messageCounterWindow {
+			rs.bitmap = 0
+		} else {
+			rs.bitmap = (rs.bitmap << shift) | (1 << (shift - 1))
+		}
+		rs.max = counter
+		return
+	}
+	offset := rs.max - counter
+	if offset <= messageCounterWindow {
+		rs.bitmap |= 1 << (offset - 1)
+		return
+	}
+	rs.max = counter
+	rs.bitmap = 0
+}
+
+// check verifies counter of received message and records it when message is accepted.
+func (rs *receptionState) check(counter uint32) counterPosition {
+	pos := rs.position(counter)
+	if pos == counterNew {
+		rs.commit(counter)
+	}
+	return pos
+}
+
+// globalGroupCounter is Global Group Encrypted Data Message Counter of this node.
+// It is reported to peers which ask for synchronization.
+var globalGroupCounter = uint32(randomIntn(0x0fffffff)) + 1
+
+func msgCounterSyncReqGen(exchange uint16, challenge []byte) []byte {
+	var buffer bytes.Buffer
+	prot := ProtocolMessageHeader{
+		exchangeFlags: exchangeFlagsInitiator | exchangeFlagsReliable,
+		Opcode:        SEC_CHAN_OPCODE_MSG_COUNTER_SYNC_REQ,
+		ExchangeId:    exchange,
+		ProtocolId:    ProtocolIdSecureChannel,
+	}
+	prot.Encode(&buffer)
+	buffer.Write(challenge)
+	return buffer.Bytes()
+}
+
+func msgCounterSyncRspGen(exchange uint16, counter uint32, response []byte) []byte {
+	var buffer bytes.Buffer
+	prot := ProtocolMessageHeader{
+		exchangeFlags: exchangeFlagsReliable,
+		Opcode:        SEC_CHAN_OPCODE_MSG_COUNTER_SYNC_RSP,
+		ExchangeId:    exchange,
+		ProtocolId:    ProtocolIdSecureChannel,
+	}
+	prot.Encode(&buffer)
+	binary.Write(&buffer, binary.LittleEndian, counter)
+	buffer.Write(response)
+	return buffer.Bytes()
+}
+
+// respondCounterSync answers MsgCounterSyncReq with value of global group counter of this node.
+func respondCounterSync(exchange *Exchange, msg DecodedGeneric) {
+	defer exchange.Close()
+	if len(msg.Payload) != 8 {
+		return
+	}
+	exchange.Send(msgCounterSyncRspGen(exchange.Id(), globalGroupCounter, msg.Payload))
+}
+
+// GroupCounterTable keeps message reception state of group messages for every source node.
+// Counter of peer must be synchronized using unicast session before its group messages are accepted.
+type GroupCounterTable struct {
+	mutex sync.Mutex
+	peers map[uint64]*receptionState
+}
+
+func NewGroupCounterTable() *GroupCounterTable {
+	return &GroupCounterTable{
+		peers: map[uint64]*receptionState{},
+	}
+}
+
+// Verify checks counter of group message received from source_node and records it when message is accepted.
+// ErrCounterNotSynchronized is returned when Synchronize was not done for source_node yet.
+func (t *GroupCounterTable) Verify(source_node uint64, counter uint32) error {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+	rs, ok := t.peers[source_node]
+	if !ok {
+		return ErrCounterNotSynchronized
+	}
+	switch rs.check(counter) {
+	case counterDuplicate:
+		return ErrDuplicateMessage
+	case counterOutOfWindow:
+		return ErrCounterOutOfWindow
+	}
+	return nil
+}
+
+// Synchronize obtains group message counter of source_node using MsgCounterSyncReq sent over unicast session
+// with that node. Messenger is usually new Exchange of session or SecureChannel not owned by ExchangeManager.
+func (t *GroupCounterTable) Synchronize(messenger Messenger, source_node uint64) error {
+	challenge := make([]byte, 8)
+	_, err := randReader.Read(challenge)
+	if err != nil {
+		return err
+	}
+	err = messenger.Send(msgCounterSyncReqGen(uint16(randomIntn(0xffff)), challenge))
+	if err != nil {
+		return err
+	}
+	resp, err := messenger.Receive()
+	if err != nil {
+		return err
+	}
+	if resp.ProtocolHeader.ProtocolId != ProtocolIdSecureChannel || resp.ProtocolHeader.Opcode != SEC_CHAN_OPCODE_MSG_COUNTER_SYNC_RSP {
+		return fmt.Errorf("unexpected message (protocol:%d opcode:0x%x)", resp.ProtocolHeader.ProtocolId, resp.ProtocolHeader.Opcode)
+	}
+	if len(resp.Payload) != 12 {
+		return fmt.Errorf("invalid MsgCounterSyncRsp length %d", len(resp.Payload))
+	}
+	if !bytes.Equal(resp.Payload[4:], challenge) {
+		return fmt.Errorf("MsgCounterSyncRsp does not match challenge")
+	}
+	counter := binary.LittleEndian.Uint32(resp.Payload[:4])
+	rs := newReceptionState(receptionGroup)
+	rs.synchronize(counter)
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+	t.peers[source_node] = rs
+	return nil
+}
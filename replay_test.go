@@ -0,0 +1,293 @@
+package gomat
+
+import (
+	"context"
+	"encoding/binary"
+	"errors"
+	"testing"
+	"time"
+)
+
+type counterStep struct {
+	counter uint32
+	want    counterPosition
+}
+
+func runCounterSteps(t *testing.T, name string, rs *receptionState, steps []counterStep) {
+	for n, step := range steps {
+		got := rs.check(step.counter)
+		if got != step.want {
+			t.Errorf("%s: step %d counter 0x%x got position %d, want %d (max:0x%x bitmap:0x%x)",
+				name, n, step.counter, got, step.want, rs.max, rs.bitmap)
+			return
+		}
+	}
+}
+
+func TestReceptionStateUnicast(t *testing.T) {
+	tests := []struct {
+		name  string
+		start uint32
+		steps []counterStep
+	}{
+		{"in order", 100, []counterStep{
+			{101, counterNew}, {102, counterNew}, {102, counterDuplicate}, {101, counterDuplicate}, {100, counterDuplicate},
+		}},
+		{"reordered within window", 100, []counterStep{
+			{105, counterNew}, {103, counterNew}, {103, counterDuplicate}, {104, counterNew}, {101, counterNew}, {102, counterNew}, {102, counterDuplicate},
+		}},
+		{"edge of window", 100, []counterStep{
+			{132, counterNew}, {101, counterNew}, {100, counterDuplicate}, {99, counterOutOfWindow},
+		}},
+		{"shift exactly 32", 100, []counterStep{
+			{101, counterNew}, {133, counterNew}, {101, counterDuplicate}, {102, counterNew}, {132, counterNew}, {100, counterOutOfWindow},
+		}},
+		{"shift above window", 100, []counterStep{
+			{101, counterNew}, {134, counterNew}, {101, counterOutOfWindow}, {102, counterNew}, {102, counterDuplicate},
+		}},
+		{"rollover", 0xfffffffe, []counterStep{
+			{0xffffffff, counterNew}, {0, counterNew}, {1, counterNew}, {0xffffffff, counterDuplicate}, {0xfffffffe, counterDuplicate},
+			{3, counterNew}, {2, counterNew}, {2, counterDuplicate}, {0xffffffe3, counterDuplicate}, {0xffffffe2, counterOutOfWindow},
+		}},
+		{"far behind", 0x80000000, []counterStep{
+			{5, counterOutOfWindow}, {0x7fffffff, counterDuplicate}, {0x80000001, counterNew},
+		}},
+	}
+	for _, test := range tests {
+		rs := newReceptionState(receptionUnicast)
+		rs.synchronize(test.start)
+		runCounterSteps(t, test.name, rs, test.steps)
+	}
+}
+
+func TestBehindWindowAcknowledged(t *testing.T) {
+	a, b := NewMemoryTransportPair(LinkConditions{})
+	rec := &recordingTransport{Transport: a}
+	receiver := newSecureChannel(rec, fastMrp())
+	defer receiver.Close()
+	defer b.Close()
+	// encrypted session uses unicast reception state, messages are passed to processReceived directly
+	receiver.decrypt_key = make([]byte, 16)
+
+	reliable := func(counter uint32) *DecodedGeneric {
+		msg := &DecodedGeneric{}
+		msg.MessageHeader.messageCounter = counter
+		msg.ProtocolHeader = ProtocolMessageHeader{
+			exchangeFlags: exchangeFlagsInitiator | exchangeFlagsReliable,
+			ExchangeId:    7,
+			ProtocolId:    ProtocolIdInteraction,
+			Opcode:        INTERACTION_OPCODE_STATUS_RSP,
+		}
+		return msg
+	}
+	if !receiver.processReceived(reliable(100)) {
+		t.Fatalf("new message dropped")
+	}
+	receiver.flushAcks()
+	if receiver.processReceived(reliable(100 - messageCounterWindow - 1)) {
+		t.Fatalf("message behind window passed to application")
+	}
+	deadline := time.Now().Add(time.Second)
+	for len(rec.messages()) < 2 && time.Now().Before(deadline) {
+		time.Sleep(time.Millisecond)
+	}
+	sent := rec.messages()
+	if len(sent) != 2 {
+		t.Fatalf("sent %d acks, want 2", len(sent))
+	}
+	last := sent[1].protocol
+	if last.Opcode != SEC_CHAN_OPCODE_ACK || last.ackCounter != 100-messageCounterWindow-1 {
+		t.Fatalf("message behind window not acknowledged %+v", last)
+	}
+}
+
+func TestReceptionStateInitial(t *testing.T) {
+	// secure session starts synchronized at 0 - first counters are accepted
+	rs := newReceptionState(receptionUnicast)
+	runCounterSteps(t, "unicast", rs, []counterStep{{1, counterNew}, {1, counterDuplicate}, {3, counterNew}, {2, counterNew}})
+
+	// unencrypted session accepts any first counter
+	rs = newReceptionState(receptionUnencrypted)
+	runCounterSteps(t, "unencrypted", rs, []counterStep{{0x0abcdef0, counterNew}, {0x0abcdef0, counterDuplicate}, {0x0abcdef1, counterNew}})
+}
+
+func TestReceptionStateUnencryptedReset(t *testing.T) {
+	rs := newReceptionState(receptionUnencrypted)
+	runCounterSteps(t, "reset", rs, []counterStep{
+		{1000, counterNew}, {1001, counterNew},
+		// peer restarted with lower counter - it is accepted and becomes new maximum
+		{10, counterNew}, {10, counterDuplicate}, {11, counterNew},
+		// within window behind new maximum
+		{9, counterNew}, {9, counterDuplicate},
+		// old counters are far behind new maximum, so they are accepted as another restart
+		{1001, counterNew}, {1001, counterDuplicate},
+	})
+	if rs.max != 1001 || rs.bitmap != 0 {
+		t.Fatalf("state not reset max:%d bitmap:0x%x", rs.max, rs.bitmap)
+	}
+}
+
+func TestReceptionStateGroup(t *testing.T) {
+	rs := newReceptionState(receptionGroup)
+	if rs.synced {
+		t.Fatalf("group state must start unsynchronized")
+	}
+	rs.synchronize(100)
+	runCounterSteps(t, "group", rs, []counterStep{
+		// counters within window behind synchronized value are treated as received
+		{100, counterDuplicate}, {99, counterDuplicate}, {68, counterDuplicate}, {67, counterOutOfWindow},
+		{101, counterNew}, {110, counterNew}, {105, counterNew}, {105, counterDuplicate},
+	})
+
+	rs = newReceptionState(receptionGroup)
+	rs.synchronize(0xfffffffe)
+	runCounterSteps(t, "group rollover", rs, []counterStep{
+		{0xffffffff, counterNew},
+		// group counter must not roll over
+		{0, counterOutOfWindow}, {1, counterOutOfWindow},
+	})
+}
+
+func TestReceptionStateAhead(t *testing.T) {
+	tests := []struct {
+		mode    receptionMode
+		max     uint32
+		counter uint32
+		want    bool
+	}{
+		{receptionUnicast, 10, 11, true},
+		{receptionUnicast, 10, 9, false},
+		{receptionUnicast, 0xffffffff, 0, true},
+		{receptionUnicast, 0, 0x7fffffff, true},
+		{receptionUnicast, 0, 0x80000000, false},
+		{receptionGroup, 0xffffffff, 0, false},
+		{receptionGroup, 10, 0x80000000, true},
+	}
+	for _, test := range tests {
+		rs := newReceptionState(test.mode)
+		rs.synchronize(test.max)
+		if got := rs.ahead(test.counter); got != test.want {
+			t.Errorf("mode %d max 0x%x counter 0x%x: ahead %v, want %v", test.mode, test.max, test.counter, got, test.want)
+		}
+	}
+}
+
+func TestGroupCounterSync(t *testing.T) {
+	em, peer := newManagerPair()
+	defer em.Close()
+	defer peer.Close()
+
+	table := NewGroupCounterTable()
+	if err := table.Verify(55, 1); !errors.Is(err, ErrCounterNotSynchronized) {
+		t.Fatalf("unsynchronized node accepted: %v", err)
+	}
+	ex := em.NewExchange()
+	defer ex.Close()
+	if err := table.Synchronize(ex, 55); err != nil {
+		t.Fatalf("synchronization failed %s", err.Error())
+	}
+	counter := globalGroupCounter
+	if err := table.Verify(55, counter); !errors.Is(err, ErrDuplicateMessage) {
+		t.Fatalf("synchronized counter accepted: %v", err)
+	}
+	if err := table.Verify(55, counter+1); err != nil {
+		t.Fatalf("next counter rejected %s", err.Error())
+	}
+	if err := table.Verify(55, counter+1); !errors.Is(err, ErrDuplicateMessage) {
+		t.Fatalf("replayed counter accepted: %v", err)
+	}
+	if err := table.Verify(55, counter-40); !errors.Is(err, ErrCounterOutOfWindow) {
+		t.Fatalf("old counter accepted: %v", err)
+	}
+	if err := table.Verify(56, counter+2); !errors.Is(err, ErrCounterNotSynchronized) {
+		t.Fatalf("counter of other node accepted: %v", err)
+	}
+}
+
+// fakeMessenger answers every request using respond.
+type fakeMessenger struct {
+	sent    [][]byte
+	respond func(request []byte) DecodedGeneric
+}
+
+func (m *fakeMessenger) Send(data []byte) error {
+	m.sent = append(m.sent, data)
+	return nil
+}
+
+func (m *fakeMessenger) Receive() (DecodedGeneric, error) {
+	return m.respond(m.sent[len(m.sent)-1]), nil
+}
+
+func (m *fakeMessenger) ReceiveContext(ctx context.Context) (DecodedGeneric, error) {
+	return m.Receive()
+}
+
+func counterSyncResponse(counter uint32, challenge []byte) DecodedGeneric {
+	msg := testMessage(1, 0, ProtocolIdSecureChannel, SEC_CHAN_OPCODE_MSG_COUNTER_SYNC_RSP)
+	msg.Payload = binary.LittleEndian.AppendUint32(nil, counter)
+	msg.Payload = append(msg.Payload, challenge...)
+	return msg
+}
+
+func TestGroupCounterSyncInvalidResponse(t *testing.T) {
+	// request is protocol header (6 bytes without ack) followed by 8 byte challenge
+	challengeOf := func(request []byte) []byte {
+		return request[len(request)-8:]
+	}
+	tests := []struct {
+		name    string
+		respond func(request []byte) DecodedGeneric
+	}{
+		{"challenge mismatch", func(request []byte) DecodedGeneric {
+			return counterSyncResponse(7, make([]byte, 8))
+		}},
+		{"short payload", func(request []byte) DecodedGeneric {
+			return counterSyncResponse(7, challengeOf(request)[:4])
+		}},
+		{"wrong opcode", func(request []byte) DecodedGeneric {
+			msg := counterSyncResponse(7, challengeOf(request))
+			msg.ProtocolHeader.Opcode = SEC_CHAN_OPCODE_STATUS_REP
+			return msg
+		}},
+	}
+	for _, test := range tests {
+		table := NewGroupCounterTable()
+		err := table.Synchronize(&fakeMessenger{respond: test.respond}, 1)
+		if err == nil {
+			t.Errorf("%s: invalid response accepted", test.name)
+		}
+		if err := table.Verify(1, 8); !errors.Is(err, ErrCounterNotSynchronized) {
+			t.Errorf("%s: node synchronized by invalid response", test.name)
+		}
+	}
+
+	table := NewGroupCounterTable()
+	err := table.Synchronize(&fakeMessenger{respond: func(request []byte) DecodedGeneric {
+		return counterSyncResponse(7, challengeOf(request))
+	}}, 1)
+	if err != nil {
+		t.Fatalf("valid response rejected %s", err.Error())
+	}
+	if err := table.Verify(1, 8); err != nil {
+		t.Fatalf("counter after synchronized value rejected %s", err.Error())
+	}
+}
+
+type failingReader struct{}
+
+func (failingReader) Read(p []byte) (int, error) {
+	return 0, errors.New("no entropy")
+}
+
+func TestGroupCounterSyncRandomFailure(t *testing.T) {
+	SetRandomSource(failingReader{})
+	defer SetRandomSource(nil)
+	m := &fakeMessenger{}
+	if err := NewGroupCounterTable().Synchronize(m, 1); err == nil {
+		t.Fatalf("synchronization without challenge succeeded")
+	}
+	if len(m.sent) != 0 {
+		t.Fatalf("request sent without challenge")
+	}
+}
@@ -0,0 +1,206 @@
+package gomat
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/finnigja/gomat/mattertlv"
+)
+
+// tags of ReportDataMessage
+const (
+	reportTagSubscriptionId      = 0
+	reportTagAttributeReports    = 1
+	reportTagEventReports        = 2
+	reportTagMoreChunkedMessages = 3
+	reportTagSuppressResponse    = 4
+)
+
+// ReceiveReport receives report of read or subscribe interaction.
+// When device splits report into several chunks, all chunks are received and merged into one report.
+// See ContinueReport.
+func ReceiveReport(messenger Messenger) (DecodedGeneric, error) {
+	return ReceiveReportContext(context.Background(), messenger)
+}
+
+// ReceiveReportContext is ReceiveReport which can be cancelled and limited by deadline of ctx.
+func ReceiveReportContext(ctx context.Context, messenger Messenger) (DecodedGeneric, error) {
+	first, err := messenger.ReceiveContext(ctx)
+	if err != nil {
+		return DecodedGeneric{}, err
+	}
+	return ContinueReportContext(ctx, messenger, first)
+}
+
+// ContinueReport completes report which starts with already received ReportData message first.
+// StatusResponse is sent after every chunk which does not have SuppressResponse flag, following chunks
+// are received while MoreChunkedMessages flag is set. Lists which device split into list item appends
+// are merged, so returned report contains complete attribute values.
+// StatusResponse received instead of ReportData is returned as StatusError (errors.Is(err, StatusBusy)).
+func ContinueReport(messenger Messenger, first DecodedGeneric) (DecodedGeneric, error) {
+	return ContinueReportContext(context.Background(), messenger, first)
+}
+
+// ContinueReportContext is ContinueReport which can be cancelled and limited by deadline of ctx.
+func ContinueReportContext(ctx context.Context, messenger Messenger, first DecodedGeneric) (DecodedGeneric, error) {
+	chunks := []DecodedGeneric{}
+	msg := first
+	for {
+		if (msg.ProtocolHeader.ProtocolId == ProtocolIdInteraction) && (msg.ProtocolHeader.Opcode == INTERACTION_OPCODE_STATUS_RSP) {
+			// device rejected request (or aborted report) instead of reporting
+			err := statusResponseError(msg, "report")
+			if err == nil {
+				err = fmt.Errorf("StatusResponse received instead of report")
+			}
+			return DecodedGeneric{}, err
+		}
+		if (msg.ProtocolHeader.ProtocolId != ProtocolIdInteraction) || (msg.ProtocolHeader.Opcode != INTERACTION_OPCODE_REPORT_DATA) {
+			return DecodedGeneric{}, fmt.Errorf("unexpected message (protocol:%d opcode:0x%x)", msg.ProtocolHeader.ProtocolId, msg.ProtocolHeader.Opcode)
+		}
+		chunks = append(chunks, msg)
+		if !tlvFlag(&msg.Tlv, reportTagSuppressResponse) {
+			var iflag byte
+			if (msg.ProtocolHeader.exchangeFlags & exchangeFlagsInitiator) == 0 {
+				iflag = exchangeFlagsInitiator
+			}
+			err := messenger.Send(EncodeIMStatusResponse(msg.ProtocolHeader.ExchangeId, iflag))
+			if err != nil {
+				return DecodedGeneric{}, err
+			}
+		}
+		if !tlvFlag(&msg.Tlv, reportTagMoreChunkedMessages) {
+			break
+		}
+		var err error
+		msg, err = messenger.ReceiveContext(ctx)
+		if err != nil {
+			return DecodedGeneric{}, err
+		}
+	}
+	out := chunks[len(chunks)-1]
+	out.Tlv = mergeReportChunks(chunks)
+	out.Payload = nil
+	return out, nil
+}
+
+func tlvFlag(item *mattertlv.TlvItem, tag int) bool {
+	flag := item.GetItemWithTag(tag)
+	return (flag != nil) && flag.GetBool()
+}
+
+// attributePath is concrete path of attribute reported by device.
+type attributePath struct {
+	endpoint  int
+	cluster   int
+	attribute int
+}
+
+// pathDecoder expands AttributePathIBs which use tag compression.
+type pathDecoder struct {
+	previous attributePath
+}
+
+// decode returns path and flag which is true when path addresses list item to be appended.
+func (d *pathDecoder) decode(path *mattertlv.TlvItem) (attributePath, bool) {
+	out := attributePath{}
+	if tlvFlag(path, 0) {
+		out = d.previous
+	}
+	if endpoint := path.GetItemWithTag(2); endpoint != nil {
+		out.endpoint = endpoint.GetInt()
+	}
+	if cluster := path.GetItemWithTag(3); cluster != nil {
+		out.cluster = cluster.GetInt()
+	}
+	if attribute := path.GetItemWithTag(4); attribute != nil {
+		out.attribute = attribute.GetInt()
+	}
+	d.previous = out
+	list_index := path.GetItemWithTag(5)
+	return out, (list_index != nil) && (list_index.Type == mattertlv.TypeNull)
+}
+
+// mergeReportChunks joins attribute and event reports of all chunks into one ReportDataMessage.
+// AttributeDataIBs which append item to list are merged into preceding report of whole list.
+func mergeReportChunks(chunks []DecodedGeneric) mattertlv.TlvItem {
+	out := mattertlv.TlvItem{Type: mattertlv.TypeList}
+	attribute_reports := mattertlv.TlvItem{Tag: reportTagAttributeReports, Type: mattertlv.TypeList}
+	event_reports := mattertlv.TlvItem{Tag: reportTagEventReports, Type: mattertlv.TypeList}
+
+	merged := []mattertlv.TlvItem{}
+	paths := []attributePath{}
+	for _, chunk := range chunks {
+		var decoder pathDecoder
+		if reports := chunk.Tlv.GetItemWithTag(reportTagAttributeReports); reports != nil {
+			for _, report := range reports.GetChild() {
+				data_ib := report.GetItemWithTag(1)
+				var path *mattertlv.TlvItem
+				if data_ib != nil {
+					path = data_ib.GetItemWithTag(1)
+				} else if status_ib := report.GetItemWithTag(0); status_ib != nil {
+					path = status_ib.GetItemWithTag(0)
+				}
+				var p attributePath
+				append_item := false
+				if path != nil {
+					p, append_item = decoder.decode(path)
+				}
+				if append_item && data_ib != nil && mergeListItem(merged, paths, p, data_ib) {
+					continue
+				}
+				merged = append(merged, report)
+				paths = append(paths, p)
+			}
+		}
+		if reports := chunk.Tlv.GetItemWithTag(reportTagEventReports); reports != nil {
+			for _, report := range reports.GetChild() {
+				event_reports.AppendChild(report)
+			}
+		}
+	}
+	for _, report := range merged {
+		attribute_reports.AppendChild(report)
+	}
+
+	last := chunks[len(chunks)-1].Tlv
+	if subscription := last.GetItemWithTag(reportTagSubscriptionId); subscription != nil {
+		out.AppendChild(*subscription)
+	}
+	if len(attribute_reports.GetChild()) > 0 {
+		out.AppendChild(attribute_reports)
+	}
+	if len(event_reports.GetChild()) > 0 {
+		out.AppendChild(event_reports)
+	}
+	if suppress := last.GetItemWithTag(reportTagSuppressResponse); suppress != nil {
+		out.AppendChild(*suppress)
+	}
+	return out
+}
+
+// mergeListItem appends data of list item to last report of same attribute. It returns false when
+// such report does not exist.
+func mergeListItem(merged []mattertlv.TlvItem, paths []attributePath, path attributePath, data_ib *mattertlv.TlvItem) bool {
+	item := data_ib.GetItemWithTag(2)
+	if item == nil {
+		return false
+	}
+	for n := len(merged) - 1; n >= 0; n-- {
+		if paths[n] != path {
+			continue
+		}
+		target := merged[n].GetItemWithTag(1)
+		if target == nil {
+			return false
+		}
+		list := target.GetItemWithTag(2)
+		if list == nil || list.Type != mattertlv.TypeList {
+			return false
+		}
+		element := *item
+		element.Tag = 0 // list elements are anonymous
+		list.AppendChild(element)
+		return true
+	}
+	return false
+}
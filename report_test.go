@@ -0,0 +1,149 @@
+package gomat
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/finnigja/gomat/mattertlv"
+)
+
+// reportChunk creates ReportData message. reports writes AttributeReportIBs into AttributeReports array.
+func reportChunk(t *testing.T, more bool, suppress bool, reports func(tlv *mattertlv.TLVBuffer)) DecodedGeneric {
+	var tlv mattertlv.TLVBuffer
+	tlv.WriteAnonStruct()
+	tlv.WriteArray(reportTagAttributeReports)
+	reports(&tlv)
+	tlv.WriteStructEnd()
+	if more {
+		tlv.WriteBool(reportTagMoreChunkedMessages, true)
+	}
+	if suppress {
+		tlv.WriteBool(reportTagSuppressResponse, true)
+	}
+	tlv.WriteUInt8(0xff, 11)
+	tlv.WriteStructEnd()
+	item, err := mattertlv.Decode(tlv.Bytes())
+	if err != nil {
+		t.Fatal(err)
+	}
+	msg := testMessage(9, 0, ProtocolIdInteraction, INTERACTION_OPCODE_REPORT_DATA)
+	msg.Tlv = item
+	return msg
+}
+
+// attributeReportGen writes AttributeReportIB with AttributeDataIB. Path elements < 0 are left out,
+// list_index writes null ListIndex (list item append). value writes data with tag 2.
+func attributeReportGen(tlv *mattertlv.TLVBuffer, endpoint, cluster, attribute int, list_index bool, value func(tlv *mattertlv.TLVBuffer)) {
+	tlv.WriteAnonStruct()
+	tlv.WriteStruct(1)
+	tlv.WriteUInt32(0, 1)
+	tlv.WriteList(1)
+	if endpoint >= 0 {
+		tlv.WriteUInt16(2, uint16(endpoint))
+	}
+	if cluster >= 0 {
+		tlv.WriteUInt32(3, uint32(cluster))
+	}
+	if attribute >= 0 {
+		tlv.WriteUInt32(4, uint32(attribute))
+	}
+	if list_index {
+		tlv.WriteNull(5)
+	}
+	tlv.WriteStructEnd()
+	value(tlv)
+	tlv.WriteStructEnd()
+	tlv.WriteStructEnd()
+}
+
+// scriptedMessenger returns messenger which answers receive calls following StatusResponses with messages in order.
+func scriptedMessenger(messages ...DecodedGeneric) *fakeMessenger {
+	return &fakeMessenger{
+		respond: func(request []byte) DecodedGeneric {
+			out := messages[0]
+			messages = messages[1:]
+			return out
+		},
+	}
+}
+
+func TestReceiveReportChunkedList(t *testing.T) {
+	// PartsList of endpoint 0 is split: whole list with 2 items in first chunk, appends in second chunk
+	first := reportChunk(t, true, false, func(tlv *mattertlv.TLVBuffer) {
+		attributeReportGen(tlv, 0, 0x1d, 3, false, func(tlv *mattertlv.TLVBuffer) {
+			tlv.WriteArray(2)
+			tlv.WriteTagged(mattertlv.AnonymousTag(), uint16(1))
+			tlv.WriteTagged(mattertlv.AnonymousTag(), uint16(2))
+			tlv.WriteStructEnd()
+		})
+	})
+	second := reportChunk(t, false, false, func(tlv *mattertlv.TLVBuffer) {
+		attributeReportGen(tlv, 0, 0x1d, 3, true, func(tlv *mattertlv.TLVBuffer) {
+			tlv.WriteUInt16(2, 3)
+		})
+		attributeReportGen(tlv, 0, 0x1d, 3, true, func(tlv *mattertlv.TLVBuffer) {
+			tlv.WriteUInt16(2, 4)
+		})
+		attributeReportGen(tlv, 0, 0x1d, 1, false, func(tlv *mattertlv.TLVBuffer) {
+			tlv.WriteArray(2)
+			tlv.WriteTagged(mattertlv.AnonymousTag(), uint32(6))
+			tlv.WriteStructEnd()
+		})
+	})
+	messenger := scriptedMessenger(second)
+	report, err := ContinueReport(messenger, first)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	reports := report.Tlv.GetItemWithTag(reportTagAttributeReports).GetChild()
+	if len(reports) != 2 {
+		t.Fatalf("merged report has %d attribute reports, want 2:\n%s", len(reports), mattertlv.FormatText(&report.Tlv))
+	}
+	parts := reports[0].GetItemRec([]int{1, 2})
+	got := []int{}
+	for _, part := range parts.GetChild() {
+		got = append(got, part.GetInt())
+	}
+	if len(got) != 4 || got[0] != 1 || got[1] != 2 || got[2] != 3 || got[3] != 4 {
+		t.Fatalf("merged PartsList %v, want [1 2 3 4]", got)
+	}
+	if attribute := reports[1].GetItemRec([]int{1, 1, 4}); attribute == nil || attribute.GetInt() != 1 {
+		t.Fatalf("ServerList report missing")
+	}
+	if tlvFlag(&report.Tlv, reportTagMoreChunkedMessages) {
+		t.Fatalf("merged report has MoreChunkedMessages flag")
+	}
+
+	// StatusResponse follows every chunk
+	if len(messenger.sent) != 2 {
+		t.Fatalf("%d StatusResponses sent, want 2", len(messenger.sent))
+	}
+	for n, sent := range messenger.sent {
+		var prot ProtocolMessageHeader
+		prot.Decode(bytes.NewBuffer(sent))
+		if prot.ProtocolId != ProtocolIdInteraction || prot.Opcode != INTERACTION_OPCODE_STATUS_RSP || prot.ExchangeId != 9 {
+			t.Fatalf("message %d is not StatusResponse of exchange 9: %+v", n, prot)
+		}
+	}
+}
+
+func TestReceiveReportSuppressResponse(t *testing.T) {
+	value := func(tlv *mattertlv.TLVBuffer) {
+		attributeReportGen(tlv, 1, 6, 0, false, func(tlv *mattertlv.TLVBuffer) {
+			tlv.WriteBool(2, true)
+		})
+	}
+	// first chunk is acknowledged by StatusResponse, last one suppresses it
+	messenger := scriptedMessenger(reportChunk(t, false, true, value))
+	report, err := ContinueReport(messenger, reportChunk(t, true, false, value))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(messenger.sent) != 1 {
+		t.Fatalf("%d StatusResponses sent, want 1", len(messenger.sent))
+	}
+	if n := len(report.Tlv.GetItemWithTag(reportTagAttributeReports).GetChild()); n != 2 {
+		t.Fatalf("merged report has %d attribute reports, want 2", n)
+	}
+}
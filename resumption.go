@@ -0,0 +1,210 @@
+package gomat
+
+import (
+	"bytes"
+	"crypto/aes"
+	"crypto/subtle"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+
+	"github.com/finnigja/gomat/ccm"
+	"github.com/finnigja/gomat/mattertlv"
+)
+
+// ResumptionRecord is state kept after successful CASE which allows to resume session
+// without new certificate exchange.
+type ResumptionRecord struct {
+	ResumptionId []byte
+	SharedSecret []byte
+}
+
+// ResumptionStore keeps resumption state of CASE sessions.
+// Records are identified by fabric, controller node and device node.
+type ResumptionStore interface {
+	// LoadResumption returns record stored for controller and device. ok is false when there is no record.
+	LoadResumption(fabric_id, controller_id, device_id uint64) (record ResumptionRecord, ok bool)
+
+	// StoreResumption stores record for controller and device. It replaces previous record.
+	StoreResumption(fabric_id, controller_id, device_id uint64, record ResumptionRecord) error
+
+	// DeleteResumption removes record of controller and device.
+	DeleteResumption(fabric_id, controller_id, device_id uint64) error
+}
+
+type resumptionKey struct {
+	fabric_id     uint64
+	controller_id uint64
+	device_id     uint64
+}
+
+// MemoryResumptionStore keeps resumption records in memory of process.
+type MemoryResumptionStore struct {
+	mutex   sync.Mutex
+	records map[resumptionKey]ResumptionRecord
+}
+
+func NewMemoryResumptionStore() *MemoryResumptionStore {
+	return &MemoryResumptionStore{
+		records: map[resumptionKey]ResumptionRecord{},
+	}
+}
+
+func (rs *MemoryResumptionStore) LoadResumption(fabric_id, controller_id, device_id uint64) (ResumptionRecord, bool) {
+	rs.mutex.Lock()
+	defer rs.mutex.Unlock()
+	record, ok := rs.records[resumptionKey{fabric_id, controller_id, device_id}]
+	return record, ok
+}
+
+func (rs *MemoryResumptionStore) StoreResumption(fabric_id, controller_id, device_id uint64, record ResumptionRecord) error {
+	rs.mutex.Lock()
+	defer rs.mutex.Unlock()
+	rs.records[resumptionKey{fabric_id, controller_id, device_id}] = record
+	return nil
+}
+
+func (rs *MemoryResumptionStore) DeleteResumption(fabric_id, controller_id, device_id uint64) error {
+	rs.mutex.Lock()
+	defer rs.mutex.Unlock()
+	delete(rs.records, resumptionKey{fabric_id, controller_id, device_id})
+	return nil
+}
+
+// FileResumptionStore keeps each resumption record in json file within basePath directory.
+// Files contain session secret so they are readable only by owner.
+type FileResumptionStore struct {
+	basePath string
+}
+
+func NewFileResumptionStore(basePath string) *FileResumptionStore {
+	return &FileResumptionStore{
+		basePath: basePath,
+	}
+}
+
+func (rs *FileResumptionStore) fileName(fabric_id, controller_id, device_id uint64) string {
+	return filepath.Join(rs.basePath, fmt.Sprintf("resumption-%x-%d-%d.json", fabric_id, controller_id, device_id))
+}
+
+func (rs *FileResumptionStore) LoadResumption(fabric_id, controller_id, device_id uint64) (ResumptionRecord, bool) {
+	var record ResumptionRecord
+	data, err := os.ReadFile(rs.fileName(fabric_id, controller_id, device_id))
+	if err != nil {
+		return record, false
+	}
+	err = json.Unmarshal(data, &record)
+	if err != nil || len(record.ResumptionId) != 16 || len(record.SharedSecret) == 0 {
+		return ResumptionRecord{}, false
+	}
+	return record, true
+}
+
+func (rs *FileResumptionStore) StoreResumption(fabric_id, controller_id, device_id uint64, record ResumptionRecord) error {
+	data, err := json.Marshal(record)
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(rs.fileName(fabric_id, controller_id, device_id), data, 0600)
+}
+
+func (rs *FileResumptionStore) DeleteResumption(fabric_id, controller_id, device_id uint64) error {
+	err := os.Remove(rs.fileName(fabric_id, controller_id, device_id))
+	if errors.Is(err, os.ErrNotExist) {
+		return nil
+	}
+	return err
+}
+
+// resumeMic computes MIC which proves knowledge of shared secret of resumed session.
+// It is AES-CCM tag of empty message encrypted by key derived from shared secret.
+func resumeMic(shared_secret, initiator_random, resumption_id []byte, info string, nonce string) ([]byte, error) {
+	salt := append([]byte{}, initiator_random...)
+	salt = append(salt, resumption_id...)
+	key := hkdf_sha256(shared_secret, salt, []byte(info), 16)
+	c, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	ccm, err := ccm.NewCCM(c, 16, len(nonce))
+	if err != nil {
+		return nil, err
+	}
+	return ccm.Seal(nil, []byte(nonce), []byte{}, []byte{}), nil
+}
+
+// sigma1ResumeMic computes initiatorResumeMIC field of Sigma1.
+func (sc *sigmaContext) sigma1ResumeMic() ([]byte, error) {
+	return resumeMic(sc.resumption.SharedSecret, sc.initiator_random, sc.resumption.ResumptionId, "Sigma1_Resume", "NCASE_SigmaS1")
+}
+
+// sigma2Resume verifies Sigma2_Resume message and derives session keys of resumed session.
+func (sc *sigmaContext) sigma2Resume(msg DecodedGeneric) error {
+	resumption_id := msg.Tlv.GetOctetStringRec([]int{1})
+	mic := msg.Tlv.GetOctetStringRec([]int{2})
+	responder_session, err := msg.Tlv.GetIntRec([]int{3})
+	if err != nil {
+		return err
+	}
+	if len(resumption_id) != 16 {
+		return fmt.Errorf("sigma2_resume: invalid resumption id")
+	}
+	expected, err := resumeMic(sc.resumption.SharedSecret, sc.initiator_random, resumption_id, "Sigma2_Resume", "NCASE_SigmaS2")
+	if err != nil {
+		return err
+	}
+	if subtle.ConstantTimeCompare(expected, mic) != 1 {
+		return fmt.Errorf("sigma2_resume: mic does not match")
+	}
+
+	salt := append([]byte{}, sc.initiator_random...)
+	salt = append(salt, resumption_id...)
+	keypack := hkdf_sha256(sc.resumption.SharedSecret, salt, []byte("SessionResumptionKeys"), 16*3)
+	sc.i2rkey = keypack[:16]
+	sc.r2ikey = keypack[16:32]
+	sc.session = int(responder_session)
+	sc.resumption_id = resumption_id
+	sc.shared_secret = sc.resumption.SharedSecret
+	return nil
+}
+
+// sigma2ResumptionId decrypts TBEData2 of Sigma2 and returns resumption id assigned by responder.
+// It must be called after sigma3 computed shared secret.
+func (sc *sigmaContext) sigma2ResumptionId(fabric *Fabric) ([]byte, error) {
+	responder_random := sc.sigma2dec.Tlv.GetOctetStringRec([]int{1})
+	responder_public := sc.sigma2dec.Tlv.GetOctetStringRec([]int{3})
+	encrypted2 := sc.sigma2dec.Tlv.GetOctetStringRec([]int{4})
+
+	var salt bytes.Buffer
+	salt.Write(fabric.make_ipk())
+	salt.Write(responder_random)
+	salt.Write(responder_public)
+	salt.Write(sha256_enc(sc.sigma1payload))
+	s2k := hkdf_sha256(sc.shared_secret, salt.Bytes(), []byte("Sigma2"), 16)
+
+	c, err := aes.NewCipher(s2k)
+	if err != nil {
+		return nil, err
+	}
+	nonce := []byte("NCASE_Sigma2N")
+	ccm, err := ccm.NewCCM(c, 16, len(nonce))
+	if err != nil {
+		return nil, err
+	}
+	tbedata2, err := ccm.Open(nil, nonce, encrypted2, []byte{})
+	if err != nil {
+		return nil, fmt.Errorf("can't decrypt sigma2: %w", err)
+	}
+	decoded, err := mattertlv.Decode(tbedata2)
+	if err != nil {
+		return nil, fmt.Errorf("can't decode sigma2: %w", err)
+	}
+	resumption_id := decoded.GetOctetStringRec([]int{4})
+	if len(resumption_id) != 16 {
+		return nil, fmt.Errorf("sigma2 does not contain valid resumption id")
+	}
+	return resumption_id, nil
+}
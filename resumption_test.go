@@ -0,0 +1,159 @@
+package gomat
+
+import (
+	"bytes"
+	"crypto/aes"
+	"os"
+	"testing"
+
+	"github.com/finnigja/gomat/ccm"
+	"github.com/finnigja/gomat/mattertlv"
+)
+
+func testResumption() (*ResumptionRecord, []byte) {
+	record := &ResumptionRecord{
+		ResumptionId: bytes.Repeat([]byte{0x11}, 16),
+		SharedSecret: bytes.Repeat([]byte{0x22}, 32),
+	}
+	return record, bytes.Repeat([]byte{0x33}, 32)
+}
+
+func TestResumeMic(t *testing.T) {
+	record, random := testResumption()
+	mic, err := resumeMic(record.SharedSecret, random, record.ResumptionId, "Sigma1_Resume", "NCASE_SigmaS1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(mic) != 16 {
+		t.Fatalf("mic has %d bytes", len(mic))
+	}
+
+	// responder derives same key from salt initiator random || resumption id and opens empty message
+	salt := append(append([]byte{}, random...), record.ResumptionId...)
+	key := hkdf_sha256(record.SharedSecret, salt, []byte("Sigma1_Resume"), 16)
+	c, _ := aes.NewCipher(key)
+	nonce := []byte("NCASE_SigmaS1")
+	aead, _ := ccm.NewCCM(c, 16, len(nonce))
+	if _, err := aead.Open(nil, nonce, mic, nil); err != nil {
+		t.Fatalf("mic not accepted by responder: %s", err)
+	}
+
+	for _, other := range []struct {
+		secret, random, id []byte
+		info, nonce        string
+	}{
+		{bytes.Repeat([]byte{0x23}, 32), random, record.ResumptionId, "Sigma1_Resume", "NCASE_SigmaS1"},
+		{record.SharedSecret, bytes.Repeat([]byte{0x34}, 32), record.ResumptionId, "Sigma1_Resume", "NCASE_SigmaS1"},
+		{record.SharedSecret, random, bytes.Repeat([]byte{0x12}, 16), "Sigma1_Resume", "NCASE_SigmaS1"},
+		{record.SharedSecret, random, record.ResumptionId, "Sigma2_Resume", "NCASE_SigmaS1"},
+		{record.SharedSecret, random, record.ResumptionId, "Sigma1_Resume", "NCASE_SigmaS2"},
+	} {
+		mic2, err := resumeMic(other.secret, other.random, other.id, other.info, other.nonce)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if bytes.Equal(mic, mic2) {
+			t.Fatalf("mic does not depend on all inputs")
+		}
+	}
+}
+
+// sigma2ResumeMessage creates Sigma2_Resume with given mic.
+func sigma2ResumeMessage(t *testing.T, resumption_id, mic []byte, session uint16) DecodedGeneric {
+	var tlv mattertlv.TLVBuffer
+	tlv.WriteAnonStruct()
+	tlv.WriteOctetString(1, resumption_id)
+	tlv.WriteOctetString(2, mic)
+	tlv.WriteUInt16(3, session)
+	tlv.WriteStructEnd()
+	item, err := mattertlv.Decode(tlv.Bytes())
+	if err != nil {
+		t.Fatal(err)
+	}
+	return DecodedGeneric{Tlv: item}
+}
+
+func TestSigma2Resume(t *testing.T) {
+	record, random := testResumption()
+	new_id := bytes.Repeat([]byte{0x44}, 16)
+	mic, err := resumeMic(record.SharedSecret, random, new_id, "Sigma2_Resume", "NCASE_SigmaS2")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	sc := sigmaContext{initiator_random: random, resumption: record}
+	err = sc.sigma2Resume(sigma2ResumeMessage(t, new_id, mic, 0x1234))
+	if err != nil {
+		t.Fatal(err)
+	}
+	salt := append(append([]byte{}, random...), new_id...)
+	keys := hkdf_sha256(record.SharedSecret, salt, []byte("SessionResumptionKeys"), 48)
+	if !bytes.Equal(sc.i2rkey, keys[:16]) || !bytes.Equal(sc.r2ikey, keys[16:32]) {
+		t.Fatalf("unexpected session keys")
+	}
+	if sc.session != 0x1234 || !bytes.Equal(sc.resumption_id, new_id) || !bytes.Equal(sc.shared_secret, record.SharedSecret) {
+		t.Fatalf("resumed session not recorded %+v", sc)
+	}
+
+	bad := append([]byte{}, mic...)
+	bad[0] ^= 1
+	sc = sigmaContext{initiator_random: random, resumption: record}
+	if err := sc.sigma2Resume(sigma2ResumeMessage(t, new_id, bad, 0x1234)); err == nil {
+		t.Fatalf("bad mic accepted")
+	}
+	if sc.i2rkey != nil {
+		t.Fatalf("keys derived from rejected message")
+	}
+	// mic computed for other resumption id
+	sc = sigmaContext{initiator_random: random, resumption: record}
+	if err := sc.sigma2Resume(sigma2ResumeMessage(t, record.ResumptionId, mic, 0x1234)); err == nil {
+		t.Fatalf("mic of other resumption id accepted")
+	}
+	if err := sc.sigma2Resume(sigma2ResumeMessage(t, new_id[:8], mic, 0x1234)); err == nil {
+		t.Fatalf("short resumption id accepted")
+	}
+}
+
+func TestFileResumptionStore(t *testing.T) {
+	dir := t.TempDir()
+	record, _ := testResumption()
+	err := NewFileResumptionStore(dir).StoreResumption(0x110, 100, 500, *record)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// record is available to another instance, for example next run of program
+	store := NewFileResumptionStore(dir)
+	loaded, ok := store.LoadResumption(0x110, 100, 500)
+	if !ok {
+		t.Fatalf("record not loaded")
+	}
+	if !bytes.Equal(loaded.ResumptionId, record.ResumptionId) || !bytes.Equal(loaded.SharedSecret, record.SharedSecret) {
+		t.Fatalf("loaded record differs %+v", loaded)
+	}
+	info, err := os.Stat(store.fileName(0x110, 100, 500))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if info.Mode().Perm() != 0600 {
+		t.Fatalf("record file has mode %v", info.Mode().Perm())
+	}
+	if _, ok := store.LoadResumption(0x110, 100, 501); ok {
+		t.Fatalf("record of other device loaded")
+	}
+
+	os.WriteFile(store.fileName(0x110, 100, 502), []byte(`{"ResumptionId": "AAEC"}`), 0600)
+	if _, ok := store.LoadResumption(0x110, 100, 502); ok {
+		t.Fatalf("invalid record loaded")
+	}
+
+	if err := store.DeleteResumption(0x110, 100, 500); err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := NewFileResumptionStore(dir).LoadResumption(0x110, 100, 500); ok {
+		t.Fatalf("deleted record loaded")
+	}
+	if err := store.DeleteResumption(0x110, 100, 500); err != nil {
+		t.Fatalf("delete of missing record failed: %s", err)
+	}
+}
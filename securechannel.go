@@ -2,11 +2,13 @@ package gomat
 
 import (
 	"bytes"
+	"context"
 	"crypto/aes"
 	"encoding/binary"
 	"fmt"
-	"math/rand"
 	"net"
+	"os"
+	"sync"
 	"time"
 
 	"github.com/finnigja/gomat/ccm"
@@ -16,6 +18,15 @@ import (
 type udpChannel struct {
 	Udp            net.PacketConn
 	Remote_address net.UDPAddr
+
+	// following fields are used when socket is shared and owned by SessionManager
+	manager       *SessionManager
+	local_session uint16 // 0 for unsecured session
+	incoming      chan []byte
+	closed        chan struct{}
+	wake          chan struct{} // signals change of deadline to blocked receive
+	deadline_lock sync.Mutex
+	deadline      time.Time
 }
 
 func startUdpChannel(remote_ip net.IP, remote_port, local_port int) (*udpChannel, error) {
@@ -32,11 +43,33 @@ func startUdpChannel(remote_ip net.IP, remote_port, local_port int) (*udpChannel
 	return out, nil
 }
 
-func (ch *udpChannel) send(data []byte) error {
+func (ch *udpChannel) Send(data []byte) error {
+	if ch.manager != nil {
+		ch.manager.touch(ch)
+	}
 	_, err := ch.Udp.WriteTo(data, &ch.Remote_address)
 	return err
 }
-func (ch *udpChannel) receive() ([]byte, error) {
+
+// setDeadline sets deadline for following receive calls. Zero value means no deadline.
+func (ch *udpChannel) SetDeadline(deadline time.Time) {
+	if ch.manager == nil {
+		ch.Udp.SetReadDeadline(deadline)
+		return
+	}
+	ch.deadline_lock.Lock()
+	ch.deadline = deadline
+	ch.deadline_lock.Unlock()
+	select {
+	case ch.wake <- struct{}{}:
+	default:
+	}
+}
+
+func (ch *udpChannel) Receive() ([]byte, error) {
+	if ch.manager != nil {
+		return ch.receiveShared()
+	}
 	buf := make([]byte, 1024*10)
 	n, _, errx := ch.Udp.ReadFrom(buf)
 	if errx != nil {
@@ -45,6 +78,51 @@ func (ch *udpChannel) receive() ([]byte, error) {
 	return buf[:n], nil
 }
 
+func (ch *udpChannel) receiveShared() ([]byte, error) {
+	for {
+		ch.deadline_lock.Lock()
+		deadline := ch.deadline
+		ch.deadline_lock.Unlock()
+		var timeout <-chan time.Time
+		var timer *time.Timer
+		if !deadline.IsZero() {
+			timer = time.NewTimer(time.Until(deadline))
+			timeout = timer.C
+		}
+		select {
+		case data := <-ch.incoming:
+			stopTimer(timer)
+			return data, nil
+		case <-ch.closed:
+			stopTimer(timer)
+			return []byte{}, net.ErrClosed
+		case <-timeout:
+			return []byte{}, os.ErrDeadlineExceeded
+		case <-ch.wake:
+			stopTimer(timer)
+		}
+	}
+}
+
+func stopTimer(timer *time.Timer) {
+	if timer != nil {
+		timer.Stop()
+	}
+}
+
+// close releases channel. Socket shared by SessionManager stays open.
+func (ch *udpChannel) Close() {
+	if ch.manager != nil {
+		ch.manager.release(ch)
+		return
+	}
+	ch.Udp.Close()
+}
+
+func (ch *udpChannel) Stream() bool {
+	return false
+}
+
 func make_nonce3(counter uint32, node []byte) []byte {
 	var n bytes.Buffer
 	n.WriteByte(0)
@@ -54,13 +132,26 @@ func make_nonce3(counter uint32, node []byte) []byte {
 }
 
 type SecureChannel struct {
-	Udp         *udpChannel
+	transport   Transport
 	encrypt_key []byte
 	decrypt_key []byte
 	remote_node []byte
 	local_node  []byte
-	Counter     uint32
 	session     int
+	state       *channelState
+}
+
+func newSecureChannel(t Transport, params MrpParameters) SecureChannel {
+	return SecureChannel{
+		transport: t,
+		state:     newChannelState(params),
+	}
+}
+
+// NewSecureChannel creates secure channel for plain unencrypted communication over transport.
+// It can be used with any Transport implementation, for example with NewMemoryTransportPair in tests.
+func NewSecureChannel(t Transport) SecureChannel {
+	return newSecureChannel(t, DefaultMrpParameters())
 }
 
 // StartSecureChannel initializes secure channel for plain unencrypted communication.
@@ -71,21 +162,104 @@ func StartSecureChannel(remote_ip net.IP, remote_port, local_port int) (SecureCh
 	if err != nil {
 		return SecureChannel{}, err
 	}
-	return SecureChannel{
-		Udp:     udp,
-		Counter: uint32(rand.Intn(0xffffffff)),
-	}, nil
+	return newSecureChannel(udp, DefaultMrpParameters()), nil
+}
+
+// SetMrpParameters sets retransmission parameters of remote node (for example SII/SAI learnt using discovery).
+func (sc *SecureChannel) SetMrpParameters(params MrpParameters) {
+	sc.state.mutex.Lock()
+	defer sc.state.mutex.Unlock()
+	sc.state.params = params
+}
+
+// MrpParameters returns retransmission parameters of remote node used by secure channel.
+func (sc *SecureChannel) MrpParameters() MrpParameters {
+	sc.state.mutex.Lock()
+	defer sc.state.mutex.Unlock()
+	return sc.state.params
 }
 
+// Receive waits for next message from remote node.
+// Acknowledgements and retransmissions are handled transparently - duplicate messages
+// and standalone acknowledgements are not returned to caller.
+// Receive must not be used when channel is owned by ExchangeManager.
 func (sc *SecureChannel) Receive() (DecodedGeneric, error) {
-	sc.Udp.Udp.SetReadDeadline(time.Now().Add(time.Second * 3))
-	data, err := sc.Udp.receive()
+	return sc.ReceiveContext(context.Background())
+}
+
+// ReceiveContext is Receive which can be cancelled using ctx.
+// It waits until deadline of ctx or, when ctx does not have deadline, for time derived
+// from MRP parameters of remote node.
+func (sc *SecureChannel) ReceiveContext(ctx context.Context) (DecodedGeneric, error) {
+	out, err := sc.receive(ctx, sc.receiveDeadline(ctx))
 	if err != nil {
-		return DecodedGeneric{}, err
+		return DecodedGeneric{}, sc.timeoutError(err)
+	}
+	return out, nil
+}
+
+// receiveDeadline returns deadline of ctx or default deadline derived from MRP parameters.
+func (sc *SecureChannel) receiveDeadline(ctx context.Context) time.Time {
+	if deadline, ok := ctx.Deadline(); ok {
+		return deadline
 	}
+	return time.Now().Add(sc.MrpParameters().receiveTimeout())
+}
+
+// receive reads messages until message for application arrives or deadline expires.
+// Zero deadline means no deadline.
+func (sc *SecureChannel) receive(ctx context.Context, deadline time.Time) (DecodedGeneric, error) {
+	for {
+		data, err := sc.receiveData(ctx, deadline)
+		if err != nil {
+			return DecodedGeneric{}, err
+		}
+		out, err := sc.decode(data)
+		if err != nil {
+			return DecodedGeneric{}, err
+		}
+		if !sc.processReceived(&out) {
+			continue
+		}
+		return out, nil
+	}
+}
+
+// receiveData reads one message from transport. Blocked read is interrupted when ctx is cancelled.
+func (sc *SecureChannel) receiveData(ctx context.Context, deadline time.Time) ([]byte, error) {
+	sc.transport.SetDeadline(deadline)
+	stop := context.AfterFunc(ctx, func() {
+		sc.transport.SetDeadline(time.Unix(1, 0))
+	})
+	data, err := sc.transport.Receive()
+	stop()
+	if err != nil && ctx.Err() != nil {
+		return nil, ctx.Err()
+	}
+	return data, err
+}
+
+// timeoutError extends receive error with information about message which peer did not acknowledge.
+// Lost message is reported only once.
+func (sc *SecureChannel) timeoutError(err error) error {
+	sc.state.mutex.Lock()
+	lost := sc.state.lost
+	sc.state.lost = nil
+	sc.state.mutex.Unlock()
+	if lost != nil {
+		return fmt.Errorf("%s: %w", lost.Error(), err)
+	}
+	return err
+}
+
+// decode decrypts and parses received Matter Message.
+func (sc *SecureChannel) decode(data []byte) (DecodedGeneric, error) {
 	decode_buffer := bytes.NewBuffer(data)
 	var out DecodedGeneric
-	out.MessageHeader.Decode(decode_buffer)
+	err := out.MessageHeader.Decode(decode_buffer)
+	if err != nil {
+		return DecodedGeneric{}, fmt.Errorf("can't decode message header: %w", err)
+	}
 	add := data[:len(data)-decode_buffer.Len()]
 	proto := decode_buffer.Bytes()
 
@@ -108,14 +282,20 @@ func (sc *SecureChannel) Receive() (DecodedGeneric, error) {
 
 		decoder := bytes.NewBuffer(outx)
 
-		out.ProtocolHeader.Decode(decoder)
+		err = out.ProtocolHeader.Decode(decoder)
+		if err != nil {
+			return DecodedGeneric{}, fmt.Errorf("can't decode protocol header: %w", err)
+		}
 		if len(decoder.Bytes()) > 0 {
 			tlvdata := make([]byte, decoder.Len())
 			n, _ := decoder.Read(tlvdata)
 			out.Payload = tlvdata[:n]
 		}
 	} else {
-		out.ProtocolHeader.Decode(decode_buffer)
+		err = out.ProtocolHeader.Decode(decode_buffer)
+		if err != nil {
+			return DecodedGeneric{}, fmt.Errorf("can't decode protocol header: %w", err)
+		}
 		if len(decode_buffer.Bytes()) > 0 {
 			tlvdata := make([]byte, decode_buffer.Len())
 			n, _ := decode_buffer.Read(tlvdata)
@@ -123,15 +303,6 @@ func (sc *SecureChannel) Receive() (DecodedGeneric, error) {
 		}
 	}
 
-	if out.ProtocolHeader.ProtocolId == 0 {
-		if out.ProtocolHeader.Opcode == SEC_CHAN_OPCODE_ACK { // standalone ack
-			return sc.Receive()
-		}
-	}
-
-	ack := ackGen(out.ProtocolHeader, out.MessageHeader.messageCounter)
-	sc.Send(ack)
-
 	if out.ProtocolHeader.ProtocolId == 0 {
 		if out.ProtocolHeader.Opcode == SEC_CHAN_OPCODE_STATUS_REP { // status report
 			buf := bytes.NewBuffer(out.Payload)
@@ -140,9 +311,20 @@ func (sc *SecureChannel) Receive() (DecodedGeneric, error) {
 			binary.Read(buf, binary.LittleEndian, &out.StatusReport.ProtocolCode)
 			return out, nil
 		}
+		if out.ProtocolHeader.Opcode == SEC_CHAN_OPCODE_ACK { // standalone ack
+			return out, nil
+		}
+		if out.ProtocolHeader.Opcode == SEC_CHAN_OPCODE_MSG_COUNTER_SYNC_REQ ||
+			out.ProtocolHeader.Opcode == SEC_CHAN_OPCODE_MSG_COUNTER_SYNC_RSP { // payload is not tlv
+			return out, nil
+		}
 	}
 	if len(out.Payload) > 0 {
-		out.Tlv = mattertlv.Decode(out.Payload)
+		tlv, err := mattertlv.Decode(out.Payload)
+		if err != nil {
+			return DecodedGeneric{}, fmt.Errorf("can't decode payload: %w", err)
+		}
+		out.Tlv = tlv
 	}
 	return out, nil
 }
@@ -150,14 +332,57 @@ func (sc *SecureChannel) Receive() (DecodedGeneric, error) {
 // Send sends Protocol Message via secure channel. It creates Matter Message by adding Message Header.
 // Protocol Message is aes-ccm encrypted when channel does have encryption keys.
 // When encryption keys are empty plain Message is sent.
+// Pending acknowledgement of exchange is piggybacked on message and reliable message
+// is retransmitted until remote node acknowledges it. Returned error covers only first transmission.
+// When retransmissions run out, Send has already returned - loss is reported by error of following
+// Receive or ReceiveContext which times out.
 func (sc *SecureChannel) Send(data []byte) error {
+	return sc.send(data, true)
+}
+
+// sendAck sends standalone acknowledgement of message counter. Acknowledgement already carried by message
+// must not be replaced by pending acknowledgement of same exchange, so piggybacking is skipped.
+func (sc *SecureChannel) sendAck(exchange exchangeKey, counter uint32) error {
+	return sc.send(ackGen(exchange, counter), false)
+}
 
-	sc.Counter = sc.Counter + 1
+// send sends Protocol Message. When piggyback is true pending acknowledgement of exchange is added to message.
+func (sc *SecureChannel) send(data []byte, piggyback bool) error {
+	var prot ProtocolMessageHeader
+	prot_buffer := bytes.NewBuffer(data)
+	prot.Decode(prot_buffer)
+	exchange := exchangeKey{
+		id:        prot.ExchangeId,
+		initiator: (prot.exchangeFlags & exchangeFlagsInitiator) != 0,
+	}
+	stream := sc.transport.Stream()
+	rewrite := false
+	if stream {
+		// delivery is guaranteed by transport - MRP is not used
+		if (prot.exchangeFlags & exchangeFlagsReliable) != 0 {
+			prot.exchangeFlags &^= exchangeFlagsReliable
+			rewrite = true
+		}
+	} else if piggyback {
+		if ack, ok := sc.state.takeAck(exchange); ok {
+			prot.exchangeFlags |= exchangeFlagsAcknowledge
+			prot.ackCounter = ack
+			rewrite = true
+		}
+	}
+	if rewrite {
+		var rewritten bytes.Buffer
+		prot.Encode(&rewritten)
+		rewritten.Write(prot_buffer.Bytes())
+		data = rewritten.Bytes()
+	}
+
+	counter := sc.state.nextCounter()
 	var buffer bytes.Buffer
 	msg := MessageHeader{
 		sessionId:      uint16(sc.session),
 		securityFlags:  0,
-		messageCounter: sc.Counter,
+		messageCounter: counter,
 		sourceNodeId:   []byte{1, 2, 3, 4, 5, 6, 7, 8},
 	}
 	msg.Encode(&buffer)
@@ -168,7 +393,7 @@ func (sc *SecureChannel) Send(data []byte) error {
 		add2 := make([]byte, len(header_slice))
 		copy(add2, header_slice)
 
-		nonce := make_nonce3(sc.Counter, sc.local_node)
+		nonce := make_nonce3(counter, sc.local_node)
 
 		c, err := aes.NewCipher(sc.encrypt_key)
 		if err != nil {
@@ -182,20 +407,26 @@ func (sc *SecureChannel) Send(data []byte) error {
 		buffer.Write(CipherText)
 	}
 
-	err := sc.Udp.send(buffer.Bytes())
+	if (prot.exchangeFlags & exchangeFlagsReliable) != 0 {
+		sc.state.track(counter, exchange, buffer.Bytes(), sc.transport)
+	}
+	err := sc.transport.Send(buffer.Bytes())
 	return err
 }
 
-// Close secure channel. Send close session message to remote end and relase UDP port.
+// Close secure channel. Send close session message to remote end and relase UDP port or TCP connection.
+// When channel was created by SessionManager, session is removed from manager and shared UDP port stays open.
 func (sc *SecureChannel) Close() {
-	if sc.Udp == nil || sc.Udp.Udp == nil {
+	if sc.transport == nil {
 		return
 	}
+	sc.flushAcks()
 	sr := EncodeStatusReport(StatusReportElements{
 		GeneralCode:  0,
 		ProtocolId:   0,
 		ProtocolCode: 3, //close session
 	})
 	sc.Send(sr)
-	sc.Udp.Udp.Close()
+	sc.state.stop()
+	sc.transport.Close()
 }
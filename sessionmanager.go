@@ -0,0 +1,387 @@
+package gomat
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"fmt"
+	"log"
+	"net"
+	"sync"
+	"time"
+
+	"github.com/finnigja/gomat/discover"
+)
+
+// DefaultSessionIdleTimeout is time after which session without any traffic is closed by SessionManager.
+const DefaultSessionIdleTimeout = 30 * time.Minute
+
+// SessionInfo describes session owned by SessionManager.
+type SessionInfo struct {
+	LocalSessionId uint16
+	PeerSessionId  uint16
+	PeerNodeId     uint64
+	PeerAddress    net.UDPAddr
+	LastActivity   time.Time
+}
+
+type sessionEntry struct {
+	udp           *udpChannel
+	local_session uint16
+	secure        *SecureChannel // set when session is established
+	last_activity time.Time
+}
+
+// SessionManager owns one UDP socket shared by all sessions of process.
+// It allocates unique local session ids and routes received messages to sessions
+// by session id and address of peer. Messages of unsecured sessions (used during PASE and CASE)
+// are routed by address of peer.
+type SessionManager struct {
+	conn         net.PacketConn
+	mutex        sync.Mutex
+	sessions     map[uint16]*sessionEntry
+	unsecured    map[string]*udpChannel
+	next_session uint16
+	idle_timeout time.Duration
+	done         chan struct{}
+	close_once   sync.Once
+}
+
+var defaultSessionManager *SessionManager
+var defaultSessionManagerMutex sync.Mutex
+
+// DefaultSessionManager returns session manager shared by ConnectDevice and Commission functions.
+// It is created on first use and it binds ephemeral UDP port.
+func DefaultSessionManager() (*SessionManager, error) {
+	defaultSessionManagerMutex.Lock()
+	defer defaultSessionManagerMutex.Unlock()
+	if defaultSessionManager != nil {
+		return defaultSessionManager, nil
+	}
+	sm, err := NewSessionManager(0)
+	if err != nil {
+		return nil, err
+	}
+	defaultSessionManager = sm
+	return sm, nil
+}
+
+// NewSessionManager creates session manager with UDP socket bound to local_port (0 selects ephemeral port).
+// Socket accepts both IPv4 and IPv6 traffic.
+func NewSessionManager(local_port int) (*SessionManager, error) {
+	conn, err := net.ListenPacket("udp", fmt.Sprintf(":%d", local_port))
+	if err != nil {
+		return nil, err
+	}
+	sm := &SessionManager{
+		conn:         conn,
+		sessions:     map[uint16]*sessionEntry{},
+		unsecured:    map[string]*udpChannel{},
+		next_session: uint16(randomIntn(0xffff)),
+		idle_timeout: DefaultSessionIdleTimeout,
+		done:         make(chan struct{}),
+	}
+	go sm.reader()
+	go sm.janitor()
+	return sm, nil
+}
+
+// LocalAddr returns address of shared UDP socket.
+func (sm *SessionManager) LocalAddr() net.Addr {
+	return sm.conn.LocalAddr()
+}
+
+// SetIdleTimeout sets time after which idle established session is closed. Zero disables closing.
+func (sm *SessionManager) SetIdleTimeout(timeout time.Duration) {
+	sm.mutex.Lock()
+	defer sm.mutex.Unlock()
+	sm.idle_timeout = timeout
+}
+
+// Sessions returns information about all established sessions.
+func (sm *SessionManager) Sessions() []SessionInfo {
+	sm.mutex.Lock()
+	defer sm.mutex.Unlock()
+	out := []SessionInfo{}
+	for _, entry := range sm.sessions {
+		if entry.secure == nil {
+			continue
+		}
+		out = append(out, SessionInfo{
+			LocalSessionId: entry.local_session,
+			PeerSessionId:  uint16(entry.secure.session),
+			PeerNodeId:     binary.LittleEndian.Uint64(entry.secure.remote_node),
+			PeerAddress:    entry.udp.Remote_address,
+			LastActivity:   entry.last_activity,
+		})
+	}
+	return out
+}
+
+// Close closes all sessions and releases UDP socket. Only first call has effect.
+func (sm *SessionManager) Close() {
+	sm.close_once.Do(sm.close)
+}
+
+func (sm *SessionManager) close() {
+	sm.mutex.Lock()
+	to_close := []*SecureChannel{}
+	for _, entry := range sm.sessions {
+		if entry.secure != nil {
+			to_close = append(to_close, entry.secure)
+		}
+	}
+	sm.mutex.Unlock()
+	for _, sc := range to_close {
+		sc.Close()
+	}
+	close(sm.done)
+	sm.conn.Close()
+}
+
+func (sm *SessionManager) newChannel(remote net.UDPAddr) *udpChannel {
+	return &udpChannel{
+		Udp:            sm.conn,
+		Remote_address: remote,
+		manager:        sm,
+		incoming:       make(chan []byte, 64),
+		closed:         make(chan struct{}),
+		wake:           make(chan struct{}, 1),
+	}
+}
+
+// unsecuredChannel creates channel for unsecured session with peer.
+// Only one unsecured session with same peer can exist at the time.
+func (sm *SessionManager) unsecuredChannel(remote_ip net.IP, remote_port int) (*udpChannel, error) {
+	remote := net.UDPAddr{
+		IP:   remote_ip,
+		Port: remote_port,
+	}
+	sm.mutex.Lock()
+	defer sm.mutex.Unlock()
+	key := remote.String()
+	if _, ok := sm.unsecured[key]; ok {
+		return nil, fmt.Errorf("session establishment with %s already in progress", key)
+	}
+	ch := sm.newChannel(remote)
+	sm.unsecured[key] = ch
+	return ch, nil
+}
+
+// sessionChannel allocates unique local session id and channel which receives messages of that session.
+func (sm *SessionManager) sessionChannel(remote net.UDPAddr) (*udpChannel, uint16) {
+	sm.mutex.Lock()
+	defer sm.mutex.Unlock()
+	for {
+		sm.next_session++
+		if sm.next_session == 0 {
+			continue
+		}
+		if _, used := sm.sessions[sm.next_session]; used {
+			continue
+		}
+		ch := sm.newChannel(remote)
+		ch.local_session = sm.next_session
+		sm.sessions[sm.next_session] = &sessionEntry{
+			udp:           ch,
+			local_session: sm.next_session,
+			last_activity: time.Now(),
+		}
+		return ch, sm.next_session
+	}
+}
+
+// register marks session as established. Manager keeps its keys and peer node id.
+func (sm *SessionManager) register(local_session uint16, sc SecureChannel) {
+	sm.mutex.Lock()
+	defer sm.mutex.Unlock()
+	entry, ok := sm.sessions[local_session]
+	if !ok {
+		return
+	}
+	entry.secure = &sc
+	entry.last_activity = time.Now()
+}
+
+// release removes channel from routing tables.
+func (sm *SessionManager) release(ch *udpChannel) {
+	sm.mutex.Lock()
+	defer sm.mutex.Unlock()
+	key := ch.Remote_address.String()
+	if sm.unsecured[key] == ch {
+		delete(sm.unsecured, key)
+	}
+	if entry, ok := sm.sessions[ch.local_session]; ok && entry.udp == ch {
+		delete(sm.sessions, ch.local_session)
+	}
+	select {
+	case <-ch.closed:
+	default:
+		close(ch.closed)
+	}
+}
+
+func (sm *SessionManager) reader() {
+	buf := make([]byte, 1024*10)
+	for {
+		n, addr, err := sm.conn.ReadFrom(buf)
+		if err != nil {
+			select {
+			case <-sm.done:
+			default:
+				log.Printf("session manager socket error: %s\n", err.Error())
+			}
+			return
+		}
+		data := make([]byte, n)
+		copy(data, buf[:n])
+		sm.route(data, addr)
+	}
+}
+
+func (sm *SessionManager) route(data []byte, addr net.Addr) {
+	var header MessageHeader
+	if err := header.Decode(bytes.NewBuffer(data)); err != nil {
+		return
+	}
+	udp_addr, ok := addr.(*net.UDPAddr)
+	if !ok {
+		return
+	}
+	sm.mutex.Lock()
+	defer sm.mutex.Unlock()
+	var ch *udpChannel
+	if header.sessionId == 0 {
+		ch = sm.unsecured[udp_addr.String()]
+		if ch == nil {
+			// ipv4 peer may be reported as ipv4-mapped ipv6 address by dual stack socket
+			for _, c := range sm.unsecured {
+				if sameAddress(&c.Remote_address, udp_addr) {
+					ch = c
+					break
+				}
+			}
+		}
+	} else {
+		entry, ok := sm.sessions[header.sessionId]
+		if ok && sameAddress(&entry.udp.Remote_address, udp_addr) {
+			entry.last_activity = time.Now()
+			ch = entry.udp
+		}
+	}
+	if ch == nil {
+		return
+	}
+	select {
+	case ch.incoming <- data:
+	default:
+		// receiver is too slow - drop message, it will be retransmitted by peer
+	}
+}
+
+func sameAddress(a, b *net.UDPAddr) bool {
+	return a.IP.Equal(b.IP) && a.Port == b.Port
+}
+
+// janitor closes sessions which were idle for too long.
+func (sm *SessionManager) janitor() {
+	ticker := time.NewTicker(10 * time.Second)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-sm.done:
+			return
+		case <-ticker.C:
+		}
+		sm.closeIdle()
+	}
+}
+
+// closeIdle closes established sessions without traffic for longer than idle timeout.
+func (sm *SessionManager) closeIdle() {
+	sm.mutex.Lock()
+	to_close := []*SecureChannel{}
+	if sm.idle_timeout > 0 {
+		for _, entry := range sm.sessions {
+			if entry.secure != nil && time.Since(entry.last_activity) > sm.idle_timeout {
+				to_close = append(to_close, entry.secure)
+			}
+		}
+	}
+	sm.mutex.Unlock()
+	for _, sc := range to_close {
+		sc.Close()
+	}
+}
+
+// touch records activity of session which uses channel.
+func (sm *SessionManager) touch(ch *udpChannel) {
+	sm.mutex.Lock()
+	defer sm.mutex.Unlock()
+	if entry, ok := sm.sessions[ch.local_session]; ok && entry.udp == ch {
+		entry.last_activity = time.Now()
+	}
+}
+
+// ConnectDevice establishes CASE session with commissioned device over UDP.
+func (sm *SessionManager) ConnectDevice(device_ip net.IP, port int, fabric *Fabric, device_id, admin_id uint64) (SecureChannel, error) {
+	return sm.ConnectDeviceWithTransportContext(context.Background(), device_ip, port, fabric, device_id, admin_id, TransportUdp)
+}
+
+// ConnectDeviceContext is ConnectDevice which can be cancelled and limited by deadline of ctx.
+func (sm *SessionManager) ConnectDeviceContext(ctx context.Context, device_ip net.IP, port int, fabric *Fabric, device_id, admin_id uint64) (SecureChannel, error) {
+	return sm.ConnectDeviceWithTransportContext(ctx, device_ip, port, fabric, device_id, admin_id, TransportUdp)
+}
+
+// ConnectDeviceWithTransport establishes CASE session with commissioned device over selected transport.
+// Session over TCP uses own connection which is closed together with session.
+func (sm *SessionManager) ConnectDeviceWithTransport(device_ip net.IP, port int, fabric *Fabric, device_id, admin_id uint64, transport_type TransportType) (SecureChannel, error) {
+	return sm.ConnectDeviceWithTransportContext(context.Background(), device_ip, port, fabric, device_id, admin_id, transport_type)
+}
+
+// ConnectDeviceWithTransportContext is ConnectDeviceWithTransport which can be cancelled and limited by deadline of ctx.
+// TransportAuto selects UDP because TCP support of device is not known - use ConnectDiscoveredDeviceContext for it.
+func (sm *SessionManager) ConnectDeviceWithTransportContext(ctx context.Context, device_ip net.IP, port int, fabric *Fabric, device_id, admin_id uint64, transport_type TransportType) (SecureChannel, error) {
+	return sm.connectDevice(ctx, device_ip, port, fabric, device_id, admin_id, SelectTransport(transport_type, false), DefaultMrpParameters())
+}
+
+// ConnectDiscoveredDevice establishes CASE session with commissioned device found using discover package.
+// See ConnectDiscoveredDeviceContext.
+func (sm *SessionManager) ConnectDiscoveredDevice(device discover.DiscoveredDevice, fabric *Fabric, device_id, admin_id uint64, transport_type TransportType) (SecureChannel, error) {
+	return sm.ConnectDiscoveredDeviceContext(context.Background(), device, fabric, device_id, admin_id, transport_type)
+}
+
+// ConnectDiscoveredDeviceContext establishes CASE session with commissioned device using address, port and
+// retransmission parameters advertised by device. TransportAuto selects TCP when device advertises TCP server
+// in T txt key, TransportUdp and TransportTcp override advertised support.
+func (sm *SessionManager) ConnectDiscoveredDeviceContext(ctx context.Context, device discover.DiscoveredDevice, fabric *Fabric, device_id, admin_id uint64, transport_type TransportType) (SecureChannel, error) {
+	if len(device.Addrs) == 0 {
+		return SecureChannel{}, fmt.Errorf("device %s does not have address", device.Name)
+	}
+	port := device.Port
+	if port == 0 {
+		port = 5540
+	}
+	params := NewMrpParameters(device.SII, device.SAI, device.SAT)
+	return sm.connectDevice(ctx, device.Addrs[0], port, fabric, device_id, admin_id, SelectTransport(transport_type, device.SupportsTcp()), params)
+}
+
+func (sm *SessionManager) connectDevice(ctx context.Context, device_ip net.IP, port int, fabric *Fabric, device_id, admin_id uint64, transport_type TransportType, params MrpParameters) (SecureChannel, error) {
+	if transport_type == TransportTcp {
+		tcp, err := startTcpChannel(ctx, device_ip, port)
+		if err != nil {
+			return SecureChannel{}, err
+		}
+		channel, err := SigmaExchangeContext(ctx, fabric, admin_id, device_id, newSecureChannel(tcp, params))
+		if err != nil {
+			tcp.Close()
+		}
+		return channel, err
+	}
+	udp, err := sm.unsecuredChannel(device_ip, port)
+	if err != nil {
+		return SecureChannel{}, err
+	}
+	defer udp.Close()
+	return SigmaExchangeContext(ctx, fabric, admin_id, device_id, newSecureChannel(udp, params))
+}
@@ -0,0 +1,167 @@
+package gomat
+
+import (
+	"bytes"
+	"net"
+	"testing"
+	"time"
+)
+
+func encodedHeader(session uint16, counter uint32) []byte {
+	var buffer bytes.Buffer
+	msg := MessageHeader{
+		sessionId:      session,
+		messageCounter: counter,
+	}
+	msg.Encode(&buffer)
+	return buffer.Bytes()
+}
+
+func received(ch *udpChannel) []byte {
+	select {
+	case data := <-ch.incoming:
+		return data
+	default:
+		return nil
+	}
+}
+
+func TestSessionManagerCloseTwice(t *testing.T) {
+	sm, err := NewSessionManager(0)
+	if err != nil {
+		t.Fatalf("can't create session manager %s", err.Error())
+	}
+	sm.Close()
+	sm.Close()
+}
+
+func TestSessionManagerRouteUnsecured(t *testing.T) {
+	sm, err := NewSessionManager(0)
+	if err != nil {
+		t.Fatalf("can't create session manager %s", err.Error())
+	}
+	defer sm.Close()
+
+	first, err := sm.unsecuredChannel(net.ParseIP("192.168.1.10"), 5540)
+	if err != nil {
+		t.Fatalf("can't create channel %s", err.Error())
+	}
+	second, err := sm.unsecuredChannel(net.ParseIP("192.168.1.11"), 5540)
+	if err != nil {
+		t.Fatalf("can't create channel %s", err.Error())
+	}
+	if _, err := sm.unsecuredChannel(net.ParseIP("192.168.1.10"), 5540); err == nil {
+		t.Fatalf("second unsecured session with same peer was created")
+	}
+
+	sm.route(encodedHeader(0, 1), &net.UDPAddr{IP: net.ParseIP("192.168.1.11"), Port: 5540})
+	if received(first) != nil || received(second) == nil {
+		t.Fatalf("message not routed by address of peer")
+	}
+	// dual stack socket reports ipv4 peer as ipv4-mapped ipv6 address
+	sm.route(encodedHeader(0, 2), &net.UDPAddr{IP: net.ParseIP("::ffff:192.168.1.10"), Port: 5540})
+	if received(first) == nil {
+		t.Fatalf("message from ipv4-mapped address not routed")
+	}
+	sm.route(encodedHeader(0, 3), &net.UDPAddr{IP: net.ParseIP("192.168.1.10"), Port: 5541})
+	if received(first) != nil || received(second) != nil {
+		t.Fatalf("message from unknown port was routed")
+	}
+
+	first.Close()
+	sm.route(encodedHeader(0, 4), &net.UDPAddr{IP: net.ParseIP("192.168.1.10"), Port: 5540})
+	if received(first) != nil {
+		t.Fatalf("message routed to released channel")
+	}
+}
+
+func TestSessionManagerRouteSession(t *testing.T) {
+	sm, err := NewSessionManager(0)
+	if err != nil {
+		t.Fatalf("can't create session manager %s", err.Error())
+	}
+	defer sm.Close()
+
+	peer := net.UDPAddr{IP: net.ParseIP("192.168.1.10"), Port: 5540}
+	first, first_id := sm.sessionChannel(peer)
+	second, second_id := sm.sessionChannel(peer)
+	if first_id == 0 || second_id == 0 || first_id == second_id {
+		t.Fatalf("invalid session ids %d %d", first_id, second_id)
+	}
+	unsecured, err := sm.unsecuredChannel(peer.IP, peer.Port)
+	if err != nil {
+		t.Fatalf("can't create channel %s", err.Error())
+	}
+
+	sm.route(encodedHeader(second_id, 1), &peer)
+	if received(first) != nil || received(unsecured) != nil || received(second) == nil {
+		t.Fatalf("message not routed by session id")
+	}
+	sm.route(encodedHeader(first_id, 2), &net.UDPAddr{IP: net.ParseIP("192.168.1.99"), Port: 5540})
+	if received(first) != nil {
+		t.Fatalf("message of session from other peer was routed")
+	}
+	unknown := first_id + second_id
+	sm.route(encodedHeader(unknown, 3), &peer)
+	if received(first) != nil || received(second) != nil || received(unsecured) != nil {
+		t.Fatalf("message of unknown session was routed")
+	}
+
+	second.Close()
+	sm.route(encodedHeader(second_id, 4), &peer)
+	if received(second) != nil {
+		t.Fatalf("message routed to released session")
+	}
+	if _, used := sm.sessions[second_id]; used {
+		t.Fatalf("released session id is still used")
+	}
+}
+
+func TestSessionManagerIdleExpiry(t *testing.T) {
+	sm, err := NewSessionManager(0)
+	if err != nil {
+		t.Fatalf("can't create session manager %s", err.Error())
+	}
+	defer sm.Close()
+
+	peer := net.UDPAddr{IP: net.ParseIP("127.0.0.1"), Port: 9}
+	idle, idle_id := sm.sessionChannel(peer)
+	active, active_id := sm.sessionChannel(peer)
+	_, pending_id := sm.sessionChannel(peer)
+	for _, ch := range []*udpChannel{idle, active} {
+		sc := newSecureChannel(ch, fastMrp())
+		sc.remote_node = make([]byte, 8)
+		sm.register(ch.local_session, sc)
+	}
+
+	sm.SetIdleTimeout(time.Minute)
+	sm.mutex.Lock()
+	sm.sessions[idle_id].last_activity = time.Now().Add(-2 * time.Minute)
+	sm.sessions[pending_id].last_activity = time.Now().Add(-2 * time.Minute)
+	sm.mutex.Unlock()
+
+	sm.closeIdle()
+	if len(sm.Sessions()) != 1 || sm.Sessions()[0].LocalSessionId != active_id {
+		t.Fatalf("unexpected sessions after expiry %v", sm.Sessions())
+	}
+	select {
+	case <-idle.closed:
+	default:
+		t.Fatalf("channel of idle session was not closed")
+	}
+	sm.mutex.Lock()
+	_, pending := sm.sessions[pending_id]
+	sm.mutex.Unlock()
+	if !pending {
+		t.Fatalf("session in establishment was closed")
+	}
+
+	sm.SetIdleTimeout(0)
+	sm.mutex.Lock()
+	sm.sessions[active_id].last_activity = time.Now().Add(-time.Hour)
+	sm.mutex.Unlock()
+	sm.closeIdle()
+	if len(sm.Sessions()) != 1 {
+		t.Fatalf("session closed while expiry is disabled")
+	}
+}
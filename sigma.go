@@ -6,7 +6,6 @@ import (
 	"crypto/ecdh"
 	"crypto/ecdsa"
 	"crypto/elliptic"
-	"crypto/rand"
 	"encoding/binary"
 
 	"github.com/finnigja/gomat/ccm"
@@ -16,6 +15,7 @@ import (
 type sigmaContext struct {
 	session_privkey               *ecdh.PrivateKey
 	session                       int
+	local_session                 uint16
 	controller_key                *ecdsa.PrivateKey
 	controller_matter_certificate []byte
 
@@ -25,18 +25,23 @@ type sigmaContext struct {
 	sigma2dec     DecodedGeneric
 	sigma1payload []byte
 	exchange      uint16
+
+	initiator_random []byte
+	resumption       *ResumptionRecord // previous session to resume, nil for full CASE
+	resumption_id    []byte            // resumption id assigned by responder to this session
+	shared_secret    []byte
 }
 
-func (sc *sigmaContext) genSigma1(fabric *Fabric, device_id uint64) {
+func (sc *sigmaContext) genSigma1(fabric *Fabric, device_id uint64) error {
 	var tlvx mattertlv.TLVBuffer
 	tlvx.WriteAnonStruct()
 
 	initiatorRandom := make([]byte, 32)
-	rand.Read(initiatorRandom)
+	randReader.Read(initiatorRandom)
+	sc.initiator_random = initiatorRandom
 	tlvx.WriteOctetString(1, initiatorRandom)
 
-	sessionId := 222
-	tlvx.WriteUInt(2, mattertlv.TYPE_UINT_2, uint64(sessionId))
+	tlvx.WriteUInt(2, mattertlv.TYPE_UINT_2, uint64(sc.local_session))
 
 	var destination_message bytes.Buffer
 	destination_message.Write(initiatorRandom)
@@ -62,15 +67,24 @@ func (sc *sigmaContext) genSigma1(fabric *Fabric, device_id uint64) {
 	tlvx.WriteOctetString(3, destinationIdentifier)
 
 	tlvx.WriteOctetString(4, sc.session_privkey.PublicKey().Bytes())
+	if sc.resumption != nil {
+		mic, err := sc.sigma1ResumeMic()
+		if err != nil {
+			return err
+		}
+		tlvx.WriteOctetString(6, sc.resumption.ResumptionId)
+		tlvx.WriteOctetString(7, mic)
+	}
 	tlvx.WriteStructEnd()
 	sc.sigma1payload = tlvx.Bytes()
+	return nil
 }
 
 func genSigma1Req2(payload []byte, exchange uint16) []byte {
 	var buffer bytes.Buffer
 	prot := ProtocolMessageHeader{
 		exchangeFlags: 5,
-		Opcode:        0x30, //sigma1
+		Opcode:        SEC_CHAN_OPCODE_SIGMA1,
 		ExchangeId:    exchange,
 		ProtocolId:    0x00,
 	}
@@ -84,7 +98,7 @@ func genSigma3Req2(payload []byte, exchange uint16) []byte {
 	var buffer bytes.Buffer
 	prot := ProtocolMessageHeader{
 		exchangeFlags: 5,
-		Opcode:        0x32, //sigma1
+		Opcode:        SEC_CHAN_OPCODE_SIGMA3,
 		ExchangeId:    exchange,
 		ProtocolId:    0x00}
 
@@ -109,7 +123,7 @@ func (sc *sigmaContext) sigma3(fabric *Fabric) ([]byte, error) {
 	//log.Printf("responder public %s\n", hex.EncodeToString(responder_public))
 
 	tlv_s3tbs_hash := sha256_enc(tlv_s3tbs.Bytes())
-	sr, ss, err := ecdsa.Sign(rand.Reader, sc.controller_key, tlv_s3tbs_hash)
+	sr, ss, err := ecdsa.Sign(randReader, sc.controller_key, tlv_s3tbs_hash)
 	if err != nil {
 		return []byte{}, err
 	}
@@ -129,6 +143,7 @@ func (sc *sigmaContext) sigma3(fabric *Fabric) ([]byte, error) {
 	if err != nil {
 		return []byte{}, err
 	}
+	sc.shared_secret = shared_secret
 	s3k_th := sc.sigma1payload
 	s3k_th = append(s3k_th, sc.sigma2dec.Payload...)
 
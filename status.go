@@ -0,0 +1,243 @@
+package gomat
+
+import (
+	"fmt"
+)
+
+// Status is Interaction Model status code. Status values can be used as targets of errors.Is:
+//
+//	if errors.Is(err, gomat.StatusBusy) { ... }
+type Status uint8
+
+const (
+	StatusSuccess                Status = 0x00
+	StatusFailure                Status = 0x01
+	StatusInvalidSubscription    Status = 0x7d
+	StatusUnsupportedAccess      Status = 0x7e
+	StatusUnsupportedEndpoint    Status = 0x7f
+	StatusInvalidAction          Status = 0x80
+	StatusUnsupportedCommand     Status = 0x81
+	StatusInvalidCommand         Status = 0x85
+	StatusUnsupportedAttribute   Status = 0x86
+	StatusConstraintError        Status = 0x87
+	StatusUnsupportedWrite       Status = 0x88
+	StatusResourceExhausted      Status = 0x89
+	StatusNotFound               Status = 0x8b
+	StatusUnreportableAttribute  Status = 0x8c
+	StatusInvalidDataType        Status = 0x8d
+	StatusUnsupportedRead        Status = 0x8f
+	StatusDataVersionMismatch    Status = 0x92
+	StatusTimeout                Status = 0x94
+	StatusBusy                   Status = 0x9c
+	StatusAccessRestricted       Status = 0x9d
+	StatusUnsupportedCluster     Status = 0xc3
+	StatusNoUpstreamSubscription Status = 0xc5
+	StatusNeedsTimedInteraction  Status = 0xc6
+	StatusUnsupportedEvent       Status = 0xc7
+	StatusPathsExhausted         Status = 0xc8
+	StatusTimedRequestMismatch   Status = 0xc9
+	StatusFailsafeRequired       Status = 0xca
+	StatusInvalidInState         Status = 0xcb
+	StatusNoCommandResponse      Status = 0xcc
+)
+
+var statusNames = map[Status]string{
+	StatusSuccess:                "SUCCESS",
+	StatusFailure:                "FAILURE",
+	StatusInvalidSubscription:    "INVALID_SUBSCRIPTION",
+	StatusUnsupportedAccess:      "UNSUPPORTED_ACCESS",
+	StatusUnsupportedEndpoint:    "UNSUPPORTED_ENDPOINT",
+	StatusInvalidAction:          "INVALID_ACTION",
+	StatusUnsupportedCommand:     "UNSUPPORTED_COMMAND",
+	StatusInvalidCommand:         "INVALID_COMMAND",
+	StatusUnsupportedAttribute:   "UNSUPPORTED_ATTRIBUTE",
+	StatusConstraintError:        "CONSTRAINT_ERROR",
+	StatusUnsupportedWrite:       "UNSUPPORTED_WRITE",
+	StatusResourceExhausted:      "RESOURCE_EXHAUSTED",
+	StatusNotFound:               "NOT_FOUND",
+	StatusUnreportableAttribute:  "UNREPORTABLE_ATTRIBUTE",
+	StatusInvalidDataType:        "INVALID_DATA_TYPE",
+	StatusUnsupportedRead:        "UNSUPPORTED_READ",
+	StatusDataVersionMismatch:    "DATA_VERSION_MISMATCH",
+	StatusTimeout:                "TIMEOUT",
+	StatusBusy:                   "BUSY",
+	StatusAccessRestricted:       "ACCESS_RESTRICTED",
+	StatusUnsupportedCluster:     "UNSUPPORTED_CLUSTER",
+	StatusNoUpstreamSubscription: "NO_UPSTREAM_SUBSCRIPTION",
+	StatusNeedsTimedInteraction:  "NEEDS_TIMED_INTERACTION",
+	StatusUnsupportedEvent:       "UNSUPPORTED_EVENT",
+	StatusPathsExhausted:         "PATHS_EXHAUSTED",
+	StatusTimedRequestMismatch:   "TIMED_REQUEST_MISMATCH",
+	StatusFailsafeRequired:       "FAILSAFE_REQUIRED",
+	StatusInvalidInState:         "INVALID_IN_STATE",
+	StatusNoCommandResponse:      "NO_COMMAND_RESPONSE",
+}
+
+func (s Status) String() string {
+	name, ok := statusNames[s]
+	if !ok {
+		name = "UNKNOWN"
+	}
+	return fmt.Sprintf("%s (0x%02x)", name, uint8(s))
+}
+
+func (s Status) Error() string {
+	return s.String()
+}
+
+// ElementKind tells which kind of element is addressed by StatusPath.
+type ElementKind int
+
+const (
+	ElementAttribute ElementKind = iota + 1
+	ElementCommand
+	ElementEvent
+)
+
+// StatusPath identifies attribute, command or event which status relates to.
+type StatusPath struct {
+	Kind     ElementKind
+	Endpoint uint16
+	Cluster  uint32
+	Id       uint32
+}
+
+func (p StatusPath) String() string {
+	kind := "attribute"
+	switch p.Kind {
+	case ElementCommand:
+		kind = "command"
+	case ElementEvent:
+		kind = "event"
+	}
+	return fmt.Sprintf("endpoint %d cluster 0x%x %s 0x%x", p.Endpoint, p.Cluster, kind, p.Id)
+}
+
+// StatusError is failure reported by device using Interaction Model status.
+// errors.Is matches it with its Status, errors.As gives access to path and cluster specific status.
+type StatusError struct {
+	Status           Status
+	ClusterStatus    uint8
+	HasClusterStatus bool
+	Path             *StatusPath // nil when status does not relate to path (for example StatusResponse)
+}
+
+func (e *StatusError) Error() string {
+	out := e.Status.String()
+	if e.HasClusterStatus {
+		out += fmt.Sprintf(" cluster status 0x%02x", e.ClusterStatus)
+	}
+	if e.Path != nil {
+		out += " at " + e.Path.String()
+	}
+	return out
+}
+
+func (e *StatusError) Is(target error) bool {
+	status, ok := target.(Status)
+	return ok && status == e.Status
+}
+
+// statusError returns StatusError or nil when status is success.
+func statusError(status Status, cluster_status uint8, has_cluster_status bool, path *StatusPath) error {
+	if status == StatusSuccess && !has_cluster_status {
+		return nil
+	}
+	return &StatusError{
+		Status:           status,
+		ClusterStatus:    cluster_status,
+		HasClusterStatus: has_cluster_status,
+		Path:             path,
+	}
+}
+
+// GeneralCode is general status code of StatusReport message.
+type GeneralCode uint16
+
+const (
+	GeneralCodeSuccess           GeneralCode = 0
+	GeneralCodeFailure           GeneralCode = 1
+	GeneralCodeBadPrecondition   GeneralCode = 2
+	GeneralCodeOutOfRange        GeneralCode = 3
+	GeneralCodeBadRequest        GeneralCode = 4
+	GeneralCodeUnsupported       GeneralCode = 5
+	GeneralCodeUnexpected        GeneralCode = 6
+	GeneralCodeResourceExhausted GeneralCode = 7
+	GeneralCodeBusy              GeneralCode = 8
+	GeneralCodeTimeout           GeneralCode = 9
+	GeneralCodeContinue          GeneralCode = 10
+	GeneralCodeAborted           GeneralCode = 11
+	GeneralCodeInvalidArgument   GeneralCode = 12
+	GeneralCodeNotFound          GeneralCode = 13
+	GeneralCodeAlreadyExists     GeneralCode = 14
+	GeneralCodePermissionDenied  GeneralCode = 15
+	GeneralCodeDataLoss          GeneralCode = 16
+)
+
+var generalCodeNames = []string{
+	"SUCCESS", "FAILURE", "BAD_PRECONDITION", "OUT_OF_RANGE", "BAD_REQUEST", "UNSUPPORTED",
+	"UNEXPECTED", "RESOURCE_EXHAUSTED", "BUSY", "TIMEOUT", "CONTINUE", "ABORTED",
+	"INVALID_ARGUMENT", "NOT_FOUND", "ALREADY_EXISTS", "PERMISSION_DENIED", "DATA_LOSS",
+}
+
+func (c GeneralCode) String() string {
+	name := "UNKNOWN"
+	if int(c) < len(generalCodeNames) {
+		name = generalCodeNames[c]
+	}
+	return fmt.Sprintf("%s (%d)", name, uint16(c))
+}
+
+func (c GeneralCode) Error() string {
+	return c.String()
+}
+
+// SecureChannelCode is protocol specific code of StatusReport of secure channel protocol.
+type SecureChannelCode uint16
+
+const (
+	SecureChannelSessionEstablishmentSuccess SecureChannelCode = 0
+	SecureChannelNoSharedTrustRoots          SecureChannelCode = 1
+	SecureChannelInvalidParameter            SecureChannelCode = 2
+	SecureChannelCloseSession                SecureChannelCode = 3
+	SecureChannelBusy                        SecureChannelCode = 4
+)
+
+var secureChannelCodeNames = []string{
+	"SESSION_ESTABLISHMENT_SUCCESS", "NO_SHARED_TRUST_ROOTS", "INVALID_PARAMETER", "CLOSE_SESSION", "BUSY",
+}
+
+func (c SecureChannelCode) String() string {
+	name := "UNKNOWN"
+	if int(c) < len(secureChannelCodeNames) {
+		name = secureChannelCodeNames[c]
+	}
+	return fmt.Sprintf("%s (%d)", name, uint16(c))
+}
+
+func (c SecureChannelCode) Error() string {
+	return c.String()
+}
+
+// StatusReportError is failure reported by StatusReport message.
+// errors.Is matches it with its GeneralCode and, for secure channel protocol, with its SecureChannelCode.
+type StatusReportError struct {
+	Report StatusReportElements
+}
+
+func (e *StatusReportError) Error() string {
+	if e.Report.ProtocolId == uint32(ProtocolIdSecureChannel) {
+		return fmt.Sprintf("status report %s, secure channel %s", GeneralCode(e.Report.GeneralCode), SecureChannelCode(e.Report.ProtocolCode))
+	}
+	return fmt.Sprintf("status report %s, protocol %d code %d", GeneralCode(e.Report.GeneralCode), e.Report.ProtocolId, e.Report.ProtocolCode)
+}
+
+func (e *StatusReportError) Is(target error) bool {
+	switch t := target.(type) {
+	case GeneralCode:
+		return uint16(t) == e.Report.GeneralCode
+	case SecureChannelCode:
+		return (e.Report.ProtocolId == uint32(ProtocolIdSecureChannel)) && (uint16(t) == e.Report.ProtocolCode)
+	}
+	return false
+}
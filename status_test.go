@@ -0,0 +1,84 @@
+package gomat
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+)
+
+func TestStatusError(t *testing.T) {
+	if err := statusError(StatusSuccess, 0, false, nil); err != nil {
+		t.Fatalf("success reported as error %v", err)
+	}
+	path := &StatusPath{Kind: ElementCommand, Endpoint: 1, Cluster: 0x3e, Id: 4}
+	err := fmt.Errorf("invoke failed: %w", statusError(StatusFailure, 2, true, path))
+	if !errors.Is(err, StatusFailure) || errors.Is(err, StatusBusy) {
+		t.Fatalf("errors.Is does not match status of %v", err)
+	}
+	var status_err *StatusError
+	if !errors.As(err, &status_err) {
+		t.Fatalf("errors.As does not find StatusError in %v", err)
+	}
+	if status_err.Path == nil || *status_err.Path != *path || !status_err.HasClusterStatus || status_err.ClusterStatus != 2 {
+		t.Fatalf("unexpected StatusError %+v", status_err)
+	}
+	want := "FAILURE (0x01) cluster status 0x02 at endpoint 1 cluster 0x3e command 0x4"
+	if status_err.Error() != want {
+		t.Fatalf("error text %q, want %q", status_err.Error(), want)
+	}
+
+	// success with cluster status is failure of cluster
+	if err := statusError(StatusSuccess, 1, true, nil); err == nil {
+		t.Fatalf("cluster status ignored")
+	}
+}
+
+func TestStatusString(t *testing.T) {
+	tests := []struct {
+		status Status
+		want   string
+	}{
+		{StatusSuccess, "SUCCESS (0x00)"},
+		{StatusBusy, "BUSY (0x9c)"},
+		{StatusNeedsTimedInteraction, "NEEDS_TIMED_INTERACTION (0xc6)"},
+		{Status(0x42), "UNKNOWN (0x42)"},
+	}
+	for _, test := range tests {
+		if test.status.String() != test.want {
+			t.Errorf("status 0x%02x is %q, want %q", uint8(test.status), test.status.String(), test.want)
+		}
+	}
+	if GeneralCodeBusy.String() != "BUSY (8)" || GeneralCode(99).String() != "UNKNOWN (99)" {
+		t.Errorf("unexpected general code names %s %s", GeneralCodeBusy, GeneralCode(99))
+	}
+	if SecureChannelNoSharedTrustRoots.String() != "NO_SHARED_TRUST_ROOTS (1)" {
+		t.Errorf("unexpected secure channel code name %s", SecureChannelNoSharedTrustRoots)
+	}
+}
+
+func TestStatusReportError(t *testing.T) {
+	err := fmt.Errorf("CASE failed: %w", &StatusReportError{Report: StatusReportElements{
+		GeneralCode:  uint16(GeneralCodeFailure),
+		ProtocolId:   uint32(ProtocolIdSecureChannel),
+		ProtocolCode: uint16(SecureChannelNoSharedTrustRoots),
+	}})
+	if !errors.Is(err, GeneralCodeFailure) || errors.Is(err, GeneralCodeBusy) {
+		t.Fatalf("errors.Is does not match general code of %v", err)
+	}
+	if !errors.Is(err, SecureChannelNoSharedTrustRoots) || errors.Is(err, SecureChannelBusy) {
+		t.Fatalf("errors.Is does not match secure channel code of %v", err)
+	}
+	if !strings.Contains(err.Error(), "NO_SHARED_TRUST_ROOTS") {
+		t.Fatalf("error text does not name code: %s", err)
+	}
+
+	// protocol code of other protocol is not secure channel code
+	other := &StatusReportError{Report: StatusReportElements{GeneralCode: uint16(GeneralCodeBusy), ProtocolId: 5, ProtocolCode: 1}}
+	if errors.Is(other, SecureChannelNoSharedTrustRoots) || !errors.Is(other, GeneralCodeBusy) {
+		t.Fatalf("protocol code of protocol 5 matched as secure channel code")
+	}
+	if other.Error() != "status report BUSY (8), protocol 5 code 1" {
+		t.Fatalf("unexpected error text %s", other.Error())
+	}
+}
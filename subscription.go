@@ -0,0 +1,258 @@
+package gomat
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/finnigja/gomat/mattertlv"
+)
+
+// ErrSubscriptionTimeout is reported when device did not send any report within negotiated max interval.
+var ErrSubscriptionTimeout = errors.New("no report received within max interval of subscription")
+
+// SubscribeRequest describes Interaction Model Subscribe Request.
+type SubscribeRequest struct {
+	Attributes         []AttributePath
+	Events             []EventPath
+	EventMin           uint64 // when not 0 only events with event number >= EventMin are reported
+	DataVersionFilters []DataVersionFilter
+	MinInterval        uint16 // floor of reporting interval in seconds
+	MaxInterval        uint16 // ceiling of reporting interval in seconds, device may choose shorter one
+	KeepSubscriptions  bool   // when not set device terminates other subscriptions of this controller
+	FabricFiltered     bool
+}
+
+// EncodeIMSubscribe encodes Interaction Model Subscribe Request message with paths and intervals of request.
+func EncodeIMSubscribe(request SubscribeRequest, exchange uint16) []byte {
+	var tlv mattertlv.TLVBuffer
+	tlv.WriteAnonStruct()
+	tlv.WriteBool(0, request.KeepSubscriptions)
+	tlv.WriteUInt16(1, request.MinInterval)
+	tlv.WriteUInt16(2, request.MaxInterval)
+	writeAttributePaths(&tlv, 3, request.Attributes)
+	writeEventPaths(&tlv, 4, request.Events)
+	writeEventFilter(&tlv, 5, request.EventMin)
+	tlv.WriteBool(7, request.FabricFiltered)
+	writeDataVersionFilters(&tlv, 8, request.DataVersionFilters)
+	tlv.WriteUInt(0xff, mattertlv.TYPE_UINT_1, 10)
+	tlv.WriteStructEnd()
+
+	var buffer bytes.Buffer
+	prot := ProtocolMessageHeader{
+		exchangeFlags: 5,
+		Opcode:        INTERACTION_OPCODE_SUBSC_REQ,
+		ExchangeId:    exchange,
+		ProtocolId:    ProtocolIdInteraction,
+	}
+	prot.Encode(&buffer)
+	buffer.Write(tlv.Bytes())
+
+	return buffer.Bytes()
+}
+
+// ParseSubscribeResponse parses IM SubscribeResponse TLV. It returns subscription id and max interval
+// (seconds) chosen by device.
+func ParseSubscribeResponse(resp *mattertlv.TlvItem) (uint32, uint16, error) {
+	id := resp.GetItemWithTag(0)
+	max_interval := resp.GetItemWithTag(2)
+	if id == nil || max_interval == nil {
+		return 0, 0, fmt.Errorf("invalid SubscribeResponse")
+	}
+	return uint32(id.GetUint64()), uint16(max_interval.GetInt()), nil
+}
+
+// Subscription keeps subscription of device alive. When device does not report within max interval
+// or session fails, new session is connected and subscription is established again after backoff.
+// Fields must be set before Run is called. Callbacks are called from goroutine which runs Run.
+type Subscription struct {
+	Request SubscribeRequest
+	// Connect establishes new session with device. It is called for every (re)subscription.
+	Connect func(ctx context.Context) (SecureChannel, error)
+
+	OnEstablished func(subscription_id uint32, max_interval uint16)
+	OnAttribute   func(report AttributeReport)
+	OnEvent       func(report EventReport)
+	// OnError is called when subscription can't be established or fails. It is re-established after backoff.
+	OnError func(err error)
+
+	Slack      time.Duration // time added to max interval before subscription is considered dead, 0 means derived from MRP
+	MinBackoff time.Duration // first resubscribe delay, 0 means 1s
+	MaxBackoff time.Duration // limit of resubscribe delay, 0 means 1 minute
+
+	next_event uint64
+}
+
+// Run establishes subscription and keeps it alive until ctx is cancelled. It returns error of ctx.
+func (s *Subscription) Run(ctx context.Context) error {
+	min_backoff := s.MinBackoff
+	if min_backoff <= 0 {
+		min_backoff = time.Second
+	}
+	max_backoff := s.MaxBackoff
+	if max_backoff <= 0 {
+		max_backoff = time.Minute
+	}
+	backoff := min_backoff
+	for {
+		established, err := s.runOnce(ctx)
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		if established {
+			backoff = min_backoff
+		}
+		if s.OnError != nil {
+			s.OnError(err)
+		}
+		// random delay in range [backoff/2, backoff) spreads resubscriptions of many controllers
+		wait := backoff/2 + time.Duration(randomFloat64()*float64(backoff/2))
+		timer := time.NewTimer(wait)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return ctx.Err()
+		case <-timer.C:
+		}
+		backoff *= 2
+		if backoff > max_backoff {
+			backoff = max_backoff
+		}
+	}
+}
+
+// deliver passes content of report to callbacks.
+func (s *Subscription) deliver(report ReportResult) {
+	for _, a := range report.Attributes {
+		if s.OnAttribute != nil {
+			s.OnAttribute(a)
+		}
+	}
+	for _, e := range report.Events {
+		if e.Status == StatusSuccess && e.EventNumber >= s.next_event {
+			s.next_event = e.EventNumber + 1
+		}
+		if s.OnEvent != nil {
+			s.OnEvent(e)
+		}
+	}
+}
+
+// runOnce connects device, establishes subscription and waits until it fails.
+// Returned flag is true when subscription was established.
+func (s *Subscription) runOnce(ctx context.Context) (bool, error) {
+	channel, err := s.Connect(ctx)
+	if err != nil {
+		return false, err
+	}
+	em := NewExchangeManager(channel)
+	defer em.Close()
+
+	request := s.Request
+	if s.next_event > request.EventMin {
+		// events delivered by previous subscription are not reported again
+		request.EventMin = s.next_event
+	}
+	// handler is registered before request is sent because device may send first report immediately
+	// after SubscribeResponse, possibly before response is processed here
+	stop := make(chan struct{})
+	defer close(stop)
+	established := make(chan struct{})
+	var subscription_id uint32
+	reports := make(chan ReportResult)
+	em.RegisterHandler(ProtocolIdInteraction, INTERACTION_OPCODE_REPORT_DATA, func(ex *Exchange, msg DecodedGeneric) {
+		defer ex.Close()
+		select {
+		case <-established:
+		case <-stop:
+			return
+		}
+		id := msg.Tlv.GetItemWithTag(reportTagSubscriptionId)
+		if id == nil || uint32(id.GetUint64()) != subscription_id {
+			ex.Send(imStatusResponseGen(ex.Id(), 0, byte(StatusInvalidSubscription)))
+			return
+		}
+		report, err := ContinueReportContext(ctx, ex, msg)
+		if err != nil {
+			// incomplete report is not delivered, liveness timer expires unless device reports again
+			return
+		}
+		select {
+		case reports <- ParseReport(&report.Tlv):
+		case <-stop:
+		}
+	})
+	fail := func(err error) (bool, error) {
+		em.UnregisterHandler(ProtocolIdInteraction, INTERACTION_OPCODE_REPORT_DATA)
+		return false, err
+	}
+
+	exchange := em.NewExchange()
+	defer exchange.Close()
+	err = exchange.Send(EncodeIMSubscribe(request, 0))
+	if err != nil {
+		return fail(err)
+	}
+	priming, err := ReceiveReportContext(ctx, exchange)
+	if err != nil {
+		return fail(err)
+	}
+	resp, err := exchange.ReceiveContext(ctx)
+	if err != nil {
+		return fail(err)
+	}
+	if resp.ProtocolHeader.ProtocolId == ProtocolIdInteraction && resp.ProtocolHeader.Opcode == INTERACTION_OPCODE_STATUS_RSP {
+		err = statusResponseError(resp, "SubscribeRequest")
+		if err == nil {
+			err = fmt.Errorf("SubscribeRequest was answered by StatusResponse")
+		}
+		return fail(err)
+	}
+	if resp.ProtocolHeader.ProtocolId != ProtocolIdInteraction || resp.ProtocolHeader.Opcode != INTERACTION_OPCODE_SUBSC_RSP {
+		return fail(fmt.Errorf("unexpected response to SubscribeRequest (protocol:%d opcode:0x%x)", resp.ProtocolHeader.ProtocolId, resp.ProtocolHeader.Opcode))
+	}
+	subscription_id, max_interval, err := ParseSubscribeResponse(&resp.Tlv)
+	if err != nil {
+		return fail(err)
+	}
+	close(established)
+	exchange.Close()
+
+	if s.OnEstablished != nil {
+		s.OnEstablished(subscription_id, max_interval)
+	}
+	s.deliver(ParseReport(&priming.Tlv))
+
+	slack := s.Slack
+	if slack <= 0 {
+		slack = channel.MrpParameters().receiveTimeout()
+	}
+	timeout := time.Duration(max_interval)*time.Second + slack
+	liveness := time.NewTimer(timeout)
+	defer liveness.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return true, ctx.Err()
+		case <-em.Done():
+			err = em.Err()
+			if err == nil {
+				err = fmt.Errorf("session closed")
+			}
+			return true, err
+		case <-liveness.C:
+			return true, ErrSubscriptionTimeout
+		case report := <-reports:
+			if !liveness.Stop() {
+				select {
+				case <-liveness.C:
+				default:
+				}
+			}
+			liveness.Reset(timeout)
+			s.deliver(report)
+		}
+	}
+}
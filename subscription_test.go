@@ -0,0 +1,144 @@
+package gomat
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/finnigja/gomat/mattertlv"
+)
+
+func interactionMessage(opcode Opcode, tlv mattertlv.TLVBuffer) []byte {
+	var buffer bytes.Buffer
+	prot := ProtocolMessageHeader{
+		exchangeFlags: exchangeFlagsReliable,
+		Opcode:        opcode,
+		ProtocolId:    ProtocolIdInteraction,
+	}
+	prot.Encode(&buffer)
+	buffer.Write(tlv.Bytes())
+	return buffer.Bytes()
+}
+
+// reportDataGen encodes ReportData with value of OnOff attribute of endpoint 1.
+func reportDataGen(subscription_id uint32, value bool) []byte {
+	var tlv mattertlv.TLVBuffer
+	tlv.WriteAnonStruct()
+	tlv.WriteUInt32(reportTagSubscriptionId, subscription_id)
+	tlv.WriteArray(reportTagAttributeReports)
+	tlv.WriteAnonStruct()
+	tlv.WriteStruct(1)
+	tlv.WriteUInt32(0, 1)
+	tlv.WriteList(1)
+	tlv.WriteUInt16(2, 1)
+	tlv.WriteUInt32(3, 6)
+	tlv.WriteUInt32(4, 0)
+	tlv.WriteStructEnd()
+	tlv.WriteBool(2, value)
+	tlv.WriteStructEnd()
+	tlv.WriteStructEnd()
+	tlv.WriteStructEnd()
+	tlv.WriteStructEnd()
+	return interactionMessage(INTERACTION_OPCODE_REPORT_DATA, tlv)
+}
+
+func subscribeResponseGen(subscription_id uint32, max_interval uint16) []byte {
+	var tlv mattertlv.TLVBuffer
+	tlv.WriteAnonStruct()
+	tlv.WriteUInt32(0, subscription_id)
+	tlv.WriteUInt16(2, max_interval)
+	tlv.WriteStructEnd()
+	return interactionMessage(INTERACTION_OPCODE_SUBSC_RSP, tlv)
+}
+
+func TestSubscriptionReportAfterResponse(t *testing.T) {
+	a, b := NewMemoryTransportPair(LinkConditions{})
+	device := NewExchangeManager(newSecureChannel(b, fastMrp()))
+	defer device.Close()
+
+	device.RegisterHandler(ProtocolIdInteraction, INTERACTION_OPCODE_SUBSC_REQ, func(ex *Exchange, msg DecodedGeneric) {
+		defer ex.Close()
+		ex.Send(reportDataGen(7, false))
+		if _, err := ex.Receive(); err != nil {
+			return
+		}
+		// first report of subscription follows response without any delay
+		ex.Send(subscribeResponseGen(7, 60))
+		report := device.NewExchange()
+		defer report.Close()
+		report.Send(reportDataGen(7, true))
+		report.Receive()
+	})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+	defer cancel()
+	values := []bool{}
+	established := uint32(0)
+	subscription := Subscription{
+		Request: SubscribeRequest{
+			Attributes:  []AttributePath{NewAttributePath(1, 6, 0)},
+			MaxInterval: 60,
+		},
+		Connect: func(ctx context.Context) (SecureChannel, error) {
+			return newSecureChannel(a, fastMrp()), nil
+		},
+		OnEstablished: func(subscription_id uint32, max_interval uint16) {
+			established = subscription_id
+		},
+		OnAttribute: func(report AttributeReport) {
+			values = append(values, report.Value.GetBool())
+			if len(values) == 2 {
+				cancel()
+			}
+		},
+		OnError: func(err error) {
+			t.Errorf("subscription failed %s", err.Error())
+			cancel()
+		},
+	}
+	subscription.Run(ctx)
+	if established != 7 {
+		t.Fatalf("subscription not established")
+	}
+	if len(values) != 2 || values[0] || !values[1] {
+		t.Fatalf("unexpected reported values %v", values)
+	}
+}
+
+func TestSubscriptionStatusResponse(t *testing.T) {
+	a, b := NewMemoryTransportPair(LinkConditions{})
+	device := NewExchangeManager(newSecureChannel(b, fastMrp()))
+	defer device.Close()
+
+	device.RegisterHandler(ProtocolIdInteraction, INTERACTION_OPCODE_SUBSC_REQ, func(ex *Exchange, msg DecodedGeneric) {
+		defer ex.Close()
+		// device rejects subscription instead of sending priming report
+		ex.Send(imStatusResponseGen(ex.Id(), 0, byte(StatusBusy)))
+	})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+	defer cancel()
+	var failure error
+	subscription := Subscription{
+		Request: SubscribeRequest{
+			Attributes:  []AttributePath{NewAttributePath(1, 6, 0)},
+			MaxInterval: 60,
+		},
+		Connect: func(ctx context.Context) (SecureChannel, error) {
+			return newSecureChannel(a, fastMrp()), nil
+		},
+		OnEstablished: func(subscription_id uint32, max_interval uint16) {
+			t.Errorf("rejected subscription established")
+		},
+		OnError: func(err error) {
+			failure = err
+			cancel()
+		},
+	}
+	subscription.Run(ctx)
+	if !errors.Is(failure, StatusBusy) {
+		t.Fatalf("rejection not reported by StatusError: %v", failure)
+	}
+}
@@ -0,0 +1,97 @@
+// Package datamodel describes Matter clusters in form used by info.json and parses cluster definitions
+// in Matter data model XML format. It is used by symbols/gen and by runtime registry of symbols package.
+package datamodel
+
+import "strings"
+
+// MatterInfo is content of info.json - clusters by id.
+type MatterInfo struct {
+	Clusters map[int]ClusterInfo
+}
+
+// ClusterInfo describes cluster, its attributes, commands, events and data types.
+type ClusterInfo struct {
+	Name       string
+	Id         int
+	Commands   []CommandInfo
+	Attributes []AttributeInfo
+	Events     []EventInfo  `json:",omitempty"`
+	Enums      []EnumInfo   `json:",omitempty"`
+	Bitmaps    []BitmapInfo `json:",omitempty"`
+	Structs    []StructInfo `json:",omitempty"`
+}
+
+// FieldInfo describes member of struct, field of command or event and value of attribute.
+// Type is data model type (uint8, octstr, ...) or name of enum, bitmap or struct of cluster.
+type FieldInfo struct {
+	Name     string
+	Id       int
+	Type     string `json:",omitempty"`
+	List     bool   `json:",omitempty"` // list of Type
+	Nullable bool   `json:",omitempty"`
+}
+
+type CommandInfo struct {
+	Name            string
+	Id              int
+	Direction       string      `json:",omitempty"` // commandToServer or responseFromServer
+	Response        string      `json:",omitempty"` // name of response command, Y for status response, N for none
+	InvokePrivilege string      `json:",omitempty"`
+	Timed           bool        `json:",omitempty"`
+	FabricScoped    bool        `json:",omitempty"`
+	Fields          []FieldInfo `json:",omitempty"`
+}
+
+type AttributeInfo struct {
+	FieldInfo
+	ReadPrivilege   string `json:",omitempty"`
+	WritePrivilege  string `json:",omitempty"`
+	Writable        bool   `json:",omitempty"`
+	FabricScoped    bool   `json:",omitempty"`
+	FabricSensitive bool   `json:",omitempty"`
+	Timed           bool   `json:",omitempty"` // write must be timed interaction
+}
+
+type EventInfo struct {
+	Name            string
+	Id              int
+	Priority        string      `json:",omitempty"`
+	FabricSensitive bool        `json:",omitempty"`
+	Fields          []FieldInfo `json:",omitempty"`
+}
+
+type EnumItemInfo struct {
+	Name  string
+	Value int
+}
+
+type EnumInfo struct {
+	Name  string
+	Items []EnumItemInfo
+}
+
+type BitInfo struct {
+	Name string
+	Bit  int
+}
+
+type BitmapInfo struct {
+	Name string
+	Bits []BitInfo
+}
+
+type StructInfo struct {
+	Name         string
+	FabricScoped bool `json:",omitempty"`
+	Fields       []FieldInfo
+}
+
+// IsResponse returns true for commands sent by server. Data model lists both directions in same
+// <commands> element and responses often share id with their request.
+// Direction is missing in entries produced by older versions of gen, name is used then.
+func IsResponse(command CommandInfo) bool {
+	if command.Direction != "" {
+		return command.Direction == "responseFromServer"
+	}
+	return strings.HasSuffix(command.Name, "Response")
+}
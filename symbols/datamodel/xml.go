@@ -0,0 +1,438 @@
+package datamodel
+
+import (
+	"encoding/xml"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+type AccessXmlDef struct {
+	Read            string `xml:"read,attr"`
+	Write           string `xml:"write,attr"`
+	ReadPrivilege   string `xml:"readPrivilege,attr"`
+	WritePrivilege  string `xml:"writePrivilege,attr"`
+	InvokePrivilege string `xml:"invokePrivilege,attr"`
+	FabricScoped    string `xml:"fabricScoped,attr"`
+	FabricSensitive string `xml:"fabricSensitive,attr"`
+	Timed           string `xml:"timed,attr"`
+}
+
+type QualityXmlDef struct {
+	Nullable string `xml:"nullable,attr"`
+}
+
+type EntryXmlDef struct {
+	Type string `xml:"type,attr"`
+}
+
+// FieldXmlDef is used for attributes and for fields of structs, commands and events.
+type FieldXmlDef struct {
+	Name    string        `xml:"name,attr"`
+	Id      string        `xml:"id,attr"`
+	Type    string        `xml:"type,attr"`
+	Entry   EntryXmlDef   `xml:"entry"`
+	Access  AccessXmlDef  `xml:"access"`
+	Quality QualityXmlDef `xml:"quality"`
+}
+
+type CommandXmlDef struct {
+	Name      string        `xml:"name,attr"`
+	Id        string        `xml:"id,attr"`
+	Direction string        `xml:"direction,attr"`
+	Response  string        `xml:"response,attr"`
+	Access    AccessXmlDef  `xml:"access"`
+	Fields    []FieldXmlDef `xml:"field"`
+}
+
+type CommandListXmlDef struct {
+	Command []CommandXmlDef `xml:"command"`
+}
+
+type AttributeListXmlDef struct {
+	Attribute []FieldXmlDef `xml:"attribute"`
+}
+
+type EventXmlDef struct {
+	Name     string        `xml:"name,attr"`
+	Id       string        `xml:"id,attr"`
+	Priority string        `xml:"priority,attr"`
+	Access   AccessXmlDef  `xml:"access"`
+	Fields   []FieldXmlDef `xml:"field"`
+}
+
+type EventListXmlDef struct {
+	Event []EventXmlDef `xml:"event"`
+}
+
+type EnumItemXmlDef struct {
+	Name  string `xml:"name,attr"`
+	Value string `xml:"value,attr"`
+}
+
+type EnumXmlDef struct {
+	Name  string           `xml:"name,attr"`
+	Items []EnumItemXmlDef `xml:"item"`
+}
+
+type BitfieldXmlDef struct {
+	Name string `xml:"name,attr"`
+	Bit  string `xml:"bit,attr"`
+	From string `xml:"from,attr"` // first bit of multi bit field
+}
+
+type BitmapXmlDef struct {
+	Name      string           `xml:"name,attr"`
+	Bitfields []BitfieldXmlDef `xml:"bitfield"`
+}
+
+type StructXmlDef struct {
+	Name         string        `xml:"name,attr"`
+	FabricScoped string        `xml:"isFabricScoped,attr"`
+	Access       AccessXmlDef  `xml:"access"`
+	Fields       []FieldXmlDef `xml:"field"`
+}
+
+type DataTypesXmlDef struct {
+	Enums   []EnumXmlDef   `xml:"enum"`
+	Bitmaps []BitmapXmlDef `xml:"bitmap"`
+	Structs []StructXmlDef `xml:"struct"`
+}
+
+type ClusterIdXmlDef struct {
+	Id   string `xml:"id,attr"`
+	Name string `xml:"name,attr"`
+}
+
+// ClassificationXmlDef gives base cluster of derived clusters (hierarchy="derived").
+type ClassificationXmlDef struct {
+	Hierarchy   string `xml:"hierarchy,attr"`
+	BaseCluster string `xml:"baseCluster,attr"`
+}
+
+type ClusterXmlDef struct {
+	XMLName        xml.Name             `xml:"cluster"`
+	Name           string               `xml:"name,attr"`
+	Id             string               `xml:"id,attr"`
+	ClusterIds     []ClusterIdXmlDef    `xml:"clusterIds>clusterId"`
+	Classification ClassificationXmlDef `xml:"classification"`
+	Commands       CommandListXmlDef    `xml:"commands"`
+	Attributes     AttributeListXmlDef  `xml:"attributes"`
+	Events         EventListXmlDef      `xml:"events"`
+	DataTypes      DataTypesXmlDef      `xml:"dataTypes"`
+}
+
+func symbolize(in string) string {
+	s := strings.ReplaceAll(in, " ", "")
+	s = strings.ReplaceAll(s, "-", "")
+	s = strings.ReplaceAll(s, "/", "")
+	return s
+}
+
+func xmlBool(in string) bool {
+	return in == "true" || in == "1"
+}
+
+// xmlField converts field definition. List types are given as type="list" with <entry type="..."/>.
+func xmlField(in FieldXmlDef) (FieldInfo, error) {
+	id, err := strconv.ParseUint(in.Id, 0, 32)
+	if err != nil {
+		return FieldInfo{}, err
+	}
+	out := FieldInfo{
+		Name:     symbolize(in.Name),
+		Id:       int(id),
+		Type:     in.Type,
+		Nullable: xmlBool(in.Quality.Nullable),
+	}
+	if in.Type == "list" {
+		out.Type = in.Entry.Type
+		out.List = true
+	}
+	return out, nil
+}
+
+func xmlFields(in []FieldXmlDef) []FieldInfo {
+	out := []FieldInfo{}
+	for _, f := range in {
+		field, err := xmlField(f)
+		if err != nil {
+			continue
+		}
+		out = append(out, field)
+	}
+	return out
+}
+
+func process_data_types(parsed_xml ClusterXmlDef, out *ClusterInfo) {
+	for _, enum := range parsed_xml.DataTypes.Enums {
+		e := EnumInfo{Name: symbolize(enum.Name)}
+		for _, item := range enum.Items {
+			value, err := strconv.ParseInt(item.Value, 0, 64)
+			if err != nil {
+				continue
+			}
+			e.Items = append(e.Items, EnumItemInfo{Name: symbolize(item.Name), Value: int(value)})
+		}
+		out.Enums = append(out.Enums, e)
+	}
+	for _, bitmap := range parsed_xml.DataTypes.Bitmaps {
+		b := BitmapInfo{Name: symbolize(bitmap.Name)}
+		for _, field := range bitmap.Bitfields {
+			bit := field.Bit
+			if bit == "" {
+				bit = field.From
+			}
+			n, err := strconv.ParseUint(bit, 0, 6)
+			if err != nil {
+				continue
+			}
+			b.Bits = append(b.Bits, BitInfo{Name: symbolize(field.Name), Bit: int(n)})
+		}
+		out.Bitmaps = append(out.Bitmaps, b)
+	}
+	for _, st := range parsed_xml.DataTypes.Structs {
+		out.Structs = append(out.Structs, StructInfo{
+			Name:         symbolize(st.Name),
+			FabricScoped: xmlBool(st.FabricScoped) || xmlBool(st.Access.FabricScoped),
+			Fields:       xmlFields(st.Fields),
+		})
+	}
+}
+
+// ids returns ids and names of clusters defined by file. Base clusters (Mode Base, Alarm Base, ...) don't have id.
+func (def *ClusterXmlDef) ids() []ClusterIdXmlDef {
+	out := []ClusterIdXmlDef{}
+	if def.Id != "" {
+		out = append(out, ClusterIdXmlDef{Id: def.Id, Name: def.Name})
+	}
+	for _, id := range def.ClusterIds {
+		if id.Id != "" && id.Id != def.Id {
+			out = append(out, id)
+		}
+	}
+	return out
+}
+
+// ParseClusterXmlDef parses file in Matter data model XML format without converting it.
+func ParseClusterXmlDef(data []byte) (ClusterXmlDef, error) {
+	var out ClusterXmlDef
+	err := xml.Unmarshal(data, &out)
+	return out, err
+}
+
+// ParseClusterXml parses definition of cluster in Matter data model XML format
+// (https://github.com/project-chip/connectedhomeip/tree/master/data_model/clusters).
+// Elements of base cluster of derived cluster are not known here, use ResolveClusters for them.
+func ParseClusterXml(data []byte) (ClusterInfo, error) {
+	parsed_xml, err := ParseClusterXmlDef(data)
+	if err != nil {
+		return ClusterInfo{}, err
+	}
+	ids := parsed_xml.ids()
+	if len(ids) == 0 {
+		return ClusterInfo{}, fmt.Errorf("cluster %s does not have id", parsed_xml.Name)
+	}
+	return clusterInfo(parsed_xml, ids[0])
+}
+
+// ResolveClusters converts parsed files into clusters. Derived clusters get elements of their base cluster
+// which they don't define themselves. File which lists more clusters in <clusterIds> produces cluster for
+// each of them, base clusters without id are left out.
+func ResolveClusters(defs []ClusterXmlDef) ([]ClusterInfo, error) {
+	by_name := map[string]ClusterXmlDef{}
+	for _, def := range defs {
+		by_name[symbolize(def.Name)] = def
+	}
+	out := []ClusterInfo{}
+	for _, def := range defs {
+		resolved := def
+		seen := map[string]bool{symbolize(def.Name): true}
+		for base := resolved.Classification.BaseCluster; base != ""; {
+			name := symbolize(base)
+			if seen[name] {
+				return nil, fmt.Errorf("cluster %s: cycle of base clusters", def.Name)
+			}
+			seen[name] = true
+			base_def, ok := by_name[name]
+			if !ok {
+				return nil, fmt.Errorf("cluster %s: base cluster %s not found", def.Name, base)
+			}
+			resolved = mergeBase(resolved, base_def)
+			base = base_def.Classification.BaseCluster
+		}
+		for _, id := range resolved.ids() {
+			cluster, err := clusterInfo(resolved, id)
+			if err != nil {
+				return nil, err
+			}
+			out = append(out, cluster)
+		}
+	}
+	return out, nil
+}
+
+// mergeList returns elements of base replaced by merge with element of derived with same key,
+// followed by elements of derived which are not in base.
+func mergeList[T any](derived, base []T, key func(T) string, merge func(d, b T) T) []T {
+	out := []T{}
+	in_base := map[string]bool{}
+	for _, b := range base {
+		in_base[key(b)] = true
+		for _, d := range derived {
+			if key(d) == key(b) {
+				b = merge(d, b)
+			}
+		}
+		out = append(out, b)
+	}
+	for _, d := range derived {
+		if !in_base[key(d)] {
+			out = append(out, d)
+		}
+	}
+	return out
+}
+
+// mergeField completes element of derived cluster which only changes conformance of element of base cluster.
+func mergeField(d, b FieldXmlDef) FieldXmlDef {
+	if d.Type == "" {
+		d.Type = b.Type
+		d.Entry = b.Entry
+	}
+	if d.Access == (AccessXmlDef{}) {
+		d.Access = b.Access
+	}
+	if d.Quality == (QualityXmlDef{}) {
+		d.Quality = b.Quality
+	}
+	return d
+}
+
+// mergeBase returns derived cluster extended by elements of base. Attributes and events are matched by id,
+// commands, enums, bitmaps and structs by name. Enums and bitmaps of derived cluster add items to those of base.
+func mergeBase(derived, base ClusterXmlDef) ClusterXmlDef {
+	out := derived
+	out.Classification.BaseCluster = base.Classification.BaseCluster
+	out.Attributes.Attribute = mergeList(derived.Attributes.Attribute, base.Attributes.Attribute,
+		func(f FieldXmlDef) string { return f.Id }, mergeField)
+	out.Commands.Command = mergeList(derived.Commands.Command, base.Commands.Command,
+		func(c CommandXmlDef) string { return c.Name },
+		func(d, b CommandXmlDef) CommandXmlDef {
+			if len(d.Fields) == 0 {
+				d.Fields = b.Fields
+			}
+			if d.Direction == "" {
+				d.Direction = b.Direction
+			}
+			if d.Response == "" {
+				d.Response = b.Response
+			}
+			if d.Access == (AccessXmlDef{}) {
+				d.Access = b.Access
+			}
+			return d
+		})
+	out.Events.Event = mergeList(derived.Events.Event, base.Events.Event,
+		func(e EventXmlDef) string { return e.Id },
+		func(d, b EventXmlDef) EventXmlDef {
+			if len(d.Fields) == 0 {
+				d.Fields = b.Fields
+			}
+			if d.Priority == "" {
+				d.Priority = b.Priority
+			}
+			return d
+		})
+	out.DataTypes.Enums = mergeList(derived.DataTypes.Enums, base.DataTypes.Enums,
+		func(e EnumXmlDef) string { return e.Name },
+		func(d, b EnumXmlDef) EnumXmlDef {
+			b.Items = append(append([]EnumItemXmlDef{}, b.Items...), d.Items...)
+			return b
+		})
+	out.DataTypes.Bitmaps = mergeList(derived.DataTypes.Bitmaps, base.DataTypes.Bitmaps,
+		func(b BitmapXmlDef) string { return b.Name },
+		func(d, b BitmapXmlDef) BitmapXmlDef {
+			b.Bitfields = append(append([]BitfieldXmlDef{}, b.Bitfields...), d.Bitfields...)
+			return b
+		})
+	out.DataTypes.Structs = mergeList(derived.DataTypes.Structs, base.DataTypes.Structs,
+		func(s StructXmlDef) string { return s.Name },
+		func(d, b StructXmlDef) StructXmlDef {
+			if len(d.Fields) > 0 {
+				return d
+			}
+			return b
+		})
+	return out
+}
+
+// clusterInfo converts parsed file into cluster with given id and name.
+func clusterInfo(parsed_xml ClusterXmlDef, cluster_id ClusterIdXmlDef) (ClusterInfo, error) {
+	var out ClusterInfo
+	out.Name = symbolize(cluster_id.Name)
+	id, err := strconv.ParseUint(cluster_id.Id, 0, 32)
+	if err != nil {
+		return out, err
+	}
+	out.Id = int(id)
+
+	for _, command := range parsed_xml.Commands.Command {
+		id, err := strconv.ParseUint(command.Id, 0, 32)
+		if err != nil {
+			continue
+		}
+		cmd := CommandInfo{
+			Name:            symbolize(command.Name),
+			Id:              int(id),
+			Direction:       command.Direction,
+			Response:        command.Response,
+			InvokePrivilege: command.Access.InvokePrivilege,
+			Timed:           xmlBool(command.Access.Timed),
+			FabricScoped:    xmlBool(command.Access.FabricScoped),
+			Fields:          xmlFields(command.Fields),
+		}
+		if cmd.Response != "Y" && cmd.Response != "N" {
+			cmd.Response = symbolize(cmd.Response)
+		}
+		out.Commands = append(out.Commands, cmd)
+	}
+	deduplicate := map[string]bool{}
+	for _, attribute := range parsed_xml.Attributes.Attribute {
+		_, duplicit := deduplicate[attribute.Name]
+		if duplicit {
+			continue
+		} else {
+			deduplicate[attribute.Name] = true
+		}
+		field, err := xmlField(attribute)
+		if err != nil {
+			continue
+		}
+		attr := AttributeInfo{
+			FieldInfo:       field,
+			ReadPrivilege:   attribute.Access.ReadPrivilege,
+			WritePrivilege:  attribute.Access.WritePrivilege,
+			Writable:        attribute.Access.Write != "" && attribute.Access.Write != "false",
+			FabricScoped:    xmlBool(attribute.Access.FabricScoped),
+			FabricSensitive: xmlBool(attribute.Access.FabricSensitive),
+			Timed:           xmlBool(attribute.Access.Timed),
+		}
+		out.Attributes = append(out.Attributes, attr)
+	}
+	for _, event := range parsed_xml.Events.Event {
+		id, err := strconv.ParseUint(event.Id, 0, 32)
+		if err != nil {
+			continue
+		}
+		out.Events = append(out.Events, EventInfo{
+			Name:            symbolize(event.Name),
+			Id:              int(id),
+			Priority:        event.Priority,
+			FabricSensitive: xmlBool(event.Access.FabricSensitive),
+			Fields:          xmlFields(event.Fields),
+		})
+	}
+	process_data_types(parsed_xml, &out)
+	return out, nil
+}
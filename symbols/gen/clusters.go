@@ -0,0 +1,432 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"go/token"
+	"os"
+	"path/filepath"
+	"strings"
+	"unicode"
+
+	"github.com/finnigja/gomat/symbols/datamodel"
+)
+
+// goTypes maps data model types to Go types used in generated cluster packages.
+var goTypes = map[string]string{
+	"bool":   "bool",
+	"uint8":  "uint8",
+	"uint16": "uint16",
+	"uint24": "uint32",
+	"uint32": "uint32",
+	"uint40": "uint64",
+	"uint48": "uint64",
+	"uint56": "uint64",
+	"uint64": "uint64",
+	"int8":   "int8",
+	"int16":  "int16",
+	"int24":  "int32",
+	"int32":  "int32",
+	"int40":  "int64",
+	"int48":  "int64",
+	"int56":  "int64",
+	"int64":  "int64",
+	"single": "float32",
+	"double": "float64",
+
+	"enum8":    "uint8",
+	"enum16":   "uint16",
+	"bitmap8":  "uint8",
+	"bitmap16": "uint16",
+	"bitmap32": "uint32",
+	"bitmap64": "uint64",
+
+	"string":           "string",
+	"char_string":      "string",
+	"long_char_string": "string",
+	"octstr":           "[]byte",
+	"long_octstr":      "[]byte",
+	"ipadr":            "[]byte",
+	"ipv4adr":          "[]byte",
+	"ipv6adr":          "[]byte",
+	"ipv6pre":          "[]byte",
+	"hwadr":            "[]byte",
+
+	"percent":       "uint8",
+	"percent100ths": "uint16",
+	"temperature":   "int16",
+	"power-mW":      "int64",
+	"amperage-mA":   "int64",
+	"voltage-mV":    "int64",
+	"energy-mWh":    "int64",
+	"money":         "int64",
+	"priority":      "uint8",
+	"status":        "uint8",
+	"epoch-us":      "uint64",
+	"epoch-s":       "uint32",
+	"utc":           "uint32",
+	"posix-ms":      "uint64",
+	"systime-us":    "uint64",
+	"systime-ms":    "uint64",
+	"elapsed-s":     "uint32",
+
+	"action-id":   "uint8",
+	"attrib-id":   "uint32",
+	"cluster-id":  "uint32",
+	"command-id":  "uint32",
+	"data-ver":    "uint32",
+	"devtype-id":  "uint32",
+	"endpoint-no": "uint16",
+	"entry-idx":   "uint16",
+	"event-id":    "uint32",
+	"event-no":    "uint64",
+	"fabric-id":   "uint64",
+	"fabric-idx":  "uint8",
+	"field-id":    "uint32",
+	"group-id":    "uint16",
+	"namespace":   "uint8",
+	"node-id":     "uint64",
+	"subject-id":  "uint64",
+	"tag":         "uint8",
+	"trans-id":    "uint32",
+	"vendor-id":   "uint16",
+}
+
+// goName converts name from data model into exported Go identifier.
+func goName(name string) string {
+	out := []rune{}
+	for _, r := range name {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) || r == '_' {
+			out = append(out, r)
+		}
+	}
+	if len(out) == 0 || unicode.IsDigit(out[0]) {
+		out = append([]rune("V"), out...)
+	}
+	out[0] = unicode.ToUpper(out[0])
+	return string(out)
+}
+
+// packageName returns name of generated package of cluster.
+func packageName(cluster ClusterInfo) string {
+	name := strings.ToLower(goName(cluster.Name))
+	if token.IsKeyword(name) {
+		name += "cluster"
+	}
+	return name
+}
+
+// clusterWriter generates package of one cluster.
+type clusterWriter struct {
+	cluster ClusterInfo
+	named   map[string]string // enums, bitmaps and structs of cluster -> Go type name
+	f       bytes.Buffer
+	err     error // first type which could not be resolved
+}
+
+func newClusterWriter(cluster ClusterInfo) *clusterWriter {
+	w := &clusterWriter{cluster: cluster, named: map[string]string{}}
+	for _, enum := range cluster.Enums {
+		w.named[enum.Name] = goName(enum.Name)
+	}
+	for _, bitmap := range cluster.Bitmaps {
+		w.named[bitmap.Name] = goName(bitmap.Name)
+	}
+	for _, st := range cluster.Structs {
+		w.named[st.Name] = goName(st.Name)
+	}
+	return w
+}
+
+// baseType returns Go type of data model type. Unknown type is recorded in w.err and fails generation.
+func (w *clusterWriter) baseType(typ string) string {
+	if name, ok := w.named[typ]; ok {
+		return name
+	}
+	if name, ok := goTypes[typ]; ok {
+		return name
+	}
+	if w.err == nil {
+		w.err = fmt.Errorf("unknown type %q", typ)
+	}
+	return typ
+}
+
+func (w *clusterWriter) goType(field FieldInfo) string {
+	out := w.baseType(field.Type)
+	if field.List {
+		out = "[]" + out
+	}
+	if field.Nullable {
+		out = "*" + out
+	}
+	return out
+}
+
+func (w *clusterWriter) printf(format string, args ...any) {
+	w.f.WriteString(fmt.Sprintf(format, args...))
+}
+
+// writeStruct writes struct type with tlv tags of fields.
+func (w *clusterWriter) writeStruct(name string, fields []FieldInfo) {
+	if len(fields) == 0 {
+		w.printf("type %s struct{}\n\n", name)
+		return
+	}
+	w.printf("type %s struct {\n", name)
+	seen := map[string]bool{}
+	for _, field := range fields {
+		member := goName(field.Name)
+		if seen[member] {
+			member = fmt.Sprintf("%s%d", member, field.Id)
+		}
+		seen[member] = true
+		w.printf("%s %s `tlv:\"%d\"`\n", member, w.goType(field), field.Id)
+	}
+	w.printf("}\n\n")
+}
+
+func (w *clusterWriter) writeIds() {
+	c := w.cluster
+	w.printf("// ClusterId is id of %s cluster.\n", c.Name)
+	w.printf("const ClusterId = 0x%04x\n\n", c.Id)
+	if len(c.Attributes) > 0 {
+		w.printf("// ids of attributes\nconst (\n")
+		seen := map[int]bool{}
+		for _, attribute := range c.Attributes {
+			if !seen[attribute.Id] {
+				seen[attribute.Id] = true
+				w.printf("Attribute%s = 0x%04x\n", goName(attribute.Name), attribute.Id)
+			}
+		}
+		w.printf(")\n\n")
+	}
+	requests := []CommandInfo{}
+	responses := []CommandInfo{}
+	for _, command := range c.Commands {
+		if datamodel.IsResponse(command) {
+			responses = append(responses, command)
+		} else {
+			requests = append(requests, command)
+		}
+	}
+	if len(requests) > 0 {
+		w.printf("// ids of commands\nconst (\n")
+		for _, command := range requests {
+			w.printf("Command%s = 0x%02x\n", goName(command.Name), command.Id)
+		}
+		w.printf(")\n\n")
+	}
+	if len(responses) > 0 {
+		w.printf("// ids of response commands\nconst (\n")
+		for _, command := range responses {
+			w.printf("%s = 0x%02x\n", responseId(command), command.Id)
+		}
+		w.printf(")\n\n")
+	}
+	if len(c.Events) > 0 {
+		w.printf("// ids of events\nconst (\n")
+		for _, event := range c.Events {
+			w.printf("Event%s = 0x%02x\n", goName(event.Name), event.Id)
+		}
+		w.printf(")\n\n")
+	}
+}
+
+func (w *clusterWriter) writeDataTypes() {
+	for _, enum := range w.cluster.Enums {
+		name := goName(enum.Name)
+		typ := "uint8"
+		for _, item := range enum.Items {
+			if item.Value > 0xff {
+				typ = "uint16"
+			}
+		}
+		w.printf("type %s %s\n\n", name, typ)
+		if len(enum.Items) > 0 {
+			w.printf("const (\n")
+			for _, item := range enum.Items {
+				w.printf("%s%s %s = %d\n", name, goName(item.Name), name, item.Value)
+			}
+			w.printf(")\n\n")
+		}
+	}
+	for _, bitmap := range w.cluster.Bitmaps {
+		name := goName(bitmap.Name)
+		high := 0
+		for _, bit := range bitmap.Bits {
+			if bit.Bit > high {
+				high = bit.Bit
+			}
+		}
+		typ := "uint8"
+		switch {
+		case high > 31:
+			typ = "uint64"
+		case high > 15:
+			typ = "uint32"
+		case high > 7:
+			typ = "uint16"
+		}
+		w.printf("type %s %s\n\n", name, typ)
+		if len(bitmap.Bits) > 0 {
+			w.printf("const (\n")
+			for _, bit := range bitmap.Bits {
+				w.printf("%s%s %s = 0x%x\n", name, goName(bit.Name), name, uint64(1)<<bit.Bit)
+			}
+			w.printf(")\n\n")
+		}
+	}
+	for _, st := range w.cluster.Structs {
+		w.writeStruct(goName(st.Name), st.Fields)
+	}
+}
+
+// responseId returns name of id constant of response command, e.g. ResponseArmFailSafe for
+// ArmFailSafeResponse.
+func responseId(response CommandInfo) string {
+	return "Response" + strings.TrimSuffix(goName(response.Name), "Response")
+}
+
+// responseOf returns response command of command or nil when device responds with status.
+func (w *clusterWriter) responseOf(command CommandInfo) *CommandInfo {
+	if command.Response == "" || command.Response == "Y" || command.Response == "N" {
+		return nil
+	}
+	for n, response := range w.cluster.Commands {
+		if response.Name == command.Response && datamodel.IsResponse(response) {
+			return &w.cluster.Commands[n]
+		}
+	}
+	return nil
+}
+
+func (w *clusterWriter) writeCommands() {
+	seen := map[int]bool{}
+	for _, command := range w.cluster.Commands {
+		if datamodel.IsResponse(command) {
+			w.printf("// %s holds fields of %s response command.\n", goName(command.Name), command.Name)
+			w.writeStruct(goName(command.Name), command.Fields)
+			continue
+		}
+		if seen[command.Id] {
+			continue
+		}
+		seen[command.Id] = true
+		name := goName(command.Name)
+		params := ""
+		request := "nil"
+		if len(command.Fields) > 0 {
+			// CSRRequest command has fields CSRRequestFields rather than CSRRequestRequest
+			request_type := name + "Request"
+			if strings.HasSuffix(name, "Request") {
+				request_type = name + "Fields"
+			}
+			w.printf("// %s holds fields of %s command.\n", request_type, command.Name)
+			w.writeStruct(request_type, command.Fields)
+			params = ", request " + request_type
+			request = "request"
+		}
+		response := w.responseOf(command)
+		if response == nil {
+			w.printf("// %s invokes %s command.\n", name, command.Name)
+			w.printf("func %s(ctx context.Context, messenger gomat.Messenger, endpoint uint16%s) error {\n", name, params)
+			w.printf("_, err := clusters.Invoke(ctx, messenger, endpoint, ClusterId, Command%s, %s, %t)\nreturn err\n}\n\n", name, request, command.Timed)
+			continue
+		}
+		response_name := goName(response.Name)
+		w.printf("// %s invokes %s command and returns fields of %s.\n", name, command.Name, response.Name)
+		w.printf("func %s(ctx context.Context, messenger gomat.Messenger, endpoint uint16%s) (%s, error) {\n", name, params, response_name)
+		w.printf("var response %s\n", response_name)
+		w.printf("result, err := clusters.Invoke(ctx, messenger, endpoint, ClusterId, Command%s, %s, %t)\n", name, request, command.Timed)
+		w.printf("if err != nil {\nreturn response, err\n}\n")
+		w.printf("err = clusters.DecodeResponse(result, %s, &response)\nreturn response, err\n}\n\n", responseId(*response))
+	}
+}
+
+func (w *clusterWriter) writeAttributes() {
+	seen := map[int]bool{}
+	for _, attribute := range w.cluster.Attributes {
+		if seen[attribute.Id] {
+			continue
+		}
+		seen[attribute.Id] = true
+		name := goName(attribute.Name)
+		typ := w.goType(attribute.FieldInfo)
+		w.printf("// Read%s reads %s attribute.\n", name, attribute.Name)
+		w.printf("func Read%s(ctx context.Context, messenger gomat.Messenger, endpoint uint16) (%s, error) {\n", name, typ)
+		w.printf("return clusters.ReadAttribute[%s](ctx, messenger, endpoint, ClusterId, Attribute%s)\n}\n\n", typ, name)
+		if attribute.Writable && attribute.Type != "" {
+			w.printf("// Write%s writes %s attribute.\n", name, attribute.Name)
+			w.printf("func Write%s(ctx context.Context, messenger gomat.Messenger, endpoint uint16, value %s) error {\n", name, typ)
+			w.printf("return clusters.WriteAttribute(ctx, messenger, endpoint, ClusterId, Attribute%s, value, %t)\n}\n\n", name, attribute.Timed)
+		}
+		w.printf("// Subscribe%s adds %s attribute of endpoint to subscription s. callback receives reported values.\n", name, attribute.Name)
+		w.printf("func Subscribe%s(s *gomat.Subscription, endpoint uint16, callback func(value %s)) {\n", name, typ)
+		w.printf("clusters.SubscribeAttribute(s, endpoint, ClusterId, Attribute%s, callback)\n}\n\n", name)
+	}
+}
+
+func (w *clusterWriter) writeEvents() {
+	for _, event := range w.cluster.Events {
+		name := goName(event.Name)
+		w.printf("// %sEvent holds fields of %s event.\n", name, event.Name)
+		w.writeStruct(name+"Event", event.Fields)
+		w.printf("// Decode%sEvent decodes %s event from report.\n", name, event.Name)
+		w.printf("func Decode%sEvent(report gomat.EventReport) (%sEvent, error) {\n", name, name)
+		w.printf("return clusters.DecodeEvent[%sEvent](report, ClusterId, Event%s)\n}\n\n", name, name)
+		w.printf("// Subscribe%sEvent adds %s event of endpoint to subscription s. callback receives decoded events.\n", name, event.Name)
+		w.printf("func Subscribe%sEvent(s *gomat.Subscription, endpoint uint16, callback func(event %sEvent)) {\n", name, name)
+		w.printf("clusters.SubscribeEvent(s, endpoint, ClusterId, Event%s, callback)\n}\n\n", name)
+	}
+}
+
+// source returns formatted source of package.
+func (w *clusterWriter) source() ([]byte, error) {
+	w.writeIds()
+	w.writeDataTypes()
+	w.writeCommands()
+	w.writeAttributes()
+	w.writeEvents()
+	if w.err != nil {
+		return nil, w.err
+	}
+	body := w.f.String()
+
+	var f bytes.Buffer
+	f.WriteString("// Code generated by symbols/gen. DO NOT EDIT.\n\n")
+	f.WriteString(fmt.Sprintf("// Package %s is typed client of %s cluster (0x%04x).\n", packageName(w.cluster), w.cluster.Name, w.cluster.Id))
+	f.WriteString(fmt.Sprintf("package %s\n\nimport (\n", packageName(w.cluster)))
+	for _, imp := range []string{"context", "", "github.com/finnigja/gomat", "github.com/finnigja/gomat/clusters"} {
+		if imp == "" {
+			// standard library imports are separated from others
+			f.WriteString("\n")
+		} else if strings.Contains(body, filepath.Base(imp)+".") {
+			f.WriteString(fmt.Sprintf("%q\n", imp))
+		}
+	}
+	f.WriteString(")\n\n")
+	f.WriteString(body)
+	return format.Source(f.Bytes())
+}
+
+// writeClusters writes package of every cluster into ../../clusters/<package>.
+func writeClusters(mi MatterInfo) error {
+	for _, cluster := range sortedClusters(mi) {
+		source, err := newClusterWriter(cluster).source()
+		if err != nil {
+			return fmt.Errorf("cluster %s: %w", cluster.Name, err)
+		}
+		dir := filepath.Join("..", "..", "clusters", packageName(cluster))
+		err = os.MkdirAll(dir, 0777)
+		if err != nil {
+			return err
+		}
+		err = os.WriteFile(filepath.Join(dir, packageName(cluster)+".go"), source, 0666)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,334 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"go/format"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/finnigja/gomat/symbols/datamodel"
+)
+
+// sortedClusters returns clusters ordered by id so that generated file is stable.
+func sortedClusters(mi MatterInfo) []ClusterInfo {
+	out := []ClusterInfo{}
+	for _, cluster := range mi.Clusters {
+		out = append(out, cluster)
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].Id < out[j].Id })
+	return out
+}
+
+func commandFields(commands []CommandInfo, response bool) []FieldInfo {
+	out := []FieldInfo{}
+	for _, command := range commands {
+		if datamodel.IsResponse(command) == response {
+			out = append(out, FieldInfo{Name: command.Name, Id: command.Id})
+		}
+	}
+	return out
+}
+
+// writeNameMap writes map cluster id -> id -> name. When more elements share id first one is used.
+func writeNameMap(f *bytes.Buffer, name string, prefix string, clusters []ClusterInfo, elements func(ClusterInfo) []FieldInfo) {
+	f.WriteString(fmt.Sprintf("var %s = map[int]map[int]string{\n", name))
+	for _, cluster := range clusters {
+		list := elements(cluster)
+		if len(list) == 0 {
+			continue
+		}
+		f.WriteString(fmt.Sprintf("CLUSTER_ID_%s: {\n", cluster.Name))
+		seen := map[int]bool{}
+		for _, element := range list {
+			if seen[element.Id] {
+				continue
+			}
+			seen[element.Id] = true
+			f.WriteString(fmt.Sprintf("%s_%s_%s: \"%s\",\n", prefix, cluster.Name, element.Name, element.Name))
+		}
+		f.WriteString("},\n")
+	}
+	f.WriteString("}\n\n")
+}
+
+// writeIdMap writes map cluster id -> name -> id, reverse of map written by writeNameMap.
+// When more elements share name first one is used.
+func writeIdMap(f *bytes.Buffer, name string, prefix string, clusters []ClusterInfo, elements func(ClusterInfo) []FieldInfo) {
+	f.WriteString(fmt.Sprintf("var %s = map[int]map[string]int{\n", name))
+	for _, cluster := range clusters {
+		list := elements(cluster)
+		if len(list) == 0 {
+			continue
+		}
+		f.WriteString(fmt.Sprintf("CLUSTER_ID_%s: {\n", cluster.Name))
+		seen := map[string]bool{}
+		for _, element := range list {
+			if seen[element.Name] {
+				continue
+			}
+			seen[element.Name] = true
+			f.WriteString(fmt.Sprintf("\"%s\": %s_%s_%s,\n", element.Name, prefix, cluster.Name, element.Name))
+		}
+		f.WriteString("},\n")
+	}
+	f.WriteString("}\n\n")
+}
+
+// hasTypes returns true when cluster was processed with data types. Clusters which were generated
+// from identifiers only are left out of ClusterTypesMap.
+func hasTypes(cluster ClusterInfo) bool {
+	for _, attribute := range cluster.Attributes {
+		if attribute.Type != "" {
+			return true
+		}
+	}
+	for _, command := range cluster.Commands {
+		if command.Direction != "" {
+			return true
+		}
+	}
+	return len(cluster.Events) > 0 || len(cluster.Enums) > 0 || len(cluster.Bitmaps) > 0 || len(cluster.Structs) > 0
+}
+
+// goField returns Field literal. Ids of attributes are written in hex, ids of fields in decimal.
+func goField(field FieldInfo, id_format string) string {
+	out := fmt.Sprintf("{Id: "+id_format+", Name: %q, Type: %q", field.Id, field.Name, field.Type)
+	if field.List {
+		out += ", List: true"
+	}
+	if field.Nullable {
+		out += ", Nullable: true"
+	}
+	return out + "}"
+}
+
+// goFields returns Fields member of struct literal, empty string when there are no fields.
+func goFields(fields []FieldInfo) string {
+	if len(fields) == 0 {
+		return ""
+	}
+	out := ", Fields: []Field{\n"
+	for _, field := range fields {
+		out += goField(field, "%d") + ",\n"
+	}
+	return out + "}"
+}
+
+// goFlags returns struct literal members for flags which are set.
+func goFlags(flags ...any) string {
+	out := ""
+	for n := 0; n < len(flags); n += 2 {
+		switch v := flags[n+1].(type) {
+		case bool:
+			if v {
+				out += fmt.Sprintf(", %s: true", flags[n])
+			}
+		case string:
+			if v != "" {
+				out += fmt.Sprintf(", %s: %q", flags[n], v)
+			}
+		}
+	}
+	return out
+}
+
+// goResponse returns value of CommandInfo.Response of command.
+func goResponse(cluster ClusterInfo, command CommandInfo) string {
+	switch command.Response {
+	case "", "Y":
+		return "ResponseStatus"
+	case "N":
+		return "ResponseNone"
+	}
+	for _, response := range cluster.Commands {
+		if response.Name == command.Response && datamodel.IsResponse(response) {
+			return fmt.Sprintf("0x%02x", response.Id)
+		}
+	}
+	return "ResponseStatus"
+}
+
+func writeCommands(f *bytes.Buffer, name string, cluster ClusterInfo, response bool) {
+	seen := map[int]bool{}
+	out := []string{}
+	for _, command := range cluster.Commands {
+		if command.Direction == "" || datamodel.IsResponse(command) != response || seen[command.Id] {
+			continue
+		}
+		seen[command.Id] = true
+		resp := "ResponseNone"
+		if !response {
+			resp = goResponse(cluster, command)
+		}
+		out = append(out, fmt.Sprintf("0x%02x: {Id: 0x%02x, Name: %q, Response: %s%s%s},\n", command.Id, command.Id, command.Name,
+			resp, goFields(command.Fields), goFlags("InvokePrivilege", command.InvokePrivilege, "Timed", command.Timed, "FabricScoped", command.FabricScoped)))
+	}
+	if len(out) > 0 {
+		f.WriteString(name + ": map[int]CommandInfo{\n" + strings.Join(out, "") + "},\n")
+	}
+}
+
+// writeTypes writes ClusterTypesMap with data types of clusters.
+func writeTypes(f *bytes.Buffer, clusters []ClusterInfo) {
+	f.WriteString("// ClusterTypesMap holds data types of clusters by cluster id.\n")
+	f.WriteString("var ClusterTypesMap = map[int]*ClusterTypes{\n")
+	for _, cluster := range clusters {
+		if !hasTypes(cluster) {
+			continue
+		}
+		f.WriteString(fmt.Sprintf("CLUSTER_ID_%s: {\n", cluster.Name))
+		seen := map[int]bool{}
+		attributes := []string{}
+		for _, attribute := range cluster.Attributes {
+			if attribute.Type == "" || seen[attribute.Id] {
+				continue
+			}
+			seen[attribute.Id] = true
+			attributes = append(attributes, fmt.Sprintf("0x%04x: {Field: Field%s%s},\n", attribute.Id, goField(attribute.FieldInfo, "0x%04x"),
+				goFlags("ReadPrivilege", attribute.ReadPrivilege, "WritePrivilege", attribute.WritePrivilege,
+					"Writable", attribute.Writable, "FabricScoped", attribute.FabricScoped,
+					"FabricSensitive", attribute.FabricSensitive, "Timed", attribute.Timed)))
+		}
+		if len(attributes) > 0 {
+			f.WriteString("Attributes: map[int]AttributeInfo{\n" + strings.Join(attributes, "") + "},\n")
+		}
+		writeCommands(f, "Commands", cluster, false)
+		writeCommands(f, "Responses", cluster, true)
+		if len(cluster.Events) > 0 {
+			f.WriteString("Events: map[int]EventInfo{\n")
+			for _, event := range cluster.Events {
+				f.WriteString(fmt.Sprintf("0x%02x: {Name: %q%s%s},\n", event.Id, event.Name, goFields(event.Fields),
+					goFlags("Priority", event.Priority, "FabricSensitive", event.FabricSensitive)))
+			}
+			f.WriteString("},\n")
+		}
+		if len(cluster.Enums) > 0 {
+			f.WriteString("Enums: map[string]EnumInfo{\n")
+			for _, enum := range cluster.Enums {
+				items := []string{}
+				seen := map[int]bool{}
+				for _, item := range enum.Items {
+					if !seen[item.Value] {
+						seen[item.Value] = true
+						items = append(items, fmt.Sprintf("%d: %q", item.Value, item.Name))
+					}
+				}
+				f.WriteString(fmt.Sprintf("%q: {%s},\n", enum.Name, strings.Join(items, ", ")))
+			}
+			f.WriteString("},\n")
+		}
+		if len(cluster.Bitmaps) > 0 {
+			f.WriteString("Bitmaps: map[string]BitmapInfo{\n")
+			for _, bitmap := range cluster.Bitmaps {
+				bits := []string{}
+				seen := map[int]bool{}
+				for _, bit := range bitmap.Bits {
+					if !seen[bit.Bit] {
+						seen[bit.Bit] = true
+						bits = append(bits, fmt.Sprintf("%d: %q", bit.Bit, bit.Name))
+					}
+				}
+				f.WriteString(fmt.Sprintf("%q: {%s},\n", bitmap.Name, strings.Join(bits, ", ")))
+			}
+			f.WriteString("},\n")
+		}
+		if len(cluster.Structs) > 0 {
+			f.WriteString("Structs: map[string]StructInfo{\n")
+			for _, st := range cluster.Structs {
+				f.WriteString(fmt.Sprintf("%q: {%s%s},\n", st.Name, strings.TrimPrefix(goFields(st.Fields), ", "), goFlags("FabricScoped", st.FabricScoped)))
+			}
+			f.WriteString("},\n")
+		}
+		f.WriteString("},\n")
+	}
+	f.WriteString("}\n")
+}
+
+func writeGoInfo(mi MatterInfo) error {
+	var f bytes.Buffer
+	f.WriteString("package symbols\n\n")
+
+	clusters := sortedClusters(mi)
+	for _, cluster := range clusters {
+		f.WriteString(fmt.Sprintf("const CLUSTER_ID_%s = 0x%x\n", cluster.Name, cluster.Id))
+		for _, command := range cluster.Commands {
+			f.WriteString(fmt.Sprintf("const COMMAND_ID_%s_%s = %d\n", cluster.Name, command.Name, command.Id))
+		}
+		for _, attribute := range cluster.Attributes {
+			f.WriteString(fmt.Sprintf("const ATTRIBUTE_ID_%s_%s = %d\n", cluster.Name, attribute.Name, attribute.Id))
+		}
+		for _, event := range cluster.Events {
+			f.WriteString(fmt.Sprintf("const EVENT_ID_%s_%s = %d\n", cluster.Name, event.Name, event.Id))
+		}
+	}
+
+	f.WriteString("\nvar ClusterNameMap = map[int]string {\n")
+	for _, cluster := range clusters {
+		f.WriteString(fmt.Sprintf("  CLUSTER_ID_%s: \"%s\",\n", cluster.Name, cluster.Name))
+	}
+	f.WriteString("}\n\n")
+
+	f.WriteString("// ClusterIdMap maps name of cluster to its id.\n")
+	f.WriteString("var ClusterIdMap = map[string]int{\n")
+	for _, cluster := range clusters {
+		f.WriteString(fmt.Sprintf("  \"%s\": CLUSTER_ID_%s,\n", cluster.Name, cluster.Name))
+	}
+	f.WriteString("}\n\n")
+
+	attributes := func(c ClusterInfo) []FieldInfo {
+		out := []FieldInfo{}
+		for _, attribute := range c.Attributes {
+			out = append(out, attribute.FieldInfo)
+		}
+		return out
+	}
+	commands := func(c ClusterInfo) []FieldInfo {
+		return commandFields(c.Commands, false)
+	}
+	responses := func(c ClusterInfo) []FieldInfo {
+		return commandFields(c.Commands, true)
+	}
+	events := func(c ClusterInfo) []FieldInfo {
+		out := []FieldInfo{}
+		for _, event := range c.Events {
+			out = append(out, FieldInfo{Name: event.Name, Id: event.Id})
+		}
+		return out
+	}
+	f.WriteString("// AttributeNameMap maps cluster id and attribute id to name of attribute.\n")
+	writeNameMap(&f, "AttributeNameMap", "ATTRIBUTE_ID", clusters, attributes)
+	f.WriteString("// CommandNameMap maps cluster id and command id to name of command sent to server.\n")
+	writeNameMap(&f, "CommandNameMap", "COMMAND_ID", clusters, commands)
+	f.WriteString("// ResponseNameMap maps cluster id and command id to name of response command sent by server.\n")
+	writeNameMap(&f, "ResponseNameMap", "COMMAND_ID", clusters, responses)
+	f.WriteString("// EventNameMap maps cluster id and event id to name of event.\n")
+	writeNameMap(&f, "EventNameMap", "EVENT_ID", clusters, events)
+
+	f.WriteString("// AttributeIdMap maps cluster id and name of attribute to its id.\n")
+	writeIdMap(&f, "AttributeIdMap", "ATTRIBUTE_ID", clusters, attributes)
+	f.WriteString("// CommandIdMap maps cluster id and name of command sent to server to its id.\n")
+	writeIdMap(&f, "CommandIdMap", "COMMAND_ID", clusters, commands)
+	f.WriteString("// EventIdMap maps cluster id and name of event to its id.\n")
+	writeIdMap(&f, "EventIdMap", "EVENT_ID", clusters, events)
+	writeTypes(&f, clusters)
+
+	source, err := format.Source(f.Bytes())
+	if err != nil {
+		return err
+	}
+	return os.WriteFile("../info.go", source, 0666)
+}
+
+// loadJson reads previously generated info.json. It allows to regenerate info.go without xml files.
+func loadJson() (MatterInfo, error) {
+	var mi MatterInfo
+	data, err := os.ReadFile("../info.json")
+	if err != nil {
+		return mi, err
+	}
+	err = json.Unmarshal(data, &mi)
+	return mi, err
+}
@@ -1,212 +1,62 @@
 package symbols
 
-const CLUSTER_ID_PressureMeasurement = 0x403
-const ATTRIBUTE_ID_PressureMeasurement_MeasuredValue = 0
-const ATTRIBUTE_ID_PressureMeasurement_MinMeasuredValue = 1
-const ATTRIBUTE_ID_PressureMeasurement_MaxMeasuredValue = 2
-const ATTRIBUTE_ID_PressureMeasurement_Tolerance = 3
-const ATTRIBUTE_ID_PressureMeasurement_ScaledValue = 16
-const ATTRIBUTE_ID_PressureMeasurement_MinScaledValue = 17
-const ATTRIBUTE_ID_PressureMeasurement_MaxScaledValue = 18
-const ATTRIBUTE_ID_PressureMeasurement_ScaledTolerance = 19
-const ATTRIBUTE_ID_PressureMeasurement_Scale = 20
-const CLUSTER_ID_TargetNavigator = 0x505
-const COMMAND_ID_TargetNavigator_NavigateTarget = 0
-const COMMAND_ID_TargetNavigator_NavigateTargetResponse = 1
-const ATTRIBUTE_ID_TargetNavigator_TargetList = 0
-const ATTRIBUTE_ID_TargetNavigator_CurrentTarget = 1
-const CLUSTER_ID_AccessControl = 0x1f
-const ATTRIBUTE_ID_AccessControl_ACL = 0
-const ATTRIBUTE_ID_AccessControl_Extension = 1
-const ATTRIBUTE_ID_AccessControl_SubjectsPerAccessControlEntry = 2
-const ATTRIBUTE_ID_AccessControl_TargetsPerAccessControlEntry = 3
-const ATTRIBUTE_ID_AccessControl_AccessControlEntriesPerFabric = 4
-const CLUSTER_ID_BallastConfiguration = 0x301
-const ATTRIBUTE_ID_BallastConfiguration_PhysicalMinLevel = 0
-const ATTRIBUTE_ID_BallastConfiguration_PhysicalMaxLevel = 1
-const ATTRIBUTE_ID_BallastConfiguration_BallastStatus = 2
-const ATTRIBUTE_ID_BallastConfiguration_MinLevel = 16
-const ATTRIBUTE_ID_BallastConfiguration_MaxLevel = 17
-const ATTRIBUTE_ID_BallastConfiguration_PowerOnLevel = 18
-const ATTRIBUTE_ID_BallastConfiguration_PowerOnFadeTime = 19
-const ATTRIBUTE_ID_BallastConfiguration_IntrinsicBallastFactor = 20
-const ATTRIBUTE_ID_BallastConfiguration_BallastFactorAdjustment = 21
-const ATTRIBUTE_ID_BallastConfiguration_LampQuantity = 32
-const ATTRIBUTE_ID_BallastConfiguration_LampType = 48
-const ATTRIBUTE_ID_BallastConfiguration_LampManufacturer = 49
-const ATTRIBUTE_ID_BallastConfiguration_LampRatedHours = 50
-const ATTRIBUTE_ID_BallastConfiguration_LampBurnHours = 51
-const ATTRIBUTE_ID_BallastConfiguration_LampAlarmMode = 52
-const ATTRIBUTE_ID_BallastConfiguration_LampBurnHoursTripPoint = 53
-const CLUSTER_ID_MediaPlayback = 0x506
-const COMMAND_ID_MediaPlayback_Play = 0
-const COMMAND_ID_MediaPlayback_Pause = 1
-const COMMAND_ID_MediaPlayback_Stop = 2
-const COMMAND_ID_MediaPlayback_StartOver = 3
-const COMMAND_ID_MediaPlayback_Previous = 4
-const COMMAND_ID_MediaPlayback_Next = 5
-const COMMAND_ID_MediaPlayback_Rewind = 6
-const COMMAND_ID_MediaPlayback_FastForward = 7
-const COMMAND_ID_MediaPlayback_SkipForward = 8
-const COMMAND_ID_MediaPlayback_SkipBackward = 9
-const COMMAND_ID_MediaPlayback_PlaybackResponse = 10
-const COMMAND_ID_MediaPlayback_Seek = 11
-const ATTRIBUTE_ID_MediaPlayback_CurrentState = 0
-const ATTRIBUTE_ID_MediaPlayback_StartTime = 1
-const ATTRIBUTE_ID_MediaPlayback_Duration = 2
-const ATTRIBUTE_ID_MediaPlayback_SampledPosition = 3
-const ATTRIBUTE_ID_MediaPlayback_PlaybackSpeed = 4
-const ATTRIBUTE_ID_MediaPlayback_SeekRangeEnd = 5
-const ATTRIBUTE_ID_MediaPlayback_SeekRangeStart = 6
-const CLUSTER_ID_MicrowaveOvenMode = 0x5e
-const COMMAND_ID_MicrowaveOvenMode_ChangeToMode = 0
-const COMMAND_ID_MicrowaveOvenMode_ChangeToModeResponse = 1
-const ATTRIBUTE_ID_MicrowaveOvenMode_SupportedModes = 0
-const ATTRIBUTE_ID_MicrowaveOvenMode_CurrentMode = 1
-const ATTRIBUTE_ID_MicrowaveOvenMode_StartUpMode = 2
-const ATTRIBUTE_ID_MicrowaveOvenMode_OnMode = 3
-const CLUSTER_ID_OperationalCredentials = 0x3e
-const COMMAND_ID_OperationalCredentials_AttestationRequest = 0
-const COMMAND_ID_OperationalCredentials_AttestationResponse = 1
-const COMMAND_ID_OperationalCredentials_CertificateChainRequest = 2
-const COMMAND_ID_OperationalCredentials_CertificateChainResponse = 3
-const COMMAND_ID_OperationalCredentials_CSRRequest = 4
-const COMMAND_ID_OperationalCredentials_CSRResponse = 5
-const COMMAND_ID_OperationalCredentials_AddNOC = 6
-const COMMAND_ID_OperationalCredentials_UpdateNOC = 7
-const COMMAND_ID_OperationalCredentials_NOCResponse = 8
-const COMMAND_ID_OperationalCredentials_UpdateFabricLabel = 9
-const COMMAND_ID_OperationalCredentials_RemoveFabric = 10
-const COMMAND_ID_OperationalCredentials_AddTrustedRootCertificate = 11
-const ATTRIBUTE_ID_OperationalCredentials_NOCs = 0
-const ATTRIBUTE_ID_OperationalCredentials_Fabrics = 1
-const ATTRIBUTE_ID_OperationalCredentials_SupportedFabrics = 2
-const ATTRIBUTE_ID_OperationalCredentials_CommissionedFabrics = 3
-const ATTRIBUTE_ID_OperationalCredentials_TrustedRootCertificates = 4
-const ATTRIBUTE_ID_OperationalCredentials_CurrentFabricIndex = 5
-const CLUSTER_ID_OperationalState = 0x60
-const COMMAND_ID_OperationalState_Pause = 0
-const COMMAND_ID_OperationalState_Stop = 1
-const COMMAND_ID_OperationalState_Start = 2
-const COMMAND_ID_OperationalState_Resume = 3
-const COMMAND_ID_OperationalState_OperationalCommandResponse = 4
-const ATTRIBUTE_ID_OperationalState_PhaseList = 0
-const ATTRIBUTE_ID_OperationalState_CurrentPhase = 1
-const ATTRIBUTE_ID_OperationalState_CountdownTime = 2
-const ATTRIBUTE_ID_OperationalState_OperationalStateList = 3
-const ATTRIBUTE_ID_OperationalState_OperationalState = 4
-const ATTRIBUTE_ID_OperationalState_OperationalError = 5
-const CLUSTER_ID_WindowCovering = 0x102
-const COMMAND_ID_WindowCovering_UpOrOpen = 0
-const COMMAND_ID_WindowCovering_DownOrClose = 1
-const COMMAND_ID_WindowCovering_StopMotion = 2
-const COMMAND_ID_WindowCovering_GoToLiftValue = 4
-const COMMAND_ID_WindowCovering_GoToLiftPercentage = 5
-const COMMAND_ID_WindowCovering_GoToTiltValue = 7
-const COMMAND_ID_WindowCovering_GoToTiltPercentage = 8
-const ATTRIBUTE_ID_WindowCovering_Type = 0
-const ATTRIBUTE_ID_WindowCovering_PhysicalClosedLimitLift = 1
-const ATTRIBUTE_ID_WindowCovering_PhysicalClosedLimitTilt = 2
-const ATTRIBUTE_ID_WindowCovering_CurrentPositionLift = 3
-const ATTRIBUTE_ID_WindowCovering_CurrentPositionTilt = 4
-const ATTRIBUTE_ID_WindowCovering_NumberOfActuationsLift = 5
-const ATTRIBUTE_ID_WindowCovering_NumberOfActuationsTilt = 6
-const ATTRIBUTE_ID_WindowCovering_ConfigStatus = 7
-const ATTRIBUTE_ID_WindowCovering_CurrentPositionLiftPercentage = 8
-const ATTRIBUTE_ID_WindowCovering_CurrentPositionTiltPercentage = 9
-const ATTRIBUTE_ID_WindowCovering_OperationalStatus = 10
-const ATTRIBUTE_ID_WindowCovering_TargetPositionLiftPercent100ths = 11
-const ATTRIBUTE_ID_WindowCovering_TargetPositionTiltPercent100ths = 12
-const ATTRIBUTE_ID_WindowCovering_EndProductType = 13
-const ATTRIBUTE_ID_WindowCovering_CurrentPositionLiftPercent100ths = 14
-const ATTRIBUTE_ID_WindowCovering_CurrentPositionTiltPercent100ths = 15
-const ATTRIBUTE_ID_WindowCovering_InstalledOpenLimitLift = 16
-const ATTRIBUTE_ID_WindowCovering_InstalledClosedLimitLift = 17
-const ATTRIBUTE_ID_WindowCovering_InstalledOpenLimitTilt = 18
-const ATTRIBUTE_ID_WindowCovering_InstalledClosedLimitTilt = 19
-const ATTRIBUTE_ID_WindowCovering_VelocityLift = 20
-const ATTRIBUTE_ID_WindowCovering_AccelerationTimeLift = 21
-const ATTRIBUTE_ID_WindowCovering_DecelerationTimeLift = 22
-const ATTRIBUTE_ID_WindowCovering_Mode = 23
-const ATTRIBUTE_ID_WindowCovering_IntermediateSetpointsLift = 24
-const ATTRIBUTE_ID_WindowCovering_IntermediateSetpointsTilt = 25
-const ATTRIBUTE_ID_WindowCovering_SafetyStatus = 26
-const CLUSTER_ID_Descriptor = 0x1d
-const ATTRIBUTE_ID_Descriptor_DeviceTypeList = 0
-const ATTRIBUTE_ID_Descriptor_ServerList = 1
-const ATTRIBUTE_ID_Descriptor_ClientList = 2
-const ATTRIBUTE_ID_Descriptor_PartsList = 3
-const ATTRIBUTE_ID_Descriptor_TagList = 4
-const CLUSTER_ID_ThreadNetworkDiagnostics = 0x35
-const COMMAND_ID_ThreadNetworkDiagnostics_ResetCounts = 0
-const ATTRIBUTE_ID_ThreadNetworkDiagnostics_Channel = 0
-const ATTRIBUTE_ID_ThreadNetworkDiagnostics_RoutingRole = 1
-const ATTRIBUTE_ID_ThreadNetworkDiagnostics_NetworkName = 2
-const ATTRIBUTE_ID_ThreadNetworkDiagnostics_PanId = 3
-const ATTRIBUTE_ID_ThreadNetworkDiagnostics_ExtendedPanId = 4
-const ATTRIBUTE_ID_ThreadNetworkDiagnostics_MeshLocalPrefix = 5
-const ATTRIBUTE_ID_ThreadNetworkDiagnostics_OverrunCount = 6
-const ATTRIBUTE_ID_ThreadNetworkDiagnostics_NeighborTable = 7
-const ATTRIBUTE_ID_ThreadNetworkDiagnostics_RouteTable = 8
-const ATTRIBUTE_ID_ThreadNetworkDiagnostics_PartitionId = 9
-const ATTRIBUTE_ID_ThreadNetworkDiagnostics_Weighting = 10
-const ATTRIBUTE_ID_ThreadNetworkDiagnostics_DataVersion = 11
-const ATTRIBUTE_ID_ThreadNetworkDiagnostics_StableDataVersion = 12
-const ATTRIBUTE_ID_ThreadNetworkDiagnostics_LeaderRouterId = 13
-const ATTRIBUTE_ID_ThreadNetworkDiagnostics_DetachedRoleCount = 14
-const ATTRIBUTE_ID_ThreadNetworkDiagnostics_ChildRoleCount = 15
-const ATTRIBUTE_ID_ThreadNetworkDiagnostics_RouterRoleCount = 16
-const ATTRIBUTE_ID_ThreadNetworkDiagnostics_LeaderRoleCount = 17
-const ATTRIBUTE_ID_ThreadNetworkDiagnostics_AttachAttemptCount = 18
-const ATTRIBUTE_ID_ThreadNetworkDiagnostics_PartitionIdChangeCount = 19
-const ATTRIBUTE_ID_ThreadNetworkDiagnostics_BetterPartitionAttachAttemptCount = 20
-const ATTRIBUTE_ID_ThreadNetworkDiagnostics_ParentChangeCount = 21
-const ATTRIBUTE_ID_ThreadNetworkDiagnostics_TxTotalCount = 22
-const ATTRIBUTE_ID_ThreadNetworkDiagnostics_TxUnicastCount = 23
-const ATTRIBUTE_ID_ThreadNetworkDiagnostics_TxBroadcastCount = 24
-const ATTRIBUTE_ID_ThreadNetworkDiagnostics_TxAckRequestedCount = 25
-const ATTRIBUTE_ID_ThreadNetworkDiagnostics_TxAckedCount = 26
-const ATTRIBUTE_ID_ThreadNetworkDiagnostics_TxNoAckRequestedCount = 27
-const ATTRIBUTE_ID_ThreadNetworkDiagnostics_TxDataCount = 28
-const ATTRIBUTE_ID_ThreadNetworkDiagnostics_TxDataPollCount = 29
-const ATTRIBUTE_ID_ThreadNetworkDiagnostics_TxBeaconCount = 30
-const ATTRIBUTE_ID_ThreadNetworkDiagnostics_TxBeaconRequestCount = 31
-const ATTRIBUTE_ID_ThreadNetworkDiagnostics_TxOtherCount = 32
-const ATTRIBUTE_ID_ThreadNetworkDiagnostics_TxRetryCount = 33
-const ATTRIBUTE_ID_ThreadNetworkDiagnostics_TxDirectMaxRetryExpiryCount = 34
-const ATTRIBUTE_ID_ThreadNetworkDiagnostics_TxIndirectMaxRetryExpiryCount = 35
-const ATTRIBUTE_ID_ThreadNetworkDiagnostics_TxErrCcaCount = 36
-const ATTRIBUTE_ID_ThreadNetworkDiagnostics_TxErrAbortCount = 37
-const ATTRIBUTE_ID_ThreadNetworkDiagnostics_TxErrBusyChannelCount = 38
-const ATTRIBUTE_ID_ThreadNetworkDiagnostics_RxTotalCount = 39
-const ATTRIBUTE_ID_ThreadNetworkDiagnostics_RxUnicastCount = 40
-const ATTRIBUTE_ID_ThreadNetworkDiagnostics_RxBroadcastCount = 41
-const ATTRIBUTE_ID_ThreadNetworkDiagnostics_RxDataCount = 42
-const ATTRIBUTE_ID_ThreadNetworkDiagnostics_RxDataPollCount = 43
-const ATTRIBUTE_ID_ThreadNetworkDiagnostics_RxBeaconCount = 44
-const ATTRIBUTE_ID_ThreadNetworkDiagnostics_RxBeaconRequestCount = 45
-const ATTRIBUTE_ID_ThreadNetworkDiagnostics_RxOtherCount = 46
-const ATTRIBUTE_ID_ThreadNetworkDiagnostics_RxAddressFilteredCount = 47
-const ATTRIBUTE_ID_ThreadNetworkDiagnostics_RxDestAddrFilteredCount = 48
-const ATTRIBUTE_ID_ThreadNetworkDiagnostics_RxDuplicatedCount = 49
-const ATTRIBUTE_ID_ThreadNetworkDiagnostics_RxErrNoFrameCount = 50
-const ATTRIBUTE_ID_ThreadNetworkDiagnostics_RxErrUnknownNeighborCount = 51
-const ATTRIBUTE_ID_ThreadNetworkDiagnostics_RxErrInvalidSrcAddrCount = 52
-const ATTRIBUTE_ID_ThreadNetworkDiagnostics_RxErrSecCount = 53
-const ATTRIBUTE_ID_ThreadNetworkDiagnostics_RxErrFcsCount = 54
-const ATTRIBUTE_ID_ThreadNetworkDiagnostics_RxErrOtherCount = 55
-const ATTRIBUTE_ID_ThreadNetworkDiagnostics_ActiveTimestamp = 56
-const ATTRIBUTE_ID_ThreadNetworkDiagnostics_PendingTimestamp = 57
-const ATTRIBUTE_ID_ThreadNetworkDiagnostics_Delay = 58
-const ATTRIBUTE_ID_ThreadNetworkDiagnostics_SecurityPolicy = 59
-const ATTRIBUTE_ID_ThreadNetworkDiagnostics_ChannelPage0Mask = 60
-const ATTRIBUTE_ID_ThreadNetworkDiagnostics_OperationalDatasetComponents = 61
-const ATTRIBUTE_ID_ThreadNetworkDiagnostics_ActiveNetworkFaults = 62
-const CLUSTER_ID_FlowMeasurement = 0x404
-const ATTRIBUTE_ID_FlowMeasurement_MeasuredValue = 0
-const ATTRIBUTE_ID_FlowMeasurement_MinMeasuredValue = 1
-const ATTRIBUTE_ID_FlowMeasurement_MaxMeasuredValue = 2
-const ATTRIBUTE_ID_FlowMeasurement_Tolerance = 3
+const CLUSTER_ID_Identify = 0x3
+const COMMAND_ID_Identify_Identify = 0
+const COMMAND_ID_Identify_TriggerEffect = 64
+const ATTRIBUTE_ID_Identify_IdentifyTime = 0
+const ATTRIBUTE_ID_Identify_IdentifyType = 1
+const CLUSTER_ID_Groups = 0x4
+const COMMAND_ID_Groups_AddGroup = 0
+const COMMAND_ID_Groups_AddGroupResponse = 0
+const COMMAND_ID_Groups_ViewGroup = 1
+const COMMAND_ID_Groups_ViewGroupResponse = 1
+const COMMAND_ID_Groups_GetGroupMembership = 2
+const COMMAND_ID_Groups_GetGroupMembershipResponse = 2
+const COMMAND_ID_Groups_RemoveGroup = 3
+const COMMAND_ID_Groups_RemoveGroupResponse = 3
+const COMMAND_ID_Groups_RemoveAllGroups = 4
+const COMMAND_ID_Groups_AddGroupIfIdentifying = 5
+const ATTRIBUTE_ID_Groups_NameSupport = 0
+const CLUSTER_ID_Scenes = 0x5
+const COMMAND_ID_Scenes_AddScene = 0
+const COMMAND_ID_Scenes_AddSceneResponse = 0
+const COMMAND_ID_Scenes_ViewScene = 1
+const COMMAND_ID_Scenes_ViewSceneResponse = 1
+const COMMAND_ID_Scenes_RemoveScene = 2
+const COMMAND_ID_Scenes_RemoveSceneResponse = 2
+const COMMAND_ID_Scenes_RemoveAllScenes = 3
+const COMMAND_ID_Scenes_RemoveAllScenesResponse = 3
+const COMMAND_ID_Scenes_StoreScene = 4
+const COMMAND_ID_Scenes_StoreSceneResponse = 4
+const COMMAND_ID_Scenes_RecallScene = 5
+const COMMAND_ID_Scenes_GetSceneMembership = 6
+const COMMAND_ID_Scenes_GetSceneMembershipResponse = 6
+const COMMAND_ID_Scenes_EnhancedAddScene = 64
+const COMMAND_ID_Scenes_EnhancedAddSceneResponse = 64
+const COMMAND_ID_Scenes_EnhancedViewScene = 65
+const COMMAND_ID_Scenes_EnhancedViewSceneResponse = 65
+const COMMAND_ID_Scenes_CopyScene = 66
+const COMMAND_ID_Scenes_CopySceneResponse = 66
+const ATTRIBUTE_ID_Scenes_SceneCount = 0
+const ATTRIBUTE_ID_Scenes_CurrentScene = 1
+const ATTRIBUTE_ID_Scenes_CurrentGroup = 2
+const ATTRIBUTE_ID_Scenes_SceneValid = 3
+const ATTRIBUTE_ID_Scenes_NameSupport = 4
+const ATTRIBUTE_ID_Scenes_LastConfiguredBy = 5
+const ATTRIBUTE_ID_Scenes_SceneTableSize = 6
+const ATTRIBUTE_ID_Scenes_FabricSceneInfo = 7
+const CLUSTER_ID_OnOff = 0x6
+const COMMAND_ID_OnOff_Off = 0
+const COMMAND_ID_OnOff_On = 1
+const COMMAND_ID_OnOff_Toggle = 2
+const COMMAND_ID_OnOff_OffWithEffect = 64
+const COMMAND_ID_OnOff_OnWithRecallGlobalScene = 65
+const COMMAND_ID_OnOff_OnWithTimedOff = 66
+const ATTRIBUTE_ID_OnOff_OnOff = 0
+const ATTRIBUTE_ID_OnOff_GlobalSceneControl = 16384
+const ATTRIBUTE_ID_OnOff_OnTime = 16385
+const ATTRIBUTE_ID_OnOff_OffWaitTime = 16386
+const ATTRIBUTE_ID_OnOff_StartUpOnOff = 16387
 const CLUSTER_ID_LevelControl = 0x8
 const COMMAND_ID_LevelControl_MoveToLevel = 0
 const COMMAND_ID_LevelControl_Move = 1
@@ -231,127 +81,40 @@ const ATTRIBUTE_ID_LevelControl_OnTransitionTime = 18
 const ATTRIBUTE_ID_LevelControl_OffTransitionTime = 19
 const ATTRIBUTE_ID_LevelControl_DefaultMoveRate = 20
 const ATTRIBUTE_ID_LevelControl_StartUpCurrentLevel = 16384
-const CLUSTER_ID_Switch = 0x3b
-const ATTRIBUTE_ID_Switch_NumberOfPositions = 0
-const ATTRIBUTE_ID_Switch_CurrentPosition = 1
-const ATTRIBUTE_ID_Switch_MultiPressMax = 2
-const CLUSTER_ID_Thermostat = 0x201
-const COMMAND_ID_Thermostat_SetpointRaiseLower = 0
-const COMMAND_ID_Thermostat_GetWeeklyScheduleResponse = 0
-const COMMAND_ID_Thermostat_SetWeeklySchedule = 1
-const COMMAND_ID_Thermostat_GetRelayStatusLogResponse = 1
-const COMMAND_ID_Thermostat_GetWeeklySchedule = 2
-const COMMAND_ID_Thermostat_ClearWeeklySchedule = 3
-const COMMAND_ID_Thermostat_GetRelayStatusLog = 4
-const ATTRIBUTE_ID_Thermostat_LocalTemperature = 0
-const ATTRIBUTE_ID_Thermostat_OutdoorTemperature = 1
-const ATTRIBUTE_ID_Thermostat_Occupancy = 2
-const ATTRIBUTE_ID_Thermostat_AbsMinHeatSetpointLimit = 3
-const ATTRIBUTE_ID_Thermostat_AbsMaxHeatSetpointLimit = 4
-const ATTRIBUTE_ID_Thermostat_AbsMinCoolSetpointLimit = 5
-const ATTRIBUTE_ID_Thermostat_AbsMaxCoolSetpointLimit = 6
-const ATTRIBUTE_ID_Thermostat_PICoolingDemand = 7
-const ATTRIBUTE_ID_Thermostat_PIHeatingDemand = 8
-const ATTRIBUTE_ID_Thermostat_HVACSystemTypeConfiguration = 9
-const ATTRIBUTE_ID_Thermostat_LocalTemperatureCalibration = 16
-const ATTRIBUTE_ID_Thermostat_OccupiedCoolingSetpoint = 17
-const ATTRIBUTE_ID_Thermostat_OccupiedHeatingSetpoint = 18
-const ATTRIBUTE_ID_Thermostat_UnoccupiedCoolingSetpoint = 19
-const ATTRIBUTE_ID_Thermostat_UnoccupiedHeatingSetpoint = 20
-const ATTRIBUTE_ID_Thermostat_MinHeatSetpointLimit = 21
-const ATTRIBUTE_ID_Thermostat_MaxHeatSetpointLimit = 22
-const ATTRIBUTE_ID_Thermostat_MinCoolSetpointLimit = 23
-const ATTRIBUTE_ID_Thermostat_MaxCoolSetpointLimit = 24
-const ATTRIBUTE_ID_Thermostat_MinSetpointDeadBand = 25
-const ATTRIBUTE_ID_Thermostat_RemoteSensing = 26
-const ATTRIBUTE_ID_Thermostat_ControlSequenceOfOperation = 27
-const ATTRIBUTE_ID_Thermostat_SystemMode = 28
-const ATTRIBUTE_ID_Thermostat_AlarmMask = 29
-const ATTRIBUTE_ID_Thermostat_ThermostatRunningMode = 30
-const ATTRIBUTE_ID_Thermostat_StartOfWeek = 32
-const ATTRIBUTE_ID_Thermostat_NumberOfWeeklyTransitions = 33
-const ATTRIBUTE_ID_Thermostat_NumberOfDailyTransitions = 34
-const ATTRIBUTE_ID_Thermostat_TemperatureSetpointHold = 35
-const ATTRIBUTE_ID_Thermostat_TemperatureSetpointHoldDuration = 36
-const ATTRIBUTE_ID_Thermostat_ThermostatProgrammingOperationMode = 37
-const ATTRIBUTE_ID_Thermostat_ThermostatRunningState = 41
-const ATTRIBUTE_ID_Thermostat_SetpointChangeSource = 48
-const ATTRIBUTE_ID_Thermostat_SetpointChangeAmount = 49
-const ATTRIBUTE_ID_Thermostat_SetpointChangeSourceTimestamp = 50
-const ATTRIBUTE_ID_Thermostat_OccupiedSetback = 52
-const ATTRIBUTE_ID_Thermostat_OccupiedSetbackMin = 53
-const ATTRIBUTE_ID_Thermostat_OccupiedSetbackMax = 54
-const ATTRIBUTE_ID_Thermostat_UnoccupiedSetback = 55
-const ATTRIBUTE_ID_Thermostat_UnoccupiedSetbackMin = 56
-const ATTRIBUTE_ID_Thermostat_UnoccupiedSetbackMax = 57
-const ATTRIBUTE_ID_Thermostat_EmergencyHeatDelta = 58
-const ATTRIBUTE_ID_Thermostat_ACType = 64
-const ATTRIBUTE_ID_Thermostat_ACCapacity = 65
-const ATTRIBUTE_ID_Thermostat_ACRefrigerantType = 66
-const ATTRIBUTE_ID_Thermostat_ACCompressorType = 67
-const ATTRIBUTE_ID_Thermostat_ACErrorCode = 68
-const ATTRIBUTE_ID_Thermostat_ACLouverPosition = 69
-const ATTRIBUTE_ID_Thermostat_ACCoilTemperature = 70
-const ATTRIBUTE_ID_Thermostat_ACCapacityFormat = 71
-const CLUSTER_ID_RefrigeratorAlarm = 0x57
-const COMMAND_ID_RefrigeratorAlarm_ModifyEnabledAlarms = 1
-const CLUSTER_ID_ElectricalEnergyMeasurement = 0x91
-const ATTRIBUTE_ID_ElectricalEnergyMeasurement_Measured = 0
-const ATTRIBUTE_ID_ElectricalEnergyMeasurement_CumulativeEnergyImportedTime = 1
-const ATTRIBUTE_ID_ElectricalEnergyMeasurement_CumulativeEnergyImported = 2
-const ATTRIBUTE_ID_ElectricalEnergyMeasurement_CumulativeEnergyExportedTime = 3
-const ATTRIBUTE_ID_ElectricalEnergyMeasurement_CumulativeEnergyExported = 4
-const ATTRIBUTE_ID_ElectricalEnergyMeasurement_PeriodicEnergyImportedStartTime = 5
-const ATTRIBUTE_ID_ElectricalEnergyMeasurement_PeriodicEnergyImportedEndTime = 6
-const ATTRIBUTE_ID_ElectricalEnergyMeasurement_PeriodicEnergyImported = 7
-const ATTRIBUTE_ID_ElectricalEnergyMeasurement_PeriodicEnergyExportedStartTime = 8
-const ATTRIBUTE_ID_ElectricalEnergyMeasurement_PeriodicEnergyExportedEndTime = 9
-const ATTRIBUTE_ID_ElectricalEnergyMeasurement_PeriodicEnergyExported = 10
-const ATTRIBUTE_ID_ElectricalEnergyMeasurement_EphemeralEnergyImported = 11
-const CLUSTER_ID_IlluminanceMeasurement = 0x400
-const ATTRIBUTE_ID_IlluminanceMeasurement_MeasuredValue = 0
-const ATTRIBUTE_ID_IlluminanceMeasurement_MinMeasuredValue = 1
-const ATTRIBUTE_ID_IlluminanceMeasurement_MaxMeasuredValue = 2
-const ATTRIBUTE_ID_IlluminanceMeasurement_Tolerance = 3
-const ATTRIBUTE_ID_IlluminanceMeasurement_LightSensorType = 4
-const CLUSTER_ID_FixedLabel = 0x40
-const ATTRIBUTE_ID_FixedLabel_LabelList = 0
-const CLUSTER_ID_LaundryWasherMode = 0x51
-const ATTRIBUTE_ID_LaundryWasherMode_SupportedModes = 0
-const ATTRIBUTE_ID_LaundryWasherMode_CurrentMode = 1
-const ATTRIBUTE_ID_LaundryWasherMode_StartUpMode = 2
-const ATTRIBUTE_ID_LaundryWasherMode_OnMode = 3
-const CLUSTER_ID_NetworkCommissioning = 0x31
-const COMMAND_ID_NetworkCommissioning_ScanNetworks = 0
-const COMMAND_ID_NetworkCommissioning_ScanNetworksResponse = 1
-const COMMAND_ID_NetworkCommissioning_AddOrUpdateWiFiNetwork = 2
-const COMMAND_ID_NetworkCommissioning_AddOrUpdateThreadNetwork = 3
-const COMMAND_ID_NetworkCommissioning_RemoveNetwork = 4
-const COMMAND_ID_NetworkCommissioning_NetworkConfigResponse = 5
-const COMMAND_ID_NetworkCommissioning_ConnectNetwork = 6
-const COMMAND_ID_NetworkCommissioning_ConnectNetworkResponse = 7
-const COMMAND_ID_NetworkCommissioning_ReorderNetwork = 8
-const ATTRIBUTE_ID_NetworkCommissioning_MaxNetworks = 0
-const ATTRIBUTE_ID_NetworkCommissioning_Networks = 1
-const ATTRIBUTE_ID_NetworkCommissioning_ScanMaxTimeSeconds = 2
-const ATTRIBUTE_ID_NetworkCommissioning_ConnectMaxTimeSeconds = 3
-const ATTRIBUTE_ID_NetworkCommissioning_InterfaceEnabled = 4
-const ATTRIBUTE_ID_NetworkCommissioning_LastNetworkingStatus = 5
-const ATTRIBUTE_ID_NetworkCommissioning_LastNetworkID = 6
-const ATTRIBUTE_ID_NetworkCommissioning_LastConnectErrorValue = 7
-const ATTRIBUTE_ID_NetworkCommissioning_SupportedWiFiBands = 8
-const ATTRIBUTE_ID_NetworkCommissioning_SupportedThreadFeatures = 9
-const ATTRIBUTE_ID_NetworkCommissioning_ThreadVersion = 10
-const CLUSTER_ID_OvenCavityOperationalState = 0x48
-const CLUSTER_ID_AirQuality = 0x5b
-const ATTRIBUTE_ID_AirQuality_AirQuality = 0
-const CLUSTER_ID_ApplicationLauncher = 0x50c
-const COMMAND_ID_ApplicationLauncher_LaunchApp = 0
-const COMMAND_ID_ApplicationLauncher_StopApp = 1
-const COMMAND_ID_ApplicationLauncher_HideApp = 2
-const COMMAND_ID_ApplicationLauncher_LauncherResponse = 3
-const ATTRIBUTE_ID_ApplicationLauncher_CatalogList = 0
-const ATTRIBUTE_ID_ApplicationLauncher_CurrentApp = 1
+const CLUSTER_ID_Descriptor = 0x1d
+const ATTRIBUTE_ID_Descriptor_DeviceTypeList = 0
+const ATTRIBUTE_ID_Descriptor_ServerList = 1
+const ATTRIBUTE_ID_Descriptor_ClientList = 2
+const ATTRIBUTE_ID_Descriptor_PartsList = 3
+const ATTRIBUTE_ID_Descriptor_TagList = 4
+const CLUSTER_ID_Binding = 0x1e
+const ATTRIBUTE_ID_Binding_Binding = 0
+const CLUSTER_ID_AccessControl = 0x1f
+const ATTRIBUTE_ID_AccessControl_ACL = 0
+const ATTRIBUTE_ID_AccessControl_Extension = 1
+const ATTRIBUTE_ID_AccessControl_SubjectsPerAccessControlEntry = 2
+const ATTRIBUTE_ID_AccessControl_TargetsPerAccessControlEntry = 3
+const ATTRIBUTE_ID_AccessControl_AccessControlEntriesPerFabric = 4
+const EVENT_ID_AccessControl_AccessControlEntryChanged = 0
+const EVENT_ID_AccessControl_AccessControlExtensionChanged = 1
+const CLUSTER_ID_Actions = 0x25
+const COMMAND_ID_Actions_InstantAction = 0
+const COMMAND_ID_Actions_InstantActionWithTransition = 1
+const COMMAND_ID_Actions_StartAction = 2
+const COMMAND_ID_Actions_StartActionWithDuration = 3
+const COMMAND_ID_Actions_StopAction = 4
+const COMMAND_ID_Actions_PauseAction = 5
+const COMMAND_ID_Actions_PauseActionWithDuration = 6
+const COMMAND_ID_Actions_ResumeAction = 7
+const COMMAND_ID_Actions_EnableAction = 8
+const COMMAND_ID_Actions_EnableActionWithDuration = 9
+const COMMAND_ID_Actions_DisableAction = 10
+const COMMAND_ID_Actions_DisableActionWithDuration = 11
+const ATTRIBUTE_ID_Actions_ActionList = 0
+const ATTRIBUTE_ID_Actions_EndpointLists = 1
+const ATTRIBUTE_ID_Actions_SetupURL = 2
+const EVENT_ID_Actions_StateChanged = 0
+const EVENT_ID_Actions_ActionFailed = 1
 const CLUSTER_ID_BasicInformation = 0x28
 const ATTRIBUTE_ID_BasicInformation_DataModelRevision = 0
 const ATTRIBUTE_ID_BasicInformation_VendorName = 1
@@ -376,75 +139,244 @@ const ATTRIBUTE_ID_BasicInformation_CapabilityMinima = 19
 const ATTRIBUTE_ID_BasicInformation_ProductAppearance = 20
 const ATTRIBUTE_ID_BasicInformation_SpecificationVersion = 21
 const ATTRIBUTE_ID_BasicInformation_MaxPathsPerInvoke = 22
-const CLUSTER_ID_EnergyPrice = 0x95
-const COMMAND_ID_EnergyPrice_GetDetailedPriceRequest = 0
-const COMMAND_ID_EnergyPrice_GetDetailedPriceResponse = 1
-const COMMAND_ID_EnergyPrice_GetDetailedForecastRequest = 2
-const COMMAND_ID_EnergyPrice_GetDetailedForecastResponse = 3
-const ATTRIBUTE_ID_EnergyPrice_UnitOfMeasure = 0
-const ATTRIBUTE_ID_EnergyPrice_CurrentPrice = 1
-const ATTRIBUTE_ID_EnergyPrice_PriceForecast = 2
-const CLUSTER_ID_MediaInput = 0x507
-const COMMAND_ID_MediaInput_SelectInput = 0
-const COMMAND_ID_MediaInput_ShowInputStatus = 1
-const COMMAND_ID_MediaInput_HideInputStatus = 2
-const COMMAND_ID_MediaInput_RenameInput = 3
-const ATTRIBUTE_ID_MediaInput_InputList = 0
-const ATTRIBUTE_ID_MediaInput_CurrentInput = 1
-const CLUSTER_ID_TemperatureControl = 0x56
-const COMMAND_ID_TemperatureControl_SetTemperature = 0
-const CLUSTER_ID_ContentAppObserver = 0x510
-const COMMAND_ID_ContentAppObserver_ContentAppMessage = 0
-const COMMAND_ID_ContentAppObserver_ContentAppMessageResponse = 1
-const CLUSTER_ID_ElectricalPowerMeasurement = 0x90
-const ATTRIBUTE_ID_ElectricalPowerMeasurement_PowerMode = 0
-const ATTRIBUTE_ID_ElectricalPowerMeasurement_Accuracy = 1
-const ATTRIBUTE_ID_ElectricalPowerMeasurement_Ranges = 2
-const ATTRIBUTE_ID_ElectricalPowerMeasurement_Voltage = 3
-const ATTRIBUTE_ID_ElectricalPowerMeasurement_Current = 4
-const ATTRIBUTE_ID_ElectricalPowerMeasurement_ActivePower = 5
-const ATTRIBUTE_ID_ElectricalPowerMeasurement_RMSCurrent = 6
-const ATTRIBUTE_ID_ElectricalPowerMeasurement_RMSPower = 7
-const ATTRIBUTE_ID_ElectricalPowerMeasurement_ApparentPower = 8
-const ATTRIBUTE_ID_ElectricalPowerMeasurement_ReactivePower = 9
-const ATTRIBUTE_ID_ElectricalPowerMeasurement_Frequency = 10
-const ATTRIBUTE_ID_ElectricalPowerMeasurement_HarmonicCurrents = 11
-const ATTRIBUTE_ID_ElectricalPowerMeasurement_HarmonicPhases = 12
-const ATTRIBUTE_ID_ElectricalPowerMeasurement_PowerFactor = 13
-const ATTRIBUTE_ID_ElectricalPowerMeasurement_LineCurrent = 14
-const ATTRIBUTE_ID_ElectricalPowerMeasurement_NeutralCurrent = 15
-const CLUSTER_ID_WaterHeaterManagement = 0x94
-const COMMAND_ID_WaterHeaterManagement_Boost = 1
-const COMMAND_ID_WaterHeaterManagement_CancelBoost = 1
-const ATTRIBUTE_ID_WaterHeaterManagement_HeaterTypes = 0
-const ATTRIBUTE_ID_WaterHeaterManagement_HeatDemand = 1
-const ATTRIBUTE_ID_WaterHeaterManagement_TankVolume = 2
-const ATTRIBUTE_ID_WaterHeaterManagement_EstimatedHeatRequired = 3
-const ATTRIBUTE_ID_WaterHeaterManagement_TankPercentage = 4
-const CLUSTER_ID_Actions = 0x25
-const COMMAND_ID_Actions_InstantAction = 0
-const COMMAND_ID_Actions_InstantActionWithTransition = 1
-const COMMAND_ID_Actions_StartAction = 2
-const COMMAND_ID_Actions_StartActionWithDuration = 3
-const COMMAND_ID_Actions_StopAction = 4
-const COMMAND_ID_Actions_PauseAction = 5
-const COMMAND_ID_Actions_PauseActionWithDuration = 6
-const COMMAND_ID_Actions_ResumeAction = 7
-const COMMAND_ID_Actions_EnableAction = 8
-const COMMAND_ID_Actions_EnableActionWithDuration = 9
-const COMMAND_ID_Actions_DisableAction = 10
-const COMMAND_ID_Actions_DisableActionWithDuration = 11
-const ATTRIBUTE_ID_Actions_ActionList = 0
-const ATTRIBUTE_ID_Actions_EndpointLists = 1
-const ATTRIBUTE_ID_Actions_SetupURL = 2
+const EVENT_ID_BasicInformation_StartUp = 0
+const EVENT_ID_BasicInformation_ShutDown = 1
+const EVENT_ID_BasicInformation_Leave = 2
+const EVENT_ID_BasicInformation_ReachableChanged = 3
+const CLUSTER_ID_LocalizationConfiguration = 0x2b
+const ATTRIBUTE_ID_LocalizationConfiguration_ActiveLocale = 0
+const ATTRIBUTE_ID_LocalizationConfiguration_SupportedLocales = 1
+const CLUSTER_ID_TimeFormatLocalization = 0x2c
+const ATTRIBUTE_ID_TimeFormatLocalization_HourFormat = 0
+const ATTRIBUTE_ID_TimeFormatLocalization_ActiveCalendarType = 1
+const ATTRIBUTE_ID_TimeFormatLocalization_SupportedCalendarTypes = 2
+const CLUSTER_ID_UnitLocalization = 0x2d
+const ATTRIBUTE_ID_UnitLocalization_TemperatureUnit = 0
+const CLUSTER_ID_PowerSourceConfiguration = 0x2e
+const ATTRIBUTE_ID_PowerSourceConfiguration_Sources = 0
+const CLUSTER_ID_PowerSource = 0x2f
+const ATTRIBUTE_ID_PowerSource_Status = 0
+const ATTRIBUTE_ID_PowerSource_Order = 1
+const ATTRIBUTE_ID_PowerSource_Description = 2
+const ATTRIBUTE_ID_PowerSource_WiredAssessedInputVoltage = 3
+const ATTRIBUTE_ID_PowerSource_WiredAssessedInputFrequency = 4
+const ATTRIBUTE_ID_PowerSource_WiredCurrentType = 5
+const ATTRIBUTE_ID_PowerSource_WiredAssessedCurrent = 6
+const ATTRIBUTE_ID_PowerSource_WiredNominalVoltage = 7
+const ATTRIBUTE_ID_PowerSource_WiredMaximumCurrent = 8
+const ATTRIBUTE_ID_PowerSource_WiredPresent = 9
+const ATTRIBUTE_ID_PowerSource_ActiveWiredFaults = 10
+const ATTRIBUTE_ID_PowerSource_BatVoltage = 11
+const ATTRIBUTE_ID_PowerSource_BatPercentRemaining = 12
+const ATTRIBUTE_ID_PowerSource_BatTimeRemaining = 13
+const ATTRIBUTE_ID_PowerSource_BatChargeLevel = 14
+const ATTRIBUTE_ID_PowerSource_BatReplacementNeeded = 15
+const ATTRIBUTE_ID_PowerSource_BatReplaceability = 16
+const ATTRIBUTE_ID_PowerSource_BatPresent = 17
+const ATTRIBUTE_ID_PowerSource_ActiveBatFaults = 18
+const ATTRIBUTE_ID_PowerSource_BatReplacementDescription = 19
+const ATTRIBUTE_ID_PowerSource_BatCommonDesignation = 20
+const ATTRIBUTE_ID_PowerSource_BatANSIDesignation = 21
+const ATTRIBUTE_ID_PowerSource_BatIECDesignation = 22
+const ATTRIBUTE_ID_PowerSource_BatApprovedChemistry = 23
+const ATTRIBUTE_ID_PowerSource_BatCapacity = 24
+const ATTRIBUTE_ID_PowerSource_BatQuantity = 25
+const ATTRIBUTE_ID_PowerSource_BatChargeState = 26
+const ATTRIBUTE_ID_PowerSource_BatTimeToFullCharge = 27
+const ATTRIBUTE_ID_PowerSource_BatFunctionalWhileCharging = 28
+const ATTRIBUTE_ID_PowerSource_BatChargingCurrent = 29
+const ATTRIBUTE_ID_PowerSource_ActiveBatChargeFaults = 30
+const ATTRIBUTE_ID_PowerSource_EndpointList = 31
+const EVENT_ID_PowerSource_WiredFaultChange = 0
+const EVENT_ID_PowerSource_BatFaultChange = 1
+const EVENT_ID_PowerSource_BatChargeFaultChange = 2
+const CLUSTER_ID_GeneralCommissioning = 0x30
+const COMMAND_ID_GeneralCommissioning_ArmFailSafe = 0
+const COMMAND_ID_GeneralCommissioning_ArmFailSafeResponse = 1
+const COMMAND_ID_GeneralCommissioning_SetRegulatoryConfig = 2
+const COMMAND_ID_GeneralCommissioning_SetRegulatoryConfigResponse = 3
+const COMMAND_ID_GeneralCommissioning_CommissioningComplete = 4
+const COMMAND_ID_GeneralCommissioning_CommissioningCompleteResponse = 5
+const ATTRIBUTE_ID_GeneralCommissioning_Breadcrumb = 0
+const ATTRIBUTE_ID_GeneralCommissioning_BasicCommissioningInfo = 1
+const ATTRIBUTE_ID_GeneralCommissioning_RegulatoryConfig = 2
+const ATTRIBUTE_ID_GeneralCommissioning_LocationCapability = 3
+const ATTRIBUTE_ID_GeneralCommissioning_SupportsConcurrentConnection = 4
+const CLUSTER_ID_NetworkCommissioning = 0x31
+const COMMAND_ID_NetworkCommissioning_ScanNetworks = 0
+const COMMAND_ID_NetworkCommissioning_ScanNetworksResponse = 1
+const COMMAND_ID_NetworkCommissioning_AddOrUpdateWiFiNetwork = 2
+const COMMAND_ID_NetworkCommissioning_AddOrUpdateThreadNetwork = 3
+const COMMAND_ID_NetworkCommissioning_RemoveNetwork = 4
+const COMMAND_ID_NetworkCommissioning_NetworkConfigResponse = 5
+const COMMAND_ID_NetworkCommissioning_ConnectNetwork = 6
+const COMMAND_ID_NetworkCommissioning_ConnectNetworkResponse = 7
+const COMMAND_ID_NetworkCommissioning_ReorderNetwork = 8
+const ATTRIBUTE_ID_NetworkCommissioning_MaxNetworks = 0
+const ATTRIBUTE_ID_NetworkCommissioning_Networks = 1
+const ATTRIBUTE_ID_NetworkCommissioning_ScanMaxTimeSeconds = 2
+const ATTRIBUTE_ID_NetworkCommissioning_ConnectMaxTimeSeconds = 3
+const ATTRIBUTE_ID_NetworkCommissioning_InterfaceEnabled = 4
+const ATTRIBUTE_ID_NetworkCommissioning_LastNetworkingStatus = 5
+const ATTRIBUTE_ID_NetworkCommissioning_LastNetworkID = 6
+const ATTRIBUTE_ID_NetworkCommissioning_LastConnectErrorValue = 7
+const ATTRIBUTE_ID_NetworkCommissioning_SupportedWiFiBands = 8
+const ATTRIBUTE_ID_NetworkCommissioning_SupportedThreadFeatures = 9
+const ATTRIBUTE_ID_NetworkCommissioning_ThreadVersion = 10
+const CLUSTER_ID_DiagnosticLogs = 0x32
+const COMMAND_ID_DiagnosticLogs_RetrieveLogsRequest = 0
+const COMMAND_ID_DiagnosticLogs_RetrieveLogsResponse = 1
+const CLUSTER_ID_GeneralDiagnostics = 0x33
+const COMMAND_ID_GeneralDiagnostics_TestEventTrigger = 0
+const COMMAND_ID_GeneralDiagnostics_TimeSnapshot = 1
+const COMMAND_ID_GeneralDiagnostics_TimeSnapshotResponse = 2
+const ATTRIBUTE_ID_GeneralDiagnostics_NetworkInterfaces = 0
+const ATTRIBUTE_ID_GeneralDiagnostics_RebootCount = 1
+const ATTRIBUTE_ID_GeneralDiagnostics_UpTime = 2
+const ATTRIBUTE_ID_GeneralDiagnostics_TotalOperationalHours = 3
+const ATTRIBUTE_ID_GeneralDiagnostics_BootReason = 4
+const ATTRIBUTE_ID_GeneralDiagnostics_ActiveHardwareFaults = 5
+const ATTRIBUTE_ID_GeneralDiagnostics_ActiveRadioFaults = 6
+const ATTRIBUTE_ID_GeneralDiagnostics_ActiveNetworkFaults = 7
+const ATTRIBUTE_ID_GeneralDiagnostics_TestEventTriggersEnabled = 8
+const EVENT_ID_GeneralDiagnostics_HardwareFaultChange = 0
+const EVENT_ID_GeneralDiagnostics_RadioFaultChange = 1
+const EVENT_ID_GeneralDiagnostics_NetworkFaultChange = 2
+const EVENT_ID_GeneralDiagnostics_BootReason = 3
+const CLUSTER_ID_SoftwareDiagnostics = 0x34
+const COMMAND_ID_SoftwareDiagnostics_ResetWatermarks = 0
+const ATTRIBUTE_ID_SoftwareDiagnostics_ThreadMetrics = 0
+const ATTRIBUTE_ID_SoftwareDiagnostics_CurrentHeapFree = 1
+const ATTRIBUTE_ID_SoftwareDiagnostics_CurrentHeapUsed = 2
+const ATTRIBUTE_ID_SoftwareDiagnostics_CurrentHeapHighWatermark = 3
+const EVENT_ID_SoftwareDiagnostics_SoftwareFault = 0
+const CLUSTER_ID_ThreadNetworkDiagnostics = 0x35
+const COMMAND_ID_ThreadNetworkDiagnostics_ResetCounts = 0
+const ATTRIBUTE_ID_ThreadNetworkDiagnostics_Channel = 0
+const ATTRIBUTE_ID_ThreadNetworkDiagnostics_RoutingRole = 1
+const ATTRIBUTE_ID_ThreadNetworkDiagnostics_NetworkName = 2
+const ATTRIBUTE_ID_ThreadNetworkDiagnostics_PanId = 3
+const ATTRIBUTE_ID_ThreadNetworkDiagnostics_ExtendedPanId = 4
+const ATTRIBUTE_ID_ThreadNetworkDiagnostics_MeshLocalPrefix = 5
+const ATTRIBUTE_ID_ThreadNetworkDiagnostics_OverrunCount = 6
+const ATTRIBUTE_ID_ThreadNetworkDiagnostics_NeighborTable = 7
+const ATTRIBUTE_ID_ThreadNetworkDiagnostics_RouteTable = 8
+const ATTRIBUTE_ID_ThreadNetworkDiagnostics_PartitionId = 9
+const ATTRIBUTE_ID_ThreadNetworkDiagnostics_Weighting = 10
+const ATTRIBUTE_ID_ThreadNetworkDiagnostics_DataVersion = 11
+const ATTRIBUTE_ID_ThreadNetworkDiagnostics_StableDataVersion = 12
+const ATTRIBUTE_ID_ThreadNetworkDiagnostics_LeaderRouterId = 13
+const ATTRIBUTE_ID_ThreadNetworkDiagnostics_DetachedRoleCount = 14
+const ATTRIBUTE_ID_ThreadNetworkDiagnostics_ChildRoleCount = 15
+const ATTRIBUTE_ID_ThreadNetworkDiagnostics_RouterRoleCount = 16
+const ATTRIBUTE_ID_ThreadNetworkDiagnostics_LeaderRoleCount = 17
+const ATTRIBUTE_ID_ThreadNetworkDiagnostics_AttachAttemptCount = 18
+const ATTRIBUTE_ID_ThreadNetworkDiagnostics_PartitionIdChangeCount = 19
+const ATTRIBUTE_ID_ThreadNetworkDiagnostics_BetterPartitionAttachAttemptCount = 20
+const ATTRIBUTE_ID_ThreadNetworkDiagnostics_ParentChangeCount = 21
+const ATTRIBUTE_ID_ThreadNetworkDiagnostics_TxTotalCount = 22
+const ATTRIBUTE_ID_ThreadNetworkDiagnostics_TxUnicastCount = 23
+const ATTRIBUTE_ID_ThreadNetworkDiagnostics_TxBroadcastCount = 24
+const ATTRIBUTE_ID_ThreadNetworkDiagnostics_TxAckRequestedCount = 25
+const ATTRIBUTE_ID_ThreadNetworkDiagnostics_TxAckedCount = 26
+const ATTRIBUTE_ID_ThreadNetworkDiagnostics_TxNoAckRequestedCount = 27
+const ATTRIBUTE_ID_ThreadNetworkDiagnostics_TxDataCount = 28
+const ATTRIBUTE_ID_ThreadNetworkDiagnostics_TxDataPollCount = 29
+const ATTRIBUTE_ID_ThreadNetworkDiagnostics_TxBeaconCount = 30
+const ATTRIBUTE_ID_ThreadNetworkDiagnostics_TxBeaconRequestCount = 31
+const ATTRIBUTE_ID_ThreadNetworkDiagnostics_TxOtherCount = 32
+const ATTRIBUTE_ID_ThreadNetworkDiagnostics_TxRetryCount = 33
+const ATTRIBUTE_ID_ThreadNetworkDiagnostics_TxDirectMaxRetryExpiryCount = 34
+const ATTRIBUTE_ID_ThreadNetworkDiagnostics_TxIndirectMaxRetryExpiryCount = 35
+const ATTRIBUTE_ID_ThreadNetworkDiagnostics_TxErrCcaCount = 36
+const ATTRIBUTE_ID_ThreadNetworkDiagnostics_TxErrAbortCount = 37
+const ATTRIBUTE_ID_ThreadNetworkDiagnostics_TxErrBusyChannelCount = 38
+const ATTRIBUTE_ID_ThreadNetworkDiagnostics_RxTotalCount = 39
+const ATTRIBUTE_ID_ThreadNetworkDiagnostics_RxUnicastCount = 40
+const ATTRIBUTE_ID_ThreadNetworkDiagnostics_RxBroadcastCount = 41
+const ATTRIBUTE_ID_ThreadNetworkDiagnostics_RxDataCount = 42
+const ATTRIBUTE_ID_ThreadNetworkDiagnostics_RxDataPollCount = 43
+const ATTRIBUTE_ID_ThreadNetworkDiagnostics_RxBeaconCount = 44
+const ATTRIBUTE_ID_ThreadNetworkDiagnostics_RxBeaconRequestCount = 45
+const ATTRIBUTE_ID_ThreadNetworkDiagnostics_RxOtherCount = 46
+const ATTRIBUTE_ID_ThreadNetworkDiagnostics_RxAddressFilteredCount = 47
+const ATTRIBUTE_ID_ThreadNetworkDiagnostics_RxDestAddrFilteredCount = 48
+const ATTRIBUTE_ID_ThreadNetworkDiagnostics_RxDuplicatedCount = 49
+const ATTRIBUTE_ID_ThreadNetworkDiagnostics_RxErrNoFrameCount = 50
+const ATTRIBUTE_ID_ThreadNetworkDiagnostics_RxErrUnknownNeighborCount = 51
+const ATTRIBUTE_ID_ThreadNetworkDiagnostics_RxErrInvalidSrcAddrCount = 52
+const ATTRIBUTE_ID_ThreadNetworkDiagnostics_RxErrSecCount = 53
+const ATTRIBUTE_ID_ThreadNetworkDiagnostics_RxErrFcsCount = 54
+const ATTRIBUTE_ID_ThreadNetworkDiagnostics_RxErrOtherCount = 55
+const ATTRIBUTE_ID_ThreadNetworkDiagnostics_ActiveTimestamp = 56
+const ATTRIBUTE_ID_ThreadNetworkDiagnostics_PendingTimestamp = 57
+const ATTRIBUTE_ID_ThreadNetworkDiagnostics_Delay = 58
+const ATTRIBUTE_ID_ThreadNetworkDiagnostics_SecurityPolicy = 59
+const ATTRIBUTE_ID_ThreadNetworkDiagnostics_ChannelPage0Mask = 60
+const ATTRIBUTE_ID_ThreadNetworkDiagnostics_OperationalDatasetComponents = 61
+const ATTRIBUTE_ID_ThreadNetworkDiagnostics_ActiveNetworkFaults = 62
+const EVENT_ID_ThreadNetworkDiagnostics_ConnectionStatus = 0
+const EVENT_ID_ThreadNetworkDiagnostics_NetworkFaultChange = 1
+const CLUSTER_ID_WiFiNetworkDiagnostics = 0x36
+const COMMAND_ID_WiFiNetworkDiagnostics_ResetCounts = 0
+const ATTRIBUTE_ID_WiFiNetworkDiagnostics_BSSID = 0
+const ATTRIBUTE_ID_WiFiNetworkDiagnostics_SecurityType = 1
+const ATTRIBUTE_ID_WiFiNetworkDiagnostics_WiFiVersion = 2
+const ATTRIBUTE_ID_WiFiNetworkDiagnostics_ChannelNumber = 3
+const ATTRIBUTE_ID_WiFiNetworkDiagnostics_RSSI = 4
+const ATTRIBUTE_ID_WiFiNetworkDiagnostics_BeaconLostCount = 5
+const ATTRIBUTE_ID_WiFiNetworkDiagnostics_BeaconRxCount = 6
+const ATTRIBUTE_ID_WiFiNetworkDiagnostics_PacketMulticastRxCount = 7
+const ATTRIBUTE_ID_WiFiNetworkDiagnostics_PacketMulticastTxCount = 8
+const ATTRIBUTE_ID_WiFiNetworkDiagnostics_PacketUnicastRxCount = 9
+const ATTRIBUTE_ID_WiFiNetworkDiagnostics_PacketUnicastTxCount = 10
+const ATTRIBUTE_ID_WiFiNetworkDiagnostics_CurrentMaxRate = 11
+const ATTRIBUTE_ID_WiFiNetworkDiagnostics_OverrunCount = 12
+const EVENT_ID_WiFiNetworkDiagnostics_Disconnection = 0
+const EVENT_ID_WiFiNetworkDiagnostics_AssociationFailure = 1
+const EVENT_ID_WiFiNetworkDiagnostics_ConnectionStatus = 2
+const CLUSTER_ID_EthernetNetworkDiagnostics = 0x37
+const COMMAND_ID_EthernetNetworkDiagnostics_ResetCounts = 0
+const ATTRIBUTE_ID_EthernetNetworkDiagnostics_PHYRate = 0
+const ATTRIBUTE_ID_EthernetNetworkDiagnostics_FullDuplex = 1
+const ATTRIBUTE_ID_EthernetNetworkDiagnostics_PacketRxCount = 2
+const ATTRIBUTE_ID_EthernetNetworkDiagnostics_PacketTxCount = 3
+const ATTRIBUTE_ID_EthernetNetworkDiagnostics_TxErrCount = 4
+const ATTRIBUTE_ID_EthernetNetworkDiagnostics_CollisionCount = 5
+const ATTRIBUTE_ID_EthernetNetworkDiagnostics_OverrunCount = 6
+const ATTRIBUTE_ID_EthernetNetworkDiagnostics_CarrierDetect = 7
+const ATTRIBUTE_ID_EthernetNetworkDiagnostics_TimeSinceReset = 8
+const CLUSTER_ID_TimeSync = 0x38
+const COMMAND_ID_TimeSync_SetUTCTime = 0
+const COMMAND_ID_TimeSync_SetTrustedTimeSource = 1
+const COMMAND_ID_TimeSync_SetTimeZone = 2
+const COMMAND_ID_TimeSync_SetTimeZoneResponse = 3
+const COMMAND_ID_TimeSync_SetDSTOffset = 4
+const COMMAND_ID_TimeSync_SetDefaultNTP = 5
+const ATTRIBUTE_ID_TimeSync_UTCTime = 0
+const ATTRIBUTE_ID_TimeSync_Granularity = 1
+const ATTRIBUTE_ID_TimeSync_TimeSource = 2
+const ATTRIBUTE_ID_TimeSync_TrustedTimeSource = 3
+const ATTRIBUTE_ID_TimeSync_DefaultNTP = 4
+const ATTRIBUTE_ID_TimeSync_TimeZone = 5
+const ATTRIBUTE_ID_TimeSync_DSTOffset = 6
+const ATTRIBUTE_ID_TimeSync_LocalTime = 7
+const ATTRIBUTE_ID_TimeSync_TimeZoneDatabase = 8
+const ATTRIBUTE_ID_TimeSync_NTPServerAvailable = 9
+const ATTRIBUTE_ID_TimeSync_TimeZoneListMaxSize = 10
+const ATTRIBUTE_ID_TimeSync_DSTOffsetListMaxSize = 11
+const ATTRIBUTE_ID_TimeSync_SupportsDNSResolve = 12
+const EVENT_ID_TimeSync_DSTTableEmpty = 0
+const EVENT_ID_TimeSync_DSTStatus = 1
+const EVENT_ID_TimeSync_TimeZoneStatus = 2
+const EVENT_ID_TimeSync_TimeFailure = 3
+const EVENT_ID_TimeSync_MissingTrustedTimeSource = 4
 const CLUSTER_ID_BridgedDeviceBasicInformation = 0x39
-const ATTRIBUTE_ID_BridgedDeviceBasicInformation_DataModelRevision = 0
 const ATTRIBUTE_ID_BridgedDeviceBasicInformation_VendorName = 1
 const ATTRIBUTE_ID_BridgedDeviceBasicInformation_VendorID = 2
 const ATTRIBUTE_ID_BridgedDeviceBasicInformation_ProductName = 3
 const ATTRIBUTE_ID_BridgedDeviceBasicInformation_ProductID = 4
 const ATTRIBUTE_ID_BridgedDeviceBasicInformation_NodeLabel = 5
-const ATTRIBUTE_ID_BridgedDeviceBasicInformation_Location = 6
 const ATTRIBUTE_ID_BridgedDeviceBasicInformation_HardwareVersion = 7
 const ATTRIBUTE_ID_BridgedDeviceBasicInformation_HardwareVersionString = 8
 const ATTRIBUTE_ID_BridgedDeviceBasicInformation_SoftwareVersion = 9
@@ -454,156 +386,382 @@ const ATTRIBUTE_ID_BridgedDeviceBasicInformation_PartNumber = 12
 const ATTRIBUTE_ID_BridgedDeviceBasicInformation_ProductURL = 13
 const ATTRIBUTE_ID_BridgedDeviceBasicInformation_ProductLabel = 14
 const ATTRIBUTE_ID_BridgedDeviceBasicInformation_SerialNumber = 15
-const ATTRIBUTE_ID_BridgedDeviceBasicInformation_LocalConfigDisabled = 16
 const ATTRIBUTE_ID_BridgedDeviceBasicInformation_Reachable = 17
 const ATTRIBUTE_ID_BridgedDeviceBasicInformation_UniqueID = 18
-const ATTRIBUTE_ID_BridgedDeviceBasicInformation_CapabilityMinima = 19
 const ATTRIBUTE_ID_BridgedDeviceBasicInformation_ProductAppearance = 20
-const ATTRIBUTE_ID_BridgedDeviceBasicInformation_SpecificationVersion = 21
-const ATTRIBUTE_ID_BridgedDeviceBasicInformation_MaxPathsPerInvoke = 22
-const CLUSTER_ID_EthernetNetworkDiagnostics = 0x37
-const COMMAND_ID_EthernetNetworkDiagnostics_ResetCounts = 0
-const ATTRIBUTE_ID_EthernetNetworkDiagnostics_PHYRate = 0
-const ATTRIBUTE_ID_EthernetNetworkDiagnostics_FullDuplex = 1
-const ATTRIBUTE_ID_EthernetNetworkDiagnostics_PacketRxCount = 2
-const ATTRIBUTE_ID_EthernetNetworkDiagnostics_PacketTxCount = 3
-const ATTRIBUTE_ID_EthernetNetworkDiagnostics_TxErrCount = 4
-const ATTRIBUTE_ID_EthernetNetworkDiagnostics_CollisionCount = 5
-const ATTRIBUTE_ID_EthernetNetworkDiagnostics_OverrunCount = 6
-const ATTRIBUTE_ID_EthernetNetworkDiagnostics_CarrierDetect = 7
-const ATTRIBUTE_ID_EthernetNetworkDiagnostics_TimeSinceReset = 8
-const CLUSTER_ID_WiFiNetworkDiagnostics = 0x36
-const COMMAND_ID_WiFiNetworkDiagnostics_ResetCounts = 0
-const ATTRIBUTE_ID_WiFiNetworkDiagnostics_BSSID = 0
-const ATTRIBUTE_ID_WiFiNetworkDiagnostics_SecurityType = 1
-const ATTRIBUTE_ID_WiFiNetworkDiagnostics_WiFiVersion = 2
-const ATTRIBUTE_ID_WiFiNetworkDiagnostics_ChannelNumber = 3
-const ATTRIBUTE_ID_WiFiNetworkDiagnostics_RSSI = 4
-const ATTRIBUTE_ID_WiFiNetworkDiagnostics_BeaconLostCount = 5
-const ATTRIBUTE_ID_WiFiNetworkDiagnostics_BeaconRxCount = 6
-const ATTRIBUTE_ID_WiFiNetworkDiagnostics_PacketMulticastRxCount = 7
-const ATTRIBUTE_ID_WiFiNetworkDiagnostics_PacketMulticastTxCount = 8
-const ATTRIBUTE_ID_WiFiNetworkDiagnostics_PacketUnicastRxCount = 9
-const ATTRIBUTE_ID_WiFiNetworkDiagnostics_PacketUnicastTxCount = 10
-const ATTRIBUTE_ID_WiFiNetworkDiagnostics_CurrentMaxRate = 11
-const ATTRIBUTE_ID_WiFiNetworkDiagnostics_OverrunCount = 12
+const EVENT_ID_BridgedDeviceBasicInformation_StartUp = 0
+const EVENT_ID_BridgedDeviceBasicInformation_ShutDown = 1
+const EVENT_ID_BridgedDeviceBasicInformation_Leave = 2
+const EVENT_ID_BridgedDeviceBasicInformation_ReachableChanged = 3
+const CLUSTER_ID_Switch = 0x3b
+const ATTRIBUTE_ID_Switch_NumberOfPositions = 0
+const ATTRIBUTE_ID_Switch_CurrentPosition = 1
+const ATTRIBUTE_ID_Switch_MultiPressMax = 2
+const EVENT_ID_Switch_SwitchLatched = 0
+const EVENT_ID_Switch_InitialPress = 1
+const EVENT_ID_Switch_LongPress = 2
+const EVENT_ID_Switch_ShortRelease = 3
+const EVENT_ID_Switch_LongRelease = 4
+const EVENT_ID_Switch_MultiPressOngoing = 5
+const EVENT_ID_Switch_MultiPressComplete = 6
+const CLUSTER_ID_AdministratorCommissioning = 0x3c
+const COMMAND_ID_AdministratorCommissioning_OpenCommissioningWindow = 0
+const COMMAND_ID_AdministratorCommissioning_OpenBasicCommissioningWindow = 1
+const COMMAND_ID_AdministratorCommissioning_RevokeCommissioning = 2
+const ATTRIBUTE_ID_AdministratorCommissioning_WindowStatus = 0
+const ATTRIBUTE_ID_AdministratorCommissioning_AdminFabricIndex = 1
+const ATTRIBUTE_ID_AdministratorCommissioning_AdminVendorId = 2
+const CLUSTER_ID_OperationalCredentials = 0x3e
+const COMMAND_ID_OperationalCredentials_AttestationRequest = 0
+const COMMAND_ID_OperationalCredentials_AttestationResponse = 1
+const COMMAND_ID_OperationalCredentials_CertificateChainRequest = 2
+const COMMAND_ID_OperationalCredentials_CertificateChainResponse = 3
+const COMMAND_ID_OperationalCredentials_CSRRequest = 4
+const COMMAND_ID_OperationalCredentials_CSRResponse = 5
+const COMMAND_ID_OperationalCredentials_AddNOC = 6
+const COMMAND_ID_OperationalCredentials_UpdateNOC = 7
+const COMMAND_ID_OperationalCredentials_NOCResponse = 8
+const COMMAND_ID_OperationalCredentials_UpdateFabricLabel = 9
+const COMMAND_ID_OperationalCredentials_RemoveFabric = 10
+const COMMAND_ID_OperationalCredentials_AddTrustedRootCertificate = 11
+const ATTRIBUTE_ID_OperationalCredentials_NOCs = 0
+const ATTRIBUTE_ID_OperationalCredentials_Fabrics = 1
+const ATTRIBUTE_ID_OperationalCredentials_SupportedFabrics = 2
+const ATTRIBUTE_ID_OperationalCredentials_CommissionedFabrics = 3
+const ATTRIBUTE_ID_OperationalCredentials_TrustedRootCertificates = 4
+const ATTRIBUTE_ID_OperationalCredentials_CurrentFabricIndex = 5
+const CLUSTER_ID_GroupKeyManagement = 0x3f
+const COMMAND_ID_GroupKeyManagement_KeySetWrite = 0
+const COMMAND_ID_GroupKeyManagement_KeySetRead = 1
+const COMMAND_ID_GroupKeyManagement_KeySetReadResponse = 2
+const COMMAND_ID_GroupKeyManagement_KeySetRemove = 3
+const COMMAND_ID_GroupKeyManagement_KeySetReadAllIndices = 4
+const COMMAND_ID_GroupKeyManagement_KeySetReadAllIndicesResponse = 5
+const ATTRIBUTE_ID_GroupKeyManagement_GroupKeyMap = 0
+const ATTRIBUTE_ID_GroupKeyManagement_GroupTable = 1
+const ATTRIBUTE_ID_GroupKeyManagement_MaxGroupsPerFabric = 2
+const ATTRIBUTE_ID_GroupKeyManagement_MaxGroupKeysPerFabric = 3
+const CLUSTER_ID_FixedLabel = 0x40
+const ATTRIBUTE_ID_FixedLabel_LabelList = 0
+const CLUSTER_ID_UserLabel = 0x41
+const ATTRIBUTE_ID_UserLabel_LabelList = 0
+const CLUSTER_ID_BooleanState = 0x45
+const ATTRIBUTE_ID_BooleanState_StateValue = 0
+const EVENT_ID_BooleanState_StateChange = 0
+const CLUSTER_ID_Timer = 0x47
+const COMMAND_ID_Timer_SetTimer = 0
+const COMMAND_ID_Timer_ResetTimer = 1
+const COMMAND_ID_Timer_AddTime = 2
+const COMMAND_ID_Timer_ReduceTime = 3
+const ATTRIBUTE_ID_Timer_SetTime = 0
+const ATTRIBUTE_ID_Timer_TimeRemaining = 1
+const ATTRIBUTE_ID_Timer_TimerState = 2
+const CLUSTER_ID_OvenCavityOperationalState = 0x48
+const COMMAND_ID_OvenCavityOperationalState_Pause = 0
+const COMMAND_ID_OvenCavityOperationalState_Stop = 1
+const COMMAND_ID_OvenCavityOperationalState_Start = 2
+const COMMAND_ID_OvenCavityOperationalState_Resume = 3
+const COMMAND_ID_OvenCavityOperationalState_OperationalCommandResponse = 4
+const ATTRIBUTE_ID_OvenCavityOperationalState_PhaseList = 0
+const ATTRIBUTE_ID_OvenCavityOperationalState_CurrentPhase = 1
+const ATTRIBUTE_ID_OvenCavityOperationalState_CountdownTime = 2
+const ATTRIBUTE_ID_OvenCavityOperationalState_OperationalStateList = 3
+const ATTRIBUTE_ID_OvenCavityOperationalState_OperationalState = 4
+const ATTRIBUTE_ID_OvenCavityOperationalState_OperationalError = 5
+const EVENT_ID_OvenCavityOperationalState_OperationalError = 0
+const EVENT_ID_OvenCavityOperationalState_OperationCompletion = 1
+const CLUSTER_ID_OvenMode = 0x49
+const COMMAND_ID_OvenMode_ChangeToMode = 0
+const COMMAND_ID_OvenMode_ChangeToModeResponse = 1
+const ATTRIBUTE_ID_OvenMode_SupportedModes = 0
+const ATTRIBUTE_ID_OvenMode_CurrentMode = 1
+const ATTRIBUTE_ID_OvenMode_StartUpMode = 2
+const ATTRIBUTE_ID_OvenMode_OnMode = 3
+const CLUSTER_ID_LaundryDryerControls = 0x4a
+const ATTRIBUTE_ID_LaundryDryerControls_SupportedDrynessLevels = 0
+const ATTRIBUTE_ID_LaundryDryerControls_SelectedDrynessLevel = 1
+const CLUSTER_ID_ModeSelect = 0x50
+const COMMAND_ID_ModeSelect_ChangeToMode = 0
+const ATTRIBUTE_ID_ModeSelect_Description = 0
+const ATTRIBUTE_ID_ModeSelect_StandardNamespace = 1
+const ATTRIBUTE_ID_ModeSelect_SupportedModes = 2
+const ATTRIBUTE_ID_ModeSelect_CurrentMode = 3
+const ATTRIBUTE_ID_ModeSelect_StartUpMode = 4
+const ATTRIBUTE_ID_ModeSelect_OnMode = 5
+const CLUSTER_ID_LaundryWasherMode = 0x51
+const COMMAND_ID_LaundryWasherMode_ChangeToMode = 0
+const COMMAND_ID_LaundryWasherMode_ChangeToModeResponse = 1
+const ATTRIBUTE_ID_LaundryWasherMode_SupportedModes = 0
+const ATTRIBUTE_ID_LaundryWasherMode_CurrentMode = 1
+const ATTRIBUTE_ID_LaundryWasherMode_StartUpMode = 2
+const ATTRIBUTE_ID_LaundryWasherMode_OnMode = 3
+const CLUSTER_ID_RefrigeratorAndTemperatureControlledCabinetMode = 0x52
+const COMMAND_ID_RefrigeratorAndTemperatureControlledCabinetMode_ChangeToMode = 0
+const COMMAND_ID_RefrigeratorAndTemperatureControlledCabinetMode_ChangeToModeResponse = 1
+const ATTRIBUTE_ID_RefrigeratorAndTemperatureControlledCabinetMode_SupportedModes = 0
+const ATTRIBUTE_ID_RefrigeratorAndTemperatureControlledCabinetMode_CurrentMode = 1
+const ATTRIBUTE_ID_RefrigeratorAndTemperatureControlledCabinetMode_StartUpMode = 2
+const ATTRIBUTE_ID_RefrigeratorAndTemperatureControlledCabinetMode_OnMode = 3
+const CLUSTER_ID_LaundryWasherControls = 0x53
+const ATTRIBUTE_ID_LaundryWasherControls_SpinSpeeds = 0
+const ATTRIBUTE_ID_LaundryWasherControls_SpinSpeedCurrent = 1
+const ATTRIBUTE_ID_LaundryWasherControls_NumberOfRinses = 2
+const ATTRIBUTE_ID_LaundryWasherControls_SupportedRinses = 3
+const CLUSTER_ID_RVCRunMode = 0x54
+const COMMAND_ID_RVCRunMode_ChangeToMode = 0
+const COMMAND_ID_RVCRunMode_ChangeToModeResponse = 1
+const ATTRIBUTE_ID_RVCRunMode_SupportedModes = 0
+const ATTRIBUTE_ID_RVCRunMode_CurrentMode = 1
+const ATTRIBUTE_ID_RVCRunMode_StartUpMode = 2
+const ATTRIBUTE_ID_RVCRunMode_OnMode = 3
+const CLUSTER_ID_RVCCleanMode = 0x55
+const COMMAND_ID_RVCCleanMode_ChangeToMode = 0
+const COMMAND_ID_RVCCleanMode_ChangeToModeResponse = 1
+const ATTRIBUTE_ID_RVCCleanMode_SupportedModes = 0
+const ATTRIBUTE_ID_RVCCleanMode_CurrentMode = 1
+const ATTRIBUTE_ID_RVCCleanMode_StartUpMode = 2
+const ATTRIBUTE_ID_RVCCleanMode_OnMode = 3
+const CLUSTER_ID_TemperatureControl = 0x56
+const COMMAND_ID_TemperatureControl_SetTemperature = 0
+const ATTRIBUTE_ID_TemperatureControl_TemperatureSetpoint = 0
+const ATTRIBUTE_ID_TemperatureControl_MinTemperature = 1
+const ATTRIBUTE_ID_TemperatureControl_MaxTemperature = 2
+const ATTRIBUTE_ID_TemperatureControl_Step = 3
+const ATTRIBUTE_ID_TemperatureControl_SelectedTemperatureLevel = 4
+const ATTRIBUTE_ID_TemperatureControl_SupportedTemperatureLevels = 5
+const CLUSTER_ID_RefrigeratorAlarm = 0x57
+const COMMAND_ID_RefrigeratorAlarm_Reset = 0
+const COMMAND_ID_RefrigeratorAlarm_ModifyEnabledAlarms = 1
+const ATTRIBUTE_ID_RefrigeratorAlarm_Mask = 0
+const ATTRIBUTE_ID_RefrigeratorAlarm_Latch = 1
+const ATTRIBUTE_ID_RefrigeratorAlarm_State = 2
+const ATTRIBUTE_ID_RefrigeratorAlarm_Supported = 3
+const EVENT_ID_RefrigeratorAlarm_Notify = 0
+const CLUSTER_ID_DishwasherMode = 0x59
+const COMMAND_ID_DishwasherMode_ChangeToMode = 0
+const COMMAND_ID_DishwasherMode_ChangeToModeResponse = 1
+const ATTRIBUTE_ID_DishwasherMode_SupportedModes = 0
+const ATTRIBUTE_ID_DishwasherMode_CurrentMode = 1
+const ATTRIBUTE_ID_DishwasherMode_StartUpMode = 2
+const ATTRIBUTE_ID_DishwasherMode_OnMode = 3
+const CLUSTER_ID_AirQuality = 0x5b
+const ATTRIBUTE_ID_AirQuality_AirQuality = 0
+const CLUSTER_ID_SmokeCOAlarm = 0x5c
+const COMMAND_ID_SmokeCOAlarm_SelfTestRequest = 0
+const ATTRIBUTE_ID_SmokeCOAlarm_ExpressedState = 0
+const ATTRIBUTE_ID_SmokeCOAlarm_SmokeState = 1
+const ATTRIBUTE_ID_SmokeCOAlarm_COState = 2
+const ATTRIBUTE_ID_SmokeCOAlarm_BatteryAlert = 3
+const ATTRIBUTE_ID_SmokeCOAlarm_DeviceMuted = 4
+const ATTRIBUTE_ID_SmokeCOAlarm_TestInProgress = 5
+const ATTRIBUTE_ID_SmokeCOAlarm_HardwareFaultAlert = 6
+const ATTRIBUTE_ID_SmokeCOAlarm_EndOfServiceAlert = 7
+const ATTRIBUTE_ID_SmokeCOAlarm_InterconnectSmokeAlarm = 8
+const ATTRIBUTE_ID_SmokeCOAlarm_InterconnectCOAlarm = 9
+const ATTRIBUTE_ID_SmokeCOAlarm_ContaminationState = 10
+const ATTRIBUTE_ID_SmokeCOAlarm_SmokeSensitivityLevel = 11
+const ATTRIBUTE_ID_SmokeCOAlarm_ExpiryDate = 12
+const EVENT_ID_SmokeCOAlarm_SmokeAlarm = 0
+const EVENT_ID_SmokeCOAlarm_COAlarm = 1
+const EVENT_ID_SmokeCOAlarm_LowBattery = 2
+const EVENT_ID_SmokeCOAlarm_HardwareFault = 3
+const EVENT_ID_SmokeCOAlarm_EndOfService = 4
+const EVENT_ID_SmokeCOAlarm_SelfTestComplete = 5
+const EVENT_ID_SmokeCOAlarm_AlarmMuted = 6
+const EVENT_ID_SmokeCOAlarm_MuteEnded = 7
+const EVENT_ID_SmokeCOAlarm_InterconnectSmokeAlarm = 8
+const EVENT_ID_SmokeCOAlarm_InterconnectCOAlarm = 9
+const EVENT_ID_SmokeCOAlarm_AllClear = 10
 const CLUSTER_ID_DishwasherAlarm = 0x5d
-const CLUSTER_ID_GroupKeyManagement = 0x3f
-const COMMAND_ID_GroupKeyManagement_KeySetWriteCommand = 0
-const COMMAND_ID_GroupKeyManagement_KeySetReadCommand = 1
-const COMMAND_ID_GroupKeyManagement_KeySetReadResponseCommand = 2
-const COMMAND_ID_GroupKeyManagement_KeySetRemoveCommand = 3
-const COMMAND_ID_GroupKeyManagement_KeySetReadAllIndicesCommand = 4
-const COMMAND_ID_GroupKeyManagement_KeySetReadAllIndicesResponseCommand = 5
-const ATTRIBUTE_ID_GroupKeyManagement_GroupKeyMap = 0
-const ATTRIBUTE_ID_GroupKeyManagement_GroupTable = 1
-const ATTRIBUTE_ID_GroupKeyManagement_MaxGroupsPerFabric = 2
-const ATTRIBUTE_ID_GroupKeyManagement_MaxGroupKeysPerFabric = 3
-const CLUSTER_ID_UnitLocalization = 0x2d
-const ATTRIBUTE_ID_UnitLocalization_TemperatureUnit = 0
-const CLUSTER_ID_ThermostatUserInterfaceConfiguration = 0x204
-const ATTRIBUTE_ID_ThermostatUserInterfaceConfiguration_TemperatureDisplayMode = 0
-const ATTRIBUTE_ID_ThermostatUserInterfaceConfiguration_KeypadLockout = 1
-const ATTRIBUTE_ID_ThermostatUserInterfaceConfiguration_ScheduleProgrammingVisibility = 2
-const CLUSTER_ID_AdministratorCommissioning = 0x3c
-const COMMAND_ID_AdministratorCommissioning_OpenCommissioningWindow = 0
-const COMMAND_ID_AdministratorCommissioning_OpenBasicCommissioningWindow = 1
-const COMMAND_ID_AdministratorCommissioning_RevokeCommissioning = 2
-const ATTRIBUTE_ID_AdministratorCommissioning_WindowStatus = 0
-const ATTRIBUTE_ID_AdministratorCommissioning_AdminFabricIndex = 1
-const ATTRIBUTE_ID_AdministratorCommissioning_AdminVendorId = 2
-const CLUSTER_ID_BooleanState = 0x45
-const ATTRIBUTE_ID_BooleanState_StateValue = 0
-const CLUSTER_ID_TimeFormatLocalization = 0x2c
-const ATTRIBUTE_ID_TimeFormatLocalization_HourFormat = 0
-const ATTRIBUTE_ID_TimeFormatLocalization_ActiveCalendarType = 1
-const ATTRIBUTE_ID_TimeFormatLocalization_SupportedCalendarTypes = 2
+const COMMAND_ID_DishwasherAlarm_Reset = 0
+const COMMAND_ID_DishwasherAlarm_ModifyEnabledAlarms = 1
+const ATTRIBUTE_ID_DishwasherAlarm_Mask = 0
+const ATTRIBUTE_ID_DishwasherAlarm_Latch = 1
+const ATTRIBUTE_ID_DishwasherAlarm_State = 2
+const ATTRIBUTE_ID_DishwasherAlarm_Supported = 3
+const EVENT_ID_DishwasherAlarm_Notify = 0
+const CLUSTER_ID_MicrowaveOvenMode = 0x5e
+const COMMAND_ID_MicrowaveOvenMode_ChangeToMode = 0
+const COMMAND_ID_MicrowaveOvenMode_ChangeToModeResponse = 1
+const ATTRIBUTE_ID_MicrowaveOvenMode_SupportedModes = 0
+const ATTRIBUTE_ID_MicrowaveOvenMode_CurrentMode = 1
+const ATTRIBUTE_ID_MicrowaveOvenMode_StartUpMode = 2
+const ATTRIBUTE_ID_MicrowaveOvenMode_OnMode = 3
+const CLUSTER_ID_OperationalState = 0x60
+const COMMAND_ID_OperationalState_Pause = 0
+const COMMAND_ID_OperationalState_Stop = 1
+const COMMAND_ID_OperationalState_Start = 2
+const COMMAND_ID_OperationalState_Resume = 3
+const COMMAND_ID_OperationalState_OperationalCommandResponse = 4
+const ATTRIBUTE_ID_OperationalState_PhaseList = 0
+const ATTRIBUTE_ID_OperationalState_CurrentPhase = 1
+const ATTRIBUTE_ID_OperationalState_CountdownTime = 2
+const ATTRIBUTE_ID_OperationalState_OperationalStateList = 3
+const ATTRIBUTE_ID_OperationalState_OperationalState = 4
+const ATTRIBUTE_ID_OperationalState_OperationalError = 5
+const EVENT_ID_OperationalState_OperationalError = 0
+const EVENT_ID_OperationalState_OperationCompletion = 1
+const CLUSTER_ID_RVCOperationalState = 0x61
+const COMMAND_ID_RVCOperationalState_Pause = 0
+const COMMAND_ID_RVCOperationalState_Stop = 1
+const COMMAND_ID_RVCOperationalState_Start = 2
+const COMMAND_ID_RVCOperationalState_Resume = 3
+const COMMAND_ID_RVCOperationalState_OperationalCommandResponse = 4
+const COMMAND_ID_RVCOperationalState_GoHome = 128
+const ATTRIBUTE_ID_RVCOperationalState_PhaseList = 0
+const ATTRIBUTE_ID_RVCOperationalState_CurrentPhase = 1
+const ATTRIBUTE_ID_RVCOperationalState_CountdownTime = 2
+const ATTRIBUTE_ID_RVCOperationalState_OperationalStateList = 3
+const ATTRIBUTE_ID_RVCOperationalState_OperationalState = 4
+const ATTRIBUTE_ID_RVCOperationalState_OperationalError = 5
+const EVENT_ID_RVCOperationalState_OperationalError = 0
+const EVENT_ID_RVCOperationalState_OperationCompletion = 1
+const CLUSTER_ID_BooleanSensorConfiguration = 0x80
+const COMMAND_ID_BooleanSensorConfiguration_SuppressAlarm = 0
+const COMMAND_ID_BooleanSensorConfiguration_EnableDisableAlarm = 1
+const ATTRIBUTE_ID_BooleanSensorConfiguration_CurrentSensitivityLevel = 0
+const ATTRIBUTE_ID_BooleanSensorConfiguration_SupportedSensitivityLevels = 1
+const ATTRIBUTE_ID_BooleanSensorConfiguration_DefaultSensitivityLevel = 2
+const ATTRIBUTE_ID_BooleanSensorConfiguration_AlarmsActive = 3
+const ATTRIBUTE_ID_BooleanSensorConfiguration_AlarmsSuppressed = 4
+const ATTRIBUTE_ID_BooleanSensorConfiguration_AlarmsEnabled = 5
+const ATTRIBUTE_ID_BooleanSensorConfiguration_AlarmsSupported = 6
+const ATTRIBUTE_ID_BooleanSensorConfiguration_SensorFault = 7
+const EVENT_ID_BooleanSensorConfiguration_AlarmsStateChanged = 0
+const EVENT_ID_BooleanSensorConfiguration_SensorFault = 1
+const CLUSTER_ID_ValveConfigurationandControl = 0x81
+const COMMAND_ID_ValveConfigurationandControl_Open = 0
+const COMMAND_ID_ValveConfigurationandControl_Close = 1
+const ATTRIBUTE_ID_ValveConfigurationandControl_OpenDuration = 0
+const ATTRIBUTE_ID_ValveConfigurationandControl_DefaultOpenDuration = 1
+const ATTRIBUTE_ID_ValveConfigurationandControl_AutoCloseTime = 2
+const ATTRIBUTE_ID_ValveConfigurationandControl_RemainingDuration = 3
+const ATTRIBUTE_ID_ValveConfigurationandControl_CurrentState = 4
+const ATTRIBUTE_ID_ValveConfigurationandControl_TargetState = 5
+const ATTRIBUTE_ID_ValveConfigurationandControl_CurrentLevel = 6
+const ATTRIBUTE_ID_ValveConfigurationandControl_TargetLevel = 7
+const ATTRIBUTE_ID_ValveConfigurationandControl_DefaultOpenLevel = 8
+const ATTRIBUTE_ID_ValveConfigurationandControl_ValveFault = 9
+const ATTRIBUTE_ID_ValveConfigurationandControl_LevelStep = 10
+const EVENT_ID_ValveConfigurationandControl_ValveStateChanged = 0
+const EVENT_ID_ValveConfigurationandControl_ValveFault = 1
+const CLUSTER_ID_ElectricalPowerMeasurement = 0x90
+const ATTRIBUTE_ID_ElectricalPowerMeasurement_PowerMode = 0
+const ATTRIBUTE_ID_ElectricalPowerMeasurement_NumberOfMeasurementTypes = 1
+const ATTRIBUTE_ID_ElectricalPowerMeasurement_Accuracy = 2
+const ATTRIBUTE_ID_ElectricalPowerMeasurement_Ranges = 3
+const ATTRIBUTE_ID_ElectricalPowerMeasurement_Voltage = 4
+const ATTRIBUTE_ID_ElectricalPowerMeasurement_ActiveCurrent = 5
+const ATTRIBUTE_ID_ElectricalPowerMeasurement_ReactiveCurrent = 6
+const ATTRIBUTE_ID_ElectricalPowerMeasurement_ApparentCurrent = 7
+const ATTRIBUTE_ID_ElectricalPowerMeasurement_ActivePower = 8
+const ATTRIBUTE_ID_ElectricalPowerMeasurement_ReactivePower = 9
+const ATTRIBUTE_ID_ElectricalPowerMeasurement_ApparentPower = 10
+const ATTRIBUTE_ID_ElectricalPowerMeasurement_RMSVoltage = 11
+const ATTRIBUTE_ID_ElectricalPowerMeasurement_RMSCurrent = 12
+const ATTRIBUTE_ID_ElectricalPowerMeasurement_RMSPower = 13
+const ATTRIBUTE_ID_ElectricalPowerMeasurement_Frequency = 14
+const ATTRIBUTE_ID_ElectricalPowerMeasurement_HarmonicCurrents = 15
+const ATTRIBUTE_ID_ElectricalPowerMeasurement_HarmonicPhases = 16
+const ATTRIBUTE_ID_ElectricalPowerMeasurement_PowerFactor = 17
+const ATTRIBUTE_ID_ElectricalPowerMeasurement_NeutralCurrent = 18
+const EVENT_ID_ElectricalPowerMeasurement_MeasurementPeriodRanges = 0
+const CLUSTER_ID_ElectricalEnergyMeasurement = 0x91
+const ATTRIBUTE_ID_ElectricalEnergyMeasurement_Accuracy = 0
+const ATTRIBUTE_ID_ElectricalEnergyMeasurement_CumulativeEnergyImported = 1
+const ATTRIBUTE_ID_ElectricalEnergyMeasurement_CumulativeEnergyExported = 2
+const ATTRIBUTE_ID_ElectricalEnergyMeasurement_PeriodicEnergyImported = 3
+const ATTRIBUTE_ID_ElectricalEnergyMeasurement_PeriodicEnergyExported = 4
+const ATTRIBUTE_ID_ElectricalEnergyMeasurement_CumulativeEnergyReset = 5
+const EVENT_ID_ElectricalEnergyMeasurement_CumulativeEnergyMeasured = 0
+const EVENT_ID_ElectricalEnergyMeasurement_PeriodicEnergyMeasured = 1
+const CLUSTER_ID_WaterHeaterManagement = 0x94
+const COMMAND_ID_WaterHeaterManagement_Boost = 0
+const COMMAND_ID_WaterHeaterManagement_CancelBoost = 1
+const ATTRIBUTE_ID_WaterHeaterManagement_HeaterTypes = 0
+const ATTRIBUTE_ID_WaterHeaterManagement_HeatDemand = 1
+const ATTRIBUTE_ID_WaterHeaterManagement_TankVolume = 2
+const ATTRIBUTE_ID_WaterHeaterManagement_EstimatedHeatRequired = 3
+const ATTRIBUTE_ID_WaterHeaterManagement_TankPercentage = 4
+const ATTRIBUTE_ID_WaterHeaterManagement_BoostState = 5
+const EVENT_ID_WaterHeaterManagement_BoostStarted = 0
+const EVENT_ID_WaterHeaterManagement_BoostEnded = 1
+const CLUSTER_ID_EnergyPrice = 0x95
+const COMMAND_ID_EnergyPrice_GetDetailedPriceRequest = 0
+const COMMAND_ID_EnergyPrice_GetDetailedPriceResponse = 1
+const COMMAND_ID_EnergyPrice_GetDetailedForecastRequest = 2
+const COMMAND_ID_EnergyPrice_GetDetailedForecastResponse = 3
+const ATTRIBUTE_ID_EnergyPrice_UnitOfMeasure = 0
+const ATTRIBUTE_ID_EnergyPrice_CurrentPrice = 1
+const ATTRIBUTE_ID_EnergyPrice_PriceForecast = 2
+const EVENT_ID_EnergyPrice_PriceChange = 0
+const CLUSTER_ID_DemandResponseandLoadControl = 0x96
+const COMMAND_ID_DemandResponseandLoadControl_RegisterLoadControlProgramRequest = 0
+const COMMAND_ID_DemandResponseandLoadControl_UnregisterLoadControlProgramRequest = 1
+const COMMAND_ID_DemandResponseandLoadControl_AddLoadControlEventRequest = 2
+const COMMAND_ID_DemandResponseandLoadControl_RemoveLoadControlEventRequest = 3
+const COMMAND_ID_DemandResponseandLoadControl_ClearLoadControlEventsRequest = 4
+const ATTRIBUTE_ID_DemandResponseandLoadControl_LoadControlPrograms = 0
+const ATTRIBUTE_ID_DemandResponseandLoadControl_NumberOfLoadControlPrograms = 1
+const ATTRIBUTE_ID_DemandResponseandLoadControl_Events = 2
+const ATTRIBUTE_ID_DemandResponseandLoadControl_ActiveEvents = 3
+const ATTRIBUTE_ID_DemandResponseandLoadControl_NumberOfEventsPerProgram = 4
+const ATTRIBUTE_ID_DemandResponseandLoadControl_NumberOfTransitions = 5
+const ATTRIBUTE_ID_DemandResponseandLoadControl_DefaultRandomStart = 6
+const ATTRIBUTE_ID_DemandResponseandLoadControl_DefaultRandomDuration = 7
+const EVENT_ID_DemandResponseandLoadControl_LoadControlEventStatusChange = 0
 const CLUSTER_ID_Messages = 0x97
 const COMMAND_ID_Messages_PresentMessagesRequest = 0
 const COMMAND_ID_Messages_CancelMessagesRequest = 1
 const ATTRIBUTE_ID_Messages_Messages = 0
 const ATTRIBUTE_ID_Messages_ActiveMessageIDs = 1
-const CLUSTER_ID_RVCRunMode = 0x54
-const CLUSTER_ID_PowerSource = 0x2f
-const COMMAND_ID_PowerSource_DisableBattery = 1
-const COMMAND_ID_PowerSource_EnableBatteryCharging = 2
-const COMMAND_ID_PowerSource_EnableBatteryDischarging = 3
-const COMMAND_ID_PowerSource_StartBatteryDiagnostics = 4
-const ATTRIBUTE_ID_PowerSource_Status = 0
-const ATTRIBUTE_ID_PowerSource_Order = 1
-const ATTRIBUTE_ID_PowerSource_Description = 2
-const ATTRIBUTE_ID_PowerSource_WiredAssessedInputVoltage = 3
-const ATTRIBUTE_ID_PowerSource_WiredAssessedInputFrequency = 4
-const ATTRIBUTE_ID_PowerSource_WiredCurrentType = 5
-const ATTRIBUTE_ID_PowerSource_WiredAssessedCurrent = 6
-const ATTRIBUTE_ID_PowerSource_WiredNominalVoltage = 7
-const ATTRIBUTE_ID_PowerSource_WiredMaximumCurrent = 8
-const ATTRIBUTE_ID_PowerSource_WiredPresent = 9
-const ATTRIBUTE_ID_PowerSource_ActiveWiredFaults = 10
-const ATTRIBUTE_ID_PowerSource_BatVoltage = 11
-const ATTRIBUTE_ID_PowerSource_BatPercentRemaining = 12
-const ATTRIBUTE_ID_PowerSource_BatTimeRemaining = 13
-const ATTRIBUTE_ID_PowerSource_BatChargeLevel = 14
-const ATTRIBUTE_ID_PowerSource_BatReplacementNeeded = 15
-const ATTRIBUTE_ID_PowerSource_BatReplaceability = 16
-const ATTRIBUTE_ID_PowerSource_BatPresent = 17
-const ATTRIBUTE_ID_PowerSource_ActiveBatFaults = 18
-const ATTRIBUTE_ID_PowerSource_BatReplacementDescription = 19
-const ATTRIBUTE_ID_PowerSource_BatCommonDesignation = 20
-const ATTRIBUTE_ID_PowerSource_BatANSIDesignation = 21
-const ATTRIBUTE_ID_PowerSource_BatIECDesignation = 22
-const ATTRIBUTE_ID_PowerSource_BatApprovedChemistry = 23
-const ATTRIBUTE_ID_PowerSource_BatCapacity = 24
-const ATTRIBUTE_ID_PowerSource_BatQuantity = 25
-const ATTRIBUTE_ID_PowerSource_BatChargeState = 26
-const ATTRIBUTE_ID_PowerSource_BatTimeToFullCharge = 27
-const ATTRIBUTE_ID_PowerSource_BatFunctionalWhileCharging = 28
-const ATTRIBUTE_ID_PowerSource_BatChargingCurrent = 29
-const ATTRIBUTE_ID_PowerSource_ActiveBatChargeFaults = 30
-const ATTRIBUTE_ID_PowerSource_EndpointList = 31
-const ATTRIBUTE_ID_PowerSource_BatAbsMaxChargeCurrent = 32
-const ATTRIBUTE_ID_PowerSource_BatAbsMinChargeCurrent = 33
-const ATTRIBUTE_ID_PowerSource_BatAbsMaxDischargeCurrent = 34
-const ATTRIBUTE_ID_PowerSource_BatMaxChargeCurrent = 35
-const ATTRIBUTE_ID_PowerSource_BatMinChargeCurrent = 36
-const ATTRIBUTE_ID_PowerSource_BatMaxDischargeCurrent = 37
-const ATTRIBUTE_ID_PowerSource_BatChargePermissions = 38
-const ATTRIBUTE_ID_PowerSource_BatEnableChargeTime = 39
-const ATTRIBUTE_ID_PowerSource_BatEnableDischargeTime = 40
-const ATTRIBUTE_ID_PowerSource_WiredMaxAmperage = 41
-const ATTRIBUTE_ID_PowerSource_WiredAbsMaxAmperage = 42
-const CLUSTER_ID_AccountLogin = 0x50e
-const COMMAND_ID_AccountLogin_GetSetupPIN = 0
-const COMMAND_ID_AccountLogin_GetSetupPINResponse = 1
-const COMMAND_ID_AccountLogin_Login = 2
-const COMMAND_ID_AccountLogin_Logout = 3
-const CLUSTER_ID_ContentLauncher = 0x50a
-const COMMAND_ID_ContentLauncher_LaunchContent = 0
-const COMMAND_ID_ContentLauncher_LaunchURL = 1
-const COMMAND_ID_ContentLauncher_LauncherResponse = 2
-const ATTRIBUTE_ID_ContentLauncher_AcceptHeader = 0
-const ATTRIBUTE_ID_ContentLauncher_SupportedStreamingProtocols = 1
-const CLUSTER_ID_SoftwareDiagnostics = 0x34
-const COMMAND_ID_SoftwareDiagnostics_ResetWatermarks = 0
-const ATTRIBUTE_ID_SoftwareDiagnostics_ThreadMetrics = 0
-const ATTRIBUTE_ID_SoftwareDiagnostics_CurrentHeapFree = 1
-const ATTRIBUTE_ID_SoftwareDiagnostics_CurrentHeapUsed = 2
-const ATTRIBUTE_ID_SoftwareDiagnostics_CurrentHeapHighWatermark = 3
+const EVENT_ID_Messages_MessageQueued = 0
+const EVENT_ID_Messages_MessagePresented = 1
+const EVENT_ID_Messages_MessageComplete = 2
+const CLUSTER_ID_DeviceEnergyManagement = 0x98
+const COMMAND_ID_DeviceEnergyManagement_PowerAdjustRequest = 0
+const COMMAND_ID_DeviceEnergyManagement_CancelPowerAdjustRequest = 1
+const COMMAND_ID_DeviceEnergyManagement_StartTimeAdjustRequest = 2
+const COMMAND_ID_DeviceEnergyManagement_PauseRequest = 3
+const COMMAND_ID_DeviceEnergyManagement_ResumeRequest = 4
+const COMMAND_ID_DeviceEnergyManagement_ModifyForecastRequest = 5
+const COMMAND_ID_DeviceEnergyManagement_RequestConstraintBasedForecast = 6
+const COMMAND_ID_DeviceEnergyManagement_CancelRequest = 7
+const ATTRIBUTE_ID_DeviceEnergyManagement_ESAType = 0
+const ATTRIBUTE_ID_DeviceEnergyManagement_ESACanGenerate = 1
+const ATTRIBUTE_ID_DeviceEnergyManagement_ESAState = 2
+const ATTRIBUTE_ID_DeviceEnergyManagement_AbsMinPower = 3
+const ATTRIBUTE_ID_DeviceEnergyManagement_AbsMaxPower = 4
+const ATTRIBUTE_ID_DeviceEnergyManagement_PowerAdjustmentCapability = 5
+const ATTRIBUTE_ID_DeviceEnergyManagement_Forecast = 6
+const ATTRIBUTE_ID_DeviceEnergyManagement_OptOutState = 7
+const EVENT_ID_DeviceEnergyManagement_PowerAdjustStart = 0
+const EVENT_ID_DeviceEnergyManagement_PowerAdjustEnd = 1
+const EVENT_ID_DeviceEnergyManagement_Paused = 2
+const EVENT_ID_DeviceEnergyManagement_Resumed = 3
+const CLUSTER_ID_EnergyCalendar = 0x9a
+const ATTRIBUTE_ID_EnergyCalendar_CalendarID = 0
+const ATTRIBUTE_ID_EnergyCalendar_Name = 1
+const ATTRIBUTE_ID_EnergyCalendar_ProviderID = 2
+const ATTRIBUTE_ID_EnergyCalendar_EventID = 3
+const ATTRIBUTE_ID_EnergyCalendar_StartDate = 4
+const ATTRIBUTE_ID_EnergyCalendar_CalendarPeriods = 5
+const ATTRIBUTE_ID_EnergyCalendar_SpecialDays = 6
+const ATTRIBUTE_ID_EnergyCalendar_CurrentDay = 7
+const ATTRIBUTE_ID_EnergyCalendar_NextDay = 8
+const ATTRIBUTE_ID_EnergyCalendar_CurrentTransition = 9
+const ATTRIBUTE_ID_EnergyCalendar_CurrentPeakPeriod = 10
+const ATTRIBUTE_ID_EnergyCalendar_NextPeakPeriod = 11
+const CLUSTER_ID_EnergyPreference = 0x9b
+const ATTRIBUTE_ID_EnergyPreference_EnergyBalances = 0
+const ATTRIBUTE_ID_EnergyPreference_CurrentEnergyBalance = 1
+const ATTRIBUTE_ID_EnergyPreference_EnergyPriorities = 2
+const ATTRIBUTE_ID_EnergyPreference_LowPowerModeSensitivities = 3
+const ATTRIBUTE_ID_EnergyPreference_CurrentLowPowerModeSensitivity = 4
 const CLUSTER_ID_DoorLock = 0x101
 const COMMAND_ID_DoorLock_LockDoor = 0
 const COMMAND_ID_DoorLock_UnlockDoor = 1
-const COMMAND_ID_DoorLock_Toggle = 2
-const COMMAND_ID_DoorLock_UnlockwithTimeout = 3
-const COMMAND_ID_DoorLock_GetLogRecord = 4
-const COMMAND_ID_DoorLock_GetLogRecordResponse = 4
-const COMMAND_ID_DoorLock_SetPINCode = 5
-const COMMAND_ID_DoorLock_GetPINCode = 6
-const COMMAND_ID_DoorLock_GetPINCodeResponse = 6
-const COMMAND_ID_DoorLock_ClearPINCode = 7
-const COMMAND_ID_DoorLock_ClearAllPINCodes = 8
-const COMMAND_ID_DoorLock_SetUserStatus = 9
-const COMMAND_ID_DoorLock_GetUserStatus = 10
-const COMMAND_ID_DoorLock_GetUserStatusResponse = 10
+const COMMAND_ID_DoorLock_UnlockWithTimeout = 3
 const COMMAND_ID_DoorLock_SetWeekDaySchedule = 11
 const COMMAND_ID_DoorLock_GetWeekDaySchedule = 12
 const COMMAND_ID_DoorLock_GetWeekDayScheduleResponse = 12
@@ -616,20 +774,10 @@ const COMMAND_ID_DoorLock_SetHolidaySchedule = 17
 const COMMAND_ID_DoorLock_GetHolidaySchedule = 18
 const COMMAND_ID_DoorLock_GetHolidayScheduleResponse = 18
 const COMMAND_ID_DoorLock_ClearHolidaySchedule = 19
-const COMMAND_ID_DoorLock_SetUserType = 20
-const COMMAND_ID_DoorLock_GetUserType = 21
-const COMMAND_ID_DoorLock_GetUserTypeResponse = 21
-const COMMAND_ID_DoorLock_SetRFIDCode = 22
-const COMMAND_ID_DoorLock_GetRFIDCode = 23
-const COMMAND_ID_DoorLock_GetRFIDCodeResponse = 23
-const COMMAND_ID_DoorLock_ClearRFIDCode = 24
-const COMMAND_ID_DoorLock_ClearAllRFIDCodes = 25
 const COMMAND_ID_DoorLock_SetUser = 26
 const COMMAND_ID_DoorLock_GetUser = 27
 const COMMAND_ID_DoorLock_GetUserResponse = 28
 const COMMAND_ID_DoorLock_ClearUser = 29
-const COMMAND_ID_DoorLock_OperatingEventNotification = 32
-const COMMAND_ID_DoorLock_ProgrammingEventNotification = 33
 const COMMAND_ID_DoorLock_SetCredential = 34
 const COMMAND_ID_DoorLock_SetCredentialResponse = 35
 const COMMAND_ID_DoorLock_GetCredentialStatus = 36
@@ -643,7 +791,6 @@ const ATTRIBUTE_ID_DoorLock_DoorState = 3
 const ATTRIBUTE_ID_DoorLock_DoorOpenEvents = 4
 const ATTRIBUTE_ID_DoorLock_DoorClosedEvents = 5
 const ATTRIBUTE_ID_DoorLock_OpenPeriod = 6
-const ATTRIBUTE_ID_DoorLock_NumberOfLogRecordsSupported = 16
 const ATTRIBUTE_ID_DoorLock_NumberOfTotalUsersSupported = 17
 const ATTRIBUTE_ID_DoorLock_NumberOfPINUsersSupported = 18
 const ATTRIBUTE_ID_DoorLock_NumberOfRFIDUsersSupported = 19
@@ -656,7 +803,6 @@ const ATTRIBUTE_ID_DoorLock_MaxRFIDCodeLength = 25
 const ATTRIBUTE_ID_DoorLock_MinRFIDCodeLength = 26
 const ATTRIBUTE_ID_DoorLock_CredentialRulesSupport = 27
 const ATTRIBUTE_ID_DoorLock_NumberOfCredentialsSupportedPerUser = 28
-const ATTRIBUTE_ID_DoorLock_EnableLogging = 32
 const ATTRIBUTE_ID_DoorLock_Language = 33
 const ATTRIBUTE_ID_DoorLock_LEDSettings = 34
 const ATTRIBUTE_ID_DoorLock_AutoRelockTime = 35
@@ -673,100 +819,138 @@ const ATTRIBUTE_ID_DoorLock_WrongCodeEntryLimit = 48
 const ATTRIBUTE_ID_DoorLock_UserCodeTemporaryDisableTime = 49
 const ATTRIBUTE_ID_DoorLock_SendPINOverTheAir = 50
 const ATTRIBUTE_ID_DoorLock_RequirePINforRemoteOperation = 51
-const ATTRIBUTE_ID_DoorLock_SecurityLevel = 52
 const ATTRIBUTE_ID_DoorLock_ExpiringUserTimeout = 53
-const ATTRIBUTE_ID_DoorLock_AlarmMask = 64
-const ATTRIBUTE_ID_DoorLock_KeypadOperationEventMask = 65
-const ATTRIBUTE_ID_DoorLock_RemoteOperationEventMask = 66
-const ATTRIBUTE_ID_DoorLock_ManualOperationEventMask = 67
-const ATTRIBUTE_ID_DoorLock_RFIDOperationEventMask = 68
-const ATTRIBUTE_ID_DoorLock_KeypadProgrammingEventMask = 69
-const ATTRIBUTE_ID_DoorLock_RemoteProgrammingEventMask = 70
-const ATTRIBUTE_ID_DoorLock_RFIDProgrammingEventMask = 71
-const CLUSTER_ID_Groups = 0x4
-const COMMAND_ID_Groups_AddGroup = 0
-const COMMAND_ID_Groups_AddGroupResponse = 0
-const COMMAND_ID_Groups_ViewGroup = 1
-const COMMAND_ID_Groups_ViewGroupResponse = 1
-const COMMAND_ID_Groups_GetGroupMembership = 2
-const COMMAND_ID_Groups_GetGroupMembershipResponse = 2
-const COMMAND_ID_Groups_RemoveGroup = 3
-const COMMAND_ID_Groups_RemoveGroupResponse = 3
-const COMMAND_ID_Groups_RemoveAllGroups = 4
-const COMMAND_ID_Groups_AddGroupIfIdentifying = 5
-const ATTRIBUTE_ID_Groups_NameSupport = 0
-const CLUSTER_ID_Identify = 0x3
-const COMMAND_ID_Identify_Identify = 0
-const COMMAND_ID_Identify_IdentifyQueryResponse = 0
-const COMMAND_ID_Identify_IdentifyQuery = 1
-const COMMAND_ID_Identify_TriggerEffect = 64
-const ATTRIBUTE_ID_Identify_IdentifyTime = 0
-const ATTRIBUTE_ID_Identify_IdentifyType = 1
-const CLUSTER_ID_LocalizationConfiguration = 0x2b
-const ATTRIBUTE_ID_LocalizationConfiguration_ActiveLocale = 0
-const ATTRIBUTE_ID_LocalizationConfiguration_SupportedLocales = 1
-const CLUSTER_ID_OvenMode = 0x49
-const CLUSTER_ID_TemperatureMeasurement = 0x402
-const ATTRIBUTE_ID_TemperatureMeasurement_MeasuredValue = 0
-const ATTRIBUTE_ID_TemperatureMeasurement_MinMeasuredValue = 1
-const ATTRIBUTE_ID_TemperatureMeasurement_MaxMeasuredValue = 2
-const ATTRIBUTE_ID_TemperatureMeasurement_Tolerance = 3
-const CLUSTER_ID_ValveConfigurationandControl = 0x81
-const COMMAND_ID_ValveConfigurationandControl_Open = 0
-const COMMAND_ID_ValveConfigurationandControl_Close = 1
-const COMMAND_ID_ValveConfigurationandControl_SetLevel = 2
-const ATTRIBUTE_ID_ValveConfigurationandControl_OpenDuration = 0
-const ATTRIBUTE_ID_ValveConfigurationandControl_AutoCloseTime = 1
-const ATTRIBUTE_ID_ValveConfigurationandControl_RemainingDuration = 2
-const ATTRIBUTE_ID_ValveConfigurationandControl_CurrentState = 3
-const ATTRIBUTE_ID_ValveConfigurationandControl_TargetState = 4
-const ATTRIBUTE_ID_ValveConfigurationandControl_StartUpState = 5
-const ATTRIBUTE_ID_ValveConfigurationandControl_CurrentLevel = 6
-const ATTRIBUTE_ID_ValveConfigurationandControl_TargetLevel = 7
-const ATTRIBUTE_ID_ValveConfigurationandControl_OpenLevel = 8
-const ATTRIBUTE_ID_ValveConfigurationandControl_ValveFault = 9
-const CLUSTER_ID_NetworkInfrastructure = 0x0
-const CLUSTER_ID_Channel = 0x504
-const COMMAND_ID_Channel_ChangeChannel = 0
-const COMMAND_ID_Channel_ChangeChannelResponse = 1
-const COMMAND_ID_Channel_ChangeChannelByNumber = 2
-const COMMAND_ID_Channel_SkipChannel = 3
-const ATTRIBUTE_ID_Channel_ChannelList = 0
-const ATTRIBUTE_ID_Channel_Lineup = 1
-const ATTRIBUTE_ID_Channel_CurrentChannel = 2
-const CLUSTER_ID_UserLabel = 0x41
-const ATTRIBUTE_ID_UserLabel_LabelList = 0
-const CLUSTER_ID_LaundryWasherControls = 0x53
-const ATTRIBUTE_ID_LaundryWasherControls_SpinSpeeds = 0
-const ATTRIBUTE_ID_LaundryWasherControls_SpinSpeedCurrent = 1
-const ATTRIBUTE_ID_LaundryWasherControls_NumberOfRinses = 2
-const ATTRIBUTE_ID_LaundryWasherControls_SupportedRinses = 3
-const CLUSTER_ID_PowerSourceConfiguration = 0x2e
-const ATTRIBUTE_ID_PowerSourceConfiguration_Sources = 0
-const CLUSTER_ID_SmokeCOAlarm = 0x5c
-const COMMAND_ID_SmokeCOAlarm_SelfTestRequest = 0
-const ATTRIBUTE_ID_SmokeCOAlarm_ExpressedState = 0
-const ATTRIBUTE_ID_SmokeCOAlarm_SmokeState = 1
-const ATTRIBUTE_ID_SmokeCOAlarm_COState = 2
-const ATTRIBUTE_ID_SmokeCOAlarm_BatteryAlert = 3
-const ATTRIBUTE_ID_SmokeCOAlarm_DeviceMuted = 4
-const ATTRIBUTE_ID_SmokeCOAlarm_TestInProgress = 5
-const ATTRIBUTE_ID_SmokeCOAlarm_HardwareFaultAlert = 6
-const ATTRIBUTE_ID_SmokeCOAlarm_EndOfServiceAlert = 7
-const ATTRIBUTE_ID_SmokeCOAlarm_InterconnectSmokeAlarm = 8
-const ATTRIBUTE_ID_SmokeCOAlarm_InterconnectCOAlarm = 9
-const ATTRIBUTE_ID_SmokeCOAlarm_ContaminationState = 10
-const ATTRIBUTE_ID_SmokeCOAlarm_SmokeSensitivityLevel = 11
-const ATTRIBUTE_ID_SmokeCOAlarm_ExpiryDate = 12
-const CLUSTER_ID_ApplicationBasic = 0x50d
-const ATTRIBUTE_ID_ApplicationBasic_VendorName = 0
-const ATTRIBUTE_ID_ApplicationBasic_VendorID = 1
-const ATTRIBUTE_ID_ApplicationBasic_ApplicationName = 2
-const ATTRIBUTE_ID_ApplicationBasic_ProductID = 3
-const ATTRIBUTE_ID_ApplicationBasic_Application = 4
-const ATTRIBUTE_ID_ApplicationBasic_Status = 5
-const ATTRIBUTE_ID_ApplicationBasic_ApplicationVersion = 6
-const ATTRIBUTE_ID_ApplicationBasic_AllowedVendorList = 7
+const EVENT_ID_DoorLock_DoorLockAlarm = 0
+const EVENT_ID_DoorLock_DoorStateChange = 1
+const EVENT_ID_DoorLock_LockOperation = 2
+const EVENT_ID_DoorLock_LockOperationError = 3
+const EVENT_ID_DoorLock_LockUserChange = 4
+const CLUSTER_ID_WindowCovering = 0x102
+const COMMAND_ID_WindowCovering_UpOrOpen = 0
+const COMMAND_ID_WindowCovering_DownOrClose = 1
+const COMMAND_ID_WindowCovering_StopMotion = 2
+const COMMAND_ID_WindowCovering_GoToLiftValue = 4
+const COMMAND_ID_WindowCovering_GoToLiftPercentage = 5
+const COMMAND_ID_WindowCovering_GoToTiltValue = 7
+const COMMAND_ID_WindowCovering_GoToTiltPercentage = 8
+const ATTRIBUTE_ID_WindowCovering_Type = 0
+const ATTRIBUTE_ID_WindowCovering_PhysicalClosedLimitLift = 1
+const ATTRIBUTE_ID_WindowCovering_PhysicalClosedLimitTilt = 2
+const ATTRIBUTE_ID_WindowCovering_CurrentPositionLift = 3
+const ATTRIBUTE_ID_WindowCovering_CurrentPositionTilt = 4
+const ATTRIBUTE_ID_WindowCovering_NumberOfActuationsLift = 5
+const ATTRIBUTE_ID_WindowCovering_NumberOfActuationsTilt = 6
+const ATTRIBUTE_ID_WindowCovering_ConfigStatus = 7
+const ATTRIBUTE_ID_WindowCovering_CurrentPositionLiftPercentage = 8
+const ATTRIBUTE_ID_WindowCovering_CurrentPositionTiltPercentage = 9
+const ATTRIBUTE_ID_WindowCovering_OperationalStatus = 10
+const ATTRIBUTE_ID_WindowCovering_TargetPositionLiftPercent100ths = 11
+const ATTRIBUTE_ID_WindowCovering_TargetPositionTiltPercent100ths = 12
+const ATTRIBUTE_ID_WindowCovering_EndProductType = 13
+const ATTRIBUTE_ID_WindowCovering_CurrentPositionLiftPercent100ths = 14
+const ATTRIBUTE_ID_WindowCovering_CurrentPositionTiltPercent100ths = 15
+const ATTRIBUTE_ID_WindowCovering_InstalledOpenLimitLift = 16
+const ATTRIBUTE_ID_WindowCovering_InstalledClosedLimitLift = 17
+const ATTRIBUTE_ID_WindowCovering_InstalledOpenLimitTilt = 18
+const ATTRIBUTE_ID_WindowCovering_InstalledClosedLimitTilt = 19
+const ATTRIBUTE_ID_WindowCovering_Mode = 23
+const ATTRIBUTE_ID_WindowCovering_SafetyStatus = 26
+const CLUSTER_ID_PumpConfigurationandControl = 0x200
+const ATTRIBUTE_ID_PumpConfigurationandControl_MaxPressure = 0
+const ATTRIBUTE_ID_PumpConfigurationandControl_MaxSpeed = 1
+const ATTRIBUTE_ID_PumpConfigurationandControl_MaxFlow = 2
+const ATTRIBUTE_ID_PumpConfigurationandControl_MinConstPressure = 3
+const ATTRIBUTE_ID_PumpConfigurationandControl_MaxConstPressure = 4
+const ATTRIBUTE_ID_PumpConfigurationandControl_MinCompPressure = 5
+const ATTRIBUTE_ID_PumpConfigurationandControl_MaxCompPressure = 6
+const ATTRIBUTE_ID_PumpConfigurationandControl_MinConstSpeed = 7
+const ATTRIBUTE_ID_PumpConfigurationandControl_MaxConstSpeed = 8
+const ATTRIBUTE_ID_PumpConfigurationandControl_MinConstFlow = 9
+const ATTRIBUTE_ID_PumpConfigurationandControl_MaxConstFlow = 10
+const ATTRIBUTE_ID_PumpConfigurationandControl_MinConstTemp = 11
+const ATTRIBUTE_ID_PumpConfigurationandControl_MaxConstTemp = 12
+const ATTRIBUTE_ID_PumpConfigurationandControl_PumpStatus = 16
+const ATTRIBUTE_ID_PumpConfigurationandControl_EffectiveOperationMode = 17
+const ATTRIBUTE_ID_PumpConfigurationandControl_EffectiveControlMode = 18
+const ATTRIBUTE_ID_PumpConfigurationandControl_Capacity = 19
+const ATTRIBUTE_ID_PumpConfigurationandControl_Speed = 20
+const ATTRIBUTE_ID_PumpConfigurationandControl_LifetimeRunningHours = 21
+const ATTRIBUTE_ID_PumpConfigurationandControl_Power = 22
+const ATTRIBUTE_ID_PumpConfigurationandControl_LifetimeEnergyConsumed = 23
+const ATTRIBUTE_ID_PumpConfigurationandControl_OperationMode = 32
+const ATTRIBUTE_ID_PumpConfigurationandControl_ControlMode = 33
+const EVENT_ID_PumpConfigurationandControl_SupplyVoltageLow = 0
+const EVENT_ID_PumpConfigurationandControl_SupplyVoltageHigh = 1
+const EVENT_ID_PumpConfigurationandControl_PowerMissingPhase = 2
+const EVENT_ID_PumpConfigurationandControl_SystemPressureLow = 3
+const EVENT_ID_PumpConfigurationandControl_SystemPressureHigh = 4
+const EVENT_ID_PumpConfigurationandControl_DryRunning = 5
+const EVENT_ID_PumpConfigurationandControl_MotorTemperatureHigh = 6
+const EVENT_ID_PumpConfigurationandControl_PumpMotorFatalFailure = 7
+const EVENT_ID_PumpConfigurationandControl_ElectronicTemperatureHigh = 8
+const EVENT_ID_PumpConfigurationandControl_PumpBlocked = 9
+const EVENT_ID_PumpConfigurationandControl_SensorFailure = 10
+const EVENT_ID_PumpConfigurationandControl_ElectronicNonFatalFailure = 11
+const EVENT_ID_PumpConfigurationandControl_ElectronicFatalFailure = 12
+const EVENT_ID_PumpConfigurationandControl_GeneralFault = 13
+const EVENT_ID_PumpConfigurationandControl_Leakage = 14
+const EVENT_ID_PumpConfigurationandControl_AirDetection = 15
+const EVENT_ID_PumpConfigurationandControl_TurbineOperation = 16
+const CLUSTER_ID_Thermostat = 0x201
+const COMMAND_ID_Thermostat_SetpointRaiseLower = 0
+const COMMAND_ID_Thermostat_GetWeeklyScheduleResponse = 0
+const COMMAND_ID_Thermostat_SetWeeklySchedule = 1
+const COMMAND_ID_Thermostat_GetWeeklySchedule = 2
+const COMMAND_ID_Thermostat_ClearWeeklySchedule = 3
+const ATTRIBUTE_ID_Thermostat_LocalTemperature = 0
+const ATTRIBUTE_ID_Thermostat_OutdoorTemperature = 1
+const ATTRIBUTE_ID_Thermostat_Occupancy = 2
+const ATTRIBUTE_ID_Thermostat_AbsMinHeatSetpointLimit = 3
+const ATTRIBUTE_ID_Thermostat_AbsMaxHeatSetpointLimit = 4
+const ATTRIBUTE_ID_Thermostat_AbsMinCoolSetpointLimit = 5
+const ATTRIBUTE_ID_Thermostat_AbsMaxCoolSetpointLimit = 6
+const ATTRIBUTE_ID_Thermostat_PICoolingDemand = 7
+const ATTRIBUTE_ID_Thermostat_PIHeatingDemand = 8
+const ATTRIBUTE_ID_Thermostat_HVACSystemTypeConfiguration = 9
+const ATTRIBUTE_ID_Thermostat_LocalTemperatureCalibration = 16
+const ATTRIBUTE_ID_Thermostat_OccupiedCoolingSetpoint = 17
+const ATTRIBUTE_ID_Thermostat_OccupiedHeatingSetpoint = 18
+const ATTRIBUTE_ID_Thermostat_UnoccupiedCoolingSetpoint = 19
+const ATTRIBUTE_ID_Thermostat_UnoccupiedHeatingSetpoint = 20
+const ATTRIBUTE_ID_Thermostat_MinHeatSetpointLimit = 21
+const ATTRIBUTE_ID_Thermostat_MaxHeatSetpointLimit = 22
+const ATTRIBUTE_ID_Thermostat_MinCoolSetpointLimit = 23
+const ATTRIBUTE_ID_Thermostat_MaxCoolSetpointLimit = 24
+const ATTRIBUTE_ID_Thermostat_MinSetpointDeadBand = 25
+const ATTRIBUTE_ID_Thermostat_RemoteSensing = 26
+const ATTRIBUTE_ID_Thermostat_ControlSequenceOfOperation = 27
+const ATTRIBUTE_ID_Thermostat_SystemMode = 28
+const ATTRIBUTE_ID_Thermostat_ThermostatRunningMode = 30
+const ATTRIBUTE_ID_Thermostat_StartOfWeek = 32
+const ATTRIBUTE_ID_Thermostat_NumberOfWeeklyTransitions = 33
+const ATTRIBUTE_ID_Thermostat_NumberOfDailyTransitions = 34
+const ATTRIBUTE_ID_Thermostat_TemperatureSetpointHold = 35
+const ATTRIBUTE_ID_Thermostat_TemperatureSetpointHoldDuration = 36
+const ATTRIBUTE_ID_Thermostat_ThermostatProgrammingOperationMode = 37
+const ATTRIBUTE_ID_Thermostat_ThermostatRunningState = 41
+const ATTRIBUTE_ID_Thermostat_SetpointChangeSource = 48
+const ATTRIBUTE_ID_Thermostat_SetpointChangeAmount = 49
+const ATTRIBUTE_ID_Thermostat_SetpointChangeSourceTimestamp = 50
+const ATTRIBUTE_ID_Thermostat_OccupiedSetback = 52
+const ATTRIBUTE_ID_Thermostat_OccupiedSetbackMin = 53
+const ATTRIBUTE_ID_Thermostat_OccupiedSetbackMax = 54
+const ATTRIBUTE_ID_Thermostat_UnoccupiedSetback = 55
+const ATTRIBUTE_ID_Thermostat_UnoccupiedSetbackMin = 56
+const ATTRIBUTE_ID_Thermostat_UnoccupiedSetbackMax = 57
+const ATTRIBUTE_ID_Thermostat_EmergencyHeatDelta = 58
+const ATTRIBUTE_ID_Thermostat_ACType = 64
+const ATTRIBUTE_ID_Thermostat_ACCapacity = 65
+const ATTRIBUTE_ID_Thermostat_ACRefrigerantType = 66
+const ATTRIBUTE_ID_Thermostat_ACCompressorType = 67
+const ATTRIBUTE_ID_Thermostat_ACErrorCode = 68
+const ATTRIBUTE_ID_Thermostat_ACLouverPosition = 69
+const ATTRIBUTE_ID_Thermostat_ACCoilTemperature = 70
+const ATTRIBUTE_ID_Thermostat_ACCapacityformat = 71
 const CLUSTER_ID_FanControl = 0x202
 const COMMAND_ID_FanControl_Step = 0
 const ATTRIBUTE_ID_FanControl_FanMode = 0
@@ -781,24 +965,10 @@ const ATTRIBUTE_ID_FanControl_RockSetting = 8
 const ATTRIBUTE_ID_FanControl_WindSupport = 9
 const ATTRIBUTE_ID_FanControl_WindSetting = 10
 const ATTRIBUTE_ID_FanControl_AirflowDirection = 11
-const CLUSTER_ID_KeypadInput = 0x509
-const COMMAND_ID_KeypadInput_SendKey = 0
-const COMMAND_ID_KeypadInput_SendKeyResponse = 1
-const CLUSTER_ID_LaundryDryerControls = 0x4a
-const ATTRIBUTE_ID_LaundryDryerControls_SupportedDrynessLevels = 0
-const ATTRIBUTE_ID_LaundryDryerControls_SelectedDrynessLevel = 1
-const CLUSTER_ID_OnOff = 0x6
-const COMMAND_ID_OnOff_Off = 0
-const COMMAND_ID_OnOff_On = 1
-const COMMAND_ID_OnOff_Toggle = 2
-const COMMAND_ID_OnOff_OffWithEffect = 64
-const COMMAND_ID_OnOff_OnWithRecallGlobalScene = 65
-const COMMAND_ID_OnOff_OnWithTimedOff = 66
-const ATTRIBUTE_ID_OnOff_OnOff = 0
-const ATTRIBUTE_ID_OnOff_GlobalSceneControl = 16384
-const ATTRIBUTE_ID_OnOff_OnTime = 16385
-const ATTRIBUTE_ID_OnOff_OffWaitTime = 16386
-const ATTRIBUTE_ID_OnOff_StartUpOnOff = 16387
+const CLUSTER_ID_ThermostatUserInterfaceConfiguration = 0x204
+const ATTRIBUTE_ID_ThermostatUserInterfaceConfiguration_TemperatureDisplayMode = 0
+const ATTRIBUTE_ID_ThermostatUserInterfaceConfiguration_KeypadLockout = 1
+const ATTRIBUTE_ID_ThermostatUserInterfaceConfiguration_ScheduleProgrammingVisibility = 2
 const CLUSTER_ID_ColorControl = 0x300
 const COMMAND_ID_ColorControl_MoveToHue = 0
 const COMMAND_ID_ColorControl_MoveHue = 1
@@ -871,265 +1041,7017 @@ const ATTRIBUTE_ID_ColorControl_ColorTempPhysicalMinMireds = 16395
 const ATTRIBUTE_ID_ColorControl_ColorTempPhysicalMaxMireds = 16396
 const ATTRIBUTE_ID_ColorControl_CoupleColorTempToLevelMinMireds = 16397
 const ATTRIBUTE_ID_ColorControl_StartUpColorTemperatureMireds = 16400
-const CLUSTER_ID_EnergyCalendar = 0x9a
-const ATTRIBUTE_ID_EnergyCalendar_CalendarID = 0
-const ATTRIBUTE_ID_EnergyCalendar_Name = 1
-const ATTRIBUTE_ID_EnergyCalendar_ProviderID = 2
-const ATTRIBUTE_ID_EnergyCalendar_EventID = 3
-const ATTRIBUTE_ID_EnergyCalendar_StartDate = 4
-const ATTRIBUTE_ID_EnergyCalendar_TimeReference = 5
-const ATTRIBUTE_ID_EnergyCalendar_CalendarPeriods = 6
-const ATTRIBUTE_ID_EnergyCalendar_SpecialDays = 7
-const ATTRIBUTE_ID_EnergyCalendar_CurrentDay = 10
-const ATTRIBUTE_ID_EnergyCalendar_NextDay = 13
-const ATTRIBUTE_ID_EnergyCalendar_CurrentTransition = 13
-const ATTRIBUTE_ID_EnergyCalendar_PeakPeriodStatus = 15
-const ATTRIBUTE_ID_EnergyCalendar_PeakPeriodStartTime = 16
-const ATTRIBUTE_ID_EnergyCalendar_PeakPeriodEndTime = 17
-const CLUSTER_ID_ModeSelect = 0x50
-const COMMAND_ID_ModeSelect_ChangeToMode = 0
-const ATTRIBUTE_ID_ModeSelect_Description = 0
-const ATTRIBUTE_ID_ModeSelect_StandardNamespace = 1
-const ATTRIBUTE_ID_ModeSelect_SupportedModes = 2
-const ATTRIBUTE_ID_ModeSelect_CurrentMode = 3
-const ATTRIBUTE_ID_ModeSelect_StartUpMode = 4
-const ATTRIBUTE_ID_ModeSelect_OnMode = 5
-const CLUSTER_ID_DishwasherMode = 0x59
-const ATTRIBUTE_ID_DishwasherMode_SupportedModes = 0
-const ATTRIBUTE_ID_DishwasherMode_CurrentMode = 1
-const ATTRIBUTE_ID_DishwasherMode_StartUpMode = 2
-const ATTRIBUTE_ID_DishwasherMode_OnMode = 3
-const CLUSTER_ID_RVCOperationalState = 0x61
-const CLUSTER_ID_TimeSync = 0x38
-const CLUSTER_ID_DemandResponseandLoadControl = 0x96
-const COMMAND_ID_DemandResponseandLoadControl_RegisterLoadControlProgramRequest = 0
-const COMMAND_ID_DemandResponseandLoadControl_UnregisterLoadControlProgramRequest = 1
-const COMMAND_ID_DemandResponseandLoadControl_AddLoadControlEventRequest = 2
-const COMMAND_ID_DemandResponseandLoadControl_RemoveLoadControlEventRequest = 3
-const COMMAND_ID_DemandResponseandLoadControl_ClearLoadControlEventsRequest = 4
-const ATTRIBUTE_ID_DemandResponseandLoadControl_DeviceClass = 0
-const ATTRIBUTE_ID_DemandResponseandLoadControl_LoadControlPrograms = 1
-const ATTRIBUTE_ID_DemandResponseandLoadControl_NumberOfLoadControlPrograms = 2
-const ATTRIBUTE_ID_DemandResponseandLoadControl_Events = 3
-const ATTRIBUTE_ID_DemandResponseandLoadControl_NumberOfEventsPerProgram = 4
-const ATTRIBUTE_ID_DemandResponseandLoadControl_NumberOfTransitions = 5
-const ATTRIBUTE_ID_DemandResponseandLoadControl_DefaultRandomStart = 6
-const ATTRIBUTE_ID_DemandResponseandLoadControl_DefaultRandomDuration = 7
-const CLUSTER_ID_DeviceEnergyManagement = 0x98
-const COMMAND_ID_DeviceEnergyManagement_PowerAdjustRequest = 0
-const COMMAND_ID_DeviceEnergyManagement_CancelPowerAdjustRequest = 1
-const COMMAND_ID_DeviceEnergyManagement_StartTimeAdjustRequest = 2
-const COMMAND_ID_DeviceEnergyManagement_PauseRequest = 3
-const COMMAND_ID_DeviceEnergyManagement_ResumeRequest = 4
-const COMMAND_ID_DeviceEnergyManagement_ModifyPowerForecastRequest = 5
-const COMMAND_ID_DeviceEnergyManagement_RequestLimitBasedPowerForecast = 6
-const ATTRIBUTE_ID_DeviceEnergyManagement_EsaType = 0
-const ATTRIBUTE_ID_DeviceEnergyManagement_EsaIsGenerator = 1
-const ATTRIBUTE_ID_DeviceEnergyManagement_EsaState = 2
-const ATTRIBUTE_ID_DeviceEnergyManagement_AbsMinPower = 3
-const ATTRIBUTE_ID_DeviceEnergyManagement_AbsMaxPower = 4
-const ATTRIBUTE_ID_DeviceEnergyManagement_PowerAdjustmentCapability = 5
-const ATTRIBUTE_ID_DeviceEnergyManagement_PowerForecast = 6
-const CLUSTER_ID_GeneralDiagnostics = 0x33
-const COMMAND_ID_GeneralDiagnostics_TestEventTrigger = 0
-const COMMAND_ID_GeneralDiagnostics_TimeSnapshot = 1
-const COMMAND_ID_GeneralDiagnostics_TimeSnapshotResponse = 2
-const ATTRIBUTE_ID_GeneralDiagnostics_NetworkInterfaces = 0
-const ATTRIBUTE_ID_GeneralDiagnostics_RebootCount = 1
-const ATTRIBUTE_ID_GeneralDiagnostics_UpTime = 2
-const ATTRIBUTE_ID_GeneralDiagnostics_TotalOperationalHours = 3
-const ATTRIBUTE_ID_GeneralDiagnostics_BootReason = 4
-const ATTRIBUTE_ID_GeneralDiagnostics_ActiveHardwareFaults = 5
-const ATTRIBUTE_ID_GeneralDiagnostics_ActiveRadioFaults = 6
-const ATTRIBUTE_ID_GeneralDiagnostics_ActiveNetworkFaults = 7
-const ATTRIBUTE_ID_GeneralDiagnostics_TestEventTriggersEnabled = 8
-const CLUSTER_ID_EnergyPreference = 0x9b
-const ATTRIBUTE_ID_EnergyPreference_EnergyBalances = 0
-const ATTRIBUTE_ID_EnergyPreference_CurrentEnergyBalance = 1
-const ATTRIBUTE_ID_EnergyPreference_CurrentLowPowerModeSensitivity = 1
-const ATTRIBUTE_ID_EnergyPreference_EnergyPriorities = 2
-const ATTRIBUTE_ID_EnergyPreference_LowPowerModeSensitivities = 3
-const CLUSTER_ID_RVCCleanMode = 0x55
-const CLUSTER_ID_RefrigeratorAndTemperatureControlledCabinetMode = 0x52
-const ATTRIBUTE_ID_RefrigeratorAndTemperatureControlledCabinetMode_SupportedModes = 0
-const ATTRIBUTE_ID_RefrigeratorAndTemperatureControlledCabinetMode_CurrentMode = 1
-const ATTRIBUTE_ID_RefrigeratorAndTemperatureControlledCabinetMode_StartUpMode = 2
-const ATTRIBUTE_ID_RefrigeratorAndTemperatureControlledCabinetMode_OnMode = 3
+const CLUSTER_ID_BallastConfiguration = 0x301
+const ATTRIBUTE_ID_BallastConfiguration_PhysicalMinLevel = 0
+const ATTRIBUTE_ID_BallastConfiguration_PhysicalMaxLevel = 1
+const ATTRIBUTE_ID_BallastConfiguration_BallastStatus = 2
+const ATTRIBUTE_ID_BallastConfiguration_MinLevel = 16
+const ATTRIBUTE_ID_BallastConfiguration_MaxLevel = 17
+const ATTRIBUTE_ID_BallastConfiguration_IntrinsicBallastFactor = 20
+const ATTRIBUTE_ID_BallastConfiguration_BallastFactorAdjustment = 21
+const ATTRIBUTE_ID_BallastConfiguration_LampQuantity = 32
+const ATTRIBUTE_ID_BallastConfiguration_LampType = 48
+const ATTRIBUTE_ID_BallastConfiguration_LampManufacturer = 49
+const ATTRIBUTE_ID_BallastConfiguration_LampRatedHours = 50
+const ATTRIBUTE_ID_BallastConfiguration_LampBurnHours = 51
+const ATTRIBUTE_ID_BallastConfiguration_LampAlarmMode = 52
+const ATTRIBUTE_ID_BallastConfiguration_LampBurnHoursTripPoint = 53
+const CLUSTER_ID_IlluminanceMeasurement = 0x400
+const ATTRIBUTE_ID_IlluminanceMeasurement_MeasuredValue = 0
+const ATTRIBUTE_ID_IlluminanceMeasurement_MinMeasuredValue = 1
+const ATTRIBUTE_ID_IlluminanceMeasurement_MaxMeasuredValue = 2
+const ATTRIBUTE_ID_IlluminanceMeasurement_Tolerance = 3
+const ATTRIBUTE_ID_IlluminanceMeasurement_LightSensorType = 4
+const CLUSTER_ID_TemperatureMeasurement = 0x402
+const ATTRIBUTE_ID_TemperatureMeasurement_MeasuredValue = 0
+const ATTRIBUTE_ID_TemperatureMeasurement_MinMeasuredValue = 1
+const ATTRIBUTE_ID_TemperatureMeasurement_MaxMeasuredValue = 2
+const ATTRIBUTE_ID_TemperatureMeasurement_Tolerance = 3
+const CLUSTER_ID_PressureMeasurement = 0x403
+const ATTRIBUTE_ID_PressureMeasurement_MeasuredValue = 0
+const ATTRIBUTE_ID_PressureMeasurement_MinMeasuredValue = 1
+const ATTRIBUTE_ID_PressureMeasurement_MaxMeasuredValue = 2
+const ATTRIBUTE_ID_PressureMeasurement_Tolerance = 3
+const ATTRIBUTE_ID_PressureMeasurement_ScaledValue = 16
+const ATTRIBUTE_ID_PressureMeasurement_MinScaledValue = 17
+const ATTRIBUTE_ID_PressureMeasurement_MaxScaledValue = 18
+const ATTRIBUTE_ID_PressureMeasurement_ScaledTolerance = 19
+const ATTRIBUTE_ID_PressureMeasurement_Scale = 20
+const CLUSTER_ID_FlowMeasurement = 0x404
+const ATTRIBUTE_ID_FlowMeasurement_MeasuredValue = 0
+const ATTRIBUTE_ID_FlowMeasurement_MinMeasuredValue = 1
+const ATTRIBUTE_ID_FlowMeasurement_MaxMeasuredValue = 2
+const ATTRIBUTE_ID_FlowMeasurement_Tolerance = 3
+const CLUSTER_ID_OccupancySensing = 0x406
+const ATTRIBUTE_ID_OccupancySensing_Occupancy = 0
+const ATTRIBUTE_ID_OccupancySensing_OccupancySensorType = 1
+const ATTRIBUTE_ID_OccupancySensing_OccupancySensorTypeBitmap = 2
+const ATTRIBUTE_ID_OccupancySensing_PIROccupiedToUnoccupiedDelay = 16
+const ATTRIBUTE_ID_OccupancySensing_PIRUnoccupiedToOccupiedDelay = 17
+const ATTRIBUTE_ID_OccupancySensing_PIRUnoccupiedToOccupiedThreshold = 18
+const ATTRIBUTE_ID_OccupancySensing_UltrasonicOccupiedToUnoccupiedDelay = 32
+const ATTRIBUTE_ID_OccupancySensing_UltrasonicUnoccupiedToOccupiedDelay = 33
+const ATTRIBUTE_ID_OccupancySensing_UltrasonicUnoccupiedToOccupiedThreshold = 34
+const ATTRIBUTE_ID_OccupancySensing_PhysicalContactOccupiedToUnoccupiedDelay = 48
+const ATTRIBUTE_ID_OccupancySensing_PhysicalContactUnoccupiedToOccupiedDelay = 49
+const ATTRIBUTE_ID_OccupancySensing_PhysicalContactUnoccupiedToOccupiedThreshold = 50
+const CLUSTER_ID_WakeonLAN = 0x503
+const ATTRIBUTE_ID_WakeonLAN_MACAddress = 0
+const ATTRIBUTE_ID_WakeonLAN_LinkLocalAddress = 1
+const CLUSTER_ID_Channel = 0x504
+const COMMAND_ID_Channel_ChangeChannel = 0
+const COMMAND_ID_Channel_ChangeChannelResponse = 1
+const COMMAND_ID_Channel_ChangeChannelByNumber = 2
+const COMMAND_ID_Channel_SkipChannel = 3
+const ATTRIBUTE_ID_Channel_ChannelList = 0
+const ATTRIBUTE_ID_Channel_Lineup = 1
+const ATTRIBUTE_ID_Channel_CurrentChannel = 2
+const CLUSTER_ID_TargetNavigator = 0x505
+const COMMAND_ID_TargetNavigator_NavigateTarget = 0
+const COMMAND_ID_TargetNavigator_NavigateTargetResponse = 1
+const ATTRIBUTE_ID_TargetNavigator_TargetList = 0
+const ATTRIBUTE_ID_TargetNavigator_CurrentTarget = 1
+const CLUSTER_ID_MediaPlayback = 0x506
+const COMMAND_ID_MediaPlayback_Play = 0
+const COMMAND_ID_MediaPlayback_Pause = 1
+const COMMAND_ID_MediaPlayback_Stop = 2
+const COMMAND_ID_MediaPlayback_StartOver = 3
+const COMMAND_ID_MediaPlayback_Previous = 4
+const COMMAND_ID_MediaPlayback_Next = 5
+const COMMAND_ID_MediaPlayback_Rewind = 6
+const COMMAND_ID_MediaPlayback_FastForward = 7
+const COMMAND_ID_MediaPlayback_SkipForward = 8
+const COMMAND_ID_MediaPlayback_SkipBackward = 9
+const COMMAND_ID_MediaPlayback_PlaybackResponse = 10
+const COMMAND_ID_MediaPlayback_Seek = 11
+const ATTRIBUTE_ID_MediaPlayback_CurrentState = 0
+const ATTRIBUTE_ID_MediaPlayback_StartTime = 1
+const ATTRIBUTE_ID_MediaPlayback_Duration = 2
+const ATTRIBUTE_ID_MediaPlayback_SampledPosition = 3
+const ATTRIBUTE_ID_MediaPlayback_PlaybackSpeed = 4
+const ATTRIBUTE_ID_MediaPlayback_SeekRangeEnd = 5
+const ATTRIBUTE_ID_MediaPlayback_SeekRangeStart = 6
+const CLUSTER_ID_MediaInput = 0x507
+const COMMAND_ID_MediaInput_SelectInput = 0
+const COMMAND_ID_MediaInput_ShowInputStatus = 1
+const COMMAND_ID_MediaInput_HideInputStatus = 2
+const COMMAND_ID_MediaInput_RenameInput = 3
+const ATTRIBUTE_ID_MediaInput_InputList = 0
+const ATTRIBUTE_ID_MediaInput_CurrentInput = 1
+const CLUSTER_ID_LowPower = 0x508
+const COMMAND_ID_LowPower_Sleep = 0
+const CLUSTER_ID_KeypadInput = 0x509
+const COMMAND_ID_KeypadInput_SendKey = 0
+const COMMAND_ID_KeypadInput_SendKeyResponse = 1
+const CLUSTER_ID_ContentLauncher = 0x50a
+const COMMAND_ID_ContentLauncher_LaunchContent = 0
+const COMMAND_ID_ContentLauncher_LaunchURL = 1
+const COMMAND_ID_ContentLauncher_LauncherResponse = 2
+const ATTRIBUTE_ID_ContentLauncher_AcceptHeader = 0
+const ATTRIBUTE_ID_ContentLauncher_SupportedStreamingProtocols = 1
 const CLUSTER_ID_AudioOutput = 0x50b
 const COMMAND_ID_AudioOutput_SelectOutput = 0
 const COMMAND_ID_AudioOutput_RenameOutput = 1
 const ATTRIBUTE_ID_AudioOutput_OutputList = 0
 const ATTRIBUTE_ID_AudioOutput_CurrentOutput = 1
+const CLUSTER_ID_ApplicationLauncher = 0x50c
+const COMMAND_ID_ApplicationLauncher_LaunchApp = 0
+const COMMAND_ID_ApplicationLauncher_StopApp = 1
+const COMMAND_ID_ApplicationLauncher_HideApp = 2
+const COMMAND_ID_ApplicationLauncher_LauncherResponse = 3
+const ATTRIBUTE_ID_ApplicationLauncher_CatalogList = 0
+const ATTRIBUTE_ID_ApplicationLauncher_CurrentApp = 1
+const CLUSTER_ID_ApplicationBasic = 0x50d
+const ATTRIBUTE_ID_ApplicationBasic_VendorName = 0
+const ATTRIBUTE_ID_ApplicationBasic_VendorID = 1
+const ATTRIBUTE_ID_ApplicationBasic_ApplicationName = 2
+const ATTRIBUTE_ID_ApplicationBasic_ProductID = 3
+const ATTRIBUTE_ID_ApplicationBasic_Application = 4
+const ATTRIBUTE_ID_ApplicationBasic_Status = 5
+const ATTRIBUTE_ID_ApplicationBasic_ApplicationVersion = 6
+const ATTRIBUTE_ID_ApplicationBasic_AllowedVendorList = 7
+const CLUSTER_ID_AccountLogin = 0x50e
+const COMMAND_ID_AccountLogin_GetSetupPIN = 0
+const COMMAND_ID_AccountLogin_GetSetupPINResponse = 1
+const COMMAND_ID_AccountLogin_Login = 2
+const COMMAND_ID_AccountLogin_Logout = 3
+const EVENT_ID_AccountLogin_LoggedOut = 0
 const CLUSTER_ID_MicrowaveOvenControl = 0x50f
 const COMMAND_ID_MicrowaveOvenControl_SetCookingParameters = 0
 const COMMAND_ID_MicrowaveOvenControl_AddMoreTime = 1
-const ATTRIBUTE_ID_MicrowaveOvenControl_CookTime = 1
+const ATTRIBUTE_ID_MicrowaveOvenControl_CookTime = 0
+const ATTRIBUTE_ID_MicrowaveOvenControl_MaxCookTime = 1
 const ATTRIBUTE_ID_MicrowaveOvenControl_PowerSetting = 2
 const ATTRIBUTE_ID_MicrowaveOvenControl_MinPower = 3
 const ATTRIBUTE_ID_MicrowaveOvenControl_MaxPower = 4
 const ATTRIBUTE_ID_MicrowaveOvenControl_PowerStep = 5
-const CLUSTER_ID_OccupancySensing = 0x406
-const ATTRIBUTE_ID_OccupancySensing_Occupancy = 0
-const ATTRIBUTE_ID_OccupancySensing_OccupancySensorType = 1
-const ATTRIBUTE_ID_OccupancySensing_OccupancySensorTypeBitmap = 2
-const ATTRIBUTE_ID_OccupancySensing_PIROccupiedToUnoccupiedDelay = 16
-const ATTRIBUTE_ID_OccupancySensing_PIRUnoccupiedToOccupiedDelay = 17
-const ATTRIBUTE_ID_OccupancySensing_PIRUnoccupiedToOccupiedThreshold = 18
-const ATTRIBUTE_ID_OccupancySensing_UltrasonicOccupiedToUnoccupiedDelay = 32
-const ATTRIBUTE_ID_OccupancySensing_UltrasonicUnoccupiedToOccupiedDelay = 33
-const ATTRIBUTE_ID_OccupancySensing_UltrasonicUnoccupiedToOccupiedThreshold = 34
-const ATTRIBUTE_ID_OccupancySensing_PhysicalContactOccupiedToUnoccupiedDelay = 48
-const ATTRIBUTE_ID_OccupancySensing_PhysicalContactUnoccupiedToOccupiedDelay = 49
-const ATTRIBUTE_ID_OccupancySensing_PhysicalContactUnoccupiedToOccupiedThreshold = 50
-const CLUSTER_ID_WakeonLAN = 0x503
-const ATTRIBUTE_ID_WakeonLAN_MACAddress = 0
-const ATTRIBUTE_ID_WakeonLAN_LinkLocalAddress = 1
-const CLUSTER_ID_Binding = 0x1e
-const ATTRIBUTE_ID_Binding_Binding = 0
-const CLUSTER_ID_LowPower = 0x508
-const COMMAND_ID_LowPower_Sleep = 0
-const CLUSTER_ID_Timer = 0x47
-const COMMAND_ID_Timer_SetTimer = 0
-const COMMAND_ID_Timer_ResetTimer = 1
-const COMMAND_ID_Timer_AddTime = 2
-const COMMAND_ID_Timer_ReduceTime = 3
-const ATTRIBUTE_ID_Timer_SetTime = 0
-const ATTRIBUTE_ID_Timer_TimeRemaining = 1
-const ATTRIBUTE_ID_Timer_TimerState = 2
-const CLUSTER_ID_BooleanSensorConfiguration = 0x80
-const COMMAND_ID_BooleanSensorConfiguration_SuppressRequest = 0
-const ATTRIBUTE_ID_BooleanSensorConfiguration_SensitivityLevel = 0
-const ATTRIBUTE_ID_BooleanSensorConfiguration_AlarmsActive = 1
-const ATTRIBUTE_ID_BooleanSensorConfiguration_AlarmsSuppressed = 2
-const ATTRIBUTE_ID_BooleanSensorConfiguration_AlarmsEnabled = 3
-const CLUSTER_ID_DiagnosticLogs = 0x32
-const COMMAND_ID_DiagnosticLogs_RetrieveLogsRequest = 0
-const COMMAND_ID_DiagnosticLogs_RetrieveLogsResponse = 1
-const CLUSTER_ID_GeneralCommissioning = 0x30
-const ATTRIBUTE_ID_GeneralCommissioning_Breadcrumb = 0
-const ATTRIBUTE_ID_GeneralCommissioning_BasicCommissioningInfo = 1
-const ATTRIBUTE_ID_GeneralCommissioning_RegulatoryConfig = 2
-const ATTRIBUTE_ID_GeneralCommissioning_LocationCapability = 3
-const ATTRIBUTE_ID_GeneralCommissioning_SupportsConcurrentConnection = 4
-const CLUSTER_ID_PumpConfigurationandControl = 0x200
-const CLUSTER_ID_Scenes = 0x5
-const COMMAND_ID_Scenes_AddScene = 0
-const COMMAND_ID_Scenes_AddSceneResponse = 0
-const COMMAND_ID_Scenes_ViewScene = 1
-const COMMAND_ID_Scenes_ViewSceneResponse = 1
-const COMMAND_ID_Scenes_RemoveScene = 2
-const COMMAND_ID_Scenes_RemoveSceneResponse = 2
-const COMMAND_ID_Scenes_RemoveAllScenes = 3
-const COMMAND_ID_Scenes_RemoveAllScenesResponse = 3
-const COMMAND_ID_Scenes_StoreScene = 4
-const COMMAND_ID_Scenes_StoreSceneResponse = 4
-const COMMAND_ID_Scenes_RecallScene = 5
-const COMMAND_ID_Scenes_GetSceneMembership = 6
-const COMMAND_ID_Scenes_GetSceneMembershipResponse = 6
-const COMMAND_ID_Scenes_EnhancedAddScene = 64
-const COMMAND_ID_Scenes_EnhancedAddSceneResponse = 64
-const COMMAND_ID_Scenes_EnhancedViewScene = 65
-const COMMAND_ID_Scenes_EnhancedViewSceneResponse = 65
-const COMMAND_ID_Scenes_CopyScene = 66
-const COMMAND_ID_Scenes_CopySceneResponse = 66
-const ATTRIBUTE_ID_Scenes_SceneCount = 0
-const ATTRIBUTE_ID_Scenes_CurrentScene = 1
-const ATTRIBUTE_ID_Scenes_CurrentGroup = 2
-const ATTRIBUTE_ID_Scenes_SceneValid = 3
-const ATTRIBUTE_ID_Scenes_NameSupport = 4
-const ATTRIBUTE_ID_Scenes_LastConfiguredBy = 5
-const ATTRIBUTE_ID_Scenes_SceneTableSize = 6
-const ATTRIBUTE_ID_Scenes_FabricSceneInfo = 7
+const ATTRIBUTE_ID_MicrowaveOvenControl_SupportedWatts = 6
+const ATTRIBUTE_ID_MicrowaveOvenControl_SelectedWattIndex = 7
+const ATTRIBUTE_ID_MicrowaveOvenControl_WattRating = 8
+const CLUSTER_ID_ContentAppObserver = 0x510
+const COMMAND_ID_ContentAppObserver_ContentAppMessage = 0
+const COMMAND_ID_ContentAppObserver_ContentAppMessageResponse = 1
 
 var ClusterNameMap = map[int]string{
-	CLUSTER_ID_SoftwareDiagnostics:                             "SoftwareDiagnostics",
-	CLUSTER_ID_DoorLock:                                        "DoorLock",
-	CLUSTER_ID_Groups:                                          "Groups",
 	CLUSTER_ID_Identify:                                        "Identify",
+	CLUSTER_ID_Groups:                                          "Groups",
+	CLUSTER_ID_Scenes:                                          "Scenes",
+	CLUSTER_ID_OnOff:                                           "OnOff",
+	CLUSTER_ID_LevelControl:                                    "LevelControl",
+	CLUSTER_ID_Descriptor:                                      "Descriptor",
+	CLUSTER_ID_Binding:                                         "Binding",
+	CLUSTER_ID_AccessControl:                                   "AccessControl",
+	CLUSTER_ID_Actions:                                         "Actions",
+	CLUSTER_ID_BasicInformation:                                "BasicInformation",
 	CLUSTER_ID_LocalizationConfiguration:                       "LocalizationConfiguration",
-	CLUSTER_ID_OvenMode:                                        "OvenMode",
-	CLUSTER_ID_ValveConfigurationandControl:                    "ValveConfigurationandControl",
-	CLUSTER_ID_NetworkInfrastructure:                           "NetworkInfrastructure",
-	CLUSTER_ID_Channel:                                         "Channel",
-	CLUSTER_ID_UserLabel:                                       "UserLabel",
-	CLUSTER_ID_LaundryWasherControls:                           "LaundryWasherControls",
+	CLUSTER_ID_TimeFormatLocalization:                          "TimeFormatLocalization",
+	CLUSTER_ID_UnitLocalization:                                "UnitLocalization",
 	CLUSTER_ID_PowerSourceConfiguration:                        "PowerSourceConfiguration",
-	CLUSTER_ID_SmokeCOAlarm:                                    "SmokeCOAlarm",
-	CLUSTER_ID_TemperatureMeasurement:                          "TemperatureMeasurement",
-	CLUSTER_ID_ApplicationBasic:                                "ApplicationBasic",
-	CLUSTER_ID_FanControl:                                      "FanControl",
-	CLUSTER_ID_KeypadInput:                                     "KeypadInput",
+	CLUSTER_ID_PowerSource:                                     "PowerSource",
+	CLUSTER_ID_GeneralCommissioning:                            "GeneralCommissioning",
+	CLUSTER_ID_NetworkCommissioning:                            "NetworkCommissioning",
+	CLUSTER_ID_DiagnosticLogs:                                  "DiagnosticLogs",
+	CLUSTER_ID_GeneralDiagnostics:                              "GeneralDiagnostics",
+	CLUSTER_ID_SoftwareDiagnostics:                             "SoftwareDiagnostics",
+	CLUSTER_ID_ThreadNetworkDiagnostics:                        "ThreadNetworkDiagnostics",
+	CLUSTER_ID_WiFiNetworkDiagnostics:                          "WiFiNetworkDiagnostics",
+	CLUSTER_ID_EthernetNetworkDiagnostics:                      "EthernetNetworkDiagnostics",
+	CLUSTER_ID_TimeSync:                                        "TimeSync",
+	CLUSTER_ID_BridgedDeviceBasicInformation:                   "BridgedDeviceBasicInformation",
+	CLUSTER_ID_Switch:                                          "Switch",
+	CLUSTER_ID_AdministratorCommissioning:                      "AdministratorCommissioning",
+	CLUSTER_ID_OperationalCredentials:                          "OperationalCredentials",
+	CLUSTER_ID_GroupKeyManagement:                              "GroupKeyManagement",
+	CLUSTER_ID_FixedLabel:                                      "FixedLabel",
+	CLUSTER_ID_UserLabel:                                       "UserLabel",
+	CLUSTER_ID_BooleanState:                                    "BooleanState",
+	CLUSTER_ID_Timer:                                           "Timer",
+	CLUSTER_ID_OvenCavityOperationalState:                      "OvenCavityOperationalState",
+	CLUSTER_ID_OvenMode:                                        "OvenMode",
 	CLUSTER_ID_LaundryDryerControls:                            "LaundryDryerControls",
-	CLUSTER_ID_OnOff:                                           "OnOff",
-	CLUSTER_ID_ColorControl:                                    "ColorControl",
-	CLUSTER_ID_EnergyCalendar:                                  "EnergyCalendar",
 	CLUSTER_ID_ModeSelect:                                      "ModeSelect",
+	CLUSTER_ID_LaundryWasherMode:                               "LaundryWasherMode",
+	CLUSTER_ID_RefrigeratorAndTemperatureControlledCabinetMode: "RefrigeratorAndTemperatureControlledCabinetMode",
+	CLUSTER_ID_LaundryWasherControls:                           "LaundryWasherControls",
+	CLUSTER_ID_RVCRunMode:                                      "RVCRunMode",
+	CLUSTER_ID_RVCCleanMode:                                    "RVCCleanMode",
+	CLUSTER_ID_TemperatureControl:                              "TemperatureControl",
+	CLUSTER_ID_RefrigeratorAlarm:                               "RefrigeratorAlarm",
 	CLUSTER_ID_DishwasherMode:                                  "DishwasherMode",
+	CLUSTER_ID_AirQuality:                                      "AirQuality",
+	CLUSTER_ID_SmokeCOAlarm:                                    "SmokeCOAlarm",
+	CLUSTER_ID_DishwasherAlarm:                                 "DishwasherAlarm",
+	CLUSTER_ID_MicrowaveOvenMode:                               "MicrowaveOvenMode",
+	CLUSTER_ID_OperationalState:                                "OperationalState",
 	CLUSTER_ID_RVCOperationalState:                             "RVCOperationalState",
-	CLUSTER_ID_TimeSync:                                        "TimeSync",
+	CLUSTER_ID_BooleanSensorConfiguration:                      "BooleanSensorConfiguration",
+	CLUSTER_ID_ValveConfigurationandControl:                    "ValveConfigurationandControl",
+	CLUSTER_ID_ElectricalPowerMeasurement:                      "ElectricalPowerMeasurement",
+	CLUSTER_ID_ElectricalEnergyMeasurement:                     "ElectricalEnergyMeasurement",
+	CLUSTER_ID_WaterHeaterManagement:                           "WaterHeaterManagement",
+	CLUSTER_ID_EnergyPrice:                                     "EnergyPrice",
 	CLUSTER_ID_DemandResponseandLoadControl:                    "DemandResponseandLoadControl",
+	CLUSTER_ID_Messages:                                        "Messages",
 	CLUSTER_ID_DeviceEnergyManagement:                          "DeviceEnergyManagement",
-	CLUSTER_ID_GeneralDiagnostics:                              "GeneralDiagnostics",
+	CLUSTER_ID_EnergyCalendar:                                  "EnergyCalendar",
 	CLUSTER_ID_EnergyPreference:                                "EnergyPreference",
-	CLUSTER_ID_RVCCleanMode:                                    "RVCCleanMode",
-	CLUSTER_ID_RefrigeratorAndTemperatureControlledCabinetMode: "RefrigeratorAndTemperatureControlledCabinetMode",
-	CLUSTER_ID_AudioOutput:                                     "AudioOutput",
-	CLUSTER_ID_MicrowaveOvenControl:                            "MicrowaveOvenControl",
-	CLUSTER_ID_OccupancySensing:                                "OccupancySensing",
-	CLUSTER_ID_WakeonLAN:                                       "WakeonLAN",
-	CLUSTER_ID_Binding:                                         "Binding",
-	CLUSTER_ID_LowPower:                                        "LowPower",
-	CLUSTER_ID_Timer:                                           "Timer",
-	CLUSTER_ID_BooleanSensorConfiguration:                      "BooleanSensorConfiguration",
-	CLUSTER_ID_DiagnosticLogs:                                  "DiagnosticLogs",
-	CLUSTER_ID_GeneralCommissioning:                            "GeneralCommissioning",
+	CLUSTER_ID_DoorLock:                                        "DoorLock",
+	CLUSTER_ID_WindowCovering:                                  "WindowCovering",
 	CLUSTER_ID_PumpConfigurationandControl:                     "PumpConfigurationandControl",
-	CLUSTER_ID_Scenes:                                          "Scenes",
-	CLUSTER_ID_TargetNavigator:                                 "TargetNavigator",
-	CLUSTER_ID_AccessControl:                                   "AccessControl",
+	CLUSTER_ID_Thermostat:                                      "Thermostat",
+	CLUSTER_ID_FanControl:                                      "FanControl",
+	CLUSTER_ID_ThermostatUserInterfaceConfiguration:            "ThermostatUserInterfaceConfiguration",
+	CLUSTER_ID_ColorControl:                                    "ColorControl",
 	CLUSTER_ID_BallastConfiguration:                            "BallastConfiguration",
-	CLUSTER_ID_MediaPlayback:                                   "MediaPlayback",
-	CLUSTER_ID_MicrowaveOvenMode:                               "MicrowaveOvenMode",
-	CLUSTER_ID_OperationalCredentials:                          "OperationalCredentials",
-	CLUSTER_ID_OperationalState:                                "OperationalState",
+	CLUSTER_ID_IlluminanceMeasurement:                          "IlluminanceMeasurement",
+	CLUSTER_ID_TemperatureMeasurement:                          "TemperatureMeasurement",
 	CLUSTER_ID_PressureMeasurement:                             "PressureMeasurement",
-	CLUSTER_ID_WindowCovering:                                  "WindowCovering",
-	CLUSTER_ID_Descriptor:                                      "Descriptor",
-	CLUSTER_ID_ThreadNetworkDiagnostics:                        "ThreadNetworkDiagnostics",
 	CLUSTER_ID_FlowMeasurement:                                 "FlowMeasurement",
-	CLUSTER_ID_LevelControl:                                    "LevelControl",
-	CLUSTER_ID_Switch:                                          "Switch",
-	CLUSTER_ID_Thermostat:                                      "Thermostat",
-	CLUSTER_ID_ElectricalEnergyMeasurement:                     "ElectricalEnergyMeasurement",
-	CLUSTER_ID_IlluminanceMeasurement:                          "IlluminanceMeasurement",
-	CLUSTER_ID_FixedLabel:                                      "FixedLabel",
-	CLUSTER_ID_LaundryWasherMode:                               "LaundryWasherMode",
-	CLUSTER_ID_NetworkCommissioning:                            "NetworkCommissioning",
-	CLUSTER_ID_OvenCavityOperationalState:                      "OvenCavityOperationalState",
-	CLUSTER_ID_RefrigeratorAlarm:                               "RefrigeratorAlarm",
-	CLUSTER_ID_AirQuality:                                      "AirQuality",
-	CLUSTER_ID_ApplicationLauncher:                             "ApplicationLauncher",
-	CLUSTER_ID_BasicInformation:                                "BasicInformation",
-	CLUSTER_ID_EnergyPrice:                                     "EnergyPrice",
+	CLUSTER_ID_OccupancySensing:                                "OccupancySensing",
+	CLUSTER_ID_WakeonLAN:                                       "WakeonLAN",
+	CLUSTER_ID_Channel:                                         "Channel",
+	CLUSTER_ID_TargetNavigator:                                 "TargetNavigator",
+	CLUSTER_ID_MediaPlayback:                                   "MediaPlayback",
 	CLUSTER_ID_MediaInput:                                      "MediaInput",
-	CLUSTER_ID_TemperatureControl:                              "TemperatureControl",
-	CLUSTER_ID_ContentAppObserver:                              "ContentAppObserver",
-	CLUSTER_ID_ElectricalPowerMeasurement:                      "ElectricalPowerMeasurement",
-	CLUSTER_ID_WaterHeaterManagement:                           "WaterHeaterManagement",
-	CLUSTER_ID_Actions:                                         "Actions",
-	CLUSTER_ID_BridgedDeviceBasicInformation:                   "BridgedDeviceBasicInformation",
-	CLUSTER_ID_EthernetNetworkDiagnostics:                      "EthernetNetworkDiagnostics",
-	CLUSTER_ID_WiFiNetworkDiagnostics:                          "WiFiNetworkDiagnostics",
-	CLUSTER_ID_DishwasherAlarm:                                 "DishwasherAlarm",
-	CLUSTER_ID_GroupKeyManagement:                              "GroupKeyManagement",
-	CLUSTER_ID_UnitLocalization:                                "UnitLocalization",
-	CLUSTER_ID_AdministratorCommissioning:                      "AdministratorCommissioning",
-	CLUSTER_ID_BooleanState:                                    "BooleanState",
-	CLUSTER_ID_TimeFormatLocalization:                          "TimeFormatLocalization",
-	CLUSTER_ID_Messages:                                        "Messages",
-	CLUSTER_ID_RVCRunMode:                                      "RVCRunMode",
-	CLUSTER_ID_PowerSource:                                     "PowerSource",
-	CLUSTER_ID_ThermostatUserInterfaceConfiguration:            "ThermostatUserInterfaceConfiguration",
-	CLUSTER_ID_AccountLogin:                                    "AccountLogin",
+	CLUSTER_ID_LowPower:                                        "LowPower",
+	CLUSTER_ID_KeypadInput:                                     "KeypadInput",
 	CLUSTER_ID_ContentLauncher:                                 "ContentLauncher",
+	CLUSTER_ID_AudioOutput:                                     "AudioOutput",
+	CLUSTER_ID_ApplicationLauncher:                             "ApplicationLauncher",
+	CLUSTER_ID_ApplicationBasic:                                "ApplicationBasic",
+	CLUSTER_ID_AccountLogin:                                    "AccountLogin",
+	CLUSTER_ID_MicrowaveOvenControl:                            "MicrowaveOvenControl",
+	CLUSTER_ID_ContentAppObserver:                              "ContentAppObserver",
+}
+
+// ClusterIdMap maps name of cluster to its id.
+var ClusterIdMap = map[string]int{
+	"Identify":                      CLUSTER_ID_Identify,
+	"Groups":                        CLUSTER_ID_Groups,
+	"Scenes":                        CLUSTER_ID_Scenes,
+	"OnOff":                         CLUSTER_ID_OnOff,
+	"LevelControl":                  CLUSTER_ID_LevelControl,
+	"Descriptor":                    CLUSTER_ID_Descriptor,
+	"Binding":                       CLUSTER_ID_Binding,
+	"AccessControl":                 CLUSTER_ID_AccessControl,
+	"Actions":                       CLUSTER_ID_Actions,
+	"BasicInformation":              CLUSTER_ID_BasicInformation,
+	"LocalizationConfiguration":     CLUSTER_ID_LocalizationConfiguration,
+	"TimeFormatLocalization":        CLUSTER_ID_TimeFormatLocalization,
+	"UnitLocalization":              CLUSTER_ID_UnitLocalization,
+	"PowerSourceConfiguration":      CLUSTER_ID_PowerSourceConfiguration,
+	"PowerSource":                   CLUSTER_ID_PowerSource,
+	"GeneralCommissioning":          CLUSTER_ID_GeneralCommissioning,
+	"NetworkCommissioning":          CLUSTER_ID_NetworkCommissioning,
+	"DiagnosticLogs":                CLUSTER_ID_DiagnosticLogs,
+	"GeneralDiagnostics":            CLUSTER_ID_GeneralDiagnostics,
+	"SoftwareDiagnostics":           CLUSTER_ID_SoftwareDiagnostics,
+	"ThreadNetworkDiagnostics":      CLUSTER_ID_ThreadNetworkDiagnostics,
+	"WiFiNetworkDiagnostics":        CLUSTER_ID_WiFiNetworkDiagnostics,
+	"EthernetNetworkDiagnostics":    CLUSTER_ID_EthernetNetworkDiagnostics,
+	"TimeSync":                      CLUSTER_ID_TimeSync,
+	"BridgedDeviceBasicInformation": CLUSTER_ID_BridgedDeviceBasicInformation,
+	"Switch":                        CLUSTER_ID_Switch,
+	"AdministratorCommissioning":    CLUSTER_ID_AdministratorCommissioning,
+	"OperationalCredentials":        CLUSTER_ID_OperationalCredentials,
+	"GroupKeyManagement":            CLUSTER_ID_GroupKeyManagement,
+	"FixedLabel":                    CLUSTER_ID_FixedLabel,
+	"UserLabel":                     CLUSTER_ID_UserLabel,
+	"BooleanState":                  CLUSTER_ID_BooleanState,
+	"Timer":                         CLUSTER_ID_Timer,
+	"OvenCavityOperationalState":    CLUSTER_ID_OvenCavityOperationalState,
+	"OvenMode":                      CLUSTER_ID_OvenMode,
+	"LaundryDryerControls":          CLUSTER_ID_LaundryDryerControls,
+	"ModeSelect":                    CLUSTER_ID_ModeSelect,
+	"LaundryWasherMode":             CLUSTER_ID_LaundryWasherMode,
+	"RefrigeratorAndTemperatureControlledCabinetMode": CLUSTER_ID_RefrigeratorAndTemperatureControlledCabinetMode,
+	"LaundryWasherControls":                           CLUSTER_ID_LaundryWasherControls,
+	"RVCRunMode":                                      CLUSTER_ID_RVCRunMode,
+	"RVCCleanMode":                                    CLUSTER_ID_RVCCleanMode,
+	"TemperatureControl":                              CLUSTER_ID_TemperatureControl,
+	"RefrigeratorAlarm":                               CLUSTER_ID_RefrigeratorAlarm,
+	"DishwasherMode":                                  CLUSTER_ID_DishwasherMode,
+	"AirQuality":                                      CLUSTER_ID_AirQuality,
+	"SmokeCOAlarm":                                    CLUSTER_ID_SmokeCOAlarm,
+	"DishwasherAlarm":                                 CLUSTER_ID_DishwasherAlarm,
+	"MicrowaveOvenMode":                               CLUSTER_ID_MicrowaveOvenMode,
+	"OperationalState":                                CLUSTER_ID_OperationalState,
+	"RVCOperationalState":                             CLUSTER_ID_RVCOperationalState,
+	"BooleanSensorConfiguration":                      CLUSTER_ID_BooleanSensorConfiguration,
+	"ValveConfigurationandControl":                    CLUSTER_ID_ValveConfigurationandControl,
+	"ElectricalPowerMeasurement":                      CLUSTER_ID_ElectricalPowerMeasurement,
+	"ElectricalEnergyMeasurement":                     CLUSTER_ID_ElectricalEnergyMeasurement,
+	"WaterHeaterManagement":                           CLUSTER_ID_WaterHeaterManagement,
+	"EnergyPrice":                                     CLUSTER_ID_EnergyPrice,
+	"DemandResponseandLoadControl":                    CLUSTER_ID_DemandResponseandLoadControl,
+	"Messages":                                        CLUSTER_ID_Messages,
+	"DeviceEnergyManagement":                          CLUSTER_ID_DeviceEnergyManagement,
+	"EnergyCalendar":                                  CLUSTER_ID_EnergyCalendar,
+	"EnergyPreference":                                CLUSTER_ID_EnergyPreference,
+	"DoorLock":                                        CLUSTER_ID_DoorLock,
+	"WindowCovering":                                  CLUSTER_ID_WindowCovering,
+	"PumpConfigurationandControl":                     CLUSTER_ID_PumpConfigurationandControl,
+	"Thermostat":                                      CLUSTER_ID_Thermostat,
+	"FanControl":                                      CLUSTER_ID_FanControl,
+	"ThermostatUserInterfaceConfiguration":            CLUSTER_ID_ThermostatUserInterfaceConfiguration,
+	"ColorControl":                                    CLUSTER_ID_ColorControl,
+	"BallastConfiguration":                            CLUSTER_ID_BallastConfiguration,
+	"IlluminanceMeasurement":                          CLUSTER_ID_IlluminanceMeasurement,
+	"TemperatureMeasurement":                          CLUSTER_ID_TemperatureMeasurement,
+	"PressureMeasurement":                             CLUSTER_ID_PressureMeasurement,
+	"FlowMeasurement":                                 CLUSTER_ID_FlowMeasurement,
+	"OccupancySensing":                                CLUSTER_ID_OccupancySensing,
+	"WakeonLAN":                                       CLUSTER_ID_WakeonLAN,
+	"Channel":                                         CLUSTER_ID_Channel,
+	"TargetNavigator":                                 CLUSTER_ID_TargetNavigator,
+	"MediaPlayback":                                   CLUSTER_ID_MediaPlayback,
+	"MediaInput":                                      CLUSTER_ID_MediaInput,
+	"LowPower":                                        CLUSTER_ID_LowPower,
+	"KeypadInput":                                     CLUSTER_ID_KeypadInput,
+	"ContentLauncher":                                 CLUSTER_ID_ContentLauncher,
+	"AudioOutput":                                     CLUSTER_ID_AudioOutput,
+	"ApplicationLauncher":                             CLUSTER_ID_ApplicationLauncher,
+	"ApplicationBasic":                                CLUSTER_ID_ApplicationBasic,
+	"AccountLogin":                                    CLUSTER_ID_AccountLogin,
+	"MicrowaveOvenControl":                            CLUSTER_ID_MicrowaveOvenControl,
+	"ContentAppObserver":                              CLUSTER_ID_ContentAppObserver,
+}
+
+// AttributeNameMap maps cluster id and attribute id to name of attribute.
+var AttributeNameMap = map[int]map[int]string{
+	CLUSTER_ID_Identify: {
+		ATTRIBUTE_ID_Identify_IdentifyTime: "IdentifyTime",
+		ATTRIBUTE_ID_Identify_IdentifyType: "IdentifyType",
+	},
+	CLUSTER_ID_Groups: {
+		ATTRIBUTE_ID_Groups_NameSupport: "NameSupport",
+	},
+	CLUSTER_ID_Scenes: {
+		ATTRIBUTE_ID_Scenes_SceneCount:       "SceneCount",
+		ATTRIBUTE_ID_Scenes_CurrentScene:     "CurrentScene",
+		ATTRIBUTE_ID_Scenes_CurrentGroup:     "CurrentGroup",
+		ATTRIBUTE_ID_Scenes_SceneValid:       "SceneValid",
+		ATTRIBUTE_ID_Scenes_NameSupport:      "NameSupport",
+		ATTRIBUTE_ID_Scenes_LastConfiguredBy: "LastConfiguredBy",
+		ATTRIBUTE_ID_Scenes_SceneTableSize:   "SceneTableSize",
+		ATTRIBUTE_ID_Scenes_FabricSceneInfo:  "FabricSceneInfo",
+	},
+	CLUSTER_ID_OnOff: {
+		ATTRIBUTE_ID_OnOff_OnOff:              "OnOff",
+		ATTRIBUTE_ID_OnOff_GlobalSceneControl: "GlobalSceneControl",
+		ATTRIBUTE_ID_OnOff_OnTime:             "OnTime",
+		ATTRIBUTE_ID_OnOff_OffWaitTime:        "OffWaitTime",
+		ATTRIBUTE_ID_OnOff_StartUpOnOff:       "StartUpOnOff",
+	},
+	CLUSTER_ID_LevelControl: {
+		ATTRIBUTE_ID_LevelControl_CurrentLevel:        "CurrentLevel",
+		ATTRIBUTE_ID_LevelControl_RemainingTime:       "RemainingTime",
+		ATTRIBUTE_ID_LevelControl_MinLevel:            "MinLevel",
+		ATTRIBUTE_ID_LevelControl_MaxLevel:            "MaxLevel",
+		ATTRIBUTE_ID_LevelControl_CurrentFrequency:    "CurrentFrequency",
+		ATTRIBUTE_ID_LevelControl_MinFrequency:        "MinFrequency",
+		ATTRIBUTE_ID_LevelControl_MaxFrequency:        "MaxFrequency",
+		ATTRIBUTE_ID_LevelControl_Options:             "Options",
+		ATTRIBUTE_ID_LevelControl_OnOffTransitionTime: "OnOffTransitionTime",
+		ATTRIBUTE_ID_LevelControl_OnLevel:             "OnLevel",
+		ATTRIBUTE_ID_LevelControl_OnTransitionTime:    "OnTransitionTime",
+		ATTRIBUTE_ID_LevelControl_OffTransitionTime:   "OffTransitionTime",
+		ATTRIBUTE_ID_LevelControl_DefaultMoveRate:     "DefaultMoveRate",
+		ATTRIBUTE_ID_LevelControl_StartUpCurrentLevel: "StartUpCurrentLevel",
+	},
+	CLUSTER_ID_Descriptor: {
+		ATTRIBUTE_ID_Descriptor_DeviceTypeList: "DeviceTypeList",
+		ATTRIBUTE_ID_Descriptor_ServerList:     "ServerList",
+		ATTRIBUTE_ID_Descriptor_ClientList:     "ClientList",
+		ATTRIBUTE_ID_Descriptor_PartsList:      "PartsList",
+		ATTRIBUTE_ID_Descriptor_TagList:        "TagList",
+	},
+	CLUSTER_ID_Binding: {
+		ATTRIBUTE_ID_Binding_Binding: "Binding",
+	},
+	CLUSTER_ID_AccessControl: {
+		ATTRIBUTE_ID_AccessControl_ACL:                           "ACL",
+		ATTRIBUTE_ID_AccessControl_Extension:                     "Extension",
+		ATTRIBUTE_ID_AccessControl_SubjectsPerAccessControlEntry: "SubjectsPerAccessControlEntry",
+		ATTRIBUTE_ID_AccessControl_TargetsPerAccessControlEntry:  "TargetsPerAccessControlEntry",
+		ATTRIBUTE_ID_AccessControl_AccessControlEntriesPerFabric: "AccessControlEntriesPerFabric",
+	},
+	CLUSTER_ID_Actions: {
+		ATTRIBUTE_ID_Actions_ActionList:    "ActionList",
+		ATTRIBUTE_ID_Actions_EndpointLists: "EndpointLists",
+		ATTRIBUTE_ID_Actions_SetupURL:      "SetupURL",
+	},
+	CLUSTER_ID_BasicInformation: {
+		ATTRIBUTE_ID_BasicInformation_DataModelRevision:     "DataModelRevision",
+		ATTRIBUTE_ID_BasicInformation_VendorName:            "VendorName",
+		ATTRIBUTE_ID_BasicInformation_VendorID:              "VendorID",
+		ATTRIBUTE_ID_BasicInformation_ProductName:           "ProductName",
+		ATTRIBUTE_ID_BasicInformation_ProductID:             "ProductID",
+		ATTRIBUTE_ID_BasicInformation_NodeLabel:             "NodeLabel",
+		ATTRIBUTE_ID_BasicInformation_Location:              "Location",
+		ATTRIBUTE_ID_BasicInformation_HardwareVersion:       "HardwareVersion",
+		ATTRIBUTE_ID_BasicInformation_HardwareVersionString: "HardwareVersionString",
+		ATTRIBUTE_ID_BasicInformation_SoftwareVersion:       "SoftwareVersion",
+		ATTRIBUTE_ID_BasicInformation_SoftwareVersionString: "SoftwareVersionString",
+		ATTRIBUTE_ID_BasicInformation_ManufacturingDate:     "ManufacturingDate",
+		ATTRIBUTE_ID_BasicInformation_PartNumber:            "PartNumber",
+		ATTRIBUTE_ID_BasicInformation_ProductURL:            "ProductURL",
+		ATTRIBUTE_ID_BasicInformation_ProductLabel:          "ProductLabel",
+		ATTRIBUTE_ID_BasicInformation_SerialNumber:          "SerialNumber",
+		ATTRIBUTE_ID_BasicInformation_LocalConfigDisabled:   "LocalConfigDisabled",
+		ATTRIBUTE_ID_BasicInformation_Reachable:             "Reachable",
+		ATTRIBUTE_ID_BasicInformation_UniqueID:              "UniqueID",
+		ATTRIBUTE_ID_BasicInformation_CapabilityMinima:      "CapabilityMinima",
+		ATTRIBUTE_ID_BasicInformation_ProductAppearance:     "ProductAppearance",
+		ATTRIBUTE_ID_BasicInformation_SpecificationVersion:  "SpecificationVersion",
+		ATTRIBUTE_ID_BasicInformation_MaxPathsPerInvoke:     "MaxPathsPerInvoke",
+	},
+	CLUSTER_ID_LocalizationConfiguration: {
+		ATTRIBUTE_ID_LocalizationConfiguration_ActiveLocale:     "ActiveLocale",
+		ATTRIBUTE_ID_LocalizationConfiguration_SupportedLocales: "SupportedLocales",
+	},
+	CLUSTER_ID_TimeFormatLocalization: {
+		ATTRIBUTE_ID_TimeFormatLocalization_HourFormat:             "HourFormat",
+		ATTRIBUTE_ID_TimeFormatLocalization_ActiveCalendarType:     "ActiveCalendarType",
+		ATTRIBUTE_ID_TimeFormatLocalization_SupportedCalendarTypes: "SupportedCalendarTypes",
+	},
+	CLUSTER_ID_UnitLocalization: {
+		ATTRIBUTE_ID_UnitLocalization_TemperatureUnit: "TemperatureUnit",
+	},
+	CLUSTER_ID_PowerSourceConfiguration: {
+		ATTRIBUTE_ID_PowerSourceConfiguration_Sources: "Sources",
+	},
+	CLUSTER_ID_PowerSource: {
+		ATTRIBUTE_ID_PowerSource_Status:                      "Status",
+		ATTRIBUTE_ID_PowerSource_Order:                       "Order",
+		ATTRIBUTE_ID_PowerSource_Description:                 "Description",
+		ATTRIBUTE_ID_PowerSource_WiredAssessedInputVoltage:   "WiredAssessedInputVoltage",
+		ATTRIBUTE_ID_PowerSource_WiredAssessedInputFrequency: "WiredAssessedInputFrequency",
+		ATTRIBUTE_ID_PowerSource_WiredCurrentType:            "WiredCurrentType",
+		ATTRIBUTE_ID_PowerSource_WiredAssessedCurrent:        "WiredAssessedCurrent",
+		ATTRIBUTE_ID_PowerSource_WiredNominalVoltage:         "WiredNominalVoltage",
+		ATTRIBUTE_ID_PowerSource_WiredMaximumCurrent:         "WiredMaximumCurrent",
+		ATTRIBUTE_ID_PowerSource_WiredPresent:                "WiredPresent",
+		ATTRIBUTE_ID_PowerSource_ActiveWiredFaults:           "ActiveWiredFaults",
+		ATTRIBUTE_ID_PowerSource_BatVoltage:                  "BatVoltage",
+		ATTRIBUTE_ID_PowerSource_BatPercentRemaining:         "BatPercentRemaining",
+		ATTRIBUTE_ID_PowerSource_BatTimeRemaining:            "BatTimeRemaining",
+		ATTRIBUTE_ID_PowerSource_BatChargeLevel:              "BatChargeLevel",
+		ATTRIBUTE_ID_PowerSource_BatReplacementNeeded:        "BatReplacementNeeded",
+		ATTRIBUTE_ID_PowerSource_BatReplaceability:           "BatReplaceability",
+		ATTRIBUTE_ID_PowerSource_BatPresent:                  "BatPresent",
+		ATTRIBUTE_ID_PowerSource_ActiveBatFaults:             "ActiveBatFaults",
+		ATTRIBUTE_ID_PowerSource_BatReplacementDescription:   "BatReplacementDescription",
+		ATTRIBUTE_ID_PowerSource_BatCommonDesignation:        "BatCommonDesignation",
+		ATTRIBUTE_ID_PowerSource_BatANSIDesignation:          "BatANSIDesignation",
+		ATTRIBUTE_ID_PowerSource_BatIECDesignation:           "BatIECDesignation",
+		ATTRIBUTE_ID_PowerSource_BatApprovedChemistry:        "BatApprovedChemistry",
+		ATTRIBUTE_ID_PowerSource_BatCapacity:                 "BatCapacity",
+		ATTRIBUTE_ID_PowerSource_BatQuantity:                 "BatQuantity",
+		ATTRIBUTE_ID_PowerSource_BatChargeState:              "BatChargeState",
+		ATTRIBUTE_ID_PowerSource_BatTimeToFullCharge:         "BatTimeToFullCharge",
+		ATTRIBUTE_ID_PowerSource_BatFunctionalWhileCharging:  "BatFunctionalWhileCharging",
+		ATTRIBUTE_ID_PowerSource_BatChargingCurrent:          "BatChargingCurrent",
+		ATTRIBUTE_ID_PowerSource_ActiveBatChargeFaults:       "ActiveBatChargeFaults",
+		ATTRIBUTE_ID_PowerSource_EndpointList:                "EndpointList",
+	},
+	CLUSTER_ID_GeneralCommissioning: {
+		ATTRIBUTE_ID_GeneralCommissioning_Breadcrumb:                   "Breadcrumb",
+		ATTRIBUTE_ID_GeneralCommissioning_BasicCommissioningInfo:       "BasicCommissioningInfo",
+		ATTRIBUTE_ID_GeneralCommissioning_RegulatoryConfig:             "RegulatoryConfig",
+		ATTRIBUTE_ID_GeneralCommissioning_LocationCapability:           "LocationCapability",
+		ATTRIBUTE_ID_GeneralCommissioning_SupportsConcurrentConnection: "SupportsConcurrentConnection",
+	},
+	CLUSTER_ID_NetworkCommissioning: {
+		ATTRIBUTE_ID_NetworkCommissioning_MaxNetworks:             "MaxNetworks",
+		ATTRIBUTE_ID_NetworkCommissioning_Networks:                "Networks",
+		ATTRIBUTE_ID_NetworkCommissioning_ScanMaxTimeSeconds:      "ScanMaxTimeSeconds",
+		ATTRIBUTE_ID_NetworkCommissioning_ConnectMaxTimeSeconds:   "ConnectMaxTimeSeconds",
+		ATTRIBUTE_ID_NetworkCommissioning_InterfaceEnabled:        "InterfaceEnabled",
+		ATTRIBUTE_ID_NetworkCommissioning_LastNetworkingStatus:    "LastNetworkingStatus",
+		ATTRIBUTE_ID_NetworkCommissioning_LastNetworkID:           "LastNetworkID",
+		ATTRIBUTE_ID_NetworkCommissioning_LastConnectErrorValue:   "LastConnectErrorValue",
+		ATTRIBUTE_ID_NetworkCommissioning_SupportedWiFiBands:      "SupportedWiFiBands",
+		ATTRIBUTE_ID_NetworkCommissioning_SupportedThreadFeatures: "SupportedThreadFeatures",
+		ATTRIBUTE_ID_NetworkCommissioning_ThreadVersion:           "ThreadVersion",
+	},
+	CLUSTER_ID_GeneralDiagnostics: {
+		ATTRIBUTE_ID_GeneralDiagnostics_NetworkInterfaces:        "NetworkInterfaces",
+		ATTRIBUTE_ID_GeneralDiagnostics_RebootCount:              "RebootCount",
+		ATTRIBUTE_ID_GeneralDiagnostics_UpTime:                   "UpTime",
+		ATTRIBUTE_ID_GeneralDiagnostics_TotalOperationalHours:    "TotalOperationalHours",
+		ATTRIBUTE_ID_GeneralDiagnostics_BootReason:               "BootReason",
+		ATTRIBUTE_ID_GeneralDiagnostics_ActiveHardwareFaults:     "ActiveHardwareFaults",
+		ATTRIBUTE_ID_GeneralDiagnostics_ActiveRadioFaults:        "ActiveRadioFaults",
+		ATTRIBUTE_ID_GeneralDiagnostics_ActiveNetworkFaults:      "ActiveNetworkFaults",
+		ATTRIBUTE_ID_GeneralDiagnostics_TestEventTriggersEnabled: "TestEventTriggersEnabled",
+	},
+	CLUSTER_ID_SoftwareDiagnostics: {
+		ATTRIBUTE_ID_SoftwareDiagnostics_ThreadMetrics:            "ThreadMetrics",
+		ATTRIBUTE_ID_SoftwareDiagnostics_CurrentHeapFree:          "CurrentHeapFree",
+		ATTRIBUTE_ID_SoftwareDiagnostics_CurrentHeapUsed:          "CurrentHeapUsed",
+		ATTRIBUTE_ID_SoftwareDiagnostics_CurrentHeapHighWatermark: "CurrentHeapHighWatermark",
+	},
+	CLUSTER_ID_ThreadNetworkDiagnostics: {
+		ATTRIBUTE_ID_ThreadNetworkDiagnostics_Channel:                           "Channel",
+		ATTRIBUTE_ID_ThreadNetworkDiagnostics_RoutingRole:                       "RoutingRole",
+		ATTRIBUTE_ID_ThreadNetworkDiagnostics_NetworkName:                       "NetworkName",
+		ATTRIBUTE_ID_ThreadNetworkDiagnostics_PanId:                             "PanId",
+		ATTRIBUTE_ID_ThreadNetworkDiagnostics_ExtendedPanId:                     "ExtendedPanId",
+		ATTRIBUTE_ID_ThreadNetworkDiagnostics_MeshLocalPrefix:                   "MeshLocalPrefix",
+		ATTRIBUTE_ID_ThreadNetworkDiagnostics_OverrunCount:                      "OverrunCount",
+		ATTRIBUTE_ID_ThreadNetworkDiagnostics_NeighborTable:                     "NeighborTable",
+		ATTRIBUTE_ID_ThreadNetworkDiagnostics_RouteTable:                        "RouteTable",
+		ATTRIBUTE_ID_ThreadNetworkDiagnostics_PartitionId:                       "PartitionId",
+		ATTRIBUTE_ID_ThreadNetworkDiagnostics_Weighting:                         "Weighting",
+		ATTRIBUTE_ID_ThreadNetworkDiagnostics_DataVersion:                       "DataVersion",
+		ATTRIBUTE_ID_ThreadNetworkDiagnostics_StableDataVersion:                 "StableDataVersion",
+		ATTRIBUTE_ID_ThreadNetworkDiagnostics_LeaderRouterId:                    "LeaderRouterId",
+		ATTRIBUTE_ID_ThreadNetworkDiagnostics_DetachedRoleCount:                 "DetachedRoleCount",
+		ATTRIBUTE_ID_ThreadNetworkDiagnostics_ChildRoleCount:                    "ChildRoleCount",
+		ATTRIBUTE_ID_ThreadNetworkDiagnostics_RouterRoleCount:                   "RouterRoleCount",
+		ATTRIBUTE_ID_ThreadNetworkDiagnostics_LeaderRoleCount:                   "LeaderRoleCount",
+		ATTRIBUTE_ID_ThreadNetworkDiagnostics_AttachAttemptCount:                "AttachAttemptCount",
+		ATTRIBUTE_ID_ThreadNetworkDiagnostics_PartitionIdChangeCount:            "PartitionIdChangeCount",
+		ATTRIBUTE_ID_ThreadNetworkDiagnostics_BetterPartitionAttachAttemptCount: "BetterPartitionAttachAttemptCount",
+		ATTRIBUTE_ID_ThreadNetworkDiagnostics_ParentChangeCount:                 "ParentChangeCount",
+		ATTRIBUTE_ID_ThreadNetworkDiagnostics_TxTotalCount:                      "TxTotalCount",
+		ATTRIBUTE_ID_ThreadNetworkDiagnostics_TxUnicastCount:                    "TxUnicastCount",
+		ATTRIBUTE_ID_ThreadNetworkDiagnostics_TxBroadcastCount:                  "TxBroadcastCount",
+		ATTRIBUTE_ID_ThreadNetworkDiagnostics_TxAckRequestedCount:               "TxAckRequestedCount",
+		ATTRIBUTE_ID_ThreadNetworkDiagnostics_TxAckedCount:                      "TxAckedCount",
+		ATTRIBUTE_ID_ThreadNetworkDiagnostics_TxNoAckRequestedCount:             "TxNoAckRequestedCount",
+		ATTRIBUTE_ID_ThreadNetworkDiagnostics_TxDataCount:                       "TxDataCount",
+		ATTRIBUTE_ID_ThreadNetworkDiagnostics_TxDataPollCount:                   "TxDataPollCount",
+		ATTRIBUTE_ID_ThreadNetworkDiagnostics_TxBeaconCount:                     "TxBeaconCount",
+		ATTRIBUTE_ID_ThreadNetworkDiagnostics_TxBeaconRequestCount:              "TxBeaconRequestCount",
+		ATTRIBUTE_ID_ThreadNetworkDiagnostics_TxOtherCount:                      "TxOtherCount",
+		ATTRIBUTE_ID_ThreadNetworkDiagnostics_TxRetryCount:                      "TxRetryCount",
+		ATTRIBUTE_ID_ThreadNetworkDiagnostics_TxDirectMaxRetryExpiryCount:       "TxDirectMaxRetryExpiryCount",
+		ATTRIBUTE_ID_ThreadNetworkDiagnostics_TxIndirectMaxRetryExpiryCount:     "TxIndirectMaxRetryExpiryCount",
+		ATTRIBUTE_ID_ThreadNetworkDiagnostics_TxErrCcaCount:                     "TxErrCcaCount",
+		ATTRIBUTE_ID_ThreadNetworkDiagnostics_TxErrAbortCount:                   "TxErrAbortCount",
+		ATTRIBUTE_ID_ThreadNetworkDiagnostics_TxErrBusyChannelCount:             "TxErrBusyChannelCount",
+		ATTRIBUTE_ID_ThreadNetworkDiagnostics_RxTotalCount:                      "RxTotalCount",
+		ATTRIBUTE_ID_ThreadNetworkDiagnostics_RxUnicastCount:                    "RxUnicastCount",
+		ATTRIBUTE_ID_ThreadNetworkDiagnostics_RxBroadcastCount:                  "RxBroadcastCount",
+		ATTRIBUTE_ID_ThreadNetworkDiagnostics_RxDataCount:                       "RxDataCount",
+		ATTRIBUTE_ID_ThreadNetworkDiagnostics_RxDataPollCount:                   "RxDataPollCount",
+		ATTRIBUTE_ID_ThreadNetworkDiagnostics_RxBeaconCount:                     "RxBeaconCount",
+		ATTRIBUTE_ID_ThreadNetworkDiagnostics_RxBeaconRequestCount:              "RxBeaconRequestCount",
+		ATTRIBUTE_ID_ThreadNetworkDiagnostics_RxOtherCount:                      "RxOtherCount",
+		ATTRIBUTE_ID_ThreadNetworkDiagnostics_RxAddressFilteredCount:            "RxAddressFilteredCount",
+		ATTRIBUTE_ID_ThreadNetworkDiagnostics_RxDestAddrFilteredCount:           "RxDestAddrFilteredCount",
+		ATTRIBUTE_ID_ThreadNetworkDiagnostics_RxDuplicatedCount:                 "RxDuplicatedCount",
+		ATTRIBUTE_ID_ThreadNetworkDiagnostics_RxErrNoFrameCount:                 "RxErrNoFrameCount",
+		ATTRIBUTE_ID_ThreadNetworkDiagnostics_RxErrUnknownNeighborCount:         "RxErrUnknownNeighborCount",
+		ATTRIBUTE_ID_ThreadNetworkDiagnostics_RxErrInvalidSrcAddrCount:          "RxErrInvalidSrcAddrCount",
+		ATTRIBUTE_ID_ThreadNetworkDiagnostics_RxErrSecCount:                     "RxErrSecCount",
+		ATTRIBUTE_ID_ThreadNetworkDiagnostics_RxErrFcsCount:                     "RxErrFcsCount",
+		ATTRIBUTE_ID_ThreadNetworkDiagnostics_RxErrOtherCount:                   "RxErrOtherCount",
+		ATTRIBUTE_ID_ThreadNetworkDiagnostics_ActiveTimestamp:                   "ActiveTimestamp",
+		ATTRIBUTE_ID_ThreadNetworkDiagnostics_PendingTimestamp:                  "PendingTimestamp",
+		ATTRIBUTE_ID_ThreadNetworkDiagnostics_Delay:                             "Delay",
+		ATTRIBUTE_ID_ThreadNetworkDiagnostics_SecurityPolicy:                    "SecurityPolicy",
+		ATTRIBUTE_ID_ThreadNetworkDiagnostics_ChannelPage0Mask:                  "ChannelPage0Mask",
+		ATTRIBUTE_ID_ThreadNetworkDiagnostics_OperationalDatasetComponents:      "OperationalDatasetComponents",
+		ATTRIBUTE_ID_ThreadNetworkDiagnostics_ActiveNetworkFaults:               "ActiveNetworkFaults",
+	},
+	CLUSTER_ID_WiFiNetworkDiagnostics: {
+		ATTRIBUTE_ID_WiFiNetworkDiagnostics_BSSID:                  "BSSID",
+		ATTRIBUTE_ID_WiFiNetworkDiagnostics_SecurityType:           "SecurityType",
+		ATTRIBUTE_ID_WiFiNetworkDiagnostics_WiFiVersion:            "WiFiVersion",
+		ATTRIBUTE_ID_WiFiNetworkDiagnostics_ChannelNumber:          "ChannelNumber",
+		ATTRIBUTE_ID_WiFiNetworkDiagnostics_RSSI:                   "RSSI",
+		ATTRIBUTE_ID_WiFiNetworkDiagnostics_BeaconLostCount:        "BeaconLostCount",
+		ATTRIBUTE_ID_WiFiNetworkDiagnostics_BeaconRxCount:          "BeaconRxCount",
+		ATTRIBUTE_ID_WiFiNetworkDiagnostics_PacketMulticastRxCount: "PacketMulticastRxCount",
+		ATTRIBUTE_ID_WiFiNetworkDiagnostics_PacketMulticastTxCount: "PacketMulticastTxCount",
+		ATTRIBUTE_ID_WiFiNetworkDiagnostics_PacketUnicastRxCount:   "PacketUnicastRxCount",
+		ATTRIBUTE_ID_WiFiNetworkDiagnostics_PacketUnicastTxCount:   "PacketUnicastTxCount",
+		ATTRIBUTE_ID_WiFiNetworkDiagnostics_CurrentMaxRate:         "CurrentMaxRate",
+		ATTRIBUTE_ID_WiFiNetworkDiagnostics_OverrunCount:           "OverrunCount",
+	},
+	CLUSTER_ID_EthernetNetworkDiagnostics: {
+		ATTRIBUTE_ID_EthernetNetworkDiagnostics_PHYRate:        "PHYRate",
+		ATTRIBUTE_ID_EthernetNetworkDiagnostics_FullDuplex:     "FullDuplex",
+		ATTRIBUTE_ID_EthernetNetworkDiagnostics_PacketRxCount:  "PacketRxCount",
+		ATTRIBUTE_ID_EthernetNetworkDiagnostics_PacketTxCount:  "PacketTxCount",
+		ATTRIBUTE_ID_EthernetNetworkDiagnostics_TxErrCount:     "TxErrCount",
+		ATTRIBUTE_ID_EthernetNetworkDiagnostics_CollisionCount: "CollisionCount",
+		ATTRIBUTE_ID_EthernetNetworkDiagnostics_OverrunCount:   "OverrunCount",
+		ATTRIBUTE_ID_EthernetNetworkDiagnostics_CarrierDetect:  "CarrierDetect",
+		ATTRIBUTE_ID_EthernetNetworkDiagnostics_TimeSinceReset: "TimeSinceReset",
+	},
+	CLUSTER_ID_TimeSync: {
+		ATTRIBUTE_ID_TimeSync_UTCTime:              "UTCTime",
+		ATTRIBUTE_ID_TimeSync_Granularity:          "Granularity",
+		ATTRIBUTE_ID_TimeSync_TimeSource:           "TimeSource",
+		ATTRIBUTE_ID_TimeSync_TrustedTimeSource:    "TrustedTimeSource",
+		ATTRIBUTE_ID_TimeSync_DefaultNTP:           "DefaultNTP",
+		ATTRIBUTE_ID_TimeSync_TimeZone:             "TimeZone",
+		ATTRIBUTE_ID_TimeSync_DSTOffset:            "DSTOffset",
+		ATTRIBUTE_ID_TimeSync_LocalTime:            "LocalTime",
+		ATTRIBUTE_ID_TimeSync_TimeZoneDatabase:     "TimeZoneDatabase",
+		ATTRIBUTE_ID_TimeSync_NTPServerAvailable:   "NTPServerAvailable",
+		ATTRIBUTE_ID_TimeSync_TimeZoneListMaxSize:  "TimeZoneListMaxSize",
+		ATTRIBUTE_ID_TimeSync_DSTOffsetListMaxSize: "DSTOffsetListMaxSize",
+		ATTRIBUTE_ID_TimeSync_SupportsDNSResolve:   "SupportsDNSResolve",
+	},
+	CLUSTER_ID_BridgedDeviceBasicInformation: {
+		ATTRIBUTE_ID_BridgedDeviceBasicInformation_VendorName:            "VendorName",
+		ATTRIBUTE_ID_BridgedDeviceBasicInformation_VendorID:              "VendorID",
+		ATTRIBUTE_ID_BridgedDeviceBasicInformation_ProductName:           "ProductName",
+		ATTRIBUTE_ID_BridgedDeviceBasicInformation_ProductID:             "ProductID",
+		ATTRIBUTE_ID_BridgedDeviceBasicInformation_NodeLabel:             "NodeLabel",
+		ATTRIBUTE_ID_BridgedDeviceBasicInformation_HardwareVersion:       "HardwareVersion",
+		ATTRIBUTE_ID_BridgedDeviceBasicInformation_HardwareVersionString: "HardwareVersionString",
+		ATTRIBUTE_ID_BridgedDeviceBasicInformation_SoftwareVersion:       "SoftwareVersion",
+		ATTRIBUTE_ID_BridgedDeviceBasicInformation_SoftwareVersionString: "SoftwareVersionString",
+		ATTRIBUTE_ID_BridgedDeviceBasicInformation_ManufacturingDate:     "ManufacturingDate",
+		ATTRIBUTE_ID_BridgedDeviceBasicInformation_PartNumber:            "PartNumber",
+		ATTRIBUTE_ID_BridgedDeviceBasicInformation_ProductURL:            "ProductURL",
+		ATTRIBUTE_ID_BridgedDeviceBasicInformation_ProductLabel:          "ProductLabel",
+		ATTRIBUTE_ID_BridgedDeviceBasicInformation_SerialNumber:          "SerialNumber",
+		ATTRIBUTE_ID_BridgedDeviceBasicInformation_Reachable:             "Reachable",
+		ATTRIBUTE_ID_BridgedDeviceBasicInformation_UniqueID:              "UniqueID",
+		ATTRIBUTE_ID_BridgedDeviceBasicInformation_ProductAppearance:     "ProductAppearance",
+	},
+	CLUSTER_ID_Switch: {
+		ATTRIBUTE_ID_Switch_NumberOfPositions: "NumberOfPositions",
+		ATTRIBUTE_ID_Switch_CurrentPosition:   "CurrentPosition",
+		ATTRIBUTE_ID_Switch_MultiPressMax:     "MultiPressMax",
+	},
+	CLUSTER_ID_AdministratorCommissioning: {
+		ATTRIBUTE_ID_AdministratorCommissioning_WindowStatus:     "WindowStatus",
+		ATTRIBUTE_ID_AdministratorCommissioning_AdminFabricIndex: "AdminFabricIndex",
+		ATTRIBUTE_ID_AdministratorCommissioning_AdminVendorId:    "AdminVendorId",
+	},
+	CLUSTER_ID_OperationalCredentials: {
+		ATTRIBUTE_ID_OperationalCredentials_NOCs:                    "NOCs",
+		ATTRIBUTE_ID_OperationalCredentials_Fabrics:                 "Fabrics",
+		ATTRIBUTE_ID_OperationalCredentials_SupportedFabrics:        "SupportedFabrics",
+		ATTRIBUTE_ID_OperationalCredentials_CommissionedFabrics:     "CommissionedFabrics",
+		ATTRIBUTE_ID_OperationalCredentials_TrustedRootCertificates: "TrustedRootCertificates",
+		ATTRIBUTE_ID_OperationalCredentials_CurrentFabricIndex:      "CurrentFabricIndex",
+	},
+	CLUSTER_ID_GroupKeyManagement: {
+		ATTRIBUTE_ID_GroupKeyManagement_GroupKeyMap:           "GroupKeyMap",
+		ATTRIBUTE_ID_GroupKeyManagement_GroupTable:            "GroupTable",
+		ATTRIBUTE_ID_GroupKeyManagement_MaxGroupsPerFabric:    "MaxGroupsPerFabric",
+		ATTRIBUTE_ID_GroupKeyManagement_MaxGroupKeysPerFabric: "MaxGroupKeysPerFabric",
+	},
+	CLUSTER_ID_FixedLabel: {
+		ATTRIBUTE_ID_FixedLabel_LabelList: "LabelList",
+	},
+	CLUSTER_ID_UserLabel: {
+		ATTRIBUTE_ID_UserLabel_LabelList: "LabelList",
+	},
+	CLUSTER_ID_BooleanState: {
+		ATTRIBUTE_ID_BooleanState_StateValue: "StateValue",
+	},
+	CLUSTER_ID_Timer: {
+		ATTRIBUTE_ID_Timer_SetTime:       "SetTime",
+		ATTRIBUTE_ID_Timer_TimeRemaining: "TimeRemaining",
+		ATTRIBUTE_ID_Timer_TimerState:    "TimerState",
+	},
+	CLUSTER_ID_OvenCavityOperationalState: {
+		ATTRIBUTE_ID_OvenCavityOperationalState_PhaseList:            "PhaseList",
+		ATTRIBUTE_ID_OvenCavityOperationalState_CurrentPhase:         "CurrentPhase",
+		ATTRIBUTE_ID_OvenCavityOperationalState_CountdownTime:        "CountdownTime",
+		ATTRIBUTE_ID_OvenCavityOperationalState_OperationalStateList: "OperationalStateList",
+		ATTRIBUTE_ID_OvenCavityOperationalState_OperationalState:     "OperationalState",
+		ATTRIBUTE_ID_OvenCavityOperationalState_OperationalError:     "OperationalError",
+	},
+	CLUSTER_ID_OvenMode: {
+		ATTRIBUTE_ID_OvenMode_SupportedModes: "SupportedModes",
+		ATTRIBUTE_ID_OvenMode_CurrentMode:    "CurrentMode",
+		ATTRIBUTE_ID_OvenMode_StartUpMode:    "StartUpMode",
+		ATTRIBUTE_ID_OvenMode_OnMode:         "OnMode",
+	},
+	CLUSTER_ID_LaundryDryerControls: {
+		ATTRIBUTE_ID_LaundryDryerControls_SupportedDrynessLevels: "SupportedDrynessLevels",
+		ATTRIBUTE_ID_LaundryDryerControls_SelectedDrynessLevel:   "SelectedDrynessLevel",
+	},
+	CLUSTER_ID_ModeSelect: {
+		ATTRIBUTE_ID_ModeSelect_Description:       "Description",
+		ATTRIBUTE_ID_ModeSelect_StandardNamespace: "StandardNamespace",
+		ATTRIBUTE_ID_ModeSelect_SupportedModes:    "SupportedModes",
+		ATTRIBUTE_ID_ModeSelect_CurrentMode:       "CurrentMode",
+		ATTRIBUTE_ID_ModeSelect_StartUpMode:       "StartUpMode",
+		ATTRIBUTE_ID_ModeSelect_OnMode:            "OnMode",
+	},
+	CLUSTER_ID_LaundryWasherMode: {
+		ATTRIBUTE_ID_LaundryWasherMode_SupportedModes: "SupportedModes",
+		ATTRIBUTE_ID_LaundryWasherMode_CurrentMode:    "CurrentMode",
+		ATTRIBUTE_ID_LaundryWasherMode_StartUpMode:    "StartUpMode",
+		ATTRIBUTE_ID_LaundryWasherMode_OnMode:         "OnMode",
+	},
+	CLUSTER_ID_RefrigeratorAndTemperatureControlledCabinetMode: {
+		ATTRIBUTE_ID_RefrigeratorAndTemperatureControlledCabinetMode_SupportedModes: "SupportedModes",
+		ATTRIBUTE_ID_RefrigeratorAndTemperatureControlledCabinetMode_CurrentMode:    "CurrentMode",
+		ATTRIBUTE_ID_RefrigeratorAndTemperatureControlledCabinetMode_StartUpMode:    "StartUpMode",
+		ATTRIBUTE_ID_RefrigeratorAndTemperatureControlledCabinetMode_OnMode:         "OnMode",
+	},
+	CLUSTER_ID_LaundryWasherControls: {
+		ATTRIBUTE_ID_LaundryWasherControls_SpinSpeeds:       "SpinSpeeds",
+		ATTRIBUTE_ID_LaundryWasherControls_SpinSpeedCurrent: "SpinSpeedCurrent",
+		ATTRIBUTE_ID_LaundryWasherControls_NumberOfRinses:   "NumberOfRinses",
+		ATTRIBUTE_ID_LaundryWasherControls_SupportedRinses:  "SupportedRinses",
+	},
+	CLUSTER_ID_RVCRunMode: {
+		ATTRIBUTE_ID_RVCRunMode_SupportedModes: "SupportedModes",
+		ATTRIBUTE_ID_RVCRunMode_CurrentMode:    "CurrentMode",
+		ATTRIBUTE_ID_RVCRunMode_StartUpMode:    "StartUpMode",
+		ATTRIBUTE_ID_RVCRunMode_OnMode:         "OnMode",
+	},
+	CLUSTER_ID_RVCCleanMode: {
+		ATTRIBUTE_ID_RVCCleanMode_SupportedModes: "SupportedModes",
+		ATTRIBUTE_ID_RVCCleanMode_CurrentMode:    "CurrentMode",
+		ATTRIBUTE_ID_RVCCleanMode_StartUpMode:    "StartUpMode",
+		ATTRIBUTE_ID_RVCCleanMode_OnMode:         "OnMode",
+	},
+	CLUSTER_ID_TemperatureControl: {
+		ATTRIBUTE_ID_TemperatureControl_TemperatureSetpoint:        "TemperatureSetpoint",
+		ATTRIBUTE_ID_TemperatureControl_MinTemperature:             "MinTemperature",
+		ATTRIBUTE_ID_TemperatureControl_MaxTemperature:             "MaxTemperature",
+		ATTRIBUTE_ID_TemperatureControl_Step:                       "Step",
+		ATTRIBUTE_ID_TemperatureControl_SelectedTemperatureLevel:   "SelectedTemperatureLevel",
+		ATTRIBUTE_ID_TemperatureControl_SupportedTemperatureLevels: "SupportedTemperatureLevels",
+	},
+	CLUSTER_ID_RefrigeratorAlarm: {
+		ATTRIBUTE_ID_RefrigeratorAlarm_Mask:      "Mask",
+		ATTRIBUTE_ID_RefrigeratorAlarm_Latch:     "Latch",
+		ATTRIBUTE_ID_RefrigeratorAlarm_State:     "State",
+		ATTRIBUTE_ID_RefrigeratorAlarm_Supported: "Supported",
+	},
+	CLUSTER_ID_DishwasherMode: {
+		ATTRIBUTE_ID_DishwasherMode_SupportedModes: "SupportedModes",
+		ATTRIBUTE_ID_DishwasherMode_CurrentMode:    "CurrentMode",
+		ATTRIBUTE_ID_DishwasherMode_StartUpMode:    "StartUpMode",
+		ATTRIBUTE_ID_DishwasherMode_OnMode:         "OnMode",
+	},
+	CLUSTER_ID_AirQuality: {
+		ATTRIBUTE_ID_AirQuality_AirQuality: "AirQuality",
+	},
+	CLUSTER_ID_SmokeCOAlarm: {
+		ATTRIBUTE_ID_SmokeCOAlarm_ExpressedState:         "ExpressedState",
+		ATTRIBUTE_ID_SmokeCOAlarm_SmokeState:             "SmokeState",
+		ATTRIBUTE_ID_SmokeCOAlarm_COState:                "COState",
+		ATTRIBUTE_ID_SmokeCOAlarm_BatteryAlert:           "BatteryAlert",
+		ATTRIBUTE_ID_SmokeCOAlarm_DeviceMuted:            "DeviceMuted",
+		ATTRIBUTE_ID_SmokeCOAlarm_TestInProgress:         "TestInProgress",
+		ATTRIBUTE_ID_SmokeCOAlarm_HardwareFaultAlert:     "HardwareFaultAlert",
+		ATTRIBUTE_ID_SmokeCOAlarm_EndOfServiceAlert:      "EndOfServiceAlert",
+		ATTRIBUTE_ID_SmokeCOAlarm_InterconnectSmokeAlarm: "InterconnectSmokeAlarm",
+		ATTRIBUTE_ID_SmokeCOAlarm_InterconnectCOAlarm:    "InterconnectCOAlarm",
+		ATTRIBUTE_ID_SmokeCOAlarm_ContaminationState:     "ContaminationState",
+		ATTRIBUTE_ID_SmokeCOAlarm_SmokeSensitivityLevel:  "SmokeSensitivityLevel",
+		ATTRIBUTE_ID_SmokeCOAlarm_ExpiryDate:             "ExpiryDate",
+	},
+	CLUSTER_ID_DishwasherAlarm: {
+		ATTRIBUTE_ID_DishwasherAlarm_Mask:      "Mask",
+		ATTRIBUTE_ID_DishwasherAlarm_Latch:     "Latch",
+		ATTRIBUTE_ID_DishwasherAlarm_State:     "State",
+		ATTRIBUTE_ID_DishwasherAlarm_Supported: "Supported",
+	},
+	CLUSTER_ID_MicrowaveOvenMode: {
+		ATTRIBUTE_ID_MicrowaveOvenMode_SupportedModes: "SupportedModes",
+		ATTRIBUTE_ID_MicrowaveOvenMode_CurrentMode:    "CurrentMode",
+		ATTRIBUTE_ID_MicrowaveOvenMode_StartUpMode:    "StartUpMode",
+		ATTRIBUTE_ID_MicrowaveOvenMode_OnMode:         "OnMode",
+	},
+	CLUSTER_ID_OperationalState: {
+		ATTRIBUTE_ID_OperationalState_PhaseList:            "PhaseList",
+		ATTRIBUTE_ID_OperationalState_CurrentPhase:         "CurrentPhase",
+		ATTRIBUTE_ID_OperationalState_CountdownTime:        "CountdownTime",
+		ATTRIBUTE_ID_OperationalState_OperationalStateList: "OperationalStateList",
+		ATTRIBUTE_ID_OperationalState_OperationalState:     "OperationalState",
+		ATTRIBUTE_ID_OperationalState_OperationalError:     "OperationalError",
+	},
+	CLUSTER_ID_RVCOperationalState: {
+		ATTRIBUTE_ID_RVCOperationalState_PhaseList:            "PhaseList",
+		ATTRIBUTE_ID_RVCOperationalState_CurrentPhase:         "CurrentPhase",
+		ATTRIBUTE_ID_RVCOperationalState_CountdownTime:        "CountdownTime",
+		ATTRIBUTE_ID_RVCOperationalState_OperationalStateList: "OperationalStateList",
+		ATTRIBUTE_ID_RVCOperationalState_OperationalState:     "OperationalState",
+		ATTRIBUTE_ID_RVCOperationalState_OperationalError:     "OperationalError",
+	},
+	CLUSTER_ID_BooleanSensorConfiguration: {
+		ATTRIBUTE_ID_BooleanSensorConfiguration_CurrentSensitivityLevel:    "CurrentSensitivityLevel",
+		ATTRIBUTE_ID_BooleanSensorConfiguration_SupportedSensitivityLevels: "SupportedSensitivityLevels",
+		ATTRIBUTE_ID_BooleanSensorConfiguration_DefaultSensitivityLevel:    "DefaultSensitivityLevel",
+		ATTRIBUTE_ID_BooleanSensorConfiguration_AlarmsActive:               "AlarmsActive",
+		ATTRIBUTE_ID_BooleanSensorConfiguration_AlarmsSuppressed:           "AlarmsSuppressed",
+		ATTRIBUTE_ID_BooleanSensorConfiguration_AlarmsEnabled:              "AlarmsEnabled",
+		ATTRIBUTE_ID_BooleanSensorConfiguration_AlarmsSupported:            "AlarmsSupported",
+		ATTRIBUTE_ID_BooleanSensorConfiguration_SensorFault:                "SensorFault",
+	},
+	CLUSTER_ID_ValveConfigurationandControl: {
+		ATTRIBUTE_ID_ValveConfigurationandControl_OpenDuration:        "OpenDuration",
+		ATTRIBUTE_ID_ValveConfigurationandControl_DefaultOpenDuration: "DefaultOpenDuration",
+		ATTRIBUTE_ID_ValveConfigurationandControl_AutoCloseTime:       "AutoCloseTime",
+		ATTRIBUTE_ID_ValveConfigurationandControl_RemainingDuration:   "RemainingDuration",
+		ATTRIBUTE_ID_ValveConfigurationandControl_CurrentState:        "CurrentState",
+		ATTRIBUTE_ID_ValveConfigurationandControl_TargetState:         "TargetState",
+		ATTRIBUTE_ID_ValveConfigurationandControl_CurrentLevel:        "CurrentLevel",
+		ATTRIBUTE_ID_ValveConfigurationandControl_TargetLevel:         "TargetLevel",
+		ATTRIBUTE_ID_ValveConfigurationandControl_DefaultOpenLevel:    "DefaultOpenLevel",
+		ATTRIBUTE_ID_ValveConfigurationandControl_ValveFault:          "ValveFault",
+		ATTRIBUTE_ID_ValveConfigurationandControl_LevelStep:           "LevelStep",
+	},
+	CLUSTER_ID_ElectricalPowerMeasurement: {
+		ATTRIBUTE_ID_ElectricalPowerMeasurement_PowerMode:                "PowerMode",
+		ATTRIBUTE_ID_ElectricalPowerMeasurement_NumberOfMeasurementTypes: "NumberOfMeasurementTypes",
+		ATTRIBUTE_ID_ElectricalPowerMeasurement_Accuracy:                 "Accuracy",
+		ATTRIBUTE_ID_ElectricalPowerMeasurement_Ranges:                   "Ranges",
+		ATTRIBUTE_ID_ElectricalPowerMeasurement_Voltage:                  "Voltage",
+		ATTRIBUTE_ID_ElectricalPowerMeasurement_ActiveCurrent:            "ActiveCurrent",
+		ATTRIBUTE_ID_ElectricalPowerMeasurement_ReactiveCurrent:          "ReactiveCurrent",
+		ATTRIBUTE_ID_ElectricalPowerMeasurement_ApparentCurrent:          "ApparentCurrent",
+		ATTRIBUTE_ID_ElectricalPowerMeasurement_ActivePower:              "ActivePower",
+		ATTRIBUTE_ID_ElectricalPowerMeasurement_ReactivePower:            "ReactivePower",
+		ATTRIBUTE_ID_ElectricalPowerMeasurement_ApparentPower:            "ApparentPower",
+		ATTRIBUTE_ID_ElectricalPowerMeasurement_RMSVoltage:               "RMSVoltage",
+		ATTRIBUTE_ID_ElectricalPowerMeasurement_RMSCurrent:               "RMSCurrent",
+		ATTRIBUTE_ID_ElectricalPowerMeasurement_RMSPower:                 "RMSPower",
+		ATTRIBUTE_ID_ElectricalPowerMeasurement_Frequency:                "Frequency",
+		ATTRIBUTE_ID_ElectricalPowerMeasurement_HarmonicCurrents:         "HarmonicCurrents",
+		ATTRIBUTE_ID_ElectricalPowerMeasurement_HarmonicPhases:           "HarmonicPhases",
+		ATTRIBUTE_ID_ElectricalPowerMeasurement_PowerFactor:              "PowerFactor",
+		ATTRIBUTE_ID_ElectricalPowerMeasurement_NeutralCurrent:           "NeutralCurrent",
+	},
+	CLUSTER_ID_ElectricalEnergyMeasurement: {
+		ATTRIBUTE_ID_ElectricalEnergyMeasurement_Accuracy:                 "Accuracy",
+		ATTRIBUTE_ID_ElectricalEnergyMeasurement_CumulativeEnergyImported: "CumulativeEnergyImported",
+		ATTRIBUTE_ID_ElectricalEnergyMeasurement_CumulativeEnergyExported: "CumulativeEnergyExported",
+		ATTRIBUTE_ID_ElectricalEnergyMeasurement_PeriodicEnergyImported:   "PeriodicEnergyImported",
+		ATTRIBUTE_ID_ElectricalEnergyMeasurement_PeriodicEnergyExported:   "PeriodicEnergyExported",
+		ATTRIBUTE_ID_ElectricalEnergyMeasurement_CumulativeEnergyReset:    "CumulativeEnergyReset",
+	},
+	CLUSTER_ID_WaterHeaterManagement: {
+		ATTRIBUTE_ID_WaterHeaterManagement_HeaterTypes:           "HeaterTypes",
+		ATTRIBUTE_ID_WaterHeaterManagement_HeatDemand:            "HeatDemand",
+		ATTRIBUTE_ID_WaterHeaterManagement_TankVolume:            "TankVolume",
+		ATTRIBUTE_ID_WaterHeaterManagement_EstimatedHeatRequired: "EstimatedHeatRequired",
+		ATTRIBUTE_ID_WaterHeaterManagement_TankPercentage:        "TankPercentage",
+		ATTRIBUTE_ID_WaterHeaterManagement_BoostState:            "BoostState",
+	},
+	CLUSTER_ID_EnergyPrice: {
+		ATTRIBUTE_ID_EnergyPrice_UnitOfMeasure: "UnitOfMeasure",
+		ATTRIBUTE_ID_EnergyPrice_CurrentPrice:  "CurrentPrice",
+		ATTRIBUTE_ID_EnergyPrice_PriceForecast: "PriceForecast",
+	},
+	CLUSTER_ID_DemandResponseandLoadControl: {
+		ATTRIBUTE_ID_DemandResponseandLoadControl_LoadControlPrograms:         "LoadControlPrograms",
+		ATTRIBUTE_ID_DemandResponseandLoadControl_NumberOfLoadControlPrograms: "NumberOfLoadControlPrograms",
+		ATTRIBUTE_ID_DemandResponseandLoadControl_Events:                      "Events",
+		ATTRIBUTE_ID_DemandResponseandLoadControl_ActiveEvents:                "ActiveEvents",
+		ATTRIBUTE_ID_DemandResponseandLoadControl_NumberOfEventsPerProgram:    "NumberOfEventsPerProgram",
+		ATTRIBUTE_ID_DemandResponseandLoadControl_NumberOfTransitions:         "NumberOfTransitions",
+		ATTRIBUTE_ID_DemandResponseandLoadControl_DefaultRandomStart:          "DefaultRandomStart",
+		ATTRIBUTE_ID_DemandResponseandLoadControl_DefaultRandomDuration:       "DefaultRandomDuration",
+	},
+	CLUSTER_ID_Messages: {
+		ATTRIBUTE_ID_Messages_Messages:         "Messages",
+		ATTRIBUTE_ID_Messages_ActiveMessageIDs: "ActiveMessageIDs",
+	},
+	CLUSTER_ID_DeviceEnergyManagement: {
+		ATTRIBUTE_ID_DeviceEnergyManagement_ESAType:                   "ESAType",
+		ATTRIBUTE_ID_DeviceEnergyManagement_ESACanGenerate:            "ESACanGenerate",
+		ATTRIBUTE_ID_DeviceEnergyManagement_ESAState:                  "ESAState",
+		ATTRIBUTE_ID_DeviceEnergyManagement_AbsMinPower:               "AbsMinPower",
+		ATTRIBUTE_ID_DeviceEnergyManagement_AbsMaxPower:               "AbsMaxPower",
+		ATTRIBUTE_ID_DeviceEnergyManagement_PowerAdjustmentCapability: "PowerAdjustmentCapability",
+		ATTRIBUTE_ID_DeviceEnergyManagement_Forecast:                  "Forecast",
+		ATTRIBUTE_ID_DeviceEnergyManagement_OptOutState:               "OptOutState",
+	},
+	CLUSTER_ID_EnergyCalendar: {
+		ATTRIBUTE_ID_EnergyCalendar_CalendarID:        "CalendarID",
+		ATTRIBUTE_ID_EnergyCalendar_Name:              "Name",
+		ATTRIBUTE_ID_EnergyCalendar_ProviderID:        "ProviderID",
+		ATTRIBUTE_ID_EnergyCalendar_EventID:           "EventID",
+		ATTRIBUTE_ID_EnergyCalendar_StartDate:         "StartDate",
+		ATTRIBUTE_ID_EnergyCalendar_CalendarPeriods:   "CalendarPeriods",
+		ATTRIBUTE_ID_EnergyCalendar_SpecialDays:       "SpecialDays",
+		ATTRIBUTE_ID_EnergyCalendar_CurrentDay:        "CurrentDay",
+		ATTRIBUTE_ID_EnergyCalendar_NextDay:           "NextDay",
+		ATTRIBUTE_ID_EnergyCalendar_CurrentTransition: "CurrentTransition",
+		ATTRIBUTE_ID_EnergyCalendar_CurrentPeakPeriod: "CurrentPeakPeriod",
+		ATTRIBUTE_ID_EnergyCalendar_NextPeakPeriod:    "NextPeakPeriod",
+	},
+	CLUSTER_ID_EnergyPreference: {
+		ATTRIBUTE_ID_EnergyPreference_EnergyBalances:                 "EnergyBalances",
+		ATTRIBUTE_ID_EnergyPreference_CurrentEnergyBalance:           "CurrentEnergyBalance",
+		ATTRIBUTE_ID_EnergyPreference_EnergyPriorities:               "EnergyPriorities",
+		ATTRIBUTE_ID_EnergyPreference_LowPowerModeSensitivities:      "LowPowerModeSensitivities",
+		ATTRIBUTE_ID_EnergyPreference_CurrentLowPowerModeSensitivity: "CurrentLowPowerModeSensitivity",
+	},
+	CLUSTER_ID_DoorLock: {
+		ATTRIBUTE_ID_DoorLock_LockState:                                "LockState",
+		ATTRIBUTE_ID_DoorLock_LockType:                                 "LockType",
+		ATTRIBUTE_ID_DoorLock_ActuatorEnabled:                          "ActuatorEnabled",
+		ATTRIBUTE_ID_DoorLock_DoorState:                                "DoorState",
+		ATTRIBUTE_ID_DoorLock_DoorOpenEvents:                           "DoorOpenEvents",
+		ATTRIBUTE_ID_DoorLock_DoorClosedEvents:                         "DoorClosedEvents",
+		ATTRIBUTE_ID_DoorLock_OpenPeriod:                               "OpenPeriod",
+		ATTRIBUTE_ID_DoorLock_NumberOfTotalUsersSupported:              "NumberOfTotalUsersSupported",
+		ATTRIBUTE_ID_DoorLock_NumberOfPINUsersSupported:                "NumberOfPINUsersSupported",
+		ATTRIBUTE_ID_DoorLock_NumberOfRFIDUsersSupported:               "NumberOfRFIDUsersSupported",
+		ATTRIBUTE_ID_DoorLock_NumberOfWeekDaySchedulesSupportedPerUser: "NumberOfWeekDaySchedulesSupportedPerUser",
+		ATTRIBUTE_ID_DoorLock_NumberOfYearDaySchedulesSupportedPerUser: "NumberOfYearDaySchedulesSupportedPerUser",
+		ATTRIBUTE_ID_DoorLock_NumberOfHolidaySchedulesSupported:        "NumberOfHolidaySchedulesSupported",
+		ATTRIBUTE_ID_DoorLock_MaxPINCodeLength:                         "MaxPINCodeLength",
+		ATTRIBUTE_ID_DoorLock_MinPINCodeLength:                         "MinPINCodeLength",
+		ATTRIBUTE_ID_DoorLock_MaxRFIDCodeLength:                        "MaxRFIDCodeLength",
+		ATTRIBUTE_ID_DoorLock_MinRFIDCodeLength:                        "MinRFIDCodeLength",
+		ATTRIBUTE_ID_DoorLock_CredentialRulesSupport:                   "CredentialRulesSupport",
+		ATTRIBUTE_ID_DoorLock_NumberOfCredentialsSupportedPerUser:      "NumberOfCredentialsSupportedPerUser",
+		ATTRIBUTE_ID_DoorLock_Language:                                 "Language",
+		ATTRIBUTE_ID_DoorLock_LEDSettings:                              "LEDSettings",
+		ATTRIBUTE_ID_DoorLock_AutoRelockTime:                           "AutoRelockTime",
+		ATTRIBUTE_ID_DoorLock_SoundVolume:                              "SoundVolume",
+		ATTRIBUTE_ID_DoorLock_OperatingMode:                            "OperatingMode",
+		ATTRIBUTE_ID_DoorLock_SupportedOperatingModes:                  "SupportedOperatingModes",
+		ATTRIBUTE_ID_DoorLock_DefaultConfigurationRegister:             "DefaultConfigurationRegister",
+		ATTRIBUTE_ID_DoorLock_EnableLocalProgramming:                   "EnableLocalProgramming",
+		ATTRIBUTE_ID_DoorLock_EnableOneTouchLocking:                    "EnableOneTouchLocking",
+		ATTRIBUTE_ID_DoorLock_EnableInsideStatusLED:                    "EnableInsideStatusLED",
+		ATTRIBUTE_ID_DoorLock_EnablePrivacyModeButton:                  "EnablePrivacyModeButton",
+		ATTRIBUTE_ID_DoorLock_LocalProgrammingFeatures:                 "LocalProgrammingFeatures",
+		ATTRIBUTE_ID_DoorLock_WrongCodeEntryLimit:                      "WrongCodeEntryLimit",
+		ATTRIBUTE_ID_DoorLock_UserCodeTemporaryDisableTime:             "UserCodeTemporaryDisableTime",
+		ATTRIBUTE_ID_DoorLock_SendPINOverTheAir:                        "SendPINOverTheAir",
+		ATTRIBUTE_ID_DoorLock_RequirePINforRemoteOperation:             "RequirePINforRemoteOperation",
+		ATTRIBUTE_ID_DoorLock_ExpiringUserTimeout:                      "ExpiringUserTimeout",
+	},
+	CLUSTER_ID_WindowCovering: {
+		ATTRIBUTE_ID_WindowCovering_Type:                             "Type",
+		ATTRIBUTE_ID_WindowCovering_PhysicalClosedLimitLift:          "PhysicalClosedLimitLift",
+		ATTRIBUTE_ID_WindowCovering_PhysicalClosedLimitTilt:          "PhysicalClosedLimitTilt",
+		ATTRIBUTE_ID_WindowCovering_CurrentPositionLift:              "CurrentPositionLift",
+		ATTRIBUTE_ID_WindowCovering_CurrentPositionTilt:              "CurrentPositionTilt",
+		ATTRIBUTE_ID_WindowCovering_NumberOfActuationsLift:           "NumberOfActuationsLift",
+		ATTRIBUTE_ID_WindowCovering_NumberOfActuationsTilt:           "NumberOfActuationsTilt",
+		ATTRIBUTE_ID_WindowCovering_ConfigStatus:                     "ConfigStatus",
+		ATTRIBUTE_ID_WindowCovering_CurrentPositionLiftPercentage:    "CurrentPositionLiftPercentage",
+		ATTRIBUTE_ID_WindowCovering_CurrentPositionTiltPercentage:    "CurrentPositionTiltPercentage",
+		ATTRIBUTE_ID_WindowCovering_OperationalStatus:                "OperationalStatus",
+		ATTRIBUTE_ID_WindowCovering_TargetPositionLiftPercent100ths:  "TargetPositionLiftPercent100ths",
+		ATTRIBUTE_ID_WindowCovering_TargetPositionTiltPercent100ths:  "TargetPositionTiltPercent100ths",
+		ATTRIBUTE_ID_WindowCovering_EndProductType:                   "EndProductType",
+		ATTRIBUTE_ID_WindowCovering_CurrentPositionLiftPercent100ths: "CurrentPositionLiftPercent100ths",
+		ATTRIBUTE_ID_WindowCovering_CurrentPositionTiltPercent100ths: "CurrentPositionTiltPercent100ths",
+		ATTRIBUTE_ID_WindowCovering_InstalledOpenLimitLift:           "InstalledOpenLimitLift",
+		ATTRIBUTE_ID_WindowCovering_InstalledClosedLimitLift:         "InstalledClosedLimitLift",
+		ATTRIBUTE_ID_WindowCovering_InstalledOpenLimitTilt:           "InstalledOpenLimitTilt",
+		ATTRIBUTE_ID_WindowCovering_InstalledClosedLimitTilt:         "InstalledClosedLimitTilt",
+		ATTRIBUTE_ID_WindowCovering_Mode:                             "Mode",
+		ATTRIBUTE_ID_WindowCovering_SafetyStatus:                     "SafetyStatus",
+	},
+	CLUSTER_ID_PumpConfigurationandControl: {
+		ATTRIBUTE_ID_PumpConfigurationandControl_MaxPressure:            "MaxPressure",
+		ATTRIBUTE_ID_PumpConfigurationandControl_MaxSpeed:               "MaxSpeed",
+		ATTRIBUTE_ID_PumpConfigurationandControl_MaxFlow:                "MaxFlow",
+		ATTRIBUTE_ID_PumpConfigurationandControl_MinConstPressure:       "MinConstPressure",
+		ATTRIBUTE_ID_PumpConfigurationandControl_MaxConstPressure:       "MaxConstPressure",
+		ATTRIBUTE_ID_PumpConfigurationandControl_MinCompPressure:        "MinCompPressure",
+		ATTRIBUTE_ID_PumpConfigurationandControl_MaxCompPressure:        "MaxCompPressure",
+		ATTRIBUTE_ID_PumpConfigurationandControl_MinConstSpeed:          "MinConstSpeed",
+		ATTRIBUTE_ID_PumpConfigurationandControl_MaxConstSpeed:          "MaxConstSpeed",
+		ATTRIBUTE_ID_PumpConfigurationandControl_MinConstFlow:           "MinConstFlow",
+		ATTRIBUTE_ID_PumpConfigurationandControl_MaxConstFlow:           "MaxConstFlow",
+		ATTRIBUTE_ID_PumpConfigurationandControl_MinConstTemp:           "MinConstTemp",
+		ATTRIBUTE_ID_PumpConfigurationandControl_MaxConstTemp:           "MaxConstTemp",
+		ATTRIBUTE_ID_PumpConfigurationandControl_PumpStatus:             "PumpStatus",
+		ATTRIBUTE_ID_PumpConfigurationandControl_EffectiveOperationMode: "EffectiveOperationMode",
+		ATTRIBUTE_ID_PumpConfigurationandControl_EffectiveControlMode:   "EffectiveControlMode",
+		ATTRIBUTE_ID_PumpConfigurationandControl_Capacity:               "Capacity",
+		ATTRIBUTE_ID_PumpConfigurationandControl_Speed:                  "Speed",
+		ATTRIBUTE_ID_PumpConfigurationandControl_LifetimeRunningHours:   "LifetimeRunningHours",
+		ATTRIBUTE_ID_PumpConfigurationandControl_Power:                  "Power",
+		ATTRIBUTE_ID_PumpConfigurationandControl_LifetimeEnergyConsumed: "LifetimeEnergyConsumed",
+		ATTRIBUTE_ID_PumpConfigurationandControl_OperationMode:          "OperationMode",
+		ATTRIBUTE_ID_PumpConfigurationandControl_ControlMode:            "ControlMode",
+	},
+	CLUSTER_ID_Thermostat: {
+		ATTRIBUTE_ID_Thermostat_LocalTemperature:                   "LocalTemperature",
+		ATTRIBUTE_ID_Thermostat_OutdoorTemperature:                 "OutdoorTemperature",
+		ATTRIBUTE_ID_Thermostat_Occupancy:                          "Occupancy",
+		ATTRIBUTE_ID_Thermostat_AbsMinHeatSetpointLimit:            "AbsMinHeatSetpointLimit",
+		ATTRIBUTE_ID_Thermostat_AbsMaxHeatSetpointLimit:            "AbsMaxHeatSetpointLimit",
+		ATTRIBUTE_ID_Thermostat_AbsMinCoolSetpointLimit:            "AbsMinCoolSetpointLimit",
+		ATTRIBUTE_ID_Thermostat_AbsMaxCoolSetpointLimit:            "AbsMaxCoolSetpointLimit",
+		ATTRIBUTE_ID_Thermostat_PICoolingDemand:                    "PICoolingDemand",
+		ATTRIBUTE_ID_Thermostat_PIHeatingDemand:                    "PIHeatingDemand",
+		ATTRIBUTE_ID_Thermostat_HVACSystemTypeConfiguration:        "HVACSystemTypeConfiguration",
+		ATTRIBUTE_ID_Thermostat_LocalTemperatureCalibration:        "LocalTemperatureCalibration",
+		ATTRIBUTE_ID_Thermostat_OccupiedCoolingSetpoint:            "OccupiedCoolingSetpoint",
+		ATTRIBUTE_ID_Thermostat_OccupiedHeatingSetpoint:            "OccupiedHeatingSetpoint",
+		ATTRIBUTE_ID_Thermostat_UnoccupiedCoolingSetpoint:          "UnoccupiedCoolingSetpoint",
+		ATTRIBUTE_ID_Thermostat_UnoccupiedHeatingSetpoint:          "UnoccupiedHeatingSetpoint",
+		ATTRIBUTE_ID_Thermostat_MinHeatSetpointLimit:               "MinHeatSetpointLimit",
+		ATTRIBUTE_ID_Thermostat_MaxHeatSetpointLimit:               "MaxHeatSetpointLimit",
+		ATTRIBUTE_ID_Thermostat_MinCoolSetpointLimit:               "MinCoolSetpointLimit",
+		ATTRIBUTE_ID_Thermostat_MaxCoolSetpointLimit:               "MaxCoolSetpointLimit",
+		ATTRIBUTE_ID_Thermostat_MinSetpointDeadBand:                "MinSetpointDeadBand",
+		ATTRIBUTE_ID_Thermostat_RemoteSensing:                      "RemoteSensing",
+		ATTRIBUTE_ID_Thermostat_ControlSequenceOfOperation:         "ControlSequenceOfOperation",
+		ATTRIBUTE_ID_Thermostat_SystemMode:                         "SystemMode",
+		ATTRIBUTE_ID_Thermostat_ThermostatRunningMode:              "ThermostatRunningMode",
+		ATTRIBUTE_ID_Thermostat_StartOfWeek:                        "StartOfWeek",
+		ATTRIBUTE_ID_Thermostat_NumberOfWeeklyTransitions:          "NumberOfWeeklyTransitions",
+		ATTRIBUTE_ID_Thermostat_NumberOfDailyTransitions:           "NumberOfDailyTransitions",
+		ATTRIBUTE_ID_Thermostat_TemperatureSetpointHold:            "TemperatureSetpointHold",
+		ATTRIBUTE_ID_Thermostat_TemperatureSetpointHoldDuration:    "TemperatureSetpointHoldDuration",
+		ATTRIBUTE_ID_Thermostat_ThermostatProgrammingOperationMode: "ThermostatProgrammingOperationMode",
+		ATTRIBUTE_ID_Thermostat_ThermostatRunningState:             "ThermostatRunningState",
+		ATTRIBUTE_ID_Thermostat_SetpointChangeSource:               "SetpointChangeSource",
+		ATTRIBUTE_ID_Thermostat_SetpointChangeAmount:               "SetpointChangeAmount",
+		ATTRIBUTE_ID_Thermostat_SetpointChangeSourceTimestamp:      "SetpointChangeSourceTimestamp",
+		ATTRIBUTE_ID_Thermostat_OccupiedSetback:                    "OccupiedSetback",
+		ATTRIBUTE_ID_Thermostat_OccupiedSetbackMin:                 "OccupiedSetbackMin",
+		ATTRIBUTE_ID_Thermostat_OccupiedSetbackMax:                 "OccupiedSetbackMax",
+		ATTRIBUTE_ID_Thermostat_UnoccupiedSetback:                  "UnoccupiedSetback",
+		ATTRIBUTE_ID_Thermostat_UnoccupiedSetbackMin:               "UnoccupiedSetbackMin",
+		ATTRIBUTE_ID_Thermostat_UnoccupiedSetbackMax:               "UnoccupiedSetbackMax",
+		ATTRIBUTE_ID_Thermostat_EmergencyHeatDelta:                 "EmergencyHeatDelta",
+		ATTRIBUTE_ID_Thermostat_ACType:                             "ACType",
+		ATTRIBUTE_ID_Thermostat_ACCapacity:                         "ACCapacity",
+		ATTRIBUTE_ID_Thermostat_ACRefrigerantType:                  "ACRefrigerantType",
+		ATTRIBUTE_ID_Thermostat_ACCompressorType:                   "ACCompressorType",
+		ATTRIBUTE_ID_Thermostat_ACErrorCode:                        "ACErrorCode",
+		ATTRIBUTE_ID_Thermostat_ACLouverPosition:                   "ACLouverPosition",
+		ATTRIBUTE_ID_Thermostat_ACCoilTemperature:                  "ACCoilTemperature",
+		ATTRIBUTE_ID_Thermostat_ACCapacityformat:                   "ACCapacityformat",
+	},
+	CLUSTER_ID_FanControl: {
+		ATTRIBUTE_ID_FanControl_FanMode:          "FanMode",
+		ATTRIBUTE_ID_FanControl_FanModeSequence:  "FanModeSequence",
+		ATTRIBUTE_ID_FanControl_PercentSetting:   "PercentSetting",
+		ATTRIBUTE_ID_FanControl_PercentCurrent:   "PercentCurrent",
+		ATTRIBUTE_ID_FanControl_SpeedMax:         "SpeedMax",
+		ATTRIBUTE_ID_FanControl_SpeedSetting:     "SpeedSetting",
+		ATTRIBUTE_ID_FanControl_SpeedCurrent:     "SpeedCurrent",
+		ATTRIBUTE_ID_FanControl_RockSupport:      "RockSupport",
+		ATTRIBUTE_ID_FanControl_RockSetting:      "RockSetting",
+		ATTRIBUTE_ID_FanControl_WindSupport:      "WindSupport",
+		ATTRIBUTE_ID_FanControl_WindSetting:      "WindSetting",
+		ATTRIBUTE_ID_FanControl_AirflowDirection: "AirflowDirection",
+	},
+	CLUSTER_ID_ThermostatUserInterfaceConfiguration: {
+		ATTRIBUTE_ID_ThermostatUserInterfaceConfiguration_TemperatureDisplayMode:        "TemperatureDisplayMode",
+		ATTRIBUTE_ID_ThermostatUserInterfaceConfiguration_KeypadLockout:                 "KeypadLockout",
+		ATTRIBUTE_ID_ThermostatUserInterfaceConfiguration_ScheduleProgrammingVisibility: "ScheduleProgrammingVisibility",
+	},
+	CLUSTER_ID_ColorControl: {
+		ATTRIBUTE_ID_ColorControl_CurrentHue:                      "CurrentHue",
+		ATTRIBUTE_ID_ColorControl_CurrentSaturation:               "CurrentSaturation",
+		ATTRIBUTE_ID_ColorControl_RemainingTime:                   "RemainingTime",
+		ATTRIBUTE_ID_ColorControl_CurrentX:                        "CurrentX",
+		ATTRIBUTE_ID_ColorControl_CurrentY:                        "CurrentY",
+		ATTRIBUTE_ID_ColorControl_DriftCompensation:               "DriftCompensation",
+		ATTRIBUTE_ID_ColorControl_CompensationText:                "CompensationText",
+		ATTRIBUTE_ID_ColorControl_ColorTemperatureMireds:          "ColorTemperatureMireds",
+		ATTRIBUTE_ID_ColorControl_ColorMode:                       "ColorMode",
+		ATTRIBUTE_ID_ColorControl_Options:                         "Options",
+		ATTRIBUTE_ID_ColorControl_NumberOfPrimaries:               "NumberOfPrimaries",
+		ATTRIBUTE_ID_ColorControl_Primary1X:                       "Primary1X",
+		ATTRIBUTE_ID_ColorControl_Primary1Y:                       "Primary1Y",
+		ATTRIBUTE_ID_ColorControl_Primary1Intensity:               "Primary1Intensity",
+		ATTRIBUTE_ID_ColorControl_Primary2X:                       "Primary2X",
+		ATTRIBUTE_ID_ColorControl_Primary2Y:                       "Primary2Y",
+		ATTRIBUTE_ID_ColorControl_Primary2Intensity:               "Primary2Intensity",
+		ATTRIBUTE_ID_ColorControl_Primary3X:                       "Primary3X",
+		ATTRIBUTE_ID_ColorControl_Primary3Y:                       "Primary3Y",
+		ATTRIBUTE_ID_ColorControl_Primary3Intensity:               "Primary3Intensity",
+		ATTRIBUTE_ID_ColorControl_Primary4X:                       "Primary4X",
+		ATTRIBUTE_ID_ColorControl_Primary4Y:                       "Primary4Y",
+		ATTRIBUTE_ID_ColorControl_Primary4Intensity:               "Primary4Intensity",
+		ATTRIBUTE_ID_ColorControl_Primary5X:                       "Primary5X",
+		ATTRIBUTE_ID_ColorControl_Primary5Y:                       "Primary5Y",
+		ATTRIBUTE_ID_ColorControl_Primary5Intensity:               "Primary5Intensity",
+		ATTRIBUTE_ID_ColorControl_Primary6X:                       "Primary6X",
+		ATTRIBUTE_ID_ColorControl_Primary6Y:                       "Primary6Y",
+		ATTRIBUTE_ID_ColorControl_Primary6Intensity:               "Primary6Intensity",
+		ATTRIBUTE_ID_ColorControl_WhitePointX:                     "WhitePointX",
+		ATTRIBUTE_ID_ColorControl_WhitePointY:                     "WhitePointY",
+		ATTRIBUTE_ID_ColorControl_ColorPointRX:                    "ColorPointRX",
+		ATTRIBUTE_ID_ColorControl_ColorPointRY:                    "ColorPointRY",
+		ATTRIBUTE_ID_ColorControl_ColorPointRIntensity:            "ColorPointRIntensity",
+		ATTRIBUTE_ID_ColorControl_ColorPointGX:                    "ColorPointGX",
+		ATTRIBUTE_ID_ColorControl_ColorPointGY:                    "ColorPointGY",
+		ATTRIBUTE_ID_ColorControl_ColorPointGIntensity:            "ColorPointGIntensity",
+		ATTRIBUTE_ID_ColorControl_ColorPointBX:                    "ColorPointBX",
+		ATTRIBUTE_ID_ColorControl_ColorPointBY:                    "ColorPointBY",
+		ATTRIBUTE_ID_ColorControl_ColorPointBIntensity:            "ColorPointBIntensity",
+		ATTRIBUTE_ID_ColorControl_EnhancedCurrentHue:              "EnhancedCurrentHue",
+		ATTRIBUTE_ID_ColorControl_EnhancedColorMode:               "EnhancedColorMode",
+		ATTRIBUTE_ID_ColorControl_ColorLoopActive:                 "ColorLoopActive",
+		ATTRIBUTE_ID_ColorControl_ColorLoopDirection:              "ColorLoopDirection",
+		ATTRIBUTE_ID_ColorControl_ColorLoopTime:                   "ColorLoopTime",
+		ATTRIBUTE_ID_ColorControl_ColorLoopStartEnhancedHue:       "ColorLoopStartEnhancedHue",
+		ATTRIBUTE_ID_ColorControl_ColorLoopStoredEnhancedHue:      "ColorLoopStoredEnhancedHue",
+		ATTRIBUTE_ID_ColorControl_ColorCapabilities:               "ColorCapabilities",
+		ATTRIBUTE_ID_ColorControl_ColorTempPhysicalMinMireds:      "ColorTempPhysicalMinMireds",
+		ATTRIBUTE_ID_ColorControl_ColorTempPhysicalMaxMireds:      "ColorTempPhysicalMaxMireds",
+		ATTRIBUTE_ID_ColorControl_CoupleColorTempToLevelMinMireds: "CoupleColorTempToLevelMinMireds",
+		ATTRIBUTE_ID_ColorControl_StartUpColorTemperatureMireds:   "StartUpColorTemperatureMireds",
+	},
+	CLUSTER_ID_BallastConfiguration: {
+		ATTRIBUTE_ID_BallastConfiguration_PhysicalMinLevel:        "PhysicalMinLevel",
+		ATTRIBUTE_ID_BallastConfiguration_PhysicalMaxLevel:        "PhysicalMaxLevel",
+		ATTRIBUTE_ID_BallastConfiguration_BallastStatus:           "BallastStatus",
+		ATTRIBUTE_ID_BallastConfiguration_MinLevel:                "MinLevel",
+		ATTRIBUTE_ID_BallastConfiguration_MaxLevel:                "MaxLevel",
+		ATTRIBUTE_ID_BallastConfiguration_IntrinsicBallastFactor:  "IntrinsicBallastFactor",
+		ATTRIBUTE_ID_BallastConfiguration_BallastFactorAdjustment: "BallastFactorAdjustment",
+		ATTRIBUTE_ID_BallastConfiguration_LampQuantity:            "LampQuantity",
+		ATTRIBUTE_ID_BallastConfiguration_LampType:                "LampType",
+		ATTRIBUTE_ID_BallastConfiguration_LampManufacturer:        "LampManufacturer",
+		ATTRIBUTE_ID_BallastConfiguration_LampRatedHours:          "LampRatedHours",
+		ATTRIBUTE_ID_BallastConfiguration_LampBurnHours:           "LampBurnHours",
+		ATTRIBUTE_ID_BallastConfiguration_LampAlarmMode:           "LampAlarmMode",
+		ATTRIBUTE_ID_BallastConfiguration_LampBurnHoursTripPoint:  "LampBurnHoursTripPoint",
+	},
+	CLUSTER_ID_IlluminanceMeasurement: {
+		ATTRIBUTE_ID_IlluminanceMeasurement_MeasuredValue:    "MeasuredValue",
+		ATTRIBUTE_ID_IlluminanceMeasurement_MinMeasuredValue: "MinMeasuredValue",
+		ATTRIBUTE_ID_IlluminanceMeasurement_MaxMeasuredValue: "MaxMeasuredValue",
+		ATTRIBUTE_ID_IlluminanceMeasurement_Tolerance:        "Tolerance",
+		ATTRIBUTE_ID_IlluminanceMeasurement_LightSensorType:  "LightSensorType",
+	},
+	CLUSTER_ID_TemperatureMeasurement: {
+		ATTRIBUTE_ID_TemperatureMeasurement_MeasuredValue:    "MeasuredValue",
+		ATTRIBUTE_ID_TemperatureMeasurement_MinMeasuredValue: "MinMeasuredValue",
+		ATTRIBUTE_ID_TemperatureMeasurement_MaxMeasuredValue: "MaxMeasuredValue",
+		ATTRIBUTE_ID_TemperatureMeasurement_Tolerance:        "Tolerance",
+	},
+	CLUSTER_ID_PressureMeasurement: {
+		ATTRIBUTE_ID_PressureMeasurement_MeasuredValue:    "MeasuredValue",
+		ATTRIBUTE_ID_PressureMeasurement_MinMeasuredValue: "MinMeasuredValue",
+		ATTRIBUTE_ID_PressureMeasurement_MaxMeasuredValue: "MaxMeasuredValue",
+		ATTRIBUTE_ID_PressureMeasurement_Tolerance:        "Tolerance",
+		ATTRIBUTE_ID_PressureMeasurement_ScaledValue:      "ScaledValue",
+		ATTRIBUTE_ID_PressureMeasurement_MinScaledValue:   "MinScaledValue",
+		ATTRIBUTE_ID_PressureMeasurement_MaxScaledValue:   "MaxScaledValue",
+		ATTRIBUTE_ID_PressureMeasurement_ScaledTolerance:  "ScaledTolerance",
+		ATTRIBUTE_ID_PressureMeasurement_Scale:            "Scale",
+	},
+	CLUSTER_ID_FlowMeasurement: {
+		ATTRIBUTE_ID_FlowMeasurement_MeasuredValue:    "MeasuredValue",
+		ATTRIBUTE_ID_FlowMeasurement_MinMeasuredValue: "MinMeasuredValue",
+		ATTRIBUTE_ID_FlowMeasurement_MaxMeasuredValue: "MaxMeasuredValue",
+		ATTRIBUTE_ID_FlowMeasurement_Tolerance:        "Tolerance",
+	},
+	CLUSTER_ID_OccupancySensing: {
+		ATTRIBUTE_ID_OccupancySensing_Occupancy:                                    "Occupancy",
+		ATTRIBUTE_ID_OccupancySensing_OccupancySensorType:                          "OccupancySensorType",
+		ATTRIBUTE_ID_OccupancySensing_OccupancySensorTypeBitmap:                    "OccupancySensorTypeBitmap",
+		ATTRIBUTE_ID_OccupancySensing_PIROccupiedToUnoccupiedDelay:                 "PIROccupiedToUnoccupiedDelay",
+		ATTRIBUTE_ID_OccupancySensing_PIRUnoccupiedToOccupiedDelay:                 "PIRUnoccupiedToOccupiedDelay",
+		ATTRIBUTE_ID_OccupancySensing_PIRUnoccupiedToOccupiedThreshold:             "PIRUnoccupiedToOccupiedThreshold",
+		ATTRIBUTE_ID_OccupancySensing_UltrasonicOccupiedToUnoccupiedDelay:          "UltrasonicOccupiedToUnoccupiedDelay",
+		ATTRIBUTE_ID_OccupancySensing_UltrasonicUnoccupiedToOccupiedDelay:          "UltrasonicUnoccupiedToOccupiedDelay",
+		ATTRIBUTE_ID_OccupancySensing_UltrasonicUnoccupiedToOccupiedThreshold:      "UltrasonicUnoccupiedToOccupiedThreshold",
+		ATTRIBUTE_ID_OccupancySensing_PhysicalContactOccupiedToUnoccupiedDelay:     "PhysicalContactOccupiedToUnoccupiedDelay",
+		ATTRIBUTE_ID_OccupancySensing_PhysicalContactUnoccupiedToOccupiedDelay:     "PhysicalContactUnoccupiedToOccupiedDelay",
+		ATTRIBUTE_ID_OccupancySensing_PhysicalContactUnoccupiedToOccupiedThreshold: "PhysicalContactUnoccupiedToOccupiedThreshold",
+	},
+	CLUSTER_ID_WakeonLAN: {
+		ATTRIBUTE_ID_WakeonLAN_MACAddress:       "MACAddress",
+		ATTRIBUTE_ID_WakeonLAN_LinkLocalAddress: "LinkLocalAddress",
+	},
+	CLUSTER_ID_Channel: {
+		ATTRIBUTE_ID_Channel_ChannelList:    "ChannelList",
+		ATTRIBUTE_ID_Channel_Lineup:         "Lineup",
+		ATTRIBUTE_ID_Channel_CurrentChannel: "CurrentChannel",
+	},
+	CLUSTER_ID_TargetNavigator: {
+		ATTRIBUTE_ID_TargetNavigator_TargetList:    "TargetList",
+		ATTRIBUTE_ID_TargetNavigator_CurrentTarget: "CurrentTarget",
+	},
+	CLUSTER_ID_MediaPlayback: {
+		ATTRIBUTE_ID_MediaPlayback_CurrentState:    "CurrentState",
+		ATTRIBUTE_ID_MediaPlayback_StartTime:       "StartTime",
+		ATTRIBUTE_ID_MediaPlayback_Duration:        "Duration",
+		ATTRIBUTE_ID_MediaPlayback_SampledPosition: "SampledPosition",
+		ATTRIBUTE_ID_MediaPlayback_PlaybackSpeed:   "PlaybackSpeed",
+		ATTRIBUTE_ID_MediaPlayback_SeekRangeEnd:    "SeekRangeEnd",
+		ATTRIBUTE_ID_MediaPlayback_SeekRangeStart:  "SeekRangeStart",
+	},
+	CLUSTER_ID_MediaInput: {
+		ATTRIBUTE_ID_MediaInput_InputList:    "InputList",
+		ATTRIBUTE_ID_MediaInput_CurrentInput: "CurrentInput",
+	},
+	CLUSTER_ID_ContentLauncher: {
+		ATTRIBUTE_ID_ContentLauncher_AcceptHeader:                "AcceptHeader",
+		ATTRIBUTE_ID_ContentLauncher_SupportedStreamingProtocols: "SupportedStreamingProtocols",
+	},
+	CLUSTER_ID_AudioOutput: {
+		ATTRIBUTE_ID_AudioOutput_OutputList:    "OutputList",
+		ATTRIBUTE_ID_AudioOutput_CurrentOutput: "CurrentOutput",
+	},
+	CLUSTER_ID_ApplicationLauncher: {
+		ATTRIBUTE_ID_ApplicationLauncher_CatalogList: "CatalogList",
+		ATTRIBUTE_ID_ApplicationLauncher_CurrentApp:  "CurrentApp",
+	},
+	CLUSTER_ID_ApplicationBasic: {
+		ATTRIBUTE_ID_ApplicationBasic_VendorName:         "VendorName",
+		ATTRIBUTE_ID_ApplicationBasic_VendorID:           "VendorID",
+		ATTRIBUTE_ID_ApplicationBasic_ApplicationName:    "ApplicationName",
+		ATTRIBUTE_ID_ApplicationBasic_ProductID:          "ProductID",
+		ATTRIBUTE_ID_ApplicationBasic_Application:        "Application",
+		ATTRIBUTE_ID_ApplicationBasic_Status:             "Status",
+		ATTRIBUTE_ID_ApplicationBasic_ApplicationVersion: "ApplicationVersion",
+		ATTRIBUTE_ID_ApplicationBasic_AllowedVendorList:  "AllowedVendorList",
+	},
+	CLUSTER_ID_MicrowaveOvenControl: {
+		ATTRIBUTE_ID_MicrowaveOvenControl_CookTime:          "CookTime",
+		ATTRIBUTE_ID_MicrowaveOvenControl_MaxCookTime:       "MaxCookTime",
+		ATTRIBUTE_ID_MicrowaveOvenControl_PowerSetting:      "PowerSetting",
+		ATTRIBUTE_ID_MicrowaveOvenControl_MinPower:          "MinPower",
+		ATTRIBUTE_ID_MicrowaveOvenControl_MaxPower:          "MaxPower",
+		ATTRIBUTE_ID_MicrowaveOvenControl_PowerStep:         "PowerStep",
+		ATTRIBUTE_ID_MicrowaveOvenControl_SupportedWatts:    "SupportedWatts",
+		ATTRIBUTE_ID_MicrowaveOvenControl_SelectedWattIndex: "SelectedWattIndex",
+		ATTRIBUTE_ID_MicrowaveOvenControl_WattRating:        "WattRating",
+	},
+}
+
+// CommandNameMap maps cluster id and command id to name of command sent to server.
+var CommandNameMap = map[int]map[int]string{
+	CLUSTER_ID_Identify: {
+		COMMAND_ID_Identify_Identify:      "Identify",
+		COMMAND_ID_Identify_TriggerEffect: "TriggerEffect",
+	},
+	CLUSTER_ID_Groups: {
+		COMMAND_ID_Groups_AddGroup:              "AddGroup",
+		COMMAND_ID_Groups_ViewGroup:             "ViewGroup",
+		COMMAND_ID_Groups_GetGroupMembership:    "GetGroupMembership",
+		COMMAND_ID_Groups_RemoveGroup:           "RemoveGroup",
+		COMMAND_ID_Groups_RemoveAllGroups:       "RemoveAllGroups",
+		COMMAND_ID_Groups_AddGroupIfIdentifying: "AddGroupIfIdentifying",
+	},
+	CLUSTER_ID_Scenes: {
+		COMMAND_ID_Scenes_AddScene:           "AddScene",
+		COMMAND_ID_Scenes_ViewScene:          "ViewScene",
+		COMMAND_ID_Scenes_RemoveScene:        "RemoveScene",
+		COMMAND_ID_Scenes_RemoveAllScenes:    "RemoveAllScenes",
+		COMMAND_ID_Scenes_StoreScene:         "StoreScene",
+		COMMAND_ID_Scenes_RecallScene:        "RecallScene",
+		COMMAND_ID_Scenes_GetSceneMembership: "GetSceneMembership",
+		COMMAND_ID_Scenes_EnhancedAddScene:   "EnhancedAddScene",
+		COMMAND_ID_Scenes_EnhancedViewScene:  "EnhancedViewScene",
+		COMMAND_ID_Scenes_CopyScene:          "CopyScene",
+	},
+	CLUSTER_ID_OnOff: {
+		COMMAND_ID_OnOff_Off:                     "Off",
+		COMMAND_ID_OnOff_On:                      "On",
+		COMMAND_ID_OnOff_Toggle:                  "Toggle",
+		COMMAND_ID_OnOff_OffWithEffect:           "OffWithEffect",
+		COMMAND_ID_OnOff_OnWithRecallGlobalScene: "OnWithRecallGlobalScene",
+		COMMAND_ID_OnOff_OnWithTimedOff:          "OnWithTimedOff",
+	},
+	CLUSTER_ID_LevelControl: {
+		COMMAND_ID_LevelControl_MoveToLevel:            "MoveToLevel",
+		COMMAND_ID_LevelControl_Move:                   "Move",
+		COMMAND_ID_LevelControl_Step:                   "Step",
+		COMMAND_ID_LevelControl_Stop:                   "Stop",
+		COMMAND_ID_LevelControl_MoveToLevelWithOnOff:   "MoveToLevelWithOnOff",
+		COMMAND_ID_LevelControl_MoveWithOnOff:          "MoveWithOnOff",
+		COMMAND_ID_LevelControl_StepWithOnOff:          "StepWithOnOff",
+		COMMAND_ID_LevelControl_StopWithOnOff:          "StopWithOnOff",
+		COMMAND_ID_LevelControl_MoveToClosestFrequency: "MoveToClosestFrequency",
+	},
+	CLUSTER_ID_Actions: {
+		COMMAND_ID_Actions_InstantAction:               "InstantAction",
+		COMMAND_ID_Actions_InstantActionWithTransition: "InstantActionWithTransition",
+		COMMAND_ID_Actions_StartAction:                 "StartAction",
+		COMMAND_ID_Actions_StartActionWithDuration:     "StartActionWithDuration",
+		COMMAND_ID_Actions_StopAction:                  "StopAction",
+		COMMAND_ID_Actions_PauseAction:                 "PauseAction",
+		COMMAND_ID_Actions_PauseActionWithDuration:     "PauseActionWithDuration",
+		COMMAND_ID_Actions_ResumeAction:                "ResumeAction",
+		COMMAND_ID_Actions_EnableAction:                "EnableAction",
+		COMMAND_ID_Actions_EnableActionWithDuration:    "EnableActionWithDuration",
+		COMMAND_ID_Actions_DisableAction:               "DisableAction",
+		COMMAND_ID_Actions_DisableActionWithDuration:   "DisableActionWithDuration",
+	},
+	CLUSTER_ID_GeneralCommissioning: {
+		COMMAND_ID_GeneralCommissioning_ArmFailSafe:           "ArmFailSafe",
+		COMMAND_ID_GeneralCommissioning_SetRegulatoryConfig:   "SetRegulatoryConfig",
+		COMMAND_ID_GeneralCommissioning_CommissioningComplete: "CommissioningComplete",
+	},
+	CLUSTER_ID_NetworkCommissioning: {
+		COMMAND_ID_NetworkCommissioning_ScanNetworks:             "ScanNetworks",
+		COMMAND_ID_NetworkCommissioning_AddOrUpdateWiFiNetwork:   "AddOrUpdateWiFiNetwork",
+		COMMAND_ID_NetworkCommissioning_AddOrUpdateThreadNetwork: "AddOrUpdateThreadNetwork",
+		COMMAND_ID_NetworkCommissioning_RemoveNetwork:            "RemoveNetwork",
+		COMMAND_ID_NetworkCommissioning_ConnectNetwork:           "ConnectNetwork",
+		COMMAND_ID_NetworkCommissioning_ReorderNetwork:           "ReorderNetwork",
+	},
+	CLUSTER_ID_DiagnosticLogs: {
+		COMMAND_ID_DiagnosticLogs_RetrieveLogsRequest: "RetrieveLogsRequest",
+	},
+	CLUSTER_ID_GeneralDiagnostics: {
+		COMMAND_ID_GeneralDiagnostics_TestEventTrigger: "TestEventTrigger",
+		COMMAND_ID_GeneralDiagnostics_TimeSnapshot:     "TimeSnapshot",
+	},
+	CLUSTER_ID_SoftwareDiagnostics: {
+		COMMAND_ID_SoftwareDiagnostics_ResetWatermarks: "ResetWatermarks",
+	},
+	CLUSTER_ID_ThreadNetworkDiagnostics: {
+		COMMAND_ID_ThreadNetworkDiagnostics_ResetCounts: "ResetCounts",
+	},
+	CLUSTER_ID_WiFiNetworkDiagnostics: {
+		COMMAND_ID_WiFiNetworkDiagnostics_ResetCounts: "ResetCounts",
+	},
+	CLUSTER_ID_EthernetNetworkDiagnostics: {
+		COMMAND_ID_EthernetNetworkDiagnostics_ResetCounts: "ResetCounts",
+	},
+	CLUSTER_ID_TimeSync: {
+		COMMAND_ID_TimeSync_SetUTCTime:           "SetUTCTime",
+		COMMAND_ID_TimeSync_SetTrustedTimeSource: "SetTrustedTimeSource",
+		COMMAND_ID_TimeSync_SetTimeZone:          "SetTimeZone",
+		COMMAND_ID_TimeSync_SetDSTOffset:         "SetDSTOffset",
+		COMMAND_ID_TimeSync_SetDefaultNTP:        "SetDefaultNTP",
+	},
+	CLUSTER_ID_AdministratorCommissioning: {
+		COMMAND_ID_AdministratorCommissioning_OpenCommissioningWindow:      "OpenCommissioningWindow",
+		COMMAND_ID_AdministratorCommissioning_OpenBasicCommissioningWindow: "OpenBasicCommissioningWindow",
+		COMMAND_ID_AdministratorCommissioning_RevokeCommissioning:          "RevokeCommissioning",
+	},
+	CLUSTER_ID_OperationalCredentials: {
+		COMMAND_ID_OperationalCredentials_AttestationRequest:        "AttestationRequest",
+		COMMAND_ID_OperationalCredentials_CertificateChainRequest:   "CertificateChainRequest",
+		COMMAND_ID_OperationalCredentials_CSRRequest:                "CSRRequest",
+		COMMAND_ID_OperationalCredentials_AddNOC:                    "AddNOC",
+		COMMAND_ID_OperationalCredentials_UpdateNOC:                 "UpdateNOC",
+		COMMAND_ID_OperationalCredentials_UpdateFabricLabel:         "UpdateFabricLabel",
+		COMMAND_ID_OperationalCredentials_RemoveFabric:              "RemoveFabric",
+		COMMAND_ID_OperationalCredentials_AddTrustedRootCertificate: "AddTrustedRootCertificate",
+	},
+	CLUSTER_ID_GroupKeyManagement: {
+		COMMAND_ID_GroupKeyManagement_KeySetWrite:          "KeySetWrite",
+		COMMAND_ID_GroupKeyManagement_KeySetRead:           "KeySetRead",
+		COMMAND_ID_GroupKeyManagement_KeySetRemove:         "KeySetRemove",
+		COMMAND_ID_GroupKeyManagement_KeySetReadAllIndices: "KeySetReadAllIndices",
+	},
+	CLUSTER_ID_Timer: {
+		COMMAND_ID_Timer_SetTimer:   "SetTimer",
+		COMMAND_ID_Timer_ResetTimer: "ResetTimer",
+		COMMAND_ID_Timer_AddTime:    "AddTime",
+		COMMAND_ID_Timer_ReduceTime: "ReduceTime",
+	},
+	CLUSTER_ID_OvenCavityOperationalState: {
+		COMMAND_ID_OvenCavityOperationalState_Pause:  "Pause",
+		COMMAND_ID_OvenCavityOperationalState_Stop:   "Stop",
+		COMMAND_ID_OvenCavityOperationalState_Start:  "Start",
+		COMMAND_ID_OvenCavityOperationalState_Resume: "Resume",
+	},
+	CLUSTER_ID_OvenMode: {
+		COMMAND_ID_OvenMode_ChangeToMode: "ChangeToMode",
+	},
+	CLUSTER_ID_ModeSelect: {
+		COMMAND_ID_ModeSelect_ChangeToMode: "ChangeToMode",
+	},
+	CLUSTER_ID_LaundryWasherMode: {
+		COMMAND_ID_LaundryWasherMode_ChangeToMode: "ChangeToMode",
+	},
+	CLUSTER_ID_RefrigeratorAndTemperatureControlledCabinetMode: {
+		COMMAND_ID_RefrigeratorAndTemperatureControlledCabinetMode_ChangeToMode: "ChangeToMode",
+	},
+	CLUSTER_ID_RVCRunMode: {
+		COMMAND_ID_RVCRunMode_ChangeToMode: "ChangeToMode",
+	},
+	CLUSTER_ID_RVCCleanMode: {
+		COMMAND_ID_RVCCleanMode_ChangeToMode: "ChangeToMode",
+	},
+	CLUSTER_ID_TemperatureControl: {
+		COMMAND_ID_TemperatureControl_SetTemperature: "SetTemperature",
+	},
+	CLUSTER_ID_RefrigeratorAlarm: {
+		COMMAND_ID_RefrigeratorAlarm_Reset:               "Reset",
+		COMMAND_ID_RefrigeratorAlarm_ModifyEnabledAlarms: "ModifyEnabledAlarms",
+	},
+	CLUSTER_ID_DishwasherMode: {
+		COMMAND_ID_DishwasherMode_ChangeToMode: "ChangeToMode",
+	},
+	CLUSTER_ID_SmokeCOAlarm: {
+		COMMAND_ID_SmokeCOAlarm_SelfTestRequest: "SelfTestRequest",
+	},
+	CLUSTER_ID_DishwasherAlarm: {
+		COMMAND_ID_DishwasherAlarm_Reset:               "Reset",
+		COMMAND_ID_DishwasherAlarm_ModifyEnabledAlarms: "ModifyEnabledAlarms",
+	},
+	CLUSTER_ID_MicrowaveOvenMode: {
+		COMMAND_ID_MicrowaveOvenMode_ChangeToMode: "ChangeToMode",
+	},
+	CLUSTER_ID_OperationalState: {
+		COMMAND_ID_OperationalState_Pause:  "Pause",
+		COMMAND_ID_OperationalState_Stop:   "Stop",
+		COMMAND_ID_OperationalState_Start:  "Start",
+		COMMAND_ID_OperationalState_Resume: "Resume",
+	},
+	CLUSTER_ID_RVCOperationalState: {
+		COMMAND_ID_RVCOperationalState_Pause:  "Pause",
+		COMMAND_ID_RVCOperationalState_Stop:   "Stop",
+		COMMAND_ID_RVCOperationalState_Start:  "Start",
+		COMMAND_ID_RVCOperationalState_Resume: "Resume",
+		COMMAND_ID_RVCOperationalState_GoHome: "GoHome",
+	},
+	CLUSTER_ID_BooleanSensorConfiguration: {
+		COMMAND_ID_BooleanSensorConfiguration_SuppressAlarm:      "SuppressAlarm",
+		COMMAND_ID_BooleanSensorConfiguration_EnableDisableAlarm: "EnableDisableAlarm",
+	},
+	CLUSTER_ID_ValveConfigurationandControl: {
+		COMMAND_ID_ValveConfigurationandControl_Open:  "Open",
+		COMMAND_ID_ValveConfigurationandControl_Close: "Close",
+	},
+	CLUSTER_ID_WaterHeaterManagement: {
+		COMMAND_ID_WaterHeaterManagement_Boost:       "Boost",
+		COMMAND_ID_WaterHeaterManagement_CancelBoost: "CancelBoost",
+	},
+	CLUSTER_ID_EnergyPrice: {
+		COMMAND_ID_EnergyPrice_GetDetailedPriceRequest:    "GetDetailedPriceRequest",
+		COMMAND_ID_EnergyPrice_GetDetailedForecastRequest: "GetDetailedForecastRequest",
+	},
+	CLUSTER_ID_DemandResponseandLoadControl: {
+		COMMAND_ID_DemandResponseandLoadControl_RegisterLoadControlProgramRequest:   "RegisterLoadControlProgramRequest",
+		COMMAND_ID_DemandResponseandLoadControl_UnregisterLoadControlProgramRequest: "UnregisterLoadControlProgramRequest",
+		COMMAND_ID_DemandResponseandLoadControl_AddLoadControlEventRequest:          "AddLoadControlEventRequest",
+		COMMAND_ID_DemandResponseandLoadControl_RemoveLoadControlEventRequest:       "RemoveLoadControlEventRequest",
+		COMMAND_ID_DemandResponseandLoadControl_ClearLoadControlEventsRequest:       "ClearLoadControlEventsRequest",
+	},
+	CLUSTER_ID_Messages: {
+		COMMAND_ID_Messages_PresentMessagesRequest: "PresentMessagesRequest",
+		COMMAND_ID_Messages_CancelMessagesRequest:  "CancelMessagesRequest",
+	},
+	CLUSTER_ID_DeviceEnergyManagement: {
+		COMMAND_ID_DeviceEnergyManagement_PowerAdjustRequest:             "PowerAdjustRequest",
+		COMMAND_ID_DeviceEnergyManagement_CancelPowerAdjustRequest:       "CancelPowerAdjustRequest",
+		COMMAND_ID_DeviceEnergyManagement_StartTimeAdjustRequest:         "StartTimeAdjustRequest",
+		COMMAND_ID_DeviceEnergyManagement_PauseRequest:                   "PauseRequest",
+		COMMAND_ID_DeviceEnergyManagement_ResumeRequest:                  "ResumeRequest",
+		COMMAND_ID_DeviceEnergyManagement_ModifyForecastRequest:          "ModifyForecastRequest",
+		COMMAND_ID_DeviceEnergyManagement_RequestConstraintBasedForecast: "RequestConstraintBasedForecast",
+		COMMAND_ID_DeviceEnergyManagement_CancelRequest:                  "CancelRequest",
+	},
+	CLUSTER_ID_DoorLock: {
+		COMMAND_ID_DoorLock_LockDoor:             "LockDoor",
+		COMMAND_ID_DoorLock_UnlockDoor:           "UnlockDoor",
+		COMMAND_ID_DoorLock_UnlockWithTimeout:    "UnlockWithTimeout",
+		COMMAND_ID_DoorLock_SetWeekDaySchedule:   "SetWeekDaySchedule",
+		COMMAND_ID_DoorLock_GetWeekDaySchedule:   "GetWeekDaySchedule",
+		COMMAND_ID_DoorLock_ClearWeekDaySchedule: "ClearWeekDaySchedule",
+		COMMAND_ID_DoorLock_SetYearDaySchedule:   "SetYearDaySchedule",
+		COMMAND_ID_DoorLock_GetYearDaySchedule:   "GetYearDaySchedule",
+		COMMAND_ID_DoorLock_ClearYearDaySchedule: "ClearYearDaySchedule",
+		COMMAND_ID_DoorLock_SetHolidaySchedule:   "SetHolidaySchedule",
+		COMMAND_ID_DoorLock_GetHolidaySchedule:   "GetHolidaySchedule",
+		COMMAND_ID_DoorLock_ClearHolidaySchedule: "ClearHolidaySchedule",
+		COMMAND_ID_DoorLock_SetUser:              "SetUser",
+		COMMAND_ID_DoorLock_GetUser:              "GetUser",
+		COMMAND_ID_DoorLock_ClearUser:            "ClearUser",
+		COMMAND_ID_DoorLock_SetCredential:        "SetCredential",
+		COMMAND_ID_DoorLock_GetCredentialStatus:  "GetCredentialStatus",
+		COMMAND_ID_DoorLock_ClearCredential:      "ClearCredential",
+		COMMAND_ID_DoorLock_UnboltDoor:           "UnboltDoor",
+	},
+	CLUSTER_ID_WindowCovering: {
+		COMMAND_ID_WindowCovering_UpOrOpen:           "UpOrOpen",
+		COMMAND_ID_WindowCovering_DownOrClose:        "DownOrClose",
+		COMMAND_ID_WindowCovering_StopMotion:         "StopMotion",
+		COMMAND_ID_WindowCovering_GoToLiftValue:      "GoToLiftValue",
+		COMMAND_ID_WindowCovering_GoToLiftPercentage: "GoToLiftPercentage",
+		COMMAND_ID_WindowCovering_GoToTiltValue:      "GoToTiltValue",
+		COMMAND_ID_WindowCovering_GoToTiltPercentage: "GoToTiltPercentage",
+	},
+	CLUSTER_ID_Thermostat: {
+		COMMAND_ID_Thermostat_SetpointRaiseLower:  "SetpointRaiseLower",
+		COMMAND_ID_Thermostat_SetWeeklySchedule:   "SetWeeklySchedule",
+		COMMAND_ID_Thermostat_GetWeeklySchedule:   "GetWeeklySchedule",
+		COMMAND_ID_Thermostat_ClearWeeklySchedule: "ClearWeeklySchedule",
+	},
+	CLUSTER_ID_FanControl: {
+		COMMAND_ID_FanControl_Step: "Step",
+	},
+	CLUSTER_ID_ColorControl: {
+		COMMAND_ID_ColorControl_MoveToHue:                      "MoveToHue",
+		COMMAND_ID_ColorControl_MoveHue:                        "MoveHue",
+		COMMAND_ID_ColorControl_StepHue:                        "StepHue",
+		COMMAND_ID_ColorControl_MoveToSaturation:               "MoveToSaturation",
+		COMMAND_ID_ColorControl_MoveSaturation:                 "MoveSaturation",
+		COMMAND_ID_ColorControl_StepSaturation:                 "StepSaturation",
+		COMMAND_ID_ColorControl_MoveToHueAndSaturation:         "MoveToHueAndSaturation",
+		COMMAND_ID_ColorControl_MoveToColor:                    "MoveToColor",
+		COMMAND_ID_ColorControl_MoveColor:                      "MoveColor",
+		COMMAND_ID_ColorControl_StepColor:                      "StepColor",
+		COMMAND_ID_ColorControl_MoveToColorTemperature:         "MoveToColorTemperature",
+		COMMAND_ID_ColorControl_EnhancedMoveToHue:              "EnhancedMoveToHue",
+		COMMAND_ID_ColorControl_EnhancedMoveHue:                "EnhancedMoveHue",
+		COMMAND_ID_ColorControl_EnhancedStepHue:                "EnhancedStepHue",
+		COMMAND_ID_ColorControl_EnhancedMoveToHueAndSaturation: "EnhancedMoveToHueAndSaturation",
+		COMMAND_ID_ColorControl_ColorLoopSet:                   "ColorLoopSet",
+		COMMAND_ID_ColorControl_StopMoveStep:                   "StopMoveStep",
+		COMMAND_ID_ColorControl_MoveColorTemperature:           "MoveColorTemperature",
+		COMMAND_ID_ColorControl_StepColorTemperature:           "StepColorTemperature",
+	},
+	CLUSTER_ID_Channel: {
+		COMMAND_ID_Channel_ChangeChannel:         "ChangeChannel",
+		COMMAND_ID_Channel_ChangeChannelByNumber: "ChangeChannelByNumber",
+		COMMAND_ID_Channel_SkipChannel:           "SkipChannel",
+	},
+	CLUSTER_ID_TargetNavigator: {
+		COMMAND_ID_TargetNavigator_NavigateTarget: "NavigateTarget",
+	},
+	CLUSTER_ID_MediaPlayback: {
+		COMMAND_ID_MediaPlayback_Play:         "Play",
+		COMMAND_ID_MediaPlayback_Pause:        "Pause",
+		COMMAND_ID_MediaPlayback_Stop:         "Stop",
+		COMMAND_ID_MediaPlayback_StartOver:    "StartOver",
+		COMMAND_ID_MediaPlayback_Previous:     "Previous",
+		COMMAND_ID_MediaPlayback_Next:         "Next",
+		COMMAND_ID_MediaPlayback_Rewind:       "Rewind",
+		COMMAND_ID_MediaPlayback_FastForward:  "FastForward",
+		COMMAND_ID_MediaPlayback_SkipForward:  "SkipForward",
+		COMMAND_ID_MediaPlayback_SkipBackward: "SkipBackward",
+		COMMAND_ID_MediaPlayback_Seek:         "Seek",
+	},
+	CLUSTER_ID_MediaInput: {
+		COMMAND_ID_MediaInput_SelectInput:     "SelectInput",
+		COMMAND_ID_MediaInput_ShowInputStatus: "ShowInputStatus",
+		COMMAND_ID_MediaInput_HideInputStatus: "HideInputStatus",
+		COMMAND_ID_MediaInput_RenameInput:     "RenameInput",
+	},
+	CLUSTER_ID_LowPower: {
+		COMMAND_ID_LowPower_Sleep: "Sleep",
+	},
+	CLUSTER_ID_KeypadInput: {
+		COMMAND_ID_KeypadInput_SendKey: "SendKey",
+	},
+	CLUSTER_ID_ContentLauncher: {
+		COMMAND_ID_ContentLauncher_LaunchContent: "LaunchContent",
+		COMMAND_ID_ContentLauncher_LaunchURL:     "LaunchURL",
+	},
+	CLUSTER_ID_AudioOutput: {
+		COMMAND_ID_AudioOutput_SelectOutput: "SelectOutput",
+		COMMAND_ID_AudioOutput_RenameOutput: "RenameOutput",
+	},
+	CLUSTER_ID_ApplicationLauncher: {
+		COMMAND_ID_ApplicationLauncher_LaunchApp: "LaunchApp",
+		COMMAND_ID_ApplicationLauncher_StopApp:   "StopApp",
+		COMMAND_ID_ApplicationLauncher_HideApp:   "HideApp",
+	},
+	CLUSTER_ID_AccountLogin: {
+		COMMAND_ID_AccountLogin_GetSetupPIN: "GetSetupPIN",
+		COMMAND_ID_AccountLogin_Login:       "Login",
+		COMMAND_ID_AccountLogin_Logout:      "Logout",
+	},
+	CLUSTER_ID_MicrowaveOvenControl: {
+		COMMAND_ID_MicrowaveOvenControl_SetCookingParameters: "SetCookingParameters",
+		COMMAND_ID_MicrowaveOvenControl_AddMoreTime:          "AddMoreTime",
+	},
+	CLUSTER_ID_ContentAppObserver: {
+		COMMAND_ID_ContentAppObserver_ContentAppMessage: "ContentAppMessage",
+	},
+}
+
+// ResponseNameMap maps cluster id and command id to name of response command sent by server.
+var ResponseNameMap = map[int]map[int]string{
+	CLUSTER_ID_Groups: {
+		COMMAND_ID_Groups_AddGroupResponse:           "AddGroupResponse",
+		COMMAND_ID_Groups_ViewGroupResponse:          "ViewGroupResponse",
+		COMMAND_ID_Groups_GetGroupMembershipResponse: "GetGroupMembershipResponse",
+		COMMAND_ID_Groups_RemoveGroupResponse:        "RemoveGroupResponse",
+	},
+	CLUSTER_ID_Scenes: {
+		COMMAND_ID_Scenes_AddSceneResponse:           "AddSceneResponse",
+		COMMAND_ID_Scenes_ViewSceneResponse:          "ViewSceneResponse",
+		COMMAND_ID_Scenes_RemoveSceneResponse:        "RemoveSceneResponse",
+		COMMAND_ID_Scenes_RemoveAllScenesResponse:    "RemoveAllScenesResponse",
+		COMMAND_ID_Scenes_StoreSceneResponse:         "StoreSceneResponse",
+		COMMAND_ID_Scenes_GetSceneMembershipResponse: "GetSceneMembershipResponse",
+		COMMAND_ID_Scenes_EnhancedAddSceneResponse:   "EnhancedAddSceneResponse",
+		COMMAND_ID_Scenes_EnhancedViewSceneResponse:  "EnhancedViewSceneResponse",
+		COMMAND_ID_Scenes_CopySceneResponse:          "CopySceneResponse",
+	},
+	CLUSTER_ID_GeneralCommissioning: {
+		COMMAND_ID_GeneralCommissioning_ArmFailSafeResponse:           "ArmFailSafeResponse",
+		COMMAND_ID_GeneralCommissioning_SetRegulatoryConfigResponse:   "SetRegulatoryConfigResponse",
+		COMMAND_ID_GeneralCommissioning_CommissioningCompleteResponse: "CommissioningCompleteResponse",
+	},
+	CLUSTER_ID_NetworkCommissioning: {
+		COMMAND_ID_NetworkCommissioning_ScanNetworksResponse:   "ScanNetworksResponse",
+		COMMAND_ID_NetworkCommissioning_NetworkConfigResponse:  "NetworkConfigResponse",
+		COMMAND_ID_NetworkCommissioning_ConnectNetworkResponse: "ConnectNetworkResponse",
+	},
+	CLUSTER_ID_DiagnosticLogs: {
+		COMMAND_ID_DiagnosticLogs_RetrieveLogsResponse: "RetrieveLogsResponse",
+	},
+	CLUSTER_ID_GeneralDiagnostics: {
+		COMMAND_ID_GeneralDiagnostics_TimeSnapshotResponse: "TimeSnapshotResponse",
+	},
+	CLUSTER_ID_TimeSync: {
+		COMMAND_ID_TimeSync_SetTimeZoneResponse: "SetTimeZoneResponse",
+	},
+	CLUSTER_ID_OperationalCredentials: {
+		COMMAND_ID_OperationalCredentials_AttestationResponse:      "AttestationResponse",
+		COMMAND_ID_OperationalCredentials_CertificateChainResponse: "CertificateChainResponse",
+		COMMAND_ID_OperationalCredentials_CSRResponse:              "CSRResponse",
+		COMMAND_ID_OperationalCredentials_NOCResponse:              "NOCResponse",
+	},
+	CLUSTER_ID_GroupKeyManagement: {
+		COMMAND_ID_GroupKeyManagement_KeySetReadResponse:           "KeySetReadResponse",
+		COMMAND_ID_GroupKeyManagement_KeySetReadAllIndicesResponse: "KeySetReadAllIndicesResponse",
+	},
+	CLUSTER_ID_OvenCavityOperationalState: {
+		COMMAND_ID_OvenCavityOperationalState_OperationalCommandResponse: "OperationalCommandResponse",
+	},
+	CLUSTER_ID_OvenMode: {
+		COMMAND_ID_OvenMode_ChangeToModeResponse: "ChangeToModeResponse",
+	},
+	CLUSTER_ID_LaundryWasherMode: {
+		COMMAND_ID_LaundryWasherMode_ChangeToModeResponse: "ChangeToModeResponse",
+	},
+	CLUSTER_ID_RefrigeratorAndTemperatureControlledCabinetMode: {
+		COMMAND_ID_RefrigeratorAndTemperatureControlledCabinetMode_ChangeToModeResponse: "ChangeToModeResponse",
+	},
+	CLUSTER_ID_RVCRunMode: {
+		COMMAND_ID_RVCRunMode_ChangeToModeResponse: "ChangeToModeResponse",
+	},
+	CLUSTER_ID_RVCCleanMode: {
+		COMMAND_ID_RVCCleanMode_ChangeToModeResponse: "ChangeToModeResponse",
+	},
+	CLUSTER_ID_DishwasherMode: {
+		COMMAND_ID_DishwasherMode_ChangeToModeResponse: "ChangeToModeResponse",
+	},
+	CLUSTER_ID_MicrowaveOvenMode: {
+		COMMAND_ID_MicrowaveOvenMode_ChangeToModeResponse: "ChangeToModeResponse",
+	},
+	CLUSTER_ID_OperationalState: {
+		COMMAND_ID_OperationalState_OperationalCommandResponse: "OperationalCommandResponse",
+	},
+	CLUSTER_ID_RVCOperationalState: {
+		COMMAND_ID_RVCOperationalState_OperationalCommandResponse: "OperationalCommandResponse",
+	},
+	CLUSTER_ID_EnergyPrice: {
+		COMMAND_ID_EnergyPrice_GetDetailedPriceResponse:    "GetDetailedPriceResponse",
+		COMMAND_ID_EnergyPrice_GetDetailedForecastResponse: "GetDetailedForecastResponse",
+	},
+	CLUSTER_ID_DoorLock: {
+		COMMAND_ID_DoorLock_GetWeekDayScheduleResponse:  "GetWeekDayScheduleResponse",
+		COMMAND_ID_DoorLock_GetYearDayScheduleResponse:  "GetYearDayScheduleResponse",
+		COMMAND_ID_DoorLock_GetHolidayScheduleResponse:  "GetHolidayScheduleResponse",
+		COMMAND_ID_DoorLock_GetUserResponse:             "GetUserResponse",
+		COMMAND_ID_DoorLock_SetCredentialResponse:       "SetCredentialResponse",
+		COMMAND_ID_DoorLock_GetCredentialStatusResponse: "GetCredentialStatusResponse",
+	},
+	CLUSTER_ID_Thermostat: {
+		COMMAND_ID_Thermostat_GetWeeklyScheduleResponse: "GetWeeklyScheduleResponse",
+	},
+	CLUSTER_ID_Channel: {
+		COMMAND_ID_Channel_ChangeChannelResponse: "ChangeChannelResponse",
+	},
+	CLUSTER_ID_TargetNavigator: {
+		COMMAND_ID_TargetNavigator_NavigateTargetResponse: "NavigateTargetResponse",
+	},
+	CLUSTER_ID_MediaPlayback: {
+		COMMAND_ID_MediaPlayback_PlaybackResponse: "PlaybackResponse",
+	},
+	CLUSTER_ID_KeypadInput: {
+		COMMAND_ID_KeypadInput_SendKeyResponse: "SendKeyResponse",
+	},
+	CLUSTER_ID_ContentLauncher: {
+		COMMAND_ID_ContentLauncher_LauncherResponse: "LauncherResponse",
+	},
+	CLUSTER_ID_ApplicationLauncher: {
+		COMMAND_ID_ApplicationLauncher_LauncherResponse: "LauncherResponse",
+	},
+	CLUSTER_ID_AccountLogin: {
+		COMMAND_ID_AccountLogin_GetSetupPINResponse: "GetSetupPINResponse",
+	},
+	CLUSTER_ID_ContentAppObserver: {
+		COMMAND_ID_ContentAppObserver_ContentAppMessageResponse: "ContentAppMessageResponse",
+	},
+}
+
+// EventNameMap maps cluster id and event id to name of event.
+var EventNameMap = map[int]map[int]string{
+	CLUSTER_ID_AccessControl: {
+		EVENT_ID_AccessControl_AccessControlEntryChanged:     "AccessControlEntryChanged",
+		EVENT_ID_AccessControl_AccessControlExtensionChanged: "AccessControlExtensionChanged",
+	},
+	CLUSTER_ID_Actions: {
+		EVENT_ID_Actions_StateChanged: "StateChanged",
+		EVENT_ID_Actions_ActionFailed: "ActionFailed",
+	},
+	CLUSTER_ID_BasicInformation: {
+		EVENT_ID_BasicInformation_StartUp:          "StartUp",
+		EVENT_ID_BasicInformation_ShutDown:         "ShutDown",
+		EVENT_ID_BasicInformation_Leave:            "Leave",
+		EVENT_ID_BasicInformation_ReachableChanged: "ReachableChanged",
+	},
+	CLUSTER_ID_PowerSource: {
+		EVENT_ID_PowerSource_WiredFaultChange:     "WiredFaultChange",
+		EVENT_ID_PowerSource_BatFaultChange:       "BatFaultChange",
+		EVENT_ID_PowerSource_BatChargeFaultChange: "BatChargeFaultChange",
+	},
+	CLUSTER_ID_GeneralDiagnostics: {
+		EVENT_ID_GeneralDiagnostics_HardwareFaultChange: "HardwareFaultChange",
+		EVENT_ID_GeneralDiagnostics_RadioFaultChange:    "RadioFaultChange",
+		EVENT_ID_GeneralDiagnostics_NetworkFaultChange:  "NetworkFaultChange",
+		EVENT_ID_GeneralDiagnostics_BootReason:          "BootReason",
+	},
+	CLUSTER_ID_SoftwareDiagnostics: {
+		EVENT_ID_SoftwareDiagnostics_SoftwareFault: "SoftwareFault",
+	},
+	CLUSTER_ID_ThreadNetworkDiagnostics: {
+		EVENT_ID_ThreadNetworkDiagnostics_ConnectionStatus:   "ConnectionStatus",
+		EVENT_ID_ThreadNetworkDiagnostics_NetworkFaultChange: "NetworkFaultChange",
+	},
+	CLUSTER_ID_WiFiNetworkDiagnostics: {
+		EVENT_ID_WiFiNetworkDiagnostics_Disconnection:      "Disconnection",
+		EVENT_ID_WiFiNetworkDiagnostics_AssociationFailure: "AssociationFailure",
+		EVENT_ID_WiFiNetworkDiagnostics_ConnectionStatus:   "ConnectionStatus",
+	},
+	CLUSTER_ID_TimeSync: {
+		EVENT_ID_TimeSync_DSTTableEmpty:            "DSTTableEmpty",
+		EVENT_ID_TimeSync_DSTStatus:                "DSTStatus",
+		EVENT_ID_TimeSync_TimeZoneStatus:           "TimeZoneStatus",
+		EVENT_ID_TimeSync_TimeFailure:              "TimeFailure",
+		EVENT_ID_TimeSync_MissingTrustedTimeSource: "MissingTrustedTimeSource",
+	},
+	CLUSTER_ID_BridgedDeviceBasicInformation: {
+		EVENT_ID_BridgedDeviceBasicInformation_StartUp:          "StartUp",
+		EVENT_ID_BridgedDeviceBasicInformation_ShutDown:         "ShutDown",
+		EVENT_ID_BridgedDeviceBasicInformation_Leave:            "Leave",
+		EVENT_ID_BridgedDeviceBasicInformation_ReachableChanged: "ReachableChanged",
+	},
+	CLUSTER_ID_Switch: {
+		EVENT_ID_Switch_SwitchLatched:      "SwitchLatched",
+		EVENT_ID_Switch_InitialPress:       "InitialPress",
+		EVENT_ID_Switch_LongPress:          "LongPress",
+		EVENT_ID_Switch_ShortRelease:       "ShortRelease",
+		EVENT_ID_Switch_LongRelease:        "LongRelease",
+		EVENT_ID_Switch_MultiPressOngoing:  "MultiPressOngoing",
+		EVENT_ID_Switch_MultiPressComplete: "MultiPressComplete",
+	},
+	CLUSTER_ID_BooleanState: {
+		EVENT_ID_BooleanState_StateChange: "StateChange",
+	},
+	CLUSTER_ID_OvenCavityOperationalState: {
+		EVENT_ID_OvenCavityOperationalState_OperationalError:    "OperationalError",
+		EVENT_ID_OvenCavityOperationalState_OperationCompletion: "OperationCompletion",
+	},
+	CLUSTER_ID_RefrigeratorAlarm: {
+		EVENT_ID_RefrigeratorAlarm_Notify: "Notify",
+	},
+	CLUSTER_ID_SmokeCOAlarm: {
+		EVENT_ID_SmokeCOAlarm_SmokeAlarm:             "SmokeAlarm",
+		EVENT_ID_SmokeCOAlarm_COAlarm:                "COAlarm",
+		EVENT_ID_SmokeCOAlarm_LowBattery:             "LowBattery",
+		EVENT_ID_SmokeCOAlarm_HardwareFault:          "HardwareFault",
+		EVENT_ID_SmokeCOAlarm_EndOfService:           "EndOfService",
+		EVENT_ID_SmokeCOAlarm_SelfTestComplete:       "SelfTestComplete",
+		EVENT_ID_SmokeCOAlarm_AlarmMuted:             "AlarmMuted",
+		EVENT_ID_SmokeCOAlarm_MuteEnded:              "MuteEnded",
+		EVENT_ID_SmokeCOAlarm_InterconnectSmokeAlarm: "InterconnectSmokeAlarm",
+		EVENT_ID_SmokeCOAlarm_InterconnectCOAlarm:    "InterconnectCOAlarm",
+		EVENT_ID_SmokeCOAlarm_AllClear:               "AllClear",
+	},
+	CLUSTER_ID_DishwasherAlarm: {
+		EVENT_ID_DishwasherAlarm_Notify: "Notify",
+	},
+	CLUSTER_ID_OperationalState: {
+		EVENT_ID_OperationalState_OperationalError:    "OperationalError",
+		EVENT_ID_OperationalState_OperationCompletion: "OperationCompletion",
+	},
+	CLUSTER_ID_RVCOperationalState: {
+		EVENT_ID_RVCOperationalState_OperationalError:    "OperationalError",
+		EVENT_ID_RVCOperationalState_OperationCompletion: "OperationCompletion",
+	},
+	CLUSTER_ID_BooleanSensorConfiguration: {
+		EVENT_ID_BooleanSensorConfiguration_AlarmsStateChanged: "AlarmsStateChanged",
+		EVENT_ID_BooleanSensorConfiguration_SensorFault:        "SensorFault",
+	},
+	CLUSTER_ID_ValveConfigurationandControl: {
+		EVENT_ID_ValveConfigurationandControl_ValveStateChanged: "ValveStateChanged",
+		EVENT_ID_ValveConfigurationandControl_ValveFault:        "ValveFault",
+	},
+	CLUSTER_ID_ElectricalPowerMeasurement: {
+		EVENT_ID_ElectricalPowerMeasurement_MeasurementPeriodRanges: "MeasurementPeriodRanges",
+	},
+	CLUSTER_ID_ElectricalEnergyMeasurement: {
+		EVENT_ID_ElectricalEnergyMeasurement_CumulativeEnergyMeasured: "CumulativeEnergyMeasured",
+		EVENT_ID_ElectricalEnergyMeasurement_PeriodicEnergyMeasured:   "PeriodicEnergyMeasured",
+	},
+	CLUSTER_ID_WaterHeaterManagement: {
+		EVENT_ID_WaterHeaterManagement_BoostStarted: "BoostStarted",
+		EVENT_ID_WaterHeaterManagement_BoostEnded:   "BoostEnded",
+	},
+	CLUSTER_ID_EnergyPrice: {
+		EVENT_ID_EnergyPrice_PriceChange: "PriceChange",
+	},
+	CLUSTER_ID_DemandResponseandLoadControl: {
+		EVENT_ID_DemandResponseandLoadControl_LoadControlEventStatusChange: "LoadControlEventStatusChange",
+	},
+	CLUSTER_ID_Messages: {
+		EVENT_ID_Messages_MessageQueued:    "MessageQueued",
+		EVENT_ID_Messages_MessagePresented: "MessagePresented",
+		EVENT_ID_Messages_MessageComplete:  "MessageComplete",
+	},
+	CLUSTER_ID_DeviceEnergyManagement: {
+		EVENT_ID_DeviceEnergyManagement_PowerAdjustStart: "PowerAdjustStart",
+		EVENT_ID_DeviceEnergyManagement_PowerAdjustEnd:   "PowerAdjustEnd",
+		EVENT_ID_DeviceEnergyManagement_Paused:           "Paused",
+		EVENT_ID_DeviceEnergyManagement_Resumed:          "Resumed",
+	},
+	CLUSTER_ID_DoorLock: {
+		EVENT_ID_DoorLock_DoorLockAlarm:      "DoorLockAlarm",
+		EVENT_ID_DoorLock_DoorStateChange:    "DoorStateChange",
+		EVENT_ID_DoorLock_LockOperation:      "LockOperation",
+		EVENT_ID_DoorLock_LockOperationError: "LockOperationError",
+		EVENT_ID_DoorLock_LockUserChange:     "LockUserChange",
+	},
+	CLUSTER_ID_PumpConfigurationandControl: {
+		EVENT_ID_PumpConfigurationandControl_SupplyVoltageLow:          "SupplyVoltageLow",
+		EVENT_ID_PumpConfigurationandControl_SupplyVoltageHigh:         "SupplyVoltageHigh",
+		EVENT_ID_PumpConfigurationandControl_PowerMissingPhase:         "PowerMissingPhase",
+		EVENT_ID_PumpConfigurationandControl_SystemPressureLow:         "SystemPressureLow",
+		EVENT_ID_PumpConfigurationandControl_SystemPressureHigh:        "SystemPressureHigh",
+		EVENT_ID_PumpConfigurationandControl_DryRunning:                "DryRunning",
+		EVENT_ID_PumpConfigurationandControl_MotorTemperatureHigh:      "MotorTemperatureHigh",
+		EVENT_ID_PumpConfigurationandControl_PumpMotorFatalFailure:     "PumpMotorFatalFailure",
+		EVENT_ID_PumpConfigurationandControl_ElectronicTemperatureHigh: "ElectronicTemperatureHigh",
+		EVENT_ID_PumpConfigurationandControl_PumpBlocked:               "PumpBlocked",
+		EVENT_ID_PumpConfigurationandControl_SensorFailure:             "SensorFailure",
+		EVENT_ID_PumpConfigurationandControl_ElectronicNonFatalFailure: "ElectronicNonFatalFailure",
+		EVENT_ID_PumpConfigurationandControl_ElectronicFatalFailure:    "ElectronicFatalFailure",
+		EVENT_ID_PumpConfigurationandControl_GeneralFault:              "GeneralFault",
+		EVENT_ID_PumpConfigurationandControl_Leakage:                   "Leakage",
+		EVENT_ID_PumpConfigurationandControl_AirDetection:              "AirDetection",
+		EVENT_ID_PumpConfigurationandControl_TurbineOperation:          "TurbineOperation",
+	},
+	CLUSTER_ID_AccountLogin: {
+		EVENT_ID_AccountLogin_LoggedOut: "LoggedOut",
+	},
+}
+
+// AttributeIdMap maps cluster id and name of attribute to its id.
+var AttributeIdMap = map[int]map[string]int{
+	CLUSTER_ID_Identify: {
+		"IdentifyTime": ATTRIBUTE_ID_Identify_IdentifyTime,
+		"IdentifyType": ATTRIBUTE_ID_Identify_IdentifyType,
+	},
+	CLUSTER_ID_Groups: {
+		"NameSupport": ATTRIBUTE_ID_Groups_NameSupport,
+	},
+	CLUSTER_ID_Scenes: {
+		"SceneCount":       ATTRIBUTE_ID_Scenes_SceneCount,
+		"CurrentScene":     ATTRIBUTE_ID_Scenes_CurrentScene,
+		"CurrentGroup":     ATTRIBUTE_ID_Scenes_CurrentGroup,
+		"SceneValid":       ATTRIBUTE_ID_Scenes_SceneValid,
+		"NameSupport":      ATTRIBUTE_ID_Scenes_NameSupport,
+		"LastConfiguredBy": ATTRIBUTE_ID_Scenes_LastConfiguredBy,
+		"SceneTableSize":   ATTRIBUTE_ID_Scenes_SceneTableSize,
+		"FabricSceneInfo":  ATTRIBUTE_ID_Scenes_FabricSceneInfo,
+	},
+	CLUSTER_ID_OnOff: {
+		"OnOff":              ATTRIBUTE_ID_OnOff_OnOff,
+		"GlobalSceneControl": ATTRIBUTE_ID_OnOff_GlobalSceneControl,
+		"OnTime":             ATTRIBUTE_ID_OnOff_OnTime,
+		"OffWaitTime":        ATTRIBUTE_ID_OnOff_OffWaitTime,
+		"StartUpOnOff":       ATTRIBUTE_ID_OnOff_StartUpOnOff,
+	},
+	CLUSTER_ID_LevelControl: {
+		"CurrentLevel":        ATTRIBUTE_ID_LevelControl_CurrentLevel,
+		"RemainingTime":       ATTRIBUTE_ID_LevelControl_RemainingTime,
+		"MinLevel":            ATTRIBUTE_ID_LevelControl_MinLevel,
+		"MaxLevel":            ATTRIBUTE_ID_LevelControl_MaxLevel,
+		"CurrentFrequency":    ATTRIBUTE_ID_LevelControl_CurrentFrequency,
+		"MinFrequency":        ATTRIBUTE_ID_LevelControl_MinFrequency,
+		"MaxFrequency":        ATTRIBUTE_ID_LevelControl_MaxFrequency,
+		"Options":             ATTRIBUTE_ID_LevelControl_Options,
+		"OnOffTransitionTime": ATTRIBUTE_ID_LevelControl_OnOffTransitionTime,
+		"OnLevel":             ATTRIBUTE_ID_LevelControl_OnLevel,
+		"OnTransitionTime":    ATTRIBUTE_ID_LevelControl_OnTransitionTime,
+		"OffTransitionTime":   ATTRIBUTE_ID_LevelControl_OffTransitionTime,
+		"DefaultMoveRate":     ATTRIBUTE_ID_LevelControl_DefaultMoveRate,
+		"StartUpCurrentLevel": ATTRIBUTE_ID_LevelControl_StartUpCurrentLevel,
+	},
+	CLUSTER_ID_Descriptor: {
+		"DeviceTypeList": ATTRIBUTE_ID_Descriptor_DeviceTypeList,
+		"ServerList":     ATTRIBUTE_ID_Descriptor_ServerList,
+		"ClientList":     ATTRIBUTE_ID_Descriptor_ClientList,
+		"PartsList":      ATTRIBUTE_ID_Descriptor_PartsList,
+		"TagList":        ATTRIBUTE_ID_Descriptor_TagList,
+	},
+	CLUSTER_ID_Binding: {
+		"Binding": ATTRIBUTE_ID_Binding_Binding,
+	},
+	CLUSTER_ID_AccessControl: {
+		"ACL":                           ATTRIBUTE_ID_AccessControl_ACL,
+		"Extension":                     ATTRIBUTE_ID_AccessControl_Extension,
+		"SubjectsPerAccessControlEntry": ATTRIBUTE_ID_AccessControl_SubjectsPerAccessControlEntry,
+		"TargetsPerAccessControlEntry":  ATTRIBUTE_ID_AccessControl_TargetsPerAccessControlEntry,
+		"AccessControlEntriesPerFabric": ATTRIBUTE_ID_AccessControl_AccessControlEntriesPerFabric,
+	},
+	CLUSTER_ID_Actions: {
+		"ActionList":    ATTRIBUTE_ID_Actions_ActionList,
+		"EndpointLists": ATTRIBUTE_ID_Actions_EndpointLists,
+		"SetupURL":      ATTRIBUTE_ID_Actions_SetupURL,
+	},
+	CLUSTER_ID_BasicInformation: {
+		"DataModelRevision":     ATTRIBUTE_ID_BasicInformation_DataModelRevision,
+		"VendorName":            ATTRIBUTE_ID_BasicInformation_VendorName,
+		"VendorID":              ATTRIBUTE_ID_BasicInformation_VendorID,
+		"ProductName":           ATTRIBUTE_ID_BasicInformation_ProductName,
+		"ProductID":             ATTRIBUTE_ID_BasicInformation_ProductID,
+		"NodeLabel":             ATTRIBUTE_ID_BasicInformation_NodeLabel,
+		"Location":              ATTRIBUTE_ID_BasicInformation_Location,
+		"HardwareVersion":       ATTRIBUTE_ID_BasicInformation_HardwareVersion,
+		"HardwareVersionString": ATTRIBUTE_ID_BasicInformation_HardwareVersionString,
+		"SoftwareVersion":       ATTRIBUTE_ID_BasicInformation_SoftwareVersion,
+		"SoftwareVersionString": ATTRIBUTE_ID_BasicInformation_SoftwareVersionString,
+		"ManufacturingDate":     ATTRIBUTE_ID_BasicInformation_ManufacturingDate,
+		"PartNumber":            ATTRIBUTE_ID_BasicInformation_PartNumber,
+		"ProductURL":            ATTRIBUTE_ID_BasicInformation_ProductURL,
+		"ProductLabel":          ATTRIBUTE_ID_BasicInformation_ProductLabel,
+		"SerialNumber":          ATTRIBUTE_ID_BasicInformation_SerialNumber,
+		"LocalConfigDisabled":   ATTRIBUTE_ID_BasicInformation_LocalConfigDisabled,
+		"Reachable":             ATTRIBUTE_ID_BasicInformation_Reachable,
+		"UniqueID":              ATTRIBUTE_ID_BasicInformation_UniqueID,
+		"CapabilityMinima":      ATTRIBUTE_ID_BasicInformation_CapabilityMinima,
+		"ProductAppearance":     ATTRIBUTE_ID_BasicInformation_ProductAppearance,
+		"SpecificationVersion":  ATTRIBUTE_ID_BasicInformation_SpecificationVersion,
+		"MaxPathsPerInvoke":     ATTRIBUTE_ID_BasicInformation_MaxPathsPerInvoke,
+	},
+	CLUSTER_ID_LocalizationConfiguration: {
+		"ActiveLocale":     ATTRIBUTE_ID_LocalizationConfiguration_ActiveLocale,
+		"SupportedLocales": ATTRIBUTE_ID_LocalizationConfiguration_SupportedLocales,
+	},
+	CLUSTER_ID_TimeFormatLocalization: {
+		"HourFormat":             ATTRIBUTE_ID_TimeFormatLocalization_HourFormat,
+		"ActiveCalendarType":     ATTRIBUTE_ID_TimeFormatLocalization_ActiveCalendarType,
+		"SupportedCalendarTypes": ATTRIBUTE_ID_TimeFormatLocalization_SupportedCalendarTypes,
+	},
+	CLUSTER_ID_UnitLocalization: {
+		"TemperatureUnit": ATTRIBUTE_ID_UnitLocalization_TemperatureUnit,
+	},
+	CLUSTER_ID_PowerSourceConfiguration: {
+		"Sources": ATTRIBUTE_ID_PowerSourceConfiguration_Sources,
+	},
+	CLUSTER_ID_PowerSource: {
+		"Status":                      ATTRIBUTE_ID_PowerSource_Status,
+		"Order":                       ATTRIBUTE_ID_PowerSource_Order,
+		"Description":                 ATTRIBUTE_ID_PowerSource_Description,
+		"WiredAssessedInputVoltage":   ATTRIBUTE_ID_PowerSource_WiredAssessedInputVoltage,
+		"WiredAssessedInputFrequency": ATTRIBUTE_ID_PowerSource_WiredAssessedInputFrequency,
+		"WiredCurrentType":            ATTRIBUTE_ID_PowerSource_WiredCurrentType,
+		"WiredAssessedCurrent":        ATTRIBUTE_ID_PowerSource_WiredAssessedCurrent,
+		"WiredNominalVoltage":         ATTRIBUTE_ID_PowerSource_WiredNominalVoltage,
+		"WiredMaximumCurrent":         ATTRIBUTE_ID_PowerSource_WiredMaximumCurrent,
+		"WiredPresent":                ATTRIBUTE_ID_PowerSource_WiredPresent,
+		"ActiveWiredFaults":           ATTRIBUTE_ID_PowerSource_ActiveWiredFaults,
+		"BatVoltage":                  ATTRIBUTE_ID_PowerSource_BatVoltage,
+		"BatPercentRemaining":         ATTRIBUTE_ID_PowerSource_BatPercentRemaining,
+		"BatTimeRemaining":            ATTRIBUTE_ID_PowerSource_BatTimeRemaining,
+		"BatChargeLevel":              ATTRIBUTE_ID_PowerSource_BatChargeLevel,
+		"BatReplacementNeeded":        ATTRIBUTE_ID_PowerSource_BatReplacementNeeded,
+		"BatReplaceability":           ATTRIBUTE_ID_PowerSource_BatReplaceability,
+		"BatPresent":                  ATTRIBUTE_ID_PowerSource_BatPresent,
+		"ActiveBatFaults":             ATTRIBUTE_ID_PowerSource_ActiveBatFaults,
+		"BatReplacementDescription":   ATTRIBUTE_ID_PowerSource_BatReplacementDescription,
+		"BatCommonDesignation":        ATTRIBUTE_ID_PowerSource_BatCommonDesignation,
+		"BatANSIDesignation":          ATTRIBUTE_ID_PowerSource_BatANSIDesignation,
+		"BatIECDesignation":           ATTRIBUTE_ID_PowerSource_BatIECDesignation,
+		"BatApprovedChemistry":        ATTRIBUTE_ID_PowerSource_BatApprovedChemistry,
+		"BatCapacity":                 ATTRIBUTE_ID_PowerSource_BatCapacity,
+		"BatQuantity":                 ATTRIBUTE_ID_PowerSource_BatQuantity,
+		"BatChargeState":              ATTRIBUTE_ID_PowerSource_BatChargeState,
+		"BatTimeToFullCharge":         ATTRIBUTE_ID_PowerSource_BatTimeToFullCharge,
+		"BatFunctionalWhileCharging":  ATTRIBUTE_ID_PowerSource_BatFunctionalWhileCharging,
+		"BatChargingCurrent":          ATTRIBUTE_ID_PowerSource_BatChargingCurrent,
+		"ActiveBatChargeFaults":       ATTRIBUTE_ID_PowerSource_ActiveBatChargeFaults,
+		"EndpointList":                ATTRIBUTE_ID_PowerSource_EndpointList,
+	},
+	CLUSTER_ID_GeneralCommissioning: {
+		"Breadcrumb":                   ATTRIBUTE_ID_GeneralCommissioning_Breadcrumb,
+		"BasicCommissioningInfo":       ATTRIBUTE_ID_GeneralCommissioning_BasicCommissioningInfo,
+		"RegulatoryConfig":             ATTRIBUTE_ID_GeneralCommissioning_RegulatoryConfig,
+		"LocationCapability":           ATTRIBUTE_ID_GeneralCommissioning_LocationCapability,
+		"SupportsConcurrentConnection": ATTRIBUTE_ID_GeneralCommissioning_SupportsConcurrentConnection,
+	},
+	CLUSTER_ID_NetworkCommissioning: {
+		"MaxNetworks":             ATTRIBUTE_ID_NetworkCommissioning_MaxNetworks,
+		"Networks":                ATTRIBUTE_ID_NetworkCommissioning_Networks,
+		"ScanMaxTimeSeconds":      ATTRIBUTE_ID_NetworkCommissioning_ScanMaxTimeSeconds,
+		"ConnectMaxTimeSeconds":   ATTRIBUTE_ID_NetworkCommissioning_ConnectMaxTimeSeconds,
+		"InterfaceEnabled":        ATTRIBUTE_ID_NetworkCommissioning_InterfaceEnabled,
+		"LastNetworkingStatus":    ATTRIBUTE_ID_NetworkCommissioning_LastNetworkingStatus,
+		"LastNetworkID":           ATTRIBUTE_ID_NetworkCommissioning_LastNetworkID,
+		"LastConnectErrorValue":   ATTRIBUTE_ID_NetworkCommissioning_LastConnectErrorValue,
+		"SupportedWiFiBands":      ATTRIBUTE_ID_NetworkCommissioning_SupportedWiFiBands,
+		"SupportedThreadFeatures": ATTRIBUTE_ID_NetworkCommissioning_SupportedThreadFeatures,
+		"ThreadVersion":           ATTRIBUTE_ID_NetworkCommissioning_ThreadVersion,
+	},
+	CLUSTER_ID_GeneralDiagnostics: {
+		"NetworkInterfaces":        ATTRIBUTE_ID_GeneralDiagnostics_NetworkInterfaces,
+		"RebootCount":              ATTRIBUTE_ID_GeneralDiagnostics_RebootCount,
+		"UpTime":                   ATTRIBUTE_ID_GeneralDiagnostics_UpTime,
+		"TotalOperationalHours":    ATTRIBUTE_ID_GeneralDiagnostics_TotalOperationalHours,
+		"BootReason":               ATTRIBUTE_ID_GeneralDiagnostics_BootReason,
+		"ActiveHardwareFaults":     ATTRIBUTE_ID_GeneralDiagnostics_ActiveHardwareFaults,
+		"ActiveRadioFaults":        ATTRIBUTE_ID_GeneralDiagnostics_ActiveRadioFaults,
+		"ActiveNetworkFaults":      ATTRIBUTE_ID_GeneralDiagnostics_ActiveNetworkFaults,
+		"TestEventTriggersEnabled": ATTRIBUTE_ID_GeneralDiagnostics_TestEventTriggersEnabled,
+	},
+	CLUSTER_ID_SoftwareDiagnostics: {
+		"ThreadMetrics":            ATTRIBUTE_ID_SoftwareDiagnostics_ThreadMetrics,
+		"CurrentHeapFree":          ATTRIBUTE_ID_SoftwareDiagnostics_CurrentHeapFree,
+		"CurrentHeapUsed":          ATTRIBUTE_ID_SoftwareDiagnostics_CurrentHeapUsed,
+		"CurrentHeapHighWatermark": ATTRIBUTE_ID_SoftwareDiagnostics_CurrentHeapHighWatermark,
+	},
+	CLUSTER_ID_ThreadNetworkDiagnostics: {
+		"Channel":                           ATTRIBUTE_ID_ThreadNetworkDiagnostics_Channel,
+		"RoutingRole":                       ATTRIBUTE_ID_ThreadNetworkDiagnostics_RoutingRole,
+		"NetworkName":                       ATTRIBUTE_ID_ThreadNetworkDiagnostics_NetworkName,
+		"PanId":                             ATTRIBUTE_ID_ThreadNetworkDiagnostics_PanId,
+		"ExtendedPanId":                     ATTRIBUTE_ID_ThreadNetworkDiagnostics_ExtendedPanId,
+		"MeshLocalPrefix":                   ATTRIBUTE_ID_ThreadNetworkDiagnostics_MeshLocalPrefix,
+		"OverrunCount":                      ATTRIBUTE_ID_ThreadNetworkDiagnostics_OverrunCount,
+		"NeighborTable":                     ATTRIBUTE_ID_ThreadNetworkDiagnostics_NeighborTable,
+		"RouteTable":                        ATTRIBUTE_ID_ThreadNetworkDiagnostics_RouteTable,
+		"PartitionId":                       ATTRIBUTE_ID_ThreadNetworkDiagnostics_PartitionId,
+		"Weighting":                         ATTRIBUTE_ID_ThreadNetworkDiagnostics_Weighting,
+		"DataVersion":                       ATTRIBUTE_ID_ThreadNetworkDiagnostics_DataVersion,
+		"StableDataVersion":                 ATTRIBUTE_ID_ThreadNetworkDiagnostics_StableDataVersion,
+		"LeaderRouterId":                    ATTRIBUTE_ID_ThreadNetworkDiagnostics_LeaderRouterId,
+		"DetachedRoleCount":                 ATTRIBUTE_ID_ThreadNetworkDiagnostics_DetachedRoleCount,
+		"ChildRoleCount":                    ATTRIBUTE_ID_ThreadNetworkDiagnostics_ChildRoleCount,
+		"RouterRoleCount":                   ATTRIBUTE_ID_ThreadNetworkDiagnostics_RouterRoleCount,
+		"LeaderRoleCount":                   ATTRIBUTE_ID_ThreadNetworkDiagnostics_LeaderRoleCount,
+		"AttachAttemptCount":                ATTRIBUTE_ID_ThreadNetworkDiagnostics_AttachAttemptCount,
+		"PartitionIdChangeCount":            ATTRIBUTE_ID_ThreadNetworkDiagnostics_PartitionIdChangeCount,
+		"BetterPartitionAttachAttemptCount": ATTRIBUTE_ID_ThreadNetworkDiagnostics_BetterPartitionAttachAttemptCount,
+		"ParentChangeCount":                 ATTRIBUTE_ID_ThreadNetworkDiagnostics_ParentChangeCount,
+		"TxTotalCount":                      ATTRIBUTE_ID_ThreadNetworkDiagnostics_TxTotalCount,
+		"TxUnicastCount":                    ATTRIBUTE_ID_ThreadNetworkDiagnostics_TxUnicastCount,
+		"TxBroadcastCount":                  ATTRIBUTE_ID_ThreadNetworkDiagnostics_TxBroadcastCount,
+		"TxAckRequestedCount":               ATTRIBUTE_ID_ThreadNetworkDiagnostics_TxAckRequestedCount,
+		"TxAckedCount":                      ATTRIBUTE_ID_ThreadNetworkDiagnostics_TxAckedCount,
+		"TxNoAckRequestedCount":             ATTRIBUTE_ID_ThreadNetworkDiagnostics_TxNoAckRequestedCount,
+		"TxDataCount":                       ATTRIBUTE_ID_ThreadNetworkDiagnostics_TxDataCount,
+		"TxDataPollCount":                   ATTRIBUTE_ID_ThreadNetworkDiagnostics_TxDataPollCount,
+		"TxBeaconCount":                     ATTRIBUTE_ID_ThreadNetworkDiagnostics_TxBeaconCount,
+		"TxBeaconRequestCount":              ATTRIBUTE_ID_ThreadNetworkDiagnostics_TxBeaconRequestCount,
+		"TxOtherCount":                      ATTRIBUTE_ID_ThreadNetworkDiagnostics_TxOtherCount,
+		"TxRetryCount":                      ATTRIBUTE_ID_ThreadNetworkDiagnostics_TxRetryCount,
+		"TxDirectMaxRetryExpiryCount":       ATTRIBUTE_ID_ThreadNetworkDiagnostics_TxDirectMaxRetryExpiryCount,
+		"TxIndirectMaxRetryExpiryCount":     ATTRIBUTE_ID_ThreadNetworkDiagnostics_TxIndirectMaxRetryExpiryCount,
+		"TxErrCcaCount":                     ATTRIBUTE_ID_ThreadNetworkDiagnostics_TxErrCcaCount,
+		"TxErrAbortCount":                   ATTRIBUTE_ID_ThreadNetworkDiagnostics_TxErrAbortCount,
+		"TxErrBusyChannelCount":             ATTRIBUTE_ID_ThreadNetworkDiagnostics_TxErrBusyChannelCount,
+		"RxTotalCount":                      ATTRIBUTE_ID_ThreadNetworkDiagnostics_RxTotalCount,
+		"RxUnicastCount":                    ATTRIBUTE_ID_ThreadNetworkDiagnostics_RxUnicastCount,
+		"RxBroadcastCount":                  ATTRIBUTE_ID_ThreadNetworkDiagnostics_RxBroadcastCount,
+		"RxDataCount":                       ATTRIBUTE_ID_ThreadNetworkDiagnostics_RxDataCount,
+		"RxDataPollCount":                   ATTRIBUTE_ID_ThreadNetworkDiagnostics_RxDataPollCount,
+		"RxBeaconCount":                     ATTRIBUTE_ID_ThreadNetworkDiagnostics_RxBeaconCount,
+		"RxBeaconRequestCount":              ATTRIBUTE_ID_ThreadNetworkDiagnostics_RxBeaconRequestCount,
+		"RxOtherCount":                      ATTRIBUTE_ID_ThreadNetworkDiagnostics_RxOtherCount,
+		"RxAddressFilteredCount":            ATTRIBUTE_ID_ThreadNetworkDiagnostics_RxAddressFilteredCount,
+		"RxDestAddrFilteredCount":           ATTRIBUTE_ID_ThreadNetworkDiagnostics_RxDestAddrFilteredCount,
+		"RxDuplicatedCount":                 ATTRIBUTE_ID_ThreadNetworkDiagnostics_RxDuplicatedCount,
+		"RxErrNoFrameCount":                 ATTRIBUTE_ID_ThreadNetworkDiagnostics_RxErrNoFrameCount,
+		"RxErrUnknownNeighborCount":         ATTRIBUTE_ID_ThreadNetworkDiagnostics_RxErrUnknownNeighborCount,
+		"RxErrInvalidSrcAddrCount":          ATTRIBUTE_ID_ThreadNetworkDiagnostics_RxErrInvalidSrcAddrCount,
+		"RxErrSecCount":                     ATTRIBUTE_ID_ThreadNetworkDiagnostics_RxErrSecCount,
+		"RxErrFcsCount":                     ATTRIBUTE_ID_ThreadNetworkDiagnostics_RxErrFcsCount,
+		"RxErrOtherCount":                   ATTRIBUTE_ID_ThreadNetworkDiagnostics_RxErrOtherCount,
+		"ActiveTimestamp":                   ATTRIBUTE_ID_ThreadNetworkDiagnostics_ActiveTimestamp,
+		"PendingTimestamp":                  ATTRIBUTE_ID_ThreadNetworkDiagnostics_PendingTimestamp,
+		"Delay":                             ATTRIBUTE_ID_ThreadNetworkDiagnostics_Delay,
+		"SecurityPolicy":                    ATTRIBUTE_ID_ThreadNetworkDiagnostics_SecurityPolicy,
+		"ChannelPage0Mask":                  ATTRIBUTE_ID_ThreadNetworkDiagnostics_ChannelPage0Mask,
+		"OperationalDatasetComponents":      ATTRIBUTE_ID_ThreadNetworkDiagnostics_OperationalDatasetComponents,
+		"ActiveNetworkFaults":               ATTRIBUTE_ID_ThreadNetworkDiagnostics_ActiveNetworkFaults,
+	},
+	CLUSTER_ID_WiFiNetworkDiagnostics: {
+		"BSSID":                  ATTRIBUTE_ID_WiFiNetworkDiagnostics_BSSID,
+		"SecurityType":           ATTRIBUTE_ID_WiFiNetworkDiagnostics_SecurityType,
+		"WiFiVersion":            ATTRIBUTE_ID_WiFiNetworkDiagnostics_WiFiVersion,
+		"ChannelNumber":          ATTRIBUTE_ID_WiFiNetworkDiagnostics_ChannelNumber,
+		"RSSI":                   ATTRIBUTE_ID_WiFiNetworkDiagnostics_RSSI,
+		"BeaconLostCount":        ATTRIBUTE_ID_WiFiNetworkDiagnostics_BeaconLostCount,
+		"BeaconRxCount":          ATTRIBUTE_ID_WiFiNetworkDiagnostics_BeaconRxCount,
+		"PacketMulticastRxCount": ATTRIBUTE_ID_WiFiNetworkDiagnostics_PacketMulticastRxCount,
+		"PacketMulticastTxCount": ATTRIBUTE_ID_WiFiNetworkDiagnostics_PacketMulticastTxCount,
+		"PacketUnicastRxCount":   ATTRIBUTE_ID_WiFiNetworkDiagnostics_PacketUnicastRxCount,
+		"PacketUnicastTxCount":   ATTRIBUTE_ID_WiFiNetworkDiagnostics_PacketUnicastTxCount,
+		"CurrentMaxRate":         ATTRIBUTE_ID_WiFiNetworkDiagnostics_CurrentMaxRate,
+		"OverrunCount":           ATTRIBUTE_ID_WiFiNetworkDiagnostics_OverrunCount,
+	},
+	CLUSTER_ID_EthernetNetworkDiagnostics: {
+		"PHYRate":        ATTRIBUTE_ID_EthernetNetworkDiagnostics_PHYRate,
+		"FullDuplex":     ATTRIBUTE_ID_EthernetNetworkDiagnostics_FullDuplex,
+		"PacketRxCount":  ATTRIBUTE_ID_EthernetNetworkDiagnostics_PacketRxCount,
+		"PacketTxCount":  ATTRIBUTE_ID_EthernetNetworkDiagnostics_PacketTxCount,
+		"TxErrCount":     ATTRIBUTE_ID_EthernetNetworkDiagnostics_TxErrCount,
+		"CollisionCount": ATTRIBUTE_ID_EthernetNetworkDiagnostics_CollisionCount,
+		"OverrunCount":   ATTRIBUTE_ID_EthernetNetworkDiagnostics_OverrunCount,
+		"CarrierDetect":  ATTRIBUTE_ID_EthernetNetworkDiagnostics_CarrierDetect,
+		"TimeSinceReset": ATTRIBUTE_ID_EthernetNetworkDiagnostics_TimeSinceReset,
+	},
+	CLUSTER_ID_TimeSync: {
+		"UTCTime":              ATTRIBUTE_ID_TimeSync_UTCTime,
+		"Granularity":          ATTRIBUTE_ID_TimeSync_Granularity,
+		"TimeSource":           ATTRIBUTE_ID_TimeSync_TimeSource,
+		"TrustedTimeSource":    ATTRIBUTE_ID_TimeSync_TrustedTimeSource,
+		"DefaultNTP":           ATTRIBUTE_ID_TimeSync_DefaultNTP,
+		"TimeZone":             ATTRIBUTE_ID_TimeSync_TimeZone,
+		"DSTOffset":            ATTRIBUTE_ID_TimeSync_DSTOffset,
+		"LocalTime":            ATTRIBUTE_ID_TimeSync_LocalTime,
+		"TimeZoneDatabase":     ATTRIBUTE_ID_TimeSync_TimeZoneDatabase,
+		"NTPServerAvailable":   ATTRIBUTE_ID_TimeSync_NTPServerAvailable,
+		"TimeZoneListMaxSize":  ATTRIBUTE_ID_TimeSync_TimeZoneListMaxSize,
+		"DSTOffsetListMaxSize": ATTRIBUTE_ID_TimeSync_DSTOffsetListMaxSize,
+		"SupportsDNSResolve":   ATTRIBUTE_ID_TimeSync_SupportsDNSResolve,
+	},
+	CLUSTER_ID_BridgedDeviceBasicInformation: {
+		"VendorName":            ATTRIBUTE_ID_BridgedDeviceBasicInformation_VendorName,
+		"VendorID":              ATTRIBUTE_ID_BridgedDeviceBasicInformation_VendorID,
+		"ProductName":           ATTRIBUTE_ID_BridgedDeviceBasicInformation_ProductName,
+		"ProductID":             ATTRIBUTE_ID_BridgedDeviceBasicInformation_ProductID,
+		"NodeLabel":             ATTRIBUTE_ID_BridgedDeviceBasicInformation_NodeLabel,
+		"HardwareVersion":       ATTRIBUTE_ID_BridgedDeviceBasicInformation_HardwareVersion,
+		"HardwareVersionString": ATTRIBUTE_ID_BridgedDeviceBasicInformation_HardwareVersionString,
+		"SoftwareVersion":       ATTRIBUTE_ID_BridgedDeviceBasicInformation_SoftwareVersion,
+		"SoftwareVersionString": ATTRIBUTE_ID_BridgedDeviceBasicInformation_SoftwareVersionString,
+		"ManufacturingDate":     ATTRIBUTE_ID_BridgedDeviceBasicInformation_ManufacturingDate,
+		"PartNumber":            ATTRIBUTE_ID_BridgedDeviceBasicInformation_PartNumber,
+		"ProductURL":            ATTRIBUTE_ID_BridgedDeviceBasicInformation_ProductURL,
+		"ProductLabel":          ATTRIBUTE_ID_BridgedDeviceBasicInformation_ProductLabel,
+		"SerialNumber":          ATTRIBUTE_ID_BridgedDeviceBasicInformation_SerialNumber,
+		"Reachable":             ATTRIBUTE_ID_BridgedDeviceBasicInformation_Reachable,
+		"UniqueID":              ATTRIBUTE_ID_BridgedDeviceBasicInformation_UniqueID,
+		"ProductAppearance":     ATTRIBUTE_ID_BridgedDeviceBasicInformation_ProductAppearance,
+	},
+	CLUSTER_ID_Switch: {
+		"NumberOfPositions": ATTRIBUTE_ID_Switch_NumberOfPositions,
+		"CurrentPosition":   ATTRIBUTE_ID_Switch_CurrentPosition,
+		"MultiPressMax":     ATTRIBUTE_ID_Switch_MultiPressMax,
+	},
+	CLUSTER_ID_AdministratorCommissioning: {
+		"WindowStatus":     ATTRIBUTE_ID_AdministratorCommissioning_WindowStatus,
+		"AdminFabricIndex": ATTRIBUTE_ID_AdministratorCommissioning_AdminFabricIndex,
+		"AdminVendorId":    ATTRIBUTE_ID_AdministratorCommissioning_AdminVendorId,
+	},
+	CLUSTER_ID_OperationalCredentials: {
+		"NOCs":                    ATTRIBUTE_ID_OperationalCredentials_NOCs,
+		"Fabrics":                 ATTRIBUTE_ID_OperationalCredentials_Fabrics,
+		"SupportedFabrics":        ATTRIBUTE_ID_OperationalCredentials_SupportedFabrics,
+		"CommissionedFabrics":     ATTRIBUTE_ID_OperationalCredentials_CommissionedFabrics,
+		"TrustedRootCertificates": ATTRIBUTE_ID_OperationalCredentials_TrustedRootCertificates,
+		"CurrentFabricIndex":      ATTRIBUTE_ID_OperationalCredentials_CurrentFabricIndex,
+	},
+	CLUSTER_ID_GroupKeyManagement: {
+		"GroupKeyMap":           ATTRIBUTE_ID_GroupKeyManagement_GroupKeyMap,
+		"GroupTable":            ATTRIBUTE_ID_GroupKeyManagement_GroupTable,
+		"MaxGroupsPerFabric":    ATTRIBUTE_ID_GroupKeyManagement_MaxGroupsPerFabric,
+		"MaxGroupKeysPerFabric": ATTRIBUTE_ID_GroupKeyManagement_MaxGroupKeysPerFabric,
+	},
+	CLUSTER_ID_FixedLabel: {
+		"LabelList": ATTRIBUTE_ID_FixedLabel_LabelList,
+	},
+	CLUSTER_ID_UserLabel: {
+		"LabelList": ATTRIBUTE_ID_UserLabel_LabelList,
+	},
+	CLUSTER_ID_BooleanState: {
+		"StateValue": ATTRIBUTE_ID_BooleanState_StateValue,
+	},
+	CLUSTER_ID_Timer: {
+		"SetTime":       ATTRIBUTE_ID_Timer_SetTime,
+		"TimeRemaining": ATTRIBUTE_ID_Timer_TimeRemaining,
+		"TimerState":    ATTRIBUTE_ID_Timer_TimerState,
+	},
+	CLUSTER_ID_OvenCavityOperationalState: {
+		"PhaseList":            ATTRIBUTE_ID_OvenCavityOperationalState_PhaseList,
+		"CurrentPhase":         ATTRIBUTE_ID_OvenCavityOperationalState_CurrentPhase,
+		"CountdownTime":        ATTRIBUTE_ID_OvenCavityOperationalState_CountdownTime,
+		"OperationalStateList": ATTRIBUTE_ID_OvenCavityOperationalState_OperationalStateList,
+		"OperationalState":     ATTRIBUTE_ID_OvenCavityOperationalState_OperationalState,
+		"OperationalError":     ATTRIBUTE_ID_OvenCavityOperationalState_OperationalError,
+	},
+	CLUSTER_ID_OvenMode: {
+		"SupportedModes": ATTRIBUTE_ID_OvenMode_SupportedModes,
+		"CurrentMode":    ATTRIBUTE_ID_OvenMode_CurrentMode,
+		"StartUpMode":    ATTRIBUTE_ID_OvenMode_StartUpMode,
+		"OnMode":         ATTRIBUTE_ID_OvenMode_OnMode,
+	},
+	CLUSTER_ID_LaundryDryerControls: {
+		"SupportedDrynessLevels": ATTRIBUTE_ID_LaundryDryerControls_SupportedDrynessLevels,
+		"SelectedDrynessLevel":   ATTRIBUTE_ID_LaundryDryerControls_SelectedDrynessLevel,
+	},
+	CLUSTER_ID_ModeSelect: {
+		"Description":       ATTRIBUTE_ID_ModeSelect_Description,
+		"StandardNamespace": ATTRIBUTE_ID_ModeSelect_StandardNamespace,
+		"SupportedModes":    ATTRIBUTE_ID_ModeSelect_SupportedModes,
+		"CurrentMode":       ATTRIBUTE_ID_ModeSelect_CurrentMode,
+		"StartUpMode":       ATTRIBUTE_ID_ModeSelect_StartUpMode,
+		"OnMode":            ATTRIBUTE_ID_ModeSelect_OnMode,
+	},
+	CLUSTER_ID_LaundryWasherMode: {
+		"SupportedModes": ATTRIBUTE_ID_LaundryWasherMode_SupportedModes,
+		"CurrentMode":    ATTRIBUTE_ID_LaundryWasherMode_CurrentMode,
+		"StartUpMode":    ATTRIBUTE_ID_LaundryWasherMode_StartUpMode,
+		"OnMode":         ATTRIBUTE_ID_LaundryWasherMode_OnMode,
+	},
+	CLUSTER_ID_RefrigeratorAndTemperatureControlledCabinetMode: {
+		"SupportedModes": ATTRIBUTE_ID_RefrigeratorAndTemperatureControlledCabinetMode_SupportedModes,
+		"CurrentMode":    ATTRIBUTE_ID_RefrigeratorAndTemperatureControlledCabinetMode_CurrentMode,
+		"StartUpMode":    ATTRIBUTE_ID_RefrigeratorAndTemperatureControlledCabinetMode_StartUpMode,
+		"OnMode":         ATTRIBUTE_ID_RefrigeratorAndTemperatureControlledCabinetMode_OnMode,
+	},
+	CLUSTER_ID_LaundryWasherControls: {
+		"SpinSpeeds":       ATTRIBUTE_ID_LaundryWasherControls_SpinSpeeds,
+		"SpinSpeedCurrent": ATTRIBUTE_ID_LaundryWasherControls_SpinSpeedCurrent,
+		"NumberOfRinses":   ATTRIBUTE_ID_LaundryWasherControls_NumberOfRinses,
+		"SupportedRinses":  ATTRIBUTE_ID_LaundryWasherControls_SupportedRinses,
+	},
+	CLUSTER_ID_RVCRunMode: {
+		"SupportedModes": ATTRIBUTE_ID_RVCRunMode_SupportedModes,
+		"CurrentMode":    ATTRIBUTE_ID_RVCRunMode_CurrentMode,
+		"StartUpMode":    ATTRIBUTE_ID_RVCRunMode_StartUpMode,
+		"OnMode":         ATTRIBUTE_ID_RVCRunMode_OnMode,
+	},
+	CLUSTER_ID_RVCCleanMode: {
+		"SupportedModes": ATTRIBUTE_ID_RVCCleanMode_SupportedModes,
+		"CurrentMode":    ATTRIBUTE_ID_RVCCleanMode_CurrentMode,
+		"StartUpMode":    ATTRIBUTE_ID_RVCCleanMode_StartUpMode,
+		"OnMode":         ATTRIBUTE_ID_RVCCleanMode_OnMode,
+	},
+	CLUSTER_ID_TemperatureControl: {
+		"TemperatureSetpoint":        ATTRIBUTE_ID_TemperatureControl_TemperatureSetpoint,
+		"MinTemperature":             ATTRIBUTE_ID_TemperatureControl_MinTemperature,
+		"MaxTemperature":             ATTRIBUTE_ID_TemperatureControl_MaxTemperature,
+		"Step":                       ATTRIBUTE_ID_TemperatureControl_Step,
+		"SelectedTemperatureLevel":   ATTRIBUTE_ID_TemperatureControl_SelectedTemperatureLevel,
+		"SupportedTemperatureLevels": ATTRIBUTE_ID_TemperatureControl_SupportedTemperatureLevels,
+	},
+	CLUSTER_ID_RefrigeratorAlarm: {
+		"Mask":      ATTRIBUTE_ID_RefrigeratorAlarm_Mask,
+		"Latch":     ATTRIBUTE_ID_RefrigeratorAlarm_Latch,
+		"State":     ATTRIBUTE_ID_RefrigeratorAlarm_State,
+		"Supported": ATTRIBUTE_ID_RefrigeratorAlarm_Supported,
+	},
+	CLUSTER_ID_DishwasherMode: {
+		"SupportedModes": ATTRIBUTE_ID_DishwasherMode_SupportedModes,
+		"CurrentMode":    ATTRIBUTE_ID_DishwasherMode_CurrentMode,
+		"StartUpMode":    ATTRIBUTE_ID_DishwasherMode_StartUpMode,
+		"OnMode":         ATTRIBUTE_ID_DishwasherMode_OnMode,
+	},
+	CLUSTER_ID_AirQuality: {
+		"AirQuality": ATTRIBUTE_ID_AirQuality_AirQuality,
+	},
+	CLUSTER_ID_SmokeCOAlarm: {
+		"ExpressedState":         ATTRIBUTE_ID_SmokeCOAlarm_ExpressedState,
+		"SmokeState":             ATTRIBUTE_ID_SmokeCOAlarm_SmokeState,
+		"COState":                ATTRIBUTE_ID_SmokeCOAlarm_COState,
+		"BatteryAlert":           ATTRIBUTE_ID_SmokeCOAlarm_BatteryAlert,
+		"DeviceMuted":            ATTRIBUTE_ID_SmokeCOAlarm_DeviceMuted,
+		"TestInProgress":         ATTRIBUTE_ID_SmokeCOAlarm_TestInProgress,
+		"HardwareFaultAlert":     ATTRIBUTE_ID_SmokeCOAlarm_HardwareFaultAlert,
+		"EndOfServiceAlert":      ATTRIBUTE_ID_SmokeCOAlarm_EndOfServiceAlert,
+		"InterconnectSmokeAlarm": ATTRIBUTE_ID_SmokeCOAlarm_InterconnectSmokeAlarm,
+		"InterconnectCOAlarm":    ATTRIBUTE_ID_SmokeCOAlarm_InterconnectCOAlarm,
+		"ContaminationState":     ATTRIBUTE_ID_SmokeCOAlarm_ContaminationState,
+		"SmokeSensitivityLevel":  ATTRIBUTE_ID_SmokeCOAlarm_SmokeSensitivityLevel,
+		"ExpiryDate":             ATTRIBUTE_ID_SmokeCOAlarm_ExpiryDate,
+	},
+	CLUSTER_ID_DishwasherAlarm: {
+		"Mask":      ATTRIBUTE_ID_DishwasherAlarm_Mask,
+		"Latch":     ATTRIBUTE_ID_DishwasherAlarm_Latch,
+		"State":     ATTRIBUTE_ID_DishwasherAlarm_State,
+		"Supported": ATTRIBUTE_ID_DishwasherAlarm_Supported,
+	},
+	CLUSTER_ID_MicrowaveOvenMode: {
+		"SupportedModes": ATTRIBUTE_ID_MicrowaveOvenMode_SupportedModes,
+		"CurrentMode":    ATTRIBUTE_ID_MicrowaveOvenMode_CurrentMode,
+		"StartUpMode":    ATTRIBUTE_ID_MicrowaveOvenMode_StartUpMode,
+		"OnMode":         ATTRIBUTE_ID_MicrowaveOvenMode_OnMode,
+	},
+	CLUSTER_ID_OperationalState: {
+		"PhaseList":            ATTRIBUTE_ID_OperationalState_PhaseList,
+		"CurrentPhase":         ATTRIBUTE_ID_OperationalState_CurrentPhase,
+		"CountdownTime":        ATTRIBUTE_ID_OperationalState_CountdownTime,
+		"OperationalStateList": ATTRIBUTE_ID_OperationalState_OperationalStateList,
+		"OperationalState":     ATTRIBUTE_ID_OperationalState_OperationalState,
+		"OperationalError":     ATTRIBUTE_ID_OperationalState_OperationalError,
+	},
+	CLUSTER_ID_RVCOperationalState: {
+		"PhaseList":            ATTRIBUTE_ID_RVCOperationalState_PhaseList,
+		"CurrentPhase":         ATTRIBUTE_ID_RVCOperationalState_CurrentPhase,
+		"CountdownTime":        ATTRIBUTE_ID_RVCOperationalState_CountdownTime,
+		"OperationalStateList": ATTRIBUTE_ID_RVCOperationalState_OperationalStateList,
+		"OperationalState":     ATTRIBUTE_ID_RVCOperationalState_OperationalState,
+		"OperationalError":     ATTRIBUTE_ID_RVCOperationalState_OperationalError,
+	},
+	CLUSTER_ID_BooleanSensorConfiguration: {
+		"CurrentSensitivityLevel":    ATTRIBUTE_ID_BooleanSensorConfiguration_CurrentSensitivityLevel,
+		"SupportedSensitivityLevels": ATTRIBUTE_ID_BooleanSensorConfiguration_SupportedSensitivityLevels,
+		"DefaultSensitivityLevel":    ATTRIBUTE_ID_BooleanSensorConfiguration_DefaultSensitivityLevel,
+		"AlarmsActive":               ATTRIBUTE_ID_BooleanSensorConfiguration_AlarmsActive,
+		"AlarmsSuppressed":           ATTRIBUTE_ID_BooleanSensorConfiguration_AlarmsSuppressed,
+		"AlarmsEnabled":              ATTRIBUTE_ID_BooleanSensorConfiguration_AlarmsEnabled,
+		"AlarmsSupported":            ATTRIBUTE_ID_BooleanSensorConfiguration_AlarmsSupported,
+		"SensorFault":                ATTRIBUTE_ID_BooleanSensorConfiguration_SensorFault,
+	},
+	CLUSTER_ID_ValveConfigurationandControl: {
+		"OpenDuration":        ATTRIBUTE_ID_ValveConfigurationandControl_OpenDuration,
+		"DefaultOpenDuration": ATTRIBUTE_ID_ValveConfigurationandControl_DefaultOpenDuration,
+		"AutoCloseTime":       ATTRIBUTE_ID_ValveConfigurationandControl_AutoCloseTime,
+		"RemainingDuration":   ATTRIBUTE_ID_ValveConfigurationandControl_RemainingDuration,
+		"CurrentState":        ATTRIBUTE_ID_ValveConfigurationandControl_CurrentState,
+		"TargetState":         ATTRIBUTE_ID_ValveConfigurationandControl_TargetState,
+		"CurrentLevel":        ATTRIBUTE_ID_ValveConfigurationandControl_CurrentLevel,
+		"TargetLevel":         ATTRIBUTE_ID_ValveConfigurationandControl_TargetLevel,
+		"DefaultOpenLevel":    ATTRIBUTE_ID_ValveConfigurationandControl_DefaultOpenLevel,
+		"ValveFault":          ATTRIBUTE_ID_ValveConfigurationandControl_ValveFault,
+		"LevelStep":           ATTRIBUTE_ID_ValveConfigurationandControl_LevelStep,
+	},
+	CLUSTER_ID_ElectricalPowerMeasurement: {
+		"PowerMode":                ATTRIBUTE_ID_ElectricalPowerMeasurement_PowerMode,
+		"NumberOfMeasurementTypes": ATTRIBUTE_ID_ElectricalPowerMeasurement_NumberOfMeasurementTypes,
+		"Accuracy":                 ATTRIBUTE_ID_ElectricalPowerMeasurement_Accuracy,
+		"Ranges":                   ATTRIBUTE_ID_ElectricalPowerMeasurement_Ranges,
+		"Voltage":                  ATTRIBUTE_ID_ElectricalPowerMeasurement_Voltage,
+		"ActiveCurrent":            ATTRIBUTE_ID_ElectricalPowerMeasurement_ActiveCurrent,
+		"ReactiveCurrent":          ATTRIBUTE_ID_ElectricalPowerMeasurement_ReactiveCurrent,
+		"ApparentCurrent":          ATTRIBUTE_ID_ElectricalPowerMeasurement_ApparentCurrent,
+		"ActivePower":              ATTRIBUTE_ID_ElectricalPowerMeasurement_ActivePower,
+		"ReactivePower":            ATTRIBUTE_ID_ElectricalPowerMeasurement_ReactivePower,
+		"ApparentPower":            ATTRIBUTE_ID_ElectricalPowerMeasurement_ApparentPower,
+		"RMSVoltage":               ATTRIBUTE_ID_ElectricalPowerMeasurement_RMSVoltage,
+		"RMSCurrent":               ATTRIBUTE_ID_ElectricalPowerMeasurement_RMSCurrent,
+		"RMSPower":                 ATTRIBUTE_ID_ElectricalPowerMeasurement_RMSPower,
+		"Frequency":                ATTRIBUTE_ID_ElectricalPowerMeasurement_Frequency,
+		"HarmonicCurrents":         ATTRIBUTE_ID_ElectricalPowerMeasurement_HarmonicCurrents,
+		"HarmonicPhases":           ATTRIBUTE_ID_ElectricalPowerMeasurement_HarmonicPhases,
+		"PowerFactor":              ATTRIBUTE_ID_ElectricalPowerMeasurement_PowerFactor,
+		"NeutralCurrent":           ATTRIBUTE_ID_ElectricalPowerMeasurement_NeutralCurrent,
+	},
+	CLUSTER_ID_ElectricalEnergyMeasurement: {
+		"Accuracy":                 ATTRIBUTE_ID_ElectricalEnergyMeasurement_Accuracy,
+		"CumulativeEnergyImported": ATTRIBUTE_ID_ElectricalEnergyMeasurement_CumulativeEnergyImported,
+		"CumulativeEnergyExported": ATTRIBUTE_ID_ElectricalEnergyMeasurement_CumulativeEnergyExported,
+		"PeriodicEnergyImported":   ATTRIBUTE_ID_ElectricalEnergyMeasurement_PeriodicEnergyImported,
+		"PeriodicEnergyExported":   ATTRIBUTE_ID_ElectricalEnergyMeasurement_PeriodicEnergyExported,
+		"CumulativeEnergyReset":    ATTRIBUTE_ID_ElectricalEnergyMeasurement_CumulativeEnergyReset,
+	},
+	CLUSTER_ID_WaterHeaterManagement: {
+		"HeaterTypes":           ATTRIBUTE_ID_WaterHeaterManagement_HeaterTypes,
+		"HeatDemand":            ATTRIBUTE_ID_WaterHeaterManagement_HeatDemand,
+		"TankVolume":            ATTRIBUTE_ID_WaterHeaterManagement_TankVolume,
+		"EstimatedHeatRequired": ATTRIBUTE_ID_WaterHeaterManagement_EstimatedHeatRequired,
+		"TankPercentage":        ATTRIBUTE_ID_WaterHeaterManagement_TankPercentage,
+		"BoostState":            ATTRIBUTE_ID_WaterHeaterManagement_BoostState,
+	},
+	CLUSTER_ID_EnergyPrice: {
+		"UnitOfMeasure": ATTRIBUTE_ID_EnergyPrice_UnitOfMeasure,
+		"CurrentPrice":  ATTRIBUTE_ID_EnergyPrice_CurrentPrice,
+		"PriceForecast": ATTRIBUTE_ID_EnergyPrice_PriceForecast,
+	},
+	CLUSTER_ID_DemandResponseandLoadControl: {
+		"LoadControlPrograms":         ATTRIBUTE_ID_DemandResponseandLoadControl_LoadControlPrograms,
+		"NumberOfLoadControlPrograms": ATTRIBUTE_ID_DemandResponseandLoadControl_NumberOfLoadControlPrograms,
+		"Events":                      ATTRIBUTE_ID_DemandResponseandLoadControl_Events,
+		"ActiveEvents":                ATTRIBUTE_ID_DemandResponseandLoadControl_ActiveEvents,
+		"NumberOfEventsPerProgram":    ATTRIBUTE_ID_DemandResponseandLoadControl_NumberOfEventsPerProgram,
+		"NumberOfTransitions":         ATTRIBUTE_ID_DemandResponseandLoadControl_NumberOfTransitions,
+		"DefaultRandomStart":          ATTRIBUTE_ID_DemandResponseandLoadControl_DefaultRandomStart,
+		"DefaultRandomDuration":       ATTRIBUTE_ID_DemandResponseandLoadControl_DefaultRandomDuration,
+	},
+	CLUSTER_ID_Messages: {
+		"Messages":         ATTRIBUTE_ID_Messages_Messages,
+		"ActiveMessageIDs": ATTRIBUTE_ID_Messages_ActiveMessageIDs,
+	},
+	CLUSTER_ID_DeviceEnergyManagement: {
+		"ESAType":                   ATTRIBUTE_ID_DeviceEnergyManagement_ESAType,
+		"ESACanGenerate":            ATTRIBUTE_ID_DeviceEnergyManagement_ESACanGenerate,
+		"ESAState":                  ATTRIBUTE_ID_DeviceEnergyManagement_ESAState,
+		"AbsMinPower":               ATTRIBUTE_ID_DeviceEnergyManagement_AbsMinPower,
+		"AbsMaxPower":               ATTRIBUTE_ID_DeviceEnergyManagement_AbsMaxPower,
+		"PowerAdjustmentCapability": ATTRIBUTE_ID_DeviceEnergyManagement_PowerAdjustmentCapability,
+		"Forecast":                  ATTRIBUTE_ID_DeviceEnergyManagement_Forecast,
+		"OptOutState":               ATTRIBUTE_ID_DeviceEnergyManagement_OptOutState,
+	},
+	CLUSTER_ID_EnergyCalendar: {
+		"CalendarID":        ATTRIBUTE_ID_EnergyCalendar_CalendarID,
+		"Name":              ATTRIBUTE_ID_EnergyCalendar_Name,
+		"ProviderID":        ATTRIBUTE_ID_EnergyCalendar_ProviderID,
+		"EventID":           ATTRIBUTE_ID_EnergyCalendar_EventID,
+		"StartDate":         ATTRIBUTE_ID_EnergyCalendar_StartDate,
+		"CalendarPeriods":   ATTRIBUTE_ID_EnergyCalendar_CalendarPeriods,
+		"SpecialDays":       ATTRIBUTE_ID_EnergyCalendar_SpecialDays,
+		"CurrentDay":        ATTRIBUTE_ID_EnergyCalendar_CurrentDay,
+		"NextDay":           ATTRIBUTE_ID_EnergyCalendar_NextDay,
+		"CurrentTransition": ATTRIBUTE_ID_EnergyCalendar_CurrentTransition,
+		"CurrentPeakPeriod": ATTRIBUTE_ID_EnergyCalendar_CurrentPeakPeriod,
+		"NextPeakPeriod":    ATTRIBUTE_ID_EnergyCalendar_NextPeakPeriod,
+	},
+	CLUSTER_ID_EnergyPreference: {
+		"EnergyBalances":                 ATTRIBUTE_ID_EnergyPreference_EnergyBalances,
+		"CurrentEnergyBalance":           ATTRIBUTE_ID_EnergyPreference_CurrentEnergyBalance,
+		"EnergyPriorities":               ATTRIBUTE_ID_EnergyPreference_EnergyPriorities,
+		"LowPowerModeSensitivities":      ATTRIBUTE_ID_EnergyPreference_LowPowerModeSensitivities,
+		"CurrentLowPowerModeSensitivity": ATTRIBUTE_ID_EnergyPreference_CurrentLowPowerModeSensitivity,
+	},
+	CLUSTER_ID_DoorLock: {
+		"LockState":                   ATTRIBUTE_ID_DoorLock_LockState,
+		"LockType":                    ATTRIBUTE_ID_DoorLock_LockType,
+		"ActuatorEnabled":             ATTRIBUTE_ID_DoorLock_ActuatorEnabled,
+		"DoorState":                   ATTRIBUTE_ID_DoorLock_DoorState,
+		"DoorOpenEvents":              ATTRIBUTE_ID_DoorLock_DoorOpenEvents,
+		"DoorClosedEvents":            ATTRIBUTE_ID_DoorLock_DoorClosedEvents,
+		"OpenPeriod":                  ATTRIBUTE_ID_DoorLock_OpenPeriod,
+		"NumberOfTotalUsersSupported": ATTRIBUTE_ID_DoorLock_NumberOfTotalUsersSupported,
+		"NumberOfPINUsersSupported":   ATTRIBUTE_ID_DoorLock_NumberOfPINUsersSupported,
+		"NumberOfRFIDUsersSupported":  ATTRIBUTE_ID_DoorLock_NumberOfRFIDUsersSupported,
+		"NumberOfWeekDaySchedulesSupportedPerUser": ATTRIBUTE_ID_DoorLock_NumberOfWeekDaySchedulesSupportedPerUser,
+		"NumberOfYearDaySchedulesSupportedPerUser": ATTRIBUTE_ID_DoorLock_NumberOfYearDaySchedulesSupportedPerUser,
+		"NumberOfHolidaySchedulesSupported":        ATTRIBUTE_ID_DoorLock_NumberOfHolidaySchedulesSupported,
+		"MaxPINCodeLength":                         ATTRIBUTE_ID_DoorLock_MaxPINCodeLength,
+		"MinPINCodeLength":                         ATTRIBUTE_ID_DoorLock_MinPINCodeLength,
+		"MaxRFIDCodeLength":                        ATTRIBUTE_ID_DoorLock_MaxRFIDCodeLength,
+		"MinRFIDCodeLength":                        ATTRIBUTE_ID_DoorLock_MinRFIDCodeLength,
+		"CredentialRulesSupport":                   ATTRIBUTE_ID_DoorLock_CredentialRulesSupport,
+		"NumberOfCredentialsSupportedPerUser":      ATTRIBUTE_ID_DoorLock_NumberOfCredentialsSupportedPerUser,
+		"Language":                                 ATTRIBUTE_ID_DoorLock_Language,
+		"LEDSettings":                              ATTRIBUTE_ID_DoorLock_LEDSettings,
+		"AutoRelockTime":                           ATTRIBUTE_ID_DoorLock_AutoRelockTime,
+		"SoundVolume":                              ATTRIBUTE_ID_DoorLock_SoundVolume,
+		"OperatingMode":                            ATTRIBUTE_ID_DoorLock_OperatingMode,
+		"SupportedOperatingModes":                  ATTRIBUTE_ID_DoorLock_SupportedOperatingModes,
+		"DefaultConfigurationRegister":             ATTRIBUTE_ID_DoorLock_DefaultConfigurationRegister,
+		"EnableLocalProgramming":                   ATTRIBUTE_ID_DoorLock_EnableLocalProgramming,
+		"EnableOneTouchLocking":                    ATTRIBUTE_ID_DoorLock_EnableOneTouchLocking,
+		"EnableInsideStatusLED":                    ATTRIBUTE_ID_DoorLock_EnableInsideStatusLED,
+		"EnablePrivacyModeButton":                  ATTRIBUTE_ID_DoorLock_EnablePrivacyModeButton,
+		"LocalProgrammingFeatures":                 ATTRIBUTE_ID_DoorLock_LocalProgrammingFeatures,
+		"WrongCodeEntryLimit":                      ATTRIBUTE_ID_DoorLock_WrongCodeEntryLimit,
+		"UserCodeTemporaryDisableTime":             ATTRIBUTE_ID_DoorLock_UserCodeTemporaryDisableTime,
+		"SendPINOverTheAir":                        ATTRIBUTE_ID_DoorLock_SendPINOverTheAir,
+		"RequirePINforRemoteOperation":             ATTRIBUTE_ID_DoorLock_RequirePINforRemoteOperation,
+		"ExpiringUserTimeout":                      ATTRIBUTE_ID_DoorLock_ExpiringUserTimeout,
+	},
+	CLUSTER_ID_WindowCovering: {
+		"Type":                             ATTRIBUTE_ID_WindowCovering_Type,
+		"PhysicalClosedLimitLift":          ATTRIBUTE_ID_WindowCovering_PhysicalClosedLimitLift,
+		"PhysicalClosedLimitTilt":          ATTRIBUTE_ID_WindowCovering_PhysicalClosedLimitTilt,
+		"CurrentPositionLift":              ATTRIBUTE_ID_WindowCovering_CurrentPositionLift,
+		"CurrentPositionTilt":              ATTRIBUTE_ID_WindowCovering_CurrentPositionTilt,
+		"NumberOfActuationsLift":           ATTRIBUTE_ID_WindowCovering_NumberOfActuationsLift,
+		"NumberOfActuationsTilt":           ATTRIBUTE_ID_WindowCovering_NumberOfActuationsTilt,
+		"ConfigStatus":                     ATTRIBUTE_ID_WindowCovering_ConfigStatus,
+		"CurrentPositionLiftPercentage":    ATTRIBUTE_ID_WindowCovering_CurrentPositionLiftPercentage,
+		"CurrentPositionTiltPercentage":    ATTRIBUTE_ID_WindowCovering_CurrentPositionTiltPercentage,
+		"OperationalStatus":                ATTRIBUTE_ID_WindowCovering_OperationalStatus,
+		"TargetPositionLiftPercent100ths":  ATTRIBUTE_ID_WindowCovering_TargetPositionLiftPercent100ths,
+		"TargetPositionTiltPercent100ths":  ATTRIBUTE_ID_WindowCovering_TargetPositionTiltPercent100ths,
+		"EndProductType":                   ATTRIBUTE_ID_WindowCovering_EndProductType,
+		"CurrentPositionLiftPercent100ths": ATTRIBUTE_ID_WindowCovering_CurrentPositionLiftPercent100ths,
+		"CurrentPositionTiltPercent100ths": ATTRIBUTE_ID_WindowCovering_CurrentPositionTiltPercent100ths,
+		"InstalledOpenLimitLift":           ATTRIBUTE_ID_WindowCovering_InstalledOpenLimitLift,
+		"InstalledClosedLimitLift":         ATTRIBUTE_ID_WindowCovering_InstalledClosedLimitLift,
+		"InstalledOpenLimitTilt":           ATTRIBUTE_ID_WindowCovering_InstalledOpenLimitTilt,
+		"InstalledClosedLimitTilt":         ATTRIBUTE_ID_WindowCovering_InstalledClosedLimitTilt,
+		"Mode":                             ATTRIBUTE_ID_WindowCovering_Mode,
+		"SafetyStatus":                     ATTRIBUTE_ID_WindowCovering_SafetyStatus,
+	},
+	CLUSTER_ID_PumpConfigurationandControl: {
+		"MaxPressure":            ATTRIBUTE_ID_PumpConfigurationandControl_MaxPressure,
+		"MaxSpeed":               ATTRIBUTE_ID_PumpConfigurationandControl_MaxSpeed,
+		"MaxFlow":                ATTRIBUTE_ID_PumpConfigurationandControl_MaxFlow,
+		"MinConstPressure":       ATTRIBUTE_ID_PumpConfigurationandControl_MinConstPressure,
+		"MaxConstPressure":       ATTRIBUTE_ID_PumpConfigurationandControl_MaxConstPressure,
+		"MinCompPressure":        ATTRIBUTE_ID_PumpConfigurationandControl_MinCompPressure,
+		"MaxCompPressure":        ATTRIBUTE_ID_PumpConfigurationandControl_MaxCompPressure,
+		"MinConstSpeed":          ATTRIBUTE_ID_PumpConfigurationandControl_MinConstSpeed,
+		"MaxConstSpeed":          ATTRIBUTE_ID_PumpConfigurationandControl_MaxConstSpeed,
+		"MinConstFlow":           ATTRIBUTE_ID_PumpConfigurationandControl_MinConstFlow,
+		"MaxConstFlow":           ATTRIBUTE_ID_PumpConfigurationandControl_MaxConstFlow,
+		"MinConstTemp":           ATTRIBUTE_ID_PumpConfigurationandControl_MinConstTemp,
+		"MaxConstTemp":           ATTRIBUTE_ID_PumpConfigurationandControl_MaxConstTemp,
+		"PumpStatus":             ATTRIBUTE_ID_PumpConfigurationandControl_PumpStatus,
+		"EffectiveOperationMode": ATTRIBUTE_ID_PumpConfigurationandControl_EffectiveOperationMode,
+		"EffectiveControlMode":   ATTRIBUTE_ID_PumpConfigurationandControl_EffectiveControlMode,
+		"Capacity":               ATTRIBUTE_ID_PumpConfigurationandControl_Capacity,
+		"Speed":                  ATTRIBUTE_ID_PumpConfigurationandControl_Speed,
+		"LifetimeRunningHours":   ATTRIBUTE_ID_PumpConfigurationandControl_LifetimeRunningHours,
+		"Power":                  ATTRIBUTE_ID_PumpConfigurationandControl_Power,
+		"LifetimeEnergyConsumed": ATTRIBUTE_ID_PumpConfigurationandControl_LifetimeEnergyConsumed,
+		"OperationMode":          ATTRIBUTE_ID_PumpConfigurationandControl_OperationMode,
+		"ControlMode":            ATTRIBUTE_ID_PumpConfigurationandControl_ControlMode,
+	},
+	CLUSTER_ID_Thermostat: {
+		"LocalTemperature":                   ATTRIBUTE_ID_Thermostat_LocalTemperature,
+		"OutdoorTemperature":                 ATTRIBUTE_ID_Thermostat_OutdoorTemperature,
+		"Occupancy":                          ATTRIBUTE_ID_Thermostat_Occupancy,
+		"AbsMinHeatSetpointLimit":            ATTRIBUTE_ID_Thermostat_AbsMinHeatSetpointLimit,
+		"AbsMaxHeatSetpointLimit":            ATTRIBUTE_ID_Thermostat_AbsMaxHeatSetpointLimit,
+		"AbsMinCoolSetpointLimit":            ATTRIBUTE_ID_Thermostat_AbsMinCoolSetpointLimit,
+		"AbsMaxCoolSetpointLimit":            ATTRIBUTE_ID_Thermostat_AbsMaxCoolSetpointLimit,
+		"PICoolingDemand":                    ATTRIBUTE_ID_Thermostat_PICoolingDemand,
+		"PIHeatingDemand":                    ATTRIBUTE_ID_Thermostat_PIHeatingDemand,
+		"HVACSystemTypeConfiguration":        ATTRIBUTE_ID_Thermostat_HVACSystemTypeConfiguration,
+		"LocalTemperatureCalibration":        ATTRIBUTE_ID_Thermostat_LocalTemperatureCalibration,
+		"OccupiedCoolingSetpoint":            ATTRIBUTE_ID_Thermostat_OccupiedCoolingSetpoint,
+		"OccupiedHeatingSetpoint":            ATTRIBUTE_ID_Thermostat_OccupiedHeatingSetpoint,
+		"UnoccupiedCoolingSetpoint":          ATTRIBUTE_ID_Thermostat_UnoccupiedCoolingSetpoint,
+		"UnoccupiedHeatingSetpoint":          ATTRIBUTE_ID_Thermostat_UnoccupiedHeatingSetpoint,
+		"MinHeatSetpointLimit":               ATTRIBUTE_ID_Thermostat_MinHeatSetpointLimit,
+		"MaxHeatSetpointLimit":               ATTRIBUTE_ID_Thermostat_MaxHeatSetpointLimit,
+		"MinCoolSetpointLimit":               ATTRIBUTE_ID_Thermostat_MinCoolSetpointLimit,
+		"MaxCoolSetpointLimit":               ATTRIBUTE_ID_Thermostat_MaxCoolSetpointLimit,
+		"MinSetpointDeadBand":                ATTRIBUTE_ID_Thermostat_MinSetpointDeadBand,
+		"RemoteSensing":                      ATTRIBUTE_ID_Thermostat_RemoteSensing,
+		"ControlSequenceOfOperation":         ATTRIBUTE_ID_Thermostat_ControlSequenceOfOperation,
+		"SystemMode":                         ATTRIBUTE_ID_Thermostat_SystemMode,
+		"ThermostatRunningMode":              ATTRIBUTE_ID_Thermostat_ThermostatRunningMode,
+		"StartOfWeek":                        ATTRIBUTE_ID_Thermostat_StartOfWeek,
+		"NumberOfWeeklyTransitions":          ATTRIBUTE_ID_Thermostat_NumberOfWeeklyTransitions,
+		"NumberOfDailyTransitions":           ATTRIBUTE_ID_Thermostat_NumberOfDailyTransitions,
+		"TemperatureSetpointHold":            ATTRIBUTE_ID_Thermostat_TemperatureSetpointHold,
+		"TemperatureSetpointHoldDuration":    ATTRIBUTE_ID_Thermostat_TemperatureSetpointHoldDuration,
+		"ThermostatProgrammingOperationMode": ATTRIBUTE_ID_Thermostat_ThermostatProgrammingOperationMode,
+		"ThermostatRunningState":             ATTRIBUTE_ID_Thermostat_ThermostatRunningState,
+		"SetpointChangeSource":               ATTRIBUTE_ID_Thermostat_SetpointChangeSource,
+		"SetpointChangeAmount":               ATTRIBUTE_ID_Thermostat_SetpointChangeAmount,
+		"SetpointChangeSourceTimestamp":      ATTRIBUTE_ID_Thermostat_SetpointChangeSourceTimestamp,
+		"OccupiedSetback":                    ATTRIBUTE_ID_Thermostat_OccupiedSetback,
+		"OccupiedSetbackMin":                 ATTRIBUTE_ID_Thermostat_OccupiedSetbackMin,
+		"OccupiedSetbackMax":                 ATTRIBUTE_ID_Thermostat_OccupiedSetbackMax,
+		"UnoccupiedSetback":                  ATTRIBUTE_ID_Thermostat_UnoccupiedSetback,
+		"UnoccupiedSetbackMin":               ATTRIBUTE_ID_Thermostat_UnoccupiedSetbackMin,
+		"UnoccupiedSetbackMax":               ATTRIBUTE_ID_Thermostat_UnoccupiedSetbackMax,
+		"EmergencyHeatDelta":                 ATTRIBUTE_ID_Thermostat_EmergencyHeatDelta,
+		"ACType":                             ATTRIBUTE_ID_Thermostat_ACType,
+		"ACCapacity":                         ATTRIBUTE_ID_Thermostat_ACCapacity,
+		"ACRefrigerantType":                  ATTRIBUTE_ID_Thermostat_ACRefrigerantType,
+		"ACCompressorType":                   ATTRIBUTE_ID_Thermostat_ACCompressorType,
+		"ACErrorCode":                        ATTRIBUTE_ID_Thermostat_ACErrorCode,
+		"ACLouverPosition":                   ATTRIBUTE_ID_Thermostat_ACLouverPosition,
+		"ACCoilTemperature":                  ATTRIBUTE_ID_Thermostat_ACCoilTemperature,
+		"ACCapacityformat":                   ATTRIBUTE_ID_Thermostat_ACCapacityformat,
+	},
+	CLUSTER_ID_FanControl: {
+		"FanMode":          ATTRIBUTE_ID_FanControl_FanMode,
+		"FanModeSequence":  ATTRIBUTE_ID_FanControl_FanModeSequence,
+		"PercentSetting":   ATTRIBUTE_ID_FanControl_PercentSetting,
+		"PercentCurrent":   ATTRIBUTE_ID_FanControl_PercentCurrent,
+		"SpeedMax":         ATTRIBUTE_ID_FanControl_SpeedMax,
+		"SpeedSetting":     ATTRIBUTE_ID_FanControl_SpeedSetting,
+		"SpeedCurrent":     ATTRIBUTE_ID_FanControl_SpeedCurrent,
+		"RockSupport":      ATTRIBUTE_ID_FanControl_RockSupport,
+		"RockSetting":      ATTRIBUTE_ID_FanControl_RockSetting,
+		"WindSupport":      ATTRIBUTE_ID_FanControl_WindSupport,
+		"WindSetting":      ATTRIBUTE_ID_FanControl_WindSetting,
+		"AirflowDirection": ATTRIBUTE_ID_FanControl_AirflowDirection,
+	},
+	CLUSTER_ID_ThermostatUserInterfaceConfiguration: {
+		"TemperatureDisplayMode":        ATTRIBUTE_ID_ThermostatUserInterfaceConfiguration_TemperatureDisplayMode,
+		"KeypadLockout":                 ATTRIBUTE_ID_ThermostatUserInterfaceConfiguration_KeypadLockout,
+		"ScheduleProgrammingVisibility": ATTRIBUTE_ID_ThermostatUserInterfaceConfiguration_ScheduleProgrammingVisibility,
+	},
+	CLUSTER_ID_ColorControl: {
+		"CurrentHue":                      ATTRIBUTE_ID_ColorControl_CurrentHue,
+		"CurrentSaturation":               ATTRIBUTE_ID_ColorControl_CurrentSaturation,
+		"RemainingTime":                   ATTRIBUTE_ID_ColorControl_RemainingTime,
+		"CurrentX":                        ATTRIBUTE_ID_ColorControl_CurrentX,
+		"CurrentY":                        ATTRIBUTE_ID_ColorControl_CurrentY,
+		"DriftCompensation":               ATTRIBUTE_ID_ColorControl_DriftCompensation,
+		"CompensationText":                ATTRIBUTE_ID_ColorControl_CompensationText,
+		"ColorTemperatureMireds":          ATTRIBUTE_ID_ColorControl_ColorTemperatureMireds,
+		"ColorMode":                       ATTRIBUTE_ID_ColorControl_ColorMode,
+		"Options":                         ATTRIBUTE_ID_ColorControl_Options,
+		"NumberOfPrimaries":               ATTRIBUTE_ID_ColorControl_NumberOfPrimaries,
+		"Primary1X":                       ATTRIBUTE_ID_ColorControl_Primary1X,
+		"Primary1Y":                       ATTRIBUTE_ID_ColorControl_Primary1Y,
+		"Primary1Intensity":               ATTRIBUTE_ID_ColorControl_Primary1Intensity,
+		"Primary2X":                       ATTRIBUTE_ID_ColorControl_Primary2X,
+		"Primary2Y":                       ATTRIBUTE_ID_ColorControl_Primary2Y,
+		"Primary2Intensity":               ATTRIBUTE_ID_ColorControl_Primary2Intensity,
+		"Primary3X":                       ATTRIBUTE_ID_ColorControl_Primary3X,
+		"Primary3Y":                       ATTRIBUTE_ID_ColorControl_Primary3Y,
+		"Primary3Intensity":               ATTRIBUTE_ID_ColorControl_Primary3Intensity,
+		"Primary4X":                       ATTRIBUTE_ID_ColorControl_Primary4X,
+		"Primary4Y":                       ATTRIBUTE_ID_ColorControl_Primary4Y,
+		"Primary4Intensity":               ATTRIBUTE_ID_ColorControl_Primary4Intensity,
+		"Primary5X":                       ATTRIBUTE_ID_ColorControl_Primary5X,
+		"Primary5Y":                       ATTRIBUTE_ID_ColorControl_Primary5Y,
+		"Primary5Intensity":               ATTRIBUTE_ID_ColorControl_Primary5Intensity,
+		"Primary6X":                       ATTRIBUTE_ID_ColorControl_Primary6X,
+		"Primary6Y":                       ATTRIBUTE_ID_ColorControl_Primary6Y,
+		"Primary6Intensity":               ATTRIBUTE_ID_ColorControl_Primary6Intensity,
+		"WhitePointX":                     ATTRIBUTE_ID_ColorControl_WhitePointX,
+		"WhitePointY":                     ATTRIBUTE_ID_ColorControl_WhitePointY,
+		"ColorPointRX":                    ATTRIBUTE_ID_ColorControl_ColorPointRX,
+		"ColorPointRY":                    ATTRIBUTE_ID_ColorControl_ColorPointRY,
+		"ColorPointRIntensity":            ATTRIBUTE_ID_ColorControl_ColorPointRIntensity,
+		"ColorPointGX":                    ATTRIBUTE_ID_ColorControl_ColorPointGX,
+		"ColorPointGY":                    ATTRIBUTE_ID_ColorControl_ColorPointGY,
+		"ColorPointGIntensity":            ATTRIBUTE_ID_ColorControl_ColorPointGIntensity,
+		"ColorPointBX":                    ATTRIBUTE_ID_ColorControl_ColorPointBX,
+		"ColorPointBY":                    ATTRIBUTE_ID_ColorControl_ColorPointBY,
+		"ColorPointBIntensity":            ATTRIBUTE_ID_ColorControl_ColorPointBIntensity,
+		"EnhancedCurrentHue":              ATTRIBUTE_ID_ColorControl_EnhancedCurrentHue,
+		"EnhancedColorMode":               ATTRIBUTE_ID_ColorControl_EnhancedColorMode,
+		"ColorLoopActive":                 ATTRIBUTE_ID_ColorControl_ColorLoopActive,
+		"ColorLoopDirection":              ATTRIBUTE_ID_ColorControl_ColorLoopDirection,
+		"ColorLoopTime":                   ATTRIBUTE_ID_ColorControl_ColorLoopTime,
+		"ColorLoopStartEnhancedHue":       ATTRIBUTE_ID_ColorControl_ColorLoopStartEnhancedHue,
+		"ColorLoopStoredEnhancedHue":      ATTRIBUTE_ID_ColorControl_ColorLoopStoredEnhancedHue,
+		"ColorCapabilities":               ATTRIBUTE_ID_ColorControl_ColorCapabilities,
+		"ColorTempPhysicalMinMireds":      ATTRIBUTE_ID_ColorControl_ColorTempPhysicalMinMireds,
+		"ColorTempPhysicalMaxMireds":      ATTRIBUTE_ID_ColorControl_ColorTempPhysicalMaxMireds,
+		"CoupleColorTempToLevelMinMireds": ATTRIBUTE_ID_ColorControl_CoupleColorTempToLevelMinMireds,
+		"StartUpColorTemperatureMireds":   ATTRIBUTE_ID_ColorControl_StartUpColorTemperatureMireds,
+	},
+	CLUSTER_ID_BallastConfiguration: {
+		"PhysicalMinLevel":        ATTRIBUTE_ID_BallastConfiguration_PhysicalMinLevel,
+		"PhysicalMaxLevel":        ATTRIBUTE_ID_BallastConfiguration_PhysicalMaxLevel,
+		"BallastStatus":           ATTRIBUTE_ID_BallastConfiguration_BallastStatus,
+		"MinLevel":                ATTRIBUTE_ID_BallastConfiguration_MinLevel,
+		"MaxLevel":                ATTRIBUTE_ID_BallastConfiguration_MaxLevel,
+		"IntrinsicBallastFactor":  ATTRIBUTE_ID_BallastConfiguration_IntrinsicBallastFactor,
+		"BallastFactorAdjustment": ATTRIBUTE_ID_BallastConfiguration_BallastFactorAdjustment,
+		"LampQuantity":            ATTRIBUTE_ID_BallastConfiguration_LampQuantity,
+		"LampType":                ATTRIBUTE_ID_BallastConfiguration_LampType,
+		"LampManufacturer":        ATTRIBUTE_ID_BallastConfiguration_LampManufacturer,
+		"LampRatedHours":          ATTRIBUTE_ID_BallastConfiguration_LampRatedHours,
+		"LampBurnHours":           ATTRIBUTE_ID_BallastConfiguration_LampBurnHours,
+		"LampAlarmMode":           ATTRIBUTE_ID_BallastConfiguration_LampAlarmMode,
+		"LampBurnHoursTripPoint":  ATTRIBUTE_ID_BallastConfiguration_LampBurnHoursTripPoint,
+	},
+	CLUSTER_ID_IlluminanceMeasurement: {
+		"MeasuredValue":    ATTRIBUTE_ID_IlluminanceMeasurement_MeasuredValue,
+		"MinMeasuredValue": ATTRIBUTE_ID_IlluminanceMeasurement_MinMeasuredValue,
+		"MaxMeasuredValue": ATTRIBUTE_ID_IlluminanceMeasurement_MaxMeasuredValue,
+		"Tolerance":        ATTRIBUTE_ID_IlluminanceMeasurement_Tolerance,
+		"LightSensorType":  ATTRIBUTE_ID_IlluminanceMeasurement_LightSensorType,
+	},
+	CLUSTER_ID_TemperatureMeasurement: {
+		"MeasuredValue":    ATTRIBUTE_ID_TemperatureMeasurement_MeasuredValue,
+		"MinMeasuredValue": ATTRIBUTE_ID_TemperatureMeasurement_MinMeasuredValue,
+		"MaxMeasuredValue": ATTRIBUTE_ID_TemperatureMeasurement_MaxMeasuredValue,
+		"Tolerance":        ATTRIBUTE_ID_TemperatureMeasurement_Tolerance,
+	},
+	CLUSTER_ID_PressureMeasurement: {
+		"MeasuredValue":    ATTRIBUTE_ID_PressureMeasurement_MeasuredValue,
+		"MinMeasuredValue": ATTRIBUTE_ID_PressureMeasurement_MinMeasuredValue,
+		"MaxMeasuredValue": ATTRIBUTE_ID_PressureMeasurement_MaxMeasuredValue,
+		"Tolerance":        ATTRIBUTE_ID_PressureMeasurement_Tolerance,
+		"ScaledValue":      ATTRIBUTE_ID_PressureMeasurement_ScaledValue,
+		"MinScaledValue":   ATTRIBUTE_ID_PressureMeasurement_MinScaledValue,
+		"MaxScaledValue":   ATTRIBUTE_ID_PressureMeasurement_MaxScaledValue,
+		"ScaledTolerance":  ATTRIBUTE_ID_PressureMeasurement_ScaledTolerance,
+		"Scale":            ATTRIBUTE_ID_PressureMeasurement_Scale,
+	},
+	CLUSTER_ID_FlowMeasurement: {
+		"MeasuredValue":    ATTRIBUTE_ID_FlowMeasurement_MeasuredValue,
+		"MinMeasuredValue": ATTRIBUTE_ID_FlowMeasurement_MinMeasuredValue,
+		"MaxMeasuredValue": ATTRIBUTE_ID_FlowMeasurement_MaxMeasuredValue,
+		"Tolerance":        ATTRIBUTE_ID_FlowMeasurement_Tolerance,
+	},
+	CLUSTER_ID_OccupancySensing: {
+		"Occupancy":                                    ATTRIBUTE_ID_OccupancySensing_Occupancy,
+		"OccupancySensorType":                          ATTRIBUTE_ID_OccupancySensing_OccupancySensorType,
+		"OccupancySensorTypeBitmap":                    ATTRIBUTE_ID_OccupancySensing_OccupancySensorTypeBitmap,
+		"PIROccupiedToUnoccupiedDelay":                 ATTRIBUTE_ID_OccupancySensing_PIROccupiedToUnoccupiedDelay,
+		"PIRUnoccupiedToOccupiedDelay":                 ATTRIBUTE_ID_OccupancySensing_PIRUnoccupiedToOccupiedDelay,
+		"PIRUnoccupiedToOccupiedThreshold":             ATTRIBUTE_ID_OccupancySensing_PIRUnoccupiedToOccupiedThreshold,
+		"UltrasonicOccupiedToUnoccupiedDelay":          ATTRIBUTE_ID_OccupancySensing_UltrasonicOccupiedToUnoccupiedDelay,
+		"UltrasonicUnoccupiedToOccupiedDelay":          ATTRIBUTE_ID_OccupancySensing_UltrasonicUnoccupiedToOccupiedDelay,
+		"UltrasonicUnoccupiedToOccupiedThreshold":      ATTRIBUTE_ID_OccupancySensing_UltrasonicUnoccupiedToOccupiedThreshold,
+		"PhysicalContactOccupiedToUnoccupiedDelay":     ATTRIBUTE_ID_OccupancySensing_PhysicalContactOccupiedToUnoccupiedDelay,
+		"PhysicalContactUnoccupiedToOccupiedDelay":     ATTRIBUTE_ID_OccupancySensing_PhysicalContactUnoccupiedToOccupiedDelay,
+		"PhysicalContactUnoccupiedToOccupiedThreshold": ATTRIBUTE_ID_OccupancySensing_PhysicalContactUnoccupiedToOccupiedThreshold,
+	},
+	CLUSTER_ID_WakeonLAN: {
+		"MACAddress":       ATTRIBUTE_ID_WakeonLAN_MACAddress,
+		"LinkLocalAddress": ATTRIBUTE_ID_WakeonLAN_LinkLocalAddress,
+	},
+	CLUSTER_ID_Channel: {
+		"ChannelList":    ATTRIBUTE_ID_Channel_ChannelList,
+		"Lineup":         ATTRIBUTE_ID_Channel_Lineup,
+		"CurrentChannel": ATTRIBUTE_ID_Channel_CurrentChannel,
+	},
+	CLUSTER_ID_TargetNavigator: {
+		"TargetList":    ATTRIBUTE_ID_TargetNavigator_TargetList,
+		"CurrentTarget": ATTRIBUTE_ID_TargetNavigator_CurrentTarget,
+	},
+	CLUSTER_ID_MediaPlayback: {
+		"CurrentState":    ATTRIBUTE_ID_MediaPlayback_CurrentState,
+		"StartTime":       ATTRIBUTE_ID_MediaPlayback_StartTime,
+		"Duration":        ATTRIBUTE_ID_MediaPlayback_Duration,
+		"SampledPosition": ATTRIBUTE_ID_MediaPlayback_SampledPosition,
+		"PlaybackSpeed":   ATTRIBUTE_ID_MediaPlayback_PlaybackSpeed,
+		"SeekRangeEnd":    ATTRIBUTE_ID_MediaPlayback_SeekRangeEnd,
+		"SeekRangeStart":  ATTRIBUTE_ID_MediaPlayback_SeekRangeStart,
+	},
+	CLUSTER_ID_MediaInput: {
+		"InputList":    ATTRIBUTE_ID_MediaInput_InputList,
+		"CurrentInput": ATTRIBUTE_ID_MediaInput_CurrentInput,
+	},
+	CLUSTER_ID_ContentLauncher: {
+		"AcceptHeader":                ATTRIBUTE_ID_ContentLauncher_AcceptHeader,
+		"SupportedStreamingProtocols": ATTRIBUTE_ID_ContentLauncher_SupportedStreamingProtocols,
+	},
+	CLUSTER_ID_AudioOutput: {
+		"OutputList":    ATTRIBUTE_ID_AudioOutput_OutputList,
+		"CurrentOutput": ATTRIBUTE_ID_AudioOutput_CurrentOutput,
+	},
+	CLUSTER_ID_ApplicationLauncher: {
+		"CatalogList": ATTRIBUTE_ID_ApplicationLauncher_CatalogList,
+		"CurrentApp":  ATTRIBUTE_ID_ApplicationLauncher_CurrentApp,
+	},
+	CLUSTER_ID_ApplicationBasic: {
+		"VendorName":         ATTRIBUTE_ID_ApplicationBasic_VendorName,
+		"VendorID":           ATTRIBUTE_ID_ApplicationBasic_VendorID,
+		"ApplicationName":    ATTRIBUTE_ID_ApplicationBasic_ApplicationName,
+		"ProductID":          ATTRIBUTE_ID_ApplicationBasic_ProductID,
+		"Application":        ATTRIBUTE_ID_ApplicationBasic_Application,
+		"Status":             ATTRIBUTE_ID_ApplicationBasic_Status,
+		"ApplicationVersion": ATTRIBUTE_ID_ApplicationBasic_ApplicationVersion,
+		"AllowedVendorList":  ATTRIBUTE_ID_ApplicationBasic_AllowedVendorList,
+	},
+	CLUSTER_ID_MicrowaveOvenControl: {
+		"CookTime":          ATTRIBUTE_ID_MicrowaveOvenControl_CookTime,
+		"MaxCookTime":       ATTRIBUTE_ID_MicrowaveOvenControl_MaxCookTime,
+		"PowerSetting":      ATTRIBUTE_ID_MicrowaveOvenControl_PowerSetting,
+		"MinPower":          ATTRIBUTE_ID_MicrowaveOvenControl_MinPower,
+		"MaxPower":          ATTRIBUTE_ID_MicrowaveOvenControl_MaxPower,
+		"PowerStep":         ATTRIBUTE_ID_MicrowaveOvenControl_PowerStep,
+		"SupportedWatts":    ATTRIBUTE_ID_MicrowaveOvenControl_SupportedWatts,
+		"SelectedWattIndex": ATTRIBUTE_ID_MicrowaveOvenControl_SelectedWattIndex,
+		"WattRating":        ATTRIBUTE_ID_MicrowaveOvenControl_WattRating,
+	},
+}
+
+// CommandIdMap maps cluster id and name of command sent to server to its id.
+var CommandIdMap = map[int]map[string]int{
+	CLUSTER_ID_Identify: {
+		"Identify":      COMMAND_ID_Identify_Identify,
+		"TriggerEffect": COMMAND_ID_Identify_TriggerEffect,
+	},
+	CLUSTER_ID_Groups: {
+		"AddGroup":              COMMAND_ID_Groups_AddGroup,
+		"ViewGroup":             COMMAND_ID_Groups_ViewGroup,
+		"GetGroupMembership":    COMMAND_ID_Groups_GetGroupMembership,
+		"RemoveGroup":           COMMAND_ID_Groups_RemoveGroup,
+		"RemoveAllGroups":       COMMAND_ID_Groups_RemoveAllGroups,
+		"AddGroupIfIdentifying": COMMAND_ID_Groups_AddGroupIfIdentifying,
+	},
+	CLUSTER_ID_Scenes: {
+		"AddScene":           COMMAND_ID_Scenes_AddScene,
+		"ViewScene":          COMMAND_ID_Scenes_ViewScene,
+		"RemoveScene":        COMMAND_ID_Scenes_RemoveScene,
+		"RemoveAllScenes":    COMMAND_ID_Scenes_RemoveAllScenes,
+		"StoreScene":         COMMAND_ID_Scenes_StoreScene,
+		"RecallScene":        COMMAND_ID_Scenes_RecallScene,
+		"GetSceneMembership": COMMAND_ID_Scenes_GetSceneMembership,
+		"EnhancedAddScene":   COMMAND_ID_Scenes_EnhancedAddScene,
+		"EnhancedViewScene":  COMMAND_ID_Scenes_EnhancedViewScene,
+		"CopyScene":          COMMAND_ID_Scenes_CopyScene,
+	},
+	CLUSTER_ID_OnOff: {
+		"Off":                     COMMAND_ID_OnOff_Off,
+		"On":                      COMMAND_ID_OnOff_On,
+		"Toggle":                  COMMAND_ID_OnOff_Toggle,
+		"OffWithEffect":           COMMAND_ID_OnOff_OffWithEffect,
+		"OnWithRecallGlobalScene": COMMAND_ID_OnOff_OnWithRecallGlobalScene,
+		"OnWithTimedOff":          COMMAND_ID_OnOff_OnWithTimedOff,
+	},
+	CLUSTER_ID_LevelControl: {
+		"MoveToLevel":            COMMAND_ID_LevelControl_MoveToLevel,
+		"Move":                   COMMAND_ID_LevelControl_Move,
+		"Step":                   COMMAND_ID_LevelControl_Step,
+		"Stop":                   COMMAND_ID_LevelControl_Stop,
+		"MoveToLevelWithOnOff":   COMMAND_ID_LevelControl_MoveToLevelWithOnOff,
+		"MoveWithOnOff":          COMMAND_ID_LevelControl_MoveWithOnOff,
+		"StepWithOnOff":          COMMAND_ID_LevelControl_StepWithOnOff,
+		"StopWithOnOff":          COMMAND_ID_LevelControl_StopWithOnOff,
+		"MoveToClosestFrequency": COMMAND_ID_LevelControl_MoveToClosestFrequency,
+	},
+	CLUSTER_ID_Actions: {
+		"InstantAction":               COMMAND_ID_Actions_InstantAction,
+		"InstantActionWithTransition": COMMAND_ID_Actions_InstantActionWithTransition,
+		"StartAction":                 COMMAND_ID_Actions_StartAction,
+		"StartActionWithDuration":     COMMAND_ID_Actions_StartActionWithDuration,
+		"StopAction":                  COMMAND_ID_Actions_StopAction,
+		"PauseAction":                 COMMAND_ID_Actions_PauseAction,
+		"PauseActionWithDuration":     COMMAND_ID_Actions_PauseActionWithDuration,
+		"ResumeAction":                COMMAND_ID_Actions_ResumeAction,
+		"EnableAction":                COMMAND_ID_Actions_EnableAction,
+		"EnableActionWithDuration":    COMMAND_ID_Actions_EnableActionWithDuration,
+		"DisableAction":               COMMAND_ID_Actions_DisableAction,
+		"DisableActionWithDuration":   COMMAND_ID_Actions_DisableActionWithDuration,
+	},
+	CLUSTER_ID_GeneralCommissioning: {
+		"ArmFailSafe":           COMMAND_ID_GeneralCommissioning_ArmFailSafe,
+		"SetRegulatoryConfig":   COMMAND_ID_GeneralCommissioning_SetRegulatoryConfig,
+		"CommissioningComplete": COMMAND_ID_GeneralCommissioning_CommissioningComplete,
+	},
+	CLUSTER_ID_NetworkCommissioning: {
+		"ScanNetworks":             COMMAND_ID_NetworkCommissioning_ScanNetworks,
+		"AddOrUpdateWiFiNetwork":   COMMAND_ID_NetworkCommissioning_AddOrUpdateWiFiNetwork,
+		"AddOrUpdateThreadNetwork": COMMAND_ID_NetworkCommissioning_AddOrUpdateThreadNetwork,
+		"RemoveNetwork":            COMMAND_ID_NetworkCommissioning_RemoveNetwork,
+		"ConnectNetwork":           COMMAND_ID_NetworkCommissioning_ConnectNetwork,
+		"ReorderNetwork":           COMMAND_ID_NetworkCommissioning_ReorderNetwork,
+	},
+	CLUSTER_ID_DiagnosticLogs: {
+		"RetrieveLogsRequest": COMMAND_ID_DiagnosticLogs_RetrieveLogsRequest,
+	},
+	CLUSTER_ID_GeneralDiagnostics: {
+		"TestEventTrigger": COMMAND_ID_GeneralDiagnostics_TestEventTrigger,
+		"TimeSnapshot":     COMMAND_ID_GeneralDiagnostics_TimeSnapshot,
+	},
+	CLUSTER_ID_SoftwareDiagnostics: {
+		"ResetWatermarks": COMMAND_ID_SoftwareDiagnostics_ResetWatermarks,
+	},
+	CLUSTER_ID_ThreadNetworkDiagnostics: {
+		"ResetCounts": COMMAND_ID_ThreadNetworkDiagnostics_ResetCounts,
+	},
+	CLUSTER_ID_WiFiNetworkDiagnostics: {
+		"ResetCounts": COMMAND_ID_WiFiNetworkDiagnostics_ResetCounts,
+	},
+	CLUSTER_ID_EthernetNetworkDiagnostics: {
+		"ResetCounts": COMMAND_ID_EthernetNetworkDiagnostics_ResetCounts,
+	},
+	CLUSTER_ID_TimeSync: {
+		"SetUTCTime":           COMMAND_ID_TimeSync_SetUTCTime,
+		"SetTrustedTimeSource": COMMAND_ID_TimeSync_SetTrustedTimeSource,
+		"SetTimeZone":          COMMAND_ID_TimeSync_SetTimeZone,
+		"SetDSTOffset":         COMMAND_ID_TimeSync_SetDSTOffset,
+		"SetDefaultNTP":        COMMAND_ID_TimeSync_SetDefaultNTP,
+	},
+	CLUSTER_ID_AdministratorCommissioning: {
+		"OpenCommissioningWindow":      COMMAND_ID_AdministratorCommissioning_OpenCommissioningWindow,
+		"OpenBasicCommissioningWindow": COMMAND_ID_AdministratorCommissioning_OpenBasicCommissioningWindow,
+		"RevokeCommissioning":          COMMAND_ID_AdministratorCommissioning_RevokeCommissioning,
+	},
+	CLUSTER_ID_OperationalCredentials: {
+		"AttestationRequest":        COMMAND_ID_OperationalCredentials_AttestationRequest,
+		"CertificateChainRequest":   COMMAND_ID_OperationalCredentials_CertificateChainRequest,
+		"CSRRequest":                COMMAND_ID_OperationalCredentials_CSRRequest,
+		"AddNOC":                    COMMAND_ID_OperationalCredentials_AddNOC,
+		"UpdateNOC":                 COMMAND_ID_OperationalCredentials_UpdateNOC,
+		"UpdateFabricLabel":         COMMAND_ID_OperationalCredentials_UpdateFabricLabel,
+		"RemoveFabric":              COMMAND_ID_OperationalCredentials_RemoveFabric,
+		"AddTrustedRootCertificate": COMMAND_ID_OperationalCredentials_AddTrustedRootCertificate,
+	},
+	CLUSTER_ID_GroupKeyManagement: {
+		"KeySetWrite":          COMMAND_ID_GroupKeyManagement_KeySetWrite,
+		"KeySetRead":           COMMAND_ID_GroupKeyManagement_KeySetRead,
+		"KeySetRemove":         COMMAND_ID_GroupKeyManagement_KeySetRemove,
+		"KeySetReadAllIndices": COMMAND_ID_GroupKeyManagement_KeySetReadAllIndices,
+	},
+	CLUSTER_ID_Timer: {
+		"SetTimer":   COMMAND_ID_Timer_SetTimer,
+		"ResetTimer": COMMAND_ID_Timer_ResetTimer,
+		"AddTime":    COMMAND_ID_Timer_AddTime,
+		"ReduceTime": COMMAND_ID_Timer_ReduceTime,
+	},
+	CLUSTER_ID_OvenCavityOperationalState: {
+		"Pause":  COMMAND_ID_OvenCavityOperationalState_Pause,
+		"Stop":   COMMAND_ID_OvenCavityOperationalState_Stop,
+		"Start":  COMMAND_ID_OvenCavityOperationalState_Start,
+		"Resume": COMMAND_ID_OvenCavityOperationalState_Resume,
+	},
+	CLUSTER_ID_OvenMode: {
+		"ChangeToMode": COMMAND_ID_OvenMode_ChangeToMode,
+	},
+	CLUSTER_ID_ModeSelect: {
+		"ChangeToMode": COMMAND_ID_ModeSelect_ChangeToMode,
+	},
+	CLUSTER_ID_LaundryWasherMode: {
+		"ChangeToMode": COMMAND_ID_LaundryWasherMode_ChangeToMode,
+	},
+	CLUSTER_ID_RefrigeratorAndTemperatureControlledCabinetMode: {
+		"ChangeToMode": COMMAND_ID_RefrigeratorAndTemperatureControlledCabinetMode_ChangeToMode,
+	},
+	CLUSTER_ID_RVCRunMode: {
+		"ChangeToMode": COMMAND_ID_RVCRunMode_ChangeToMode,
+	},
+	CLUSTER_ID_RVCCleanMode: {
+		"ChangeToMode": COMMAND_ID_RVCCleanMode_ChangeToMode,
+	},
+	CLUSTER_ID_TemperatureControl: {
+		"SetTemperature": COMMAND_ID_TemperatureControl_SetTemperature,
+	},
+	CLUSTER_ID_RefrigeratorAlarm: {
+		"Reset":               COMMAND_ID_RefrigeratorAlarm_Reset,
+		"ModifyEnabledAlarms": COMMAND_ID_RefrigeratorAlarm_ModifyEnabledAlarms,
+	},
+	CLUSTER_ID_DishwasherMode: {
+		"ChangeToMode": COMMAND_ID_DishwasherMode_ChangeToMode,
+	},
+	CLUSTER_ID_SmokeCOAlarm: {
+		"SelfTestRequest": COMMAND_ID_SmokeCOAlarm_SelfTestRequest,
+	},
+	CLUSTER_ID_DishwasherAlarm: {
+		"Reset":               COMMAND_ID_DishwasherAlarm_Reset,
+		"ModifyEnabledAlarms": COMMAND_ID_DishwasherAlarm_ModifyEnabledAlarms,
+	},
+	CLUSTER_ID_MicrowaveOvenMode: {
+		"ChangeToMode": COMMAND_ID_MicrowaveOvenMode_ChangeToMode,
+	},
+	CLUSTER_ID_OperationalState: {
+		"Pause":  COMMAND_ID_OperationalState_Pause,
+		"Stop":   COMMAND_ID_OperationalState_Stop,
+		"Start":  COMMAND_ID_OperationalState_Start,
+		"Resume": COMMAND_ID_OperationalState_Resume,
+	},
+	CLUSTER_ID_RVCOperationalState: {
+		"Pause":  COMMAND_ID_RVCOperationalState_Pause,
+		"Stop":   COMMAND_ID_RVCOperationalState_Stop,
+		"Start":  COMMAND_ID_RVCOperationalState_Start,
+		"Resume": COMMAND_ID_RVCOperationalState_Resume,
+		"GoHome": COMMAND_ID_RVCOperationalState_GoHome,
+	},
+	CLUSTER_ID_BooleanSensorConfiguration: {
+		"SuppressAlarm":      COMMAND_ID_BooleanSensorConfiguration_SuppressAlarm,
+		"EnableDisableAlarm": COMMAND_ID_BooleanSensorConfiguration_EnableDisableAlarm,
+	},
+	CLUSTER_ID_ValveConfigurationandControl: {
+		"Open":  COMMAND_ID_ValveConfigurationandControl_Open,
+		"Close": COMMAND_ID_ValveConfigurationandControl_Close,
+	},
+	CLUSTER_ID_WaterHeaterManagement: {
+		"Boost":       COMMAND_ID_WaterHeaterManagement_Boost,
+		"CancelBoost": COMMAND_ID_WaterHeaterManagement_CancelBoost,
+	},
+	CLUSTER_ID_EnergyPrice: {
+		"GetDetailedPriceRequest":    COMMAND_ID_EnergyPrice_GetDetailedPriceRequest,
+		"GetDetailedForecastRequest": COMMAND_ID_EnergyPrice_GetDetailedForecastRequest,
+	},
+	CLUSTER_ID_DemandResponseandLoadControl: {
+		"RegisterLoadControlProgramRequest":   COMMAND_ID_DemandResponseandLoadControl_RegisterLoadControlProgramRequest,
+		"UnregisterLoadControlProgramRequest": COMMAND_ID_DemandResponseandLoadControl_UnregisterLoadControlProgramRequest,
+		"AddLoadControlEventRequest":          COMMAND_ID_DemandResponseandLoadControl_AddLoadControlEventRequest,
+		"RemoveLoadControlEventRequest":       COMMAND_ID_DemandResponseandLoadControl_RemoveLoadControlEventRequest,
+		"ClearLoadControlEventsRequest":       COMMAND_ID_DemandResponseandLoadControl_ClearLoadControlEventsRequest,
+	},
+	CLUSTER_ID_Messages: {
+		"PresentMessagesRequest": COMMAND_ID_Messages_PresentMessagesRequest,
+		"CancelMessagesRequest":  COMMAND_ID_Messages_CancelMessagesRequest,
+	},
+	CLUSTER_ID_DeviceEnergyManagement: {
+		"PowerAdjustRequest":             COMMAND_ID_DeviceEnergyManagement_PowerAdjustRequest,
+		"CancelPowerAdjustRequest":       COMMAND_ID_DeviceEnergyManagement_CancelPowerAdjustRequest,
+		"StartTimeAdjustRequest":         COMMAND_ID_DeviceEnergyManagement_StartTimeAdjustRequest,
+		"PauseRequest":                   COMMAND_ID_DeviceEnergyManagement_PauseRequest,
+		"ResumeRequest":                  COMMAND_ID_DeviceEnergyManagement_ResumeRequest,
+		"ModifyForecastRequest":          COMMAND_ID_DeviceEnergyManagement_ModifyForecastRequest,
+		"RequestConstraintBasedForecast": COMMAND_ID_DeviceEnergyManagement_RequestConstraintBasedForecast,
+		"CancelRequest":                  COMMAND_ID_DeviceEnergyManagement_CancelRequest,
+	},
+	CLUSTER_ID_DoorLock: {
+		"LockDoor":             COMMAND_ID_DoorLock_LockDoor,
+		"UnlockDoor":           COMMAND_ID_DoorLock_UnlockDoor,
+		"UnlockWithTimeout":    COMMAND_ID_DoorLock_UnlockWithTimeout,
+		"SetWeekDaySchedule":   COMMAND_ID_DoorLock_SetWeekDaySchedule,
+		"GetWeekDaySchedule":   COMMAND_ID_DoorLock_GetWeekDaySchedule,
+		"ClearWeekDaySchedule": COMMAND_ID_DoorLock_ClearWeekDaySchedule,
+		"SetYearDaySchedule":   COMMAND_ID_DoorLock_SetYearDaySchedule,
+		"GetYearDaySchedule":   COMMAND_ID_DoorLock_GetYearDaySchedule,
+		"ClearYearDaySchedule": COMMAND_ID_DoorLock_ClearYearDaySchedule,
+		"SetHolidaySchedule":   COMMAND_ID_DoorLock_SetHolidaySchedule,
+		"GetHolidaySchedule":   COMMAND_ID_DoorLock_GetHolidaySchedule,
+		"ClearHolidaySchedule": COMMAND_ID_DoorLock_ClearHolidaySchedule,
+		"SetUser":              COMMAND_ID_DoorLock_SetUser,
+		"GetUser":              COMMAND_ID_DoorLock_GetUser,
+		"ClearUser":            COMMAND_ID_DoorLock_ClearUser,
+		"SetCredential":        COMMAND_ID_DoorLock_SetCredential,
+		"GetCredentialStatus":  COMMAND_ID_DoorLock_GetCredentialStatus,
+		"ClearCredential":      COMMAND_ID_DoorLock_ClearCredential,
+		"UnboltDoor":           COMMAND_ID_DoorLock_UnboltDoor,
+	},
+	CLUSTER_ID_WindowCovering: {
+		"UpOrOpen":           COMMAND_ID_WindowCovering_UpOrOpen,
+		"DownOrClose":        COMMAND_ID_WindowCovering_DownOrClose,
+		"StopMotion":         COMMAND_ID_WindowCovering_StopMotion,
+		"GoToLiftValue":      COMMAND_ID_WindowCovering_GoToLiftValue,
+		"GoToLiftPercentage": COMMAND_ID_WindowCovering_GoToLiftPercentage,
+		"GoToTiltValue":      COMMAND_ID_WindowCovering_GoToTiltValue,
+		"GoToTiltPercentage": COMMAND_ID_WindowCovering_GoToTiltPercentage,
+	},
+	CLUSTER_ID_Thermostat: {
+		"SetpointRaiseLower":  COMMAND_ID_Thermostat_SetpointRaiseLower,
+		"SetWeeklySchedule":   COMMAND_ID_Thermostat_SetWeeklySchedule,
+		"GetWeeklySchedule":   COMMAND_ID_Thermostat_GetWeeklySchedule,
+		"ClearWeeklySchedule": COMMAND_ID_Thermostat_ClearWeeklySchedule,
+	},
+	CLUSTER_ID_FanControl: {
+		"Step": COMMAND_ID_FanControl_Step,
+	},
+	CLUSTER_ID_ColorControl: {
+		"MoveToHue":                      COMMAND_ID_ColorControl_MoveToHue,
+		"MoveHue":                        COMMAND_ID_ColorControl_MoveHue,
+		"StepHue":                        COMMAND_ID_ColorControl_StepHue,
+		"MoveToSaturation":               COMMAND_ID_ColorControl_MoveToSaturation,
+		"MoveSaturation":                 COMMAND_ID_ColorControl_MoveSaturation,
+		"StepSaturation":                 COMMAND_ID_ColorControl_StepSaturation,
+		"MoveToHueAndSaturation":         COMMAND_ID_ColorControl_MoveToHueAndSaturation,
+		"MoveToColor":                    COMMAND_ID_ColorControl_MoveToColor,
+		"MoveColor":                      COMMAND_ID_ColorControl_MoveColor,
+		"StepColor":                      COMMAND_ID_ColorControl_StepColor,
+		"MoveToColorTemperature":         COMMAND_ID_ColorControl_MoveToColorTemperature,
+		"EnhancedMoveToHue":              COMMAND_ID_ColorControl_EnhancedMoveToHue,
+		"EnhancedMoveHue":                COMMAND_ID_ColorControl_EnhancedMoveHue,
+		"EnhancedStepHue":                COMMAND_ID_ColorControl_EnhancedStepHue,
+		"EnhancedMoveToHueAndSaturation": COMMAND_ID_ColorControl_EnhancedMoveToHueAndSaturation,
+		"ColorLoopSet":                   COMMAND_ID_ColorControl_ColorLoopSet,
+		"StopMoveStep":                   COMMAND_ID_ColorControl_StopMoveStep,
+		"MoveColorTemperature":           COMMAND_ID_ColorControl_MoveColorTemperature,
+		"StepColorTemperature":           COMMAND_ID_ColorControl_StepColorTemperature,
+	},
+	CLUSTER_ID_Channel: {
+		"ChangeChannel":         COMMAND_ID_Channel_ChangeChannel,
+		"ChangeChannelByNumber": COMMAND_ID_Channel_ChangeChannelByNumber,
+		"SkipChannel":           COMMAND_ID_Channel_SkipChannel,
+	},
+	CLUSTER_ID_TargetNavigator: {
+		"NavigateTarget": COMMAND_ID_TargetNavigator_NavigateTarget,
+	},
+	CLUSTER_ID_MediaPlayback: {
+		"Play":         COMMAND_ID_MediaPlayback_Play,
+		"Pause":        COMMAND_ID_MediaPlayback_Pause,
+		"Stop":         COMMAND_ID_MediaPlayback_Stop,
+		"StartOver":    COMMAND_ID_MediaPlayback_StartOver,
+		"Previous":     COMMAND_ID_MediaPlayback_Previous,
+		"Next":         COMMAND_ID_MediaPlayback_Next,
+		"Rewind":       COMMAND_ID_MediaPlayback_Rewind,
+		"FastForward":  COMMAND_ID_MediaPlayback_FastForward,
+		"SkipForward":  COMMAND_ID_MediaPlayback_SkipForward,
+		"SkipBackward": COMMAND_ID_MediaPlayback_SkipBackward,
+		"Seek":         COMMAND_ID_MediaPlayback_Seek,
+	},
+	CLUSTER_ID_MediaInput: {
+		"SelectInput":     COMMAND_ID_MediaInput_SelectInput,
+		"ShowInputStatus": COMMAND_ID_MediaInput_ShowInputStatus,
+		"HideInputStatus": COMMAND_ID_MediaInput_HideInputStatus,
+		"RenameInput":     COMMAND_ID_MediaInput_RenameInput,
+	},
+	CLUSTER_ID_LowPower: {
+		"Sleep": COMMAND_ID_LowPower_Sleep,
+	},
+	CLUSTER_ID_KeypadInput: {
+		"SendKey": COMMAND_ID_KeypadInput_SendKey,
+	},
+	CLUSTER_ID_ContentLauncher: {
+		"LaunchContent": COMMAND_ID_ContentLauncher_LaunchContent,
+		"LaunchURL":     COMMAND_ID_ContentLauncher_LaunchURL,
+	},
+	CLUSTER_ID_AudioOutput: {
+		"SelectOutput": COMMAND_ID_AudioOutput_SelectOutput,
+		"RenameOutput": COMMAND_ID_AudioOutput_RenameOutput,
+	},
+	CLUSTER_ID_ApplicationLauncher: {
+		"LaunchApp": COMMAND_ID_ApplicationLauncher_LaunchApp,
+		"StopApp":   COMMAND_ID_ApplicationLauncher_StopApp,
+		"HideApp":   COMMAND_ID_ApplicationLauncher_HideApp,
+	},
+	CLUSTER_ID_AccountLogin: {
+		"GetSetupPIN": COMMAND_ID_AccountLogin_GetSetupPIN,
+		"Login":       COMMAND_ID_AccountLogin_Login,
+		"Logout":      COMMAND_ID_AccountLogin_Logout,
+	},
+	CLUSTER_ID_MicrowaveOvenControl: {
+		"SetCookingParameters": COMMAND_ID_MicrowaveOvenControl_SetCookingParameters,
+		"AddMoreTime":          COMMAND_ID_MicrowaveOvenControl_AddMoreTime,
+	},
+	CLUSTER_ID_ContentAppObserver: {
+		"ContentAppMessage": COMMAND_ID_ContentAppObserver_ContentAppMessage,
+	},
+}
+
+// EventIdMap maps cluster id and name of event to its id.
+var EventIdMap = map[int]map[string]int{
+	CLUSTER_ID_AccessControl: {
+		"AccessControlEntryChanged":     EVENT_ID_AccessControl_AccessControlEntryChanged,
+		"AccessControlExtensionChanged": EVENT_ID_AccessControl_AccessControlExtensionChanged,
+	},
+	CLUSTER_ID_Actions: {
+		"StateChanged": EVENT_ID_Actions_StateChanged,
+		"ActionFailed": EVENT_ID_Actions_ActionFailed,
+	},
+	CLUSTER_ID_BasicInformation: {
+		"StartUp":          EVENT_ID_BasicInformation_StartUp,
+		"ShutDown":         EVENT_ID_BasicInformation_ShutDown,
+		"Leave":            EVENT_ID_BasicInformation_Leave,
+		"ReachableChanged": EVENT_ID_BasicInformation_ReachableChanged,
+	},
+	CLUSTER_ID_PowerSource: {
+		"WiredFaultChange":     EVENT_ID_PowerSource_WiredFaultChange,
+		"BatFaultChange":       EVENT_ID_PowerSource_BatFaultChange,
+		"BatChargeFaultChange": EVENT_ID_PowerSource_BatChargeFaultChange,
+	},
+	CLUSTER_ID_GeneralDiagnostics: {
+		"HardwareFaultChange": EVENT_ID_GeneralDiagnostics_HardwareFaultChange,
+		"RadioFaultChange":    EVENT_ID_GeneralDiagnostics_RadioFaultChange,
+		"NetworkFaultChange":  EVENT_ID_GeneralDiagnostics_NetworkFaultChange,
+		"BootReason":          EVENT_ID_GeneralDiagnostics_BootReason,
+	},
+	CLUSTER_ID_SoftwareDiagnostics: {
+		"SoftwareFault": EVENT_ID_SoftwareDiagnostics_SoftwareFault,
+	},
+	CLUSTER_ID_ThreadNetworkDiagnostics: {
+		"ConnectionStatus":   EVENT_ID_ThreadNetworkDiagnostics_ConnectionStatus,
+		"NetworkFaultChange": EVENT_ID_ThreadNetworkDiagnostics_NetworkFaultChange,
+	},
+	CLUSTER_ID_WiFiNetworkDiagnostics: {
+		"Disconnection":      EVENT_ID_WiFiNetworkDiagnostics_Disconnection,
+		"AssociationFailure": EVENT_ID_WiFiNetworkDiagnostics_AssociationFailure,
+		"ConnectionStatus":   EVENT_ID_WiFiNetworkDiagnostics_ConnectionStatus,
+	},
+	CLUSTER_ID_TimeSync: {
+		"DSTTableEmpty":            EVENT_ID_TimeSync_DSTTableEmpty,
+		"DSTStatus":                EVENT_ID_TimeSync_DSTStatus,
+		"TimeZoneStatus":           EVENT_ID_TimeSync_TimeZoneStatus,
+		"TimeFailure":              EVENT_ID_TimeSync_TimeFailure,
+		"MissingTrustedTimeSource": EVENT_ID_TimeSync_MissingTrustedTimeSource,
+	},
+	CLUSTER_ID_BridgedDeviceBasicInformation: {
+		"StartUp":          EVENT_ID_BridgedDeviceBasicInformation_StartUp,
+		"ShutDown":         EVENT_ID_BridgedDeviceBasicInformation_ShutDown,
+		"Leave":            EVENT_ID_BridgedDeviceBasicInformation_Leave,
+		"ReachableChanged": EVENT_ID_BridgedDeviceBasicInformation_ReachableChanged,
+	},
+	CLUSTER_ID_Switch: {
+		"SwitchLatched":      EVENT_ID_Switch_SwitchLatched,
+		"InitialPress":       EVENT_ID_Switch_InitialPress,
+		"LongPress":          EVENT_ID_Switch_LongPress,
+		"ShortRelease":       EVENT_ID_Switch_ShortRelease,
+		"LongRelease":        EVENT_ID_Switch_LongRelease,
+		"MultiPressOngoing":  EVENT_ID_Switch_MultiPressOngoing,
+		"MultiPressComplete": EVENT_ID_Switch_MultiPressComplete,
+	},
+	CLUSTER_ID_BooleanState: {
+		"StateChange": EVENT_ID_BooleanState_StateChange,
+	},
+	CLUSTER_ID_OvenCavityOperationalState: {
+		"OperationalError":    EVENT_ID_OvenCavityOperationalState_OperationalError,
+		"OperationCompletion": EVENT_ID_OvenCavityOperationalState_OperationCompletion,
+	},
+	CLUSTER_ID_RefrigeratorAlarm: {
+		"Notify": EVENT_ID_RefrigeratorAlarm_Notify,
+	},
+	CLUSTER_ID_SmokeCOAlarm: {
+		"SmokeAlarm":             EVENT_ID_SmokeCOAlarm_SmokeAlarm,
+		"COAlarm":                EVENT_ID_SmokeCOAlarm_COAlarm,
+		"LowBattery":             EVENT_ID_SmokeCOAlarm_LowBattery,
+		"HardwareFault":          EVENT_ID_SmokeCOAlarm_HardwareFault,
+		"EndOfService":           EVENT_ID_SmokeCOAlarm_EndOfService,
+		"SelfTestComplete":       EVENT_ID_SmokeCOAlarm_SelfTestComplete,
+		"AlarmMuted":             EVENT_ID_SmokeCOAlarm_AlarmMuted,
+		"MuteEnded":              EVENT_ID_SmokeCOAlarm_MuteEnded,
+		"InterconnectSmokeAlarm": EVENT_ID_SmokeCOAlarm_InterconnectSmokeAlarm,
+		"InterconnectCOAlarm":    EVENT_ID_SmokeCOAlarm_InterconnectCOAlarm,
+		"AllClear":               EVENT_ID_SmokeCOAlarm_AllClear,
+	},
+	CLUSTER_ID_DishwasherAlarm: {
+		"Notify": EVENT_ID_DishwasherAlarm_Notify,
+	},
+	CLUSTER_ID_OperationalState: {
+		"OperationalError":    EVENT_ID_OperationalState_OperationalError,
+		"OperationCompletion": EVENT_ID_OperationalState_OperationCompletion,
+	},
+	CLUSTER_ID_RVCOperationalState: {
+		"OperationalError":    EVENT_ID_RVCOperationalState_OperationalError,
+		"OperationCompletion": EVENT_ID_RVCOperationalState_OperationCompletion,
+	},
+	CLUSTER_ID_BooleanSensorConfiguration: {
+		"AlarmsStateChanged": EVENT_ID_BooleanSensorConfiguration_AlarmsStateChanged,
+		"SensorFault":        EVENT_ID_BooleanSensorConfiguration_SensorFault,
+	},
+	CLUSTER_ID_ValveConfigurationandControl: {
+		"ValveStateChanged": EVENT_ID_ValveConfigurationandControl_ValveStateChanged,
+		"ValveFault":        EVENT_ID_ValveConfigurationandControl_ValveFault,
+	},
+	CLUSTER_ID_ElectricalPowerMeasurement: {
+		"MeasurementPeriodRanges": EVENT_ID_ElectricalPowerMeasurement_MeasurementPeriodRanges,
+	},
+	CLUSTER_ID_ElectricalEnergyMeasurement: {
+		"CumulativeEnergyMeasured": EVENT_ID_ElectricalEnergyMeasurement_CumulativeEnergyMeasured,
+		"PeriodicEnergyMeasured":   EVENT_ID_ElectricalEnergyMeasurement_PeriodicEnergyMeasured,
+	},
+	CLUSTER_ID_WaterHeaterManagement: {
+		"BoostStarted": EVENT_ID_WaterHeaterManagement_BoostStarted,
+		"BoostEnded":   EVENT_ID_WaterHeaterManagement_BoostEnded,
+	},
+	CLUSTER_ID_EnergyPrice: {
+		"PriceChange": EVENT_ID_EnergyPrice_PriceChange,
+	},
+	CLUSTER_ID_DemandResponseandLoadControl: {
+		"LoadControlEventStatusChange": EVENT_ID_DemandResponseandLoadControl_LoadControlEventStatusChange,
+	},
+	CLUSTER_ID_Messages: {
+		"MessageQueued":    EVENT_ID_Messages_MessageQueued,
+		"MessagePresented": EVENT_ID_Messages_MessagePresented,
+		"MessageComplete":  EVENT_ID_Messages_MessageComplete,
+	},
+	CLUSTER_ID_DeviceEnergyManagement: {
+		"PowerAdjustStart": EVENT_ID_DeviceEnergyManagement_PowerAdjustStart,
+		"PowerAdjustEnd":   EVENT_ID_DeviceEnergyManagement_PowerAdjustEnd,
+		"Paused":           EVENT_ID_DeviceEnergyManagement_Paused,
+		"Resumed":          EVENT_ID_DeviceEnergyManagement_Resumed,
+	},
+	CLUSTER_ID_DoorLock: {
+		"DoorLockAlarm":      EVENT_ID_DoorLock_DoorLockAlarm,
+		"DoorStateChange":    EVENT_ID_DoorLock_DoorStateChange,
+		"LockOperation":      EVENT_ID_DoorLock_LockOperation,
+		"LockOperationError": EVENT_ID_DoorLock_LockOperationError,
+		"LockUserChange":     EVENT_ID_DoorLock_LockUserChange,
+	},
+	CLUSTER_ID_PumpConfigurationandControl: {
+		"SupplyVoltageLow":          EVENT_ID_PumpConfigurationandControl_SupplyVoltageLow,
+		"SupplyVoltageHigh":         EVENT_ID_PumpConfigurationandControl_SupplyVoltageHigh,
+		"PowerMissingPhase":         EVENT_ID_PumpConfigurationandControl_PowerMissingPhase,
+		"SystemPressureLow":         EVENT_ID_PumpConfigurationandControl_SystemPressureLow,
+		"SystemPressureHigh":        EVENT_ID_PumpConfigurationandControl_SystemPressureHigh,
+		"DryRunning":                EVENT_ID_PumpConfigurationandControl_DryRunning,
+		"MotorTemperatureHigh":      EVENT_ID_PumpConfigurationandControl_MotorTemperatureHigh,
+		"PumpMotorFatalFailure":     EVENT_ID_PumpConfigurationandControl_PumpMotorFatalFailure,
+		"ElectronicTemperatureHigh": EVENT_ID_PumpConfigurationandControl_ElectronicTemperatureHigh,
+		"PumpBlocked":               EVENT_ID_PumpConfigurationandControl_PumpBlocked,
+		"SensorFailure":             EVENT_ID_PumpConfigurationandControl_SensorFailure,
+		"ElectronicNonFatalFailure": EVENT_ID_PumpConfigurationandControl_ElectronicNonFatalFailure,
+		"ElectronicFatalFailure":    EVENT_ID_PumpConfigurationandControl_ElectronicFatalFailure,
+		"GeneralFault":              EVENT_ID_PumpConfigurationandControl_GeneralFault,
+		"Leakage":                   EVENT_ID_PumpConfigurationandControl_Leakage,
+		"AirDetection":              EVENT_ID_PumpConfigurationandControl_AirDetection,
+		"TurbineOperation":          EVENT_ID_PumpConfigurationandControl_TurbineOperation,
+	},
+	CLUSTER_ID_AccountLogin: {
+		"LoggedOut": EVENT_ID_AccountLogin_LoggedOut,
+	},
+}
+
+// ClusterTypesMap holds data types of clusters by cluster id.
+var ClusterTypesMap = map[int]*ClusterTypes{
+	CLUSTER_ID_Identify: {
+		Attributes: map[int]AttributeInfo{
+			0x0000: {Field: Field{Id: 0x0000, Name: "IdentifyTime", Type: "uint16"}, ReadPrivilege: "view", WritePrivilege: "operate", Writable: true},
+			0x0001: {Field: Field{Id: 0x0001, Name: "IdentifyType", Type: "IdentifyTypeEnum"}, ReadPrivilege: "view"},
+		},
+		Commands: map[int]CommandInfo{
+			0x00: {Id: 0x00, Name: "Identify", Response: ResponseStatus, Fields: []Field{
+				{Id: 0, Name: "IdentifyTime", Type: "uint16"},
+			}, InvokePrivilege: "manage"},
+			0x40: {Id: 0x40, Name: "TriggerEffect", Response: ResponseStatus, Fields: []Field{
+				{Id: 0, Name: "EffectIdentifier", Type: "EffectIdentifierEnum"},
+				{Id: 1, Name: "EffectVariant", Type: "EffectVariantEnum"},
+			}, InvokePrivilege: "manage"},
+		},
+		Enums: map[string]EnumInfo{
+			"IdentifyTypeEnum":     {0: "None", 1: "LightOutput", 2: "VisibleIndicator", 3: "AudibleBeep", 4: "Display", 5: "Actuator"},
+			"EffectIdentifierEnum": {0: "Blink", 1: "Breathe", 2: "Okay", 11: "ChannelChange", 254: "FinishEffect", 255: "StopEffect"},
+			"EffectVariantEnum":    {0: "Default"},
+		},
+	},
+	CLUSTER_ID_Groups: {
+		Attributes: map[int]AttributeInfo{
+			0x0000: {Field: Field{Id: 0x0000, Name: "NameSupport", Type: "NameSupportBitmap"}, ReadPrivilege: "view"},
+		},
+		Commands: map[int]CommandInfo{
+			0x00: {Id: 0x00, Name: "AddGroup", Response: 0x00, Fields: []Field{
+				{Id: 0, Name: "GroupID", Type: "group-id"},
+				{Id: 1, Name: "GroupName", Type: "string"},
+			}, InvokePrivilege: "manage", FabricScoped: true},
+			0x01: {Id: 0x01, Name: "ViewGroup", Response: 0x01, Fields: []Field{
+				{Id: 0, Name: "GroupID", Type: "group-id"},
+			}, InvokePrivilege: "operate", FabricScoped: true},
+			0x02: {Id: 0x02, Name: "GetGroupMembership", Response: 0x02, Fields: []Field{
+				{Id: 0, Name: "GroupList", Type: "group-id", List: true},
+			}, InvokePrivilege: "operate", FabricScoped: true},
+			0x03: {Id: 0x03, Name: "RemoveGroup", Response: 0x03, Fields: []Field{
+				{Id: 0, Name: "GroupID", Type: "group-id"},
+			}, InvokePrivilege: "manage", FabricScoped: true},
+			0x04: {Id: 0x04, Name: "RemoveAllGroups", Response: ResponseStatus, InvokePrivilege: "manage", FabricScoped: true},
+			0x05: {Id: 0x05, Name: "AddGroupIfIdentifying", Response: ResponseStatus, Fields: []Field{
+				{Id: 0, Name: "GroupID", Type: "group-id"},
+				{Id: 1, Name: "GroupName", Type: "string"},
+			}, InvokePrivilege: "manage", FabricScoped: true},
+		},
+		Responses: map[int]CommandInfo{
+			0x00: {Id: 0x00, Name: "AddGroupResponse", Response: ResponseNone, Fields: []Field{
+				{Id: 0, Name: "Status", Type: "status"},
+				{Id: 1, Name: "GroupID", Type: "group-id"},
+			}},
+			0x01: {Id: 0x01, Name: "ViewGroupResponse", Response: ResponseNone, Fields: []Field{
+				{Id: 0, Name: "Status", Type: "status"},
+				{Id: 1, Name: "GroupID", Type: "group-id"},
+				{Id: 2, Name: "GroupName", Type: "string"},
+			}},
+			0x02: {Id: 0x02, Name: "GetGroupMembershipResponse", Response: ResponseNone, Fields: []Field{
+				{Id: 0, Name: "Capacity", Type: "uint8", Nullable: true},
+				{Id: 1, Name: "GroupList", Type: "group-id", List: true},
+			}},
+			0x03: {Id: 0x03, Name: "RemoveGroupResponse", Response: ResponseNone, Fields: []Field{
+				{Id: 0, Name: "Status", Type: "status"},
+				{Id: 1, Name: "GroupID", Type: "group-id"},
+			}},
+		},
+		Bitmaps: map[string]BitmapInfo{
+			"NameSupportBitmap": {7: "GroupNames"},
+		},
+	},
+	CLUSTER_ID_Scenes: {
+		Attributes: map[int]AttributeInfo{
+			0x0000: {Field: Field{Id: 0x0000, Name: "SceneCount", Type: "uint8"}, ReadPrivilege: "view"},
+			0x0001: {Field: Field{Id: 0x0001, Name: "CurrentScene", Type: "uint8"}, ReadPrivilege: "view"},
+			0x0002: {Field: Field{Id: 0x0002, Name: "CurrentGroup", Type: "group-id"}, ReadPrivilege: "view"},
+			0x0003: {Field: Field{Id: 0x0003, Name: "SceneValid", Type: "bool"}, ReadPrivilege: "view"},
+			0x0004: {Field: Field{Id: 0x0004, Name: "NameSupport", Type: "NameSupportBitmap"}, ReadPrivilege: "view"},
+			0x0005: {Field: Field{Id: 0x0005, Name: "LastConfiguredBy", Type: "node-id", Nullable: true}, ReadPrivilege: "view"},
+			0x0006: {Field: Field{Id: 0x0006, Name: "SceneTableSize", Type: "uint16"}, ReadPrivilege: "view"},
+			0x0007: {Field: Field{Id: 0x0007, Name: "FabricSceneInfo", Type: "SceneInfoStruct", List: true}, ReadPrivilege: "view", FabricScoped: true},
+		},
+		Commands: map[int]CommandInfo{
+			0x00: {Id: 0x00, Name: "AddScene", Response: 0x00, Fields: []Field{
+				{Id: 0, Name: "GroupID", Type: "group-id"},
+				{Id: 1, Name: "SceneID", Type: "uint8"},
+				{Id: 2, Name: "TransitionTime", Type: "uint16"},
+				{Id: 3, Name: "SceneName", Type: "string"},
+				{Id: 4, Name: "ExtensionFieldSets", Type: "ExtensionFieldSet", List: true},
+			}, InvokePrivilege: "manage", FabricScoped: true},
+			0x01: {Id: 0x01, Name: "ViewScene", Response: 0x01, Fields: []Field{
+				{Id: 0, Name: "GroupID", Type: "group-id"},
+				{Id: 1, Name: "SceneID", Type: "uint8"},
+			}, InvokePrivilege: "operate", FabricScoped: true},
+			0x02: {Id: 0x02, Name: "RemoveScene", Response: 0x02, Fields: []Field{
+				{Id: 0, Name: "GroupID", Type: "group-id"},
+				{Id: 1, Name: "SceneID", Type: "uint8"},
+			}, InvokePrivilege: "manage", FabricScoped: true},
+			0x03: {Id: 0x03, Name: "RemoveAllScenes", Response: 0x03, Fields: []Field{
+				{Id: 0, Name: "GroupID", Type: "group-id"},
+			}, InvokePrivilege: "manage", FabricScoped: true},
+			0x04: {Id: 0x04, Name: "StoreScene", Response: 0x04, Fields: []Field{
+				{Id: 0, Name: "GroupID", Type: "group-id"},
+				{Id: 1, Name: "SceneID", Type: "uint8"},
+			}, InvokePrivilege: "manage", FabricScoped: true},
+			0x05: {Id: 0x05, Name: "RecallScene", Response: ResponseStatus, Fields: []Field{
+				{Id: 0, Name: "GroupID", Type: "group-id"},
+				{Id: 1, Name: "SceneID", Type: "uint8"},
+				{Id: 2, Name: "TransitionTime", Type: "uint16", Nullable: true},
+			}, InvokePrivilege: "operate", FabricScoped: true},
+			0x06: {Id: 0x06, Name: "GetSceneMembership", Response: 0x06, Fields: []Field{
+				{Id: 0, Name: "GroupID", Type: "group-id"},
+			}, InvokePrivilege: "operate", FabricScoped: true},
+			0x40: {Id: 0x40, Name: "EnhancedAddScene", Response: 0x40, Fields: []Field{
+				{Id: 0, Name: "GroupID", Type: "group-id"},
+				{Id: 1, Name: "SceneID", Type: "uint8"},
+				{Id: 2, Name: "TransitionTime", Type: "uint16"},
+				{Id: 3, Name: "SceneName", Type: "string"},
+				{Id: 4, Name: "ExtensionFieldSets", Type: "ExtensionFieldSet", List: true},
+			}, InvokePrivilege: "manage", FabricScoped: true},
+			0x41: {Id: 0x41, Name: "EnhancedViewScene", Response: 0x41, Fields: []Field{
+				{Id: 0, Name: "GroupID", Type: "group-id"},
+				{Id: 1, Name: "SceneID", Type: "uint8"},
+			}, InvokePrivilege: "operate", FabricScoped: true},
+			0x42: {Id: 0x42, Name: "CopyScene", Response: 0x42, Fields: []Field{
+				{Id: 0, Name: "Mode", Type: "CopyModeBitmap"},
+				{Id: 1, Name: "GroupIdentifierFrom", Type: "group-id"},
+				{Id: 2, Name: "SceneIdentifierFrom", Type: "uint8"},
+				{Id: 3, Name: "GroupIdentifierTo", Type: "group-id"},
+				{Id: 4, Name: "SceneIdentifierTo", Type: "uint8"},
+			}, InvokePrivilege: "manage", FabricScoped: true},
+		},
+		Responses: map[int]CommandInfo{
+			0x00: {Id: 0x00, Name: "AddSceneResponse", Response: ResponseNone, Fields: []Field{
+				{Id: 0, Name: "Status", Type: "status"},
+				{Id: 1, Name: "GroupID", Type: "group-id"},
+				{Id: 2, Name: "SceneID", Type: "uint8"},
+			}},
+			0x01: {Id: 0x01, Name: "ViewSceneResponse", Response: ResponseNone, Fields: []Field{
+				{Id: 0, Name: "Status", Type: "status"},
+				{Id: 1, Name: "GroupID", Type: "group-id"},
+				{Id: 2, Name: "SceneID", Type: "uint8"},
+				{Id: 3, Name: "TransitionTime", Type: "uint16"},
+				{Id: 4, Name: "SceneName", Type: "string"},
+				{Id: 5, Name: "ExtensionFieldSets", Type: "ExtensionFieldSet", List: true},
+			}},
+			0x02: {Id: 0x02, Name: "RemoveSceneResponse", Response: ResponseNone, Fields: []Field{
+				{Id: 0, Name: "Status", Type: "status"},
+				{Id: 1, Name: "GroupID", Type: "group-id"},
+				{Id: 2, Name: "SceneID", Type: "uint8"},
+			}},
+			0x03: {Id: 0x03, Name: "RemoveAllScenesResponse", Response: ResponseNone, Fields: []Field{
+				{Id: 0, Name: "Status", Type: "status"},
+				{Id: 1, Name: "GroupID", Type: "group-id"},
+			}},
+			0x04: {Id: 0x04, Name: "StoreSceneResponse", Response: ResponseNone, Fields: []Field{
+				{Id: 0, Name: "Status", Type: "status"},
+				{Id: 1, Name: "GroupID", Type: "group-id"},
+				{Id: 2, Name: "SceneID", Type: "uint8"},
+			}},
+			0x06: {Id: 0x06, Name: "GetSceneMembershipResponse", Response: ResponseNone, Fields: []Field{
+				{Id: 0, Name: "Status", Type: "status"},
+				{Id: 1, Name: "Capacity", Type: "uint8", Nullable: true},
+				{Id: 2, Name: "GroupID", Type: "group-id"},
+				{Id: 3, Name: "SceneList", Type: "uint8", List: true},
+			}},
+			0x40: {Id: 0x40, Name: "EnhancedAddSceneResponse", Response: ResponseNone, Fields: []Field{
+				{Id: 0, Name: "Status", Type: "status"},
+				{Id: 1, Name: "GroupID", Type: "group-id"},
+				{Id: 2, Name: "SceneID", Type: "uint8"},
+			}},
+			0x41: {Id: 0x41, Name: "EnhancedViewSceneResponse", Response: ResponseNone, Fields: []Field{
+				{Id: 0, Name: "Status", Type: "status"},
+				{Id: 1, Name: "GroupID", Type: "group-id"},
+				{Id: 2, Name: "SceneID", Type: "uint8"},
+				{Id: 3, Name: "TransitionTime", Type: "uint16"},
+				{Id: 4, Name: "SceneName", Type: "string"},
+				{Id: 5, Name: "ExtensionFieldSets", Type: "ExtensionFieldSet", List: true},
+			}},
+			0x42: {Id: 0x42, Name: "CopySceneResponse", Response: ResponseNone, Fields: []Field{
+				{Id: 0, Name: "Status", Type: "status"},
+				{Id: 1, Name: "GroupIdentifierFrom", Type: "group-id"},
+				{Id: 2, Name: "SceneIdentifierFrom", Type: "uint8"},
+			}},
+		},
+		Bitmaps: map[string]BitmapInfo{
+			"NameSupportBitmap": {7: "SceneNames"},
+			"CopyModeBitmap":    {0: "CopyAllScenes"},
+		},
+		Structs: map[string]StructInfo{
+			"AttributeValuePair": {Fields: []Field{
+				{Id: 0, Name: "AttributeID", Type: "attrib-id"},
+				{Id: 1, Name: "AttributeValue", Type: "uint32"},
+			}},
+			"ExtensionFieldSet": {Fields: []Field{
+				{Id: 0, Name: "ClusterID", Type: "cluster-id"},
+				{Id: 1, Name: "AttributeValueList", Type: "AttributeValuePair", List: true},
+			}},
+			"SceneInfoStruct": {Fields: []Field{
+				{Id: 0, Name: "SceneCount", Type: "uint8"},
+				{Id: 1, Name: "CurrentScene", Type: "uint8"},
+				{Id: 2, Name: "CurrentGroup", Type: "group-id"},
+				{Id: 3, Name: "SceneValid", Type: "bool"},
+				{Id: 4, Name: "RemainingCapacity", Type: "uint8"},
+				{Id: 254, Name: "FabricIndex", Type: "fabric-idx"},
+			}, FabricScoped: true},
+		},
+	},
+	CLUSTER_ID_OnOff: {
+		Attributes: map[int]AttributeInfo{
+			0x0000: {Field: Field{Id: 0x0000, Name: "OnOff", Type: "bool"}, ReadPrivilege: "view"},
+			0x4000: {Field: Field{Id: 0x4000, Name: "GlobalSceneControl", Type: "bool"}, ReadPrivilege: "view"},
+			0x4001: {Field: Field{Id: 0x4001, Name: "OnTime", Type: "uint16"}, ReadPrivilege: "view", WritePrivilege: "operate", Writable: true},
+			0x4002: {Field: Field{Id: 0x4002, Name: "OffWaitTime", Type: "uint16"}, ReadPrivilege: "view", WritePrivilege: "operate", Writable: true},
+			0x4003: {Field: Field{Id: 0x4003, Name: "StartUpOnOff", Type: "StartUpOnOffEnum", Nullable: true}, ReadPrivilege: "view", WritePrivilege: "manage", Writable: true},
+		},
+		Commands: map[int]CommandInfo{
+			0x00: {Id: 0x00, Name: "Off", Response: ResponseStatus, InvokePrivilege: "operate"},
+			0x01: {Id: 0x01, Name: "On", Response: ResponseStatus, InvokePrivilege: "operate"},
+			0x02: {Id: 0x02, Name: "Toggle", Response: ResponseStatus, InvokePrivilege: "operate"},
+			0x40: {Id: 0x40, Name: "OffWithEffect", Response: ResponseStatus, Fields: []Field{
+				{Id: 0, Name: "EffectIdentifier", Type: "EffectIdentifierEnum"},
+				{Id: 1, Name: "EffectVariant", Type: "enum8"},
+			}, InvokePrivilege: "operate"},
+			0x41: {Id: 0x41, Name: "OnWithRecallGlobalScene", Response: ResponseStatus, InvokePrivilege: "operate"},
+			0x42: {Id: 0x42, Name: "OnWithTimedOff", Response: ResponseStatus, Fields: []Field{
+				{Id: 0, Name: "OnOffControl", Type: "OnOffControlBitmap"},
+				{Id: 1, Name: "OnTime", Type: "uint16"},
+				{Id: 2, Name: "OffWaitTime", Type: "uint16"},
+			}, InvokePrivilege: "operate"},
+		},
+		Enums: map[string]EnumInfo{
+			"StartUpOnOffEnum":               {0: "Off", 1: "On", 2: "Toggle"},
+			"EffectIdentifierEnum":           {0: "DelayedAllOff", 1: "DyingLight"},
+			"DelayedAllOffEffectVariantEnum": {0: "DelayedOffFastFade", 1: "NoFade", 2: "DelayedOffSlowFade"},
+			"DyingLightEffectVariantEnum":    {0: "DyingLightFadeOff"},
+		},
+		Bitmaps: map[string]BitmapInfo{
+			"OnOffControlBitmap": {0: "AcceptOnlyWhenOn"},
+		},
+	},
+	CLUSTER_ID_LevelControl: {
+		Attributes: map[int]AttributeInfo{
+			0x0000: {Field: Field{Id: 0x0000, Name: "CurrentLevel", Type: "uint8", Nullable: true}, ReadPrivilege: "view"},
+			0x0001: {Field: Field{Id: 0x0001, Name: "RemainingTime", Type: "uint16"}, ReadPrivilege: "view"},
+			0x0002: {Field: Field{Id: 0x0002, Name: "MinLevel", Type: "uint8"}, ReadPrivilege: "view"},
+			0x0003: {Field: Field{Id: 0x0003, Name: "MaxLevel", Type: "uint8"}, ReadPrivilege: "view"},
+			0x0004: {Field: Field{Id: 0x0004, Name: "CurrentFrequency", Type: "uint16"}, ReadPrivilege: "view"},
+			0x0005: {Field: Field{Id: 0x0005, Name: "MinFrequency", Type: "uint16"}, ReadPrivilege: "view"},
+			0x0006: {Field: Field{Id: 0x0006, Name: "MaxFrequency", Type: "uint16"}, ReadPrivilege: "view"},
+			0x000f: {Field: Field{Id: 0x000f, Name: "Options", Type: "OptionsBitmap"}, ReadPrivilege: "view", WritePrivilege: "operate", Writable: true},
+			0x0010: {Field: Field{Id: 0x0010, Name: "OnOffTransitionTime", Type: "uint16"}, ReadPrivilege: "view", WritePrivilege: "operate", Writable: true},
+			0x0011: {Field: Field{Id: 0x0011, Name: "OnLevel", Type: "uint8", Nullable: true}, ReadPrivilege: "view", WritePrivilege: "operate", Writable: true},
+			0x0012: {Field: Field{Id: 0x0012, Name: "OnTransitionTime", Type: "uint16", Nullable: true}, ReadPrivilege: "view", WritePrivilege: "operate", Writable: true},
+			0x0013: {Field: Field{Id: 0x0013, Name: "OffTransitionTime", Type: "uint16", Nullable: true}, ReadPrivilege: "view", WritePrivilege: "operate", Writable: true},
+			0x0014: {Field: Field{Id: 0x0014, Name: "DefaultMoveRate", Type: "uint8", Nullable: true}, ReadPrivilege: "view", WritePrivilege: "operate", Writable: true},
+			0x4000: {Field: Field{Id: 0x4000, Name: "StartUpCurrentLevel", Type: "uint8", Nullable: true}, ReadPrivilege: "view", WritePrivilege: "manage", Writable: true},
+		},
+		Commands: map[int]CommandInfo{
+			0x00: {Id: 0x00, Name: "MoveToLevel", Response: ResponseStatus, Fields: []Field{
+				{Id: 0, Name: "Level", Type: "uint8"},
+				{Id: 1, Name: "TransitionTime", Type: "uint16", Nullable: true},
+				{Id: 2, Name: "OptionsMask", Type: "OptionsBitmap"},
+				{Id: 3, Name: "OptionsOverride", Type: "OptionsBitmap"},
+			}, InvokePrivilege: "operate"},
+			0x01: {Id: 0x01, Name: "Move", Response: ResponseStatus, Fields: []Field{
+				{Id: 0, Name: "MoveMode", Type: "MoveModeEnum"},
+				{Id: 1, Name: "Rate", Type: "uint8", Nullable: true},
+				{Id: 2, Name: "OptionsMask", Type: "OptionsBitmap"},
+				{Id: 3, Name: "OptionsOverride", Type: "OptionsBitmap"},
+			}, InvokePrivilege: "operate"},
+			0x02: {Id: 0x02, Name: "Step", Response: ResponseStatus, Fields: []Field{
+				{Id: 0, Name: "StepMode", Type: "StepModeEnum"},
+				{Id: 1, Name: "StepSize", Type: "uint8"},
+				{Id: 2, Name: "TransitionTime", Type: "uint16", Nullable: true},
+				{Id: 3, Name: "OptionsMask", Type: "OptionsBitmap"},
+				{Id: 4, Name: "OptionsOverride", Type: "OptionsBitmap"},
+			}, InvokePrivilege: "operate"},
+			0x03: {Id: 0x03, Name: "Stop", Response: ResponseStatus, Fields: []Field{
+				{Id: 0, Name: "OptionsMask", Type: "OptionsBitmap"},
+				{Id: 1, Name: "OptionsOverride", Type: "OptionsBitmap"},
+			}, InvokePrivilege: "operate"},
+			0x04: {Id: 0x04, Name: "MoveToLevelWithOnOff", Response: ResponseStatus, Fields: []Field{
+				{Id: 0, Name: "Level", Type: "uint8"},
+				{Id: 1, Name: "TransitionTime", Type: "uint16", Nullable: true},
+				{Id: 2, Name: "OptionsMask", Type: "OptionsBitmap"},
+				{Id: 3, Name: "OptionsOverride", Type: "OptionsBitmap"},
+			}, InvokePrivilege: "operate"},
+			0x05: {Id: 0x05, Name: "MoveWithOnOff", Response: ResponseStatus, Fields: []Field{
+				{Id: 0, Name: "MoveMode", Type: "MoveModeEnum"},
+				{Id: 1, Name: "Rate", Type: "uint8", Nullable: true},
+				{Id: 2, Name: "OptionsMask", Type: "OptionsBitmap"},
+				{Id: 3, Name: "OptionsOverride", Type: "OptionsBitmap"},
+			}, InvokePrivilege: "operate"},
+			0x06: {Id: 0x06, Name: "StepWithOnOff", Response: ResponseStatus, Fields: []Field{
+				{Id: 0, Name: "StepMode", Type: "StepModeEnum"},
+				{Id: 1, Name: "StepSize", Type: "uint8"},
+				{Id: 2, Name: "TransitionTime", Type: "uint16", Nullable: true},
+				{Id: 3, Name: "OptionsMask", Type: "OptionsBitmap"},
+				{Id: 4, Name: "OptionsOverride", Type: "OptionsBitmap"},
+			}, InvokePrivilege: "operate"},
+			0x07: {Id: 0x07, Name: "StopWithOnOff", Response: ResponseStatus, Fields: []Field{
+				{Id: 0, Name: "OptionsMask", Type: "OptionsBitmap"},
+				{Id: 1, Name: "OptionsOverride", Type: "OptionsBitmap"},
+			}, InvokePrivilege: "operate"},
+			0x08: {Id: 0x08, Name: "MoveToClosestFrequency", Response: ResponseStatus, Fields: []Field{
+				{Id: 0, Name: "Frequency", Type: "uint16"},
+			}, InvokePrivilege: "operate"},
+		},
+		Enums: map[string]EnumInfo{
+			"MoveModeEnum": {0: "Up", 1: "Down"},
+			"StepModeEnum": {0: "Up", 1: "Down"},
+		},
+		Bitmaps: map[string]BitmapInfo{
+			"OptionsBitmap": {0: "ExecuteIfOff", 1: "CoupleColorTempToLevel"},
+		},
+	},
+	CLUSTER_ID_Descriptor: {
+		Attributes: map[int]AttributeInfo{
+			0x0000: {Field: Field{Id: 0x0000, Name: "DeviceTypeList", Type: "DeviceTypeStruct", List: true}, ReadPrivilege: "view"},
+			0x0001: {Field: Field{Id: 0x0001, Name: "ServerList", Type: "cluster-id", List: true}, ReadPrivilege: "view"},
+			0x0002: {Field: Field{Id: 0x0002, Name: "ClientList", Type: "cluster-id", List: true}, ReadPrivilege: "view"},
+			0x0003: {Field: Field{Id: 0x0003, Name: "PartsList", Type: "endpoint-no", List: true}, ReadPrivilege: "view"},
+			0x0004: {Field: Field{Id: 0x0004, Name: "TagList", Type: "SemanticTagStruct", List: true}, ReadPrivilege: "view"},
+		},
+		Structs: map[string]StructInfo{
+			"DeviceTypeStruct": {Fields: []Field{
+				{Id: 0, Name: "DeviceType", Type: "devtype-id"},
+				{Id: 1, Name: "Revision", Type: "uint16"},
+			}},
+			"SemanticTagStruct": {Fields: []Field{
+				{Id: 0, Name: "MfgCode", Type: "vendor-id", Nullable: true},
+				{Id: 1, Name: "NamespaceID", Type: "namespace"},
+				{Id: 2, Name: "Tag", Type: "tag"},
+				{Id: 3, Name: "Label", Type: "string", Nullable: true},
+			}},
+		},
+	},
+	CLUSTER_ID_Binding: {
+		Attributes: map[int]AttributeInfo{
+			0x0000: {Field: Field{Id: 0x0000, Name: "Binding", Type: "TargetStruct", List: true}, ReadPrivilege: "view", WritePrivilege: "manage", Writable: true, FabricScoped: true},
+		},
+		Structs: map[string]StructInfo{
+			"TargetStruct": {Fields: []Field{
+				{Id: 1, Name: "Node", Type: "node-id"},
+				{Id: 2, Name: "Group", Type: "group-id"},
+				{Id: 3, Name: "Endpoint", Type: "endpoint-no"},
+				{Id: 4, Name: "Cluster", Type: "cluster-id"},
+				{Id: 254, Name: "FabricIndex", Type: "fabric-idx"},
+			}, FabricScoped: true},
+		},
+	},
+	CLUSTER_ID_AccessControl: {
+		Attributes: map[int]AttributeInfo{
+			0x0000: {Field: Field{Id: 0x0000, Name: "ACL", Type: "AccessControlEntryStruct", List: true}, ReadPrivilege: "administer", WritePrivilege: "administer", Writable: true, FabricScoped: true},
+			0x0001: {Field: Field{Id: 0x0001, Name: "Extension", Type: "AccessControlExtensionStruct", List: true}, ReadPrivilege: "administer", WritePrivilege: "administer", Writable: true, FabricScoped: true},
+			0x0002: {Field: Field{Id: 0x0002, Name: "SubjectsPerAccessControlEntry", Type: "uint16"}, ReadPrivilege: "view"},
+			0x0003: {Field: Field{Id: 0x0003, Name: "TargetsPerAccessControlEntry", Type: "uint16"}, ReadPrivilege: "view"},
+			0x0004: {Field: Field{Id: 0x0004, Name: "AccessControlEntriesPerFabric", Type: "uint16"}, ReadPrivilege: "view"},
+		},
+		Events: map[int]EventInfo{
+			0x00: {Name: "AccessControlEntryChanged", Fields: []Field{
+				{Id: 1, Name: "AdminNodeID", Type: "node-id", Nullable: true},
+				{Id: 2, Name: "AdminPasscodeID", Type: "uint16", Nullable: true},
+				{Id: 3, Name: "ChangeType", Type: "ChangeTypeEnum"},
+				{Id: 4, Name: "LatestValue", Type: "AccessControlEntryStruct", Nullable: true},
+				{Id: 254, Name: "FabricIndex", Type: "fabric-idx"},
+			}, Priority: "info", FabricSensitive: true},
+			0x01: {Name: "AccessControlExtensionChanged", Fields: []Field{
+				{Id: 1, Name: "AdminNodeID", Type: "node-id", Nullable: true},
+				{Id: 2, Name: "AdminPasscodeID", Type: "uint16", Nullable: true},
+				{Id: 3, Name: "ChangeType", Type: "ChangeTypeEnum"},
+				{Id: 4, Name: "LatestValue", Type: "AccessControlExtensionStruct", Nullable: true},
+				{Id: 254, Name: "FabricIndex", Type: "fabric-idx"},
+			}, Priority: "info", FabricSensitive: true},
+		},
+		Enums: map[string]EnumInfo{
+			"ChangeTypeEnum":                  {0: "Changed", 1: "Added", 2: "Removed"},
+			"AccessControlEntryPrivilegeEnum": {1: "View", 2: "ProxyView", 3: "Operate", 4: "Manage", 5: "Administer"},
+			"AccessControlEntryAuthModeEnum":  {1: "PASE", 2: "CASE", 3: "Group"},
+		},
+		Structs: map[string]StructInfo{
+			"AccessControlTargetStruct": {Fields: []Field{
+				{Id: 0, Name: "Cluster", Type: "cluster-id", Nullable: true},
+				{Id: 1, Name: "Endpoint", Type: "endpoint-no", Nullable: true},
+				{Id: 2, Name: "DeviceType", Type: "devtype-id", Nullable: true},
+			}},
+			"AccessControlEntryStruct": {Fields: []Field{
+				{Id: 1, Name: "Privilege", Type: "AccessControlEntryPrivilegeEnum"},
+				{Id: 2, Name: "AuthMode", Type: "AccessControlEntryAuthModeEnum"},
+				{Id: 3, Name: "Subjects", Type: "subject-id", List: true, Nullable: true},
+				{Id: 4, Name: "Targets", Type: "AccessControlTargetStruct", List: true, Nullable: true},
+				{Id: 254, Name: "FabricIndex", Type: "fabric-idx"},
+			}, FabricScoped: true},
+			"AccessControlExtensionStruct": {Fields: []Field{
+				{Id: 1, Name: "Data", Type: "octstr"},
+				{Id: 254, Name: "FabricIndex", Type: "fabric-idx"},
+			}, FabricScoped: true},
+		},
+	},
+	CLUSTER_ID_Actions: {
+		Attributes: map[int]AttributeInfo{
+			0x0000: {Field: Field{Id: 0x0000, Name: "ActionList", Type: "ActionStruct", List: true}, ReadPrivilege: "view"},
+			0x0001: {Field: Field{Id: 0x0001, Name: "EndpointLists", Type: "EndpointListStruct", List: true}, ReadPrivilege: "view"},
+			0x0002: {Field: Field{Id: 0x0002, Name: "SetupURL", Type: "string"}, ReadPrivilege: "view"},
+		},
+		Commands: map[int]CommandInfo{
+			0x00: {Id: 0x00, Name: "InstantAction", Response: ResponseStatus, Fields: []Field{
+				{Id: 0, Name: "ActionID", Type: "uint16"},
+				{Id: 1, Name: "InvokeID", Type: "uint32"},
+			}, InvokePrivilege: "operate"},
+			0x01: {Id: 0x01, Name: "InstantActionWithTransition", Response: ResponseStatus, Fields: []Field{
+				{Id: 0, Name: "ActionID", Type: "uint16"},
+				{Id: 1, Name: "InvokeID", Type: "uint32"},
+				{Id: 2, Name: "TransitionTime", Type: "uint16"},
+			}, InvokePrivilege: "operate"},
+			0x02: {Id: 0x02, Name: "StartAction", Response: ResponseStatus, Fields: []Field{
+				{Id: 0, Name: "ActionID", Type: "uint16"},
+				{Id: 1, Name: "InvokeID", Type: "uint32"},
+			}, InvokePrivilege: "operate"},
+			0x03: {Id: 0x03, Name: "StartActionWithDuration", Response: ResponseStatus, Fields: []Field{
+				{Id: 0, Name: "ActionID", Type: "uint16"},
+				{Id: 1, Name: "InvokeID", Type: "uint32"},
+				{Id: 2, Name: "Duration", Type: "uint32"},
+			}, InvokePrivilege: "operate"},
+			0x04: {Id: 0x04, Name: "StopAction", Response: ResponseStatus, Fields: []Field{
+				{Id: 0, Name: "ActionID", Type: "uint16"},
+				{Id: 1, Name: "InvokeID", Type: "uint32"},
+			}, InvokePrivilege: "operate"},
+			0x05: {Id: 0x05, Name: "PauseAction", Response: ResponseStatus, Fields: []Field{
+				{Id: 0, Name: "ActionID", Type: "uint16"},
+				{Id: 1, Name: "InvokeID", Type: "uint32"},
+			}, InvokePrivilege: "operate"},
+			0x06: {Id: 0x06, Name: "PauseActionWithDuration", Response: ResponseStatus, Fields: []Field{
+				{Id: 0, Name: "ActionID", Type: "uint16"},
+				{Id: 1, Name: "InvokeID", Type: "uint32"},
+				{Id: 2, Name: "Duration", Type: "uint32"},
+			}, InvokePrivilege: "operate"},
+			0x07: {Id: 0x07, Name: "ResumeAction", Response: ResponseStatus, Fields: []Field{
+				{Id: 0, Name: "ActionID", Type: "uint16"},
+				{Id: 1, Name: "InvokeID", Type: "uint32"},
+			}, InvokePrivilege: "operate"},
+			0x08: {Id: 0x08, Name: "EnableAction", Response: ResponseStatus, Fields: []Field{
+				{Id: 0, Name: "ActionID", Type: "uint16"},
+				{Id: 1, Name: "InvokeID", Type: "uint32"},
+			}, InvokePrivilege: "operate"},
+			0x09: {Id: 0x09, Name: "EnableActionWithDuration", Response: ResponseStatus, Fields: []Field{
+				{Id: 0, Name: "ActionID", Type: "uint16"},
+				{Id: 1, Name: "InvokeID", Type: "uint32"},
+				{Id: 2, Name: "Duration", Type: "uint32"},
+			}, InvokePrivilege: "operate"},
+			0x0a: {Id: 0x0a, Name: "DisableAction", Response: ResponseStatus, Fields: []Field{
+				{Id: 0, Name: "ActionID", Type: "uint16"},
+				{Id: 1, Name: "InvokeID", Type: "uint32"},
+			}, InvokePrivilege: "operate"},
+			0x0b: {Id: 0x0b, Name: "DisableActionWithDuration", Response: ResponseStatus, Fields: []Field{
+				{Id: 0, Name: "ActionID", Type: "uint16"},
+				{Id: 1, Name: "InvokeID", Type: "uint32"},
+				{Id: 2, Name: "Duration", Type: "uint32"},
+			}, InvokePrivilege: "operate"},
+		},
+		Events: map[int]EventInfo{
+			0x00: {Name: "StateChanged", Fields: []Field{
+				{Id: 0, Name: "ActionID", Type: "uint16"},
+				{Id: 1, Name: "InvokeID", Type: "uint32"},
+				{Id: 2, Name: "NewState", Type: "ActionStateEnum"},
+			}, Priority: "info"},
+			0x01: {Name: "ActionFailed", Fields: []Field{
+				{Id: 0, Name: "ActionID", Type: "uint16"},
+				{Id: 1, Name: "InvokeID", Type: "uint32"},
+				{Id: 2, Name: "NewState", Type: "ActionStateEnum"},
+				{Id: 3, Name: "Error", Type: "ActionErrorEnum"},
+			}, Priority: "info"},
+		},
+		Enums: map[string]EnumInfo{
+			"ActionTypeEnum":       {0: "Other", 1: "Scene", 2: "Sequence", 3: "Automation", 4: "Exception", 5: "Notification", 6: "Alarm"},
+			"ActionStateEnum":      {0: "Inactive", 1: "Active", 2: "Paused", 3: "Disabled"},
+			"ActionErrorEnum":      {0: "Unknown", 1: "Interrupted"},
+			"EndpointListTypeEnum": {0: "Other", 1: "Room", 2: "Zone"},
+		},
+		Bitmaps: map[string]BitmapInfo{
+			"CommandBits": {0: "InstantAction", 1: "InstantActionWithTransition", 2: "StartAction", 3: "StartActionWithDuration", 4: "StopAction", 5: "PauseAction", 6: "PauseActionWithDuration", 7: "ResumeAction", 8: "EnableAction", 9: "EnableActionWithDuration", 10: "DisableAction", 11: "DisableActionWithDuration"},
+		},
+		Structs: map[string]StructInfo{
+			"ActionStruct": {Fields: []Field{
+				{Id: 0, Name: "ActionID", Type: "uint16"},
+				{Id: 1, Name: "Name", Type: "string"},
+				{Id: 2, Name: "Type", Type: "ActionTypeEnum"},
+				{Id: 3, Name: "EndpointListID", Type: "uint16"},
+				{Id: 4, Name: "SupportedCommands", Type: "CommandBits"},
+				{Id: 5, Name: "State", Type: "ActionStateEnum"},
+			}},
+			"EndpointListStruct": {Fields: []Field{
+				{Id: 0, Name: "EndpointListID", Type: "uint16"},
+				{Id: 1, Name: "Name", Type: "string"},
+				{Id: 2, Name: "Type", Type: "EndpointListTypeEnum"},
+				{Id: 3, Name: "Endpoints", Type: "endpoint-no", List: true},
+			}},
+		},
+	},
+	CLUSTER_ID_BasicInformation: {
+		Attributes: map[int]AttributeInfo{
+			0x0000: {Field: Field{Id: 0x0000, Name: "DataModelRevision", Type: "uint16"}, ReadPrivilege: "view"},
+			0x0001: {Field: Field{Id: 0x0001, Name: "VendorName", Type: "string"}, ReadPrivilege: "view"},
+			0x0002: {Field: Field{Id: 0x0002, Name: "VendorID", Type: "vendor-id"}, ReadPrivilege: "view"},
+			0x0003: {Field: Field{Id: 0x0003, Name: "ProductName", Type: "string"}, ReadPrivilege: "view"},
+			0x0004: {Field: Field{Id: 0x0004, Name: "ProductID", Type: "uint16"}, ReadPrivilege: "view"},
+			0x0005: {Field: Field{Id: 0x0005, Name: "NodeLabel", Type: "string"}, ReadPrivilege: "view", WritePrivilege: "manage", Writable: true},
+			0x0006: {Field: Field{Id: 0x0006, Name: "Location", Type: "string"}, ReadPrivilege: "view", WritePrivilege: "administer", Writable: true},
+			0x0007: {Field: Field{Id: 0x0007, Name: "HardwareVersion", Type: "uint16"}, ReadPrivilege: "view"},
+			0x0008: {Field: Field{Id: 0x0008, Name: "HardwareVersionString", Type: "string"}, ReadPrivilege: "view"},
+			0x0009: {Field: Field{Id: 0x0009, Name: "SoftwareVersion", Type: "uint32"}, ReadPrivilege: "view"},
+			0x000a: {Field: Field{Id: 0x000a, Name: "SoftwareVersionString", Type: "string"}, ReadPrivilege: "view"},
+			0x000b: {Field: Field{Id: 0x000b, Name: "ManufacturingDate", Type: "string"}, ReadPrivilege: "view"},
+			0x000c: {Field: Field{Id: 0x000c, Name: "PartNumber", Type: "string"}, ReadPrivilege: "view"},
+			0x000d: {Field: Field{Id: 0x000d, Name: "ProductURL", Type: "string"}, ReadPrivilege: "view"},
+			0x000e: {Field: Field{Id: 0x000e, Name: "ProductLabel", Type: "string"}, ReadPrivilege: "view"},
+			0x000f: {Field: Field{Id: 0x000f, Name: "SerialNumber", Type: "string"}, ReadPrivilege: "view"},
+			0x0010: {Field: Field{Id: 0x0010, Name: "LocalConfigDisabled", Type: "bool"}, ReadPrivilege: "view", WritePrivilege: "manage", Writable: true},
+			0x0011: {Field: Field{Id: 0x0011, Name: "Reachable", Type: "bool"}, ReadPrivilege: "view"},
+			0x0012: {Field: Field{Id: 0x0012, Name: "UniqueID", Type: "string"}, ReadPrivilege: "view"},
+			0x0013: {Field: Field{Id: 0x0013, Name: "CapabilityMinima", Type: "CapabilityMinimaStruct"}, ReadPrivilege: "view"},
+			0x0014: {Field: Field{Id: 0x0014, Name: "ProductAppearance", Type: "ProductAppearanceStruct"}, ReadPrivilege: "view"},
+			0x0015: {Field: Field{Id: 0x0015, Name: "SpecificationVersion", Type: "uint32"}, ReadPrivilege: "view"},
+			0x0016: {Field: Field{Id: 0x0016, Name: "MaxPathsPerInvoke", Type: "uint16"}, ReadPrivilege: "view"},
+		},
+		Events: map[int]EventInfo{
+			0x00: {Name: "StartUp", Fields: []Field{
+				{Id: 0, Name: "SoftwareVersion", Type: "uint32"},
+			}, Priority: "critical"},
+			0x01: {Name: "ShutDown", Priority: "critical"},
+			0x02: {Name: "Leave", Fields: []Field{
+				{Id: 0, Name: "FabricIndex", Type: "fabric-idx"},
+			}, Priority: "info"},
+			0x03: {Name: "ReachableChanged", Fields: []Field{
+				{Id: 0, Name: "ReachableNewValue", Type: "bool"},
+			}, Priority: "info"},
+		},
+		Enums: map[string]EnumInfo{
+			"ColorEnum":         {0: "Black", 1: "Navy", 2: "Green", 3: "Teal", 4: "Maroon", 5: "Purple", 6: "Olive", 7: "Gray", 8: "Blue", 9: "Lime", 10: "Aqua", 11: "Red", 12: "Fuchsia", 13: "Yellow", 14: "White", 15: "Nickel", 16: "Chrome", 17: "Brass", 18: "Copper", 19: "Silver", 20: "Gold"},
+			"ProductFinishEnum": {0: "Other", 1: "Matte", 2: "Satin", 3: "Polished", 4: "Rugged", 5: "Fabric"},
+		},
+		Structs: map[string]StructInfo{
+			"CapabilityMinimaStruct": {Fields: []Field{
+				{Id: 0, Name: "CaseSessionsPerFabric", Type: "uint16"},
+				{Id: 1, Name: "SubscriptionsPerFabric", Type: "uint16"},
+			}},
+			"ProductAppearanceStruct": {Fields: []Field{
+				{Id: 0, Name: "Finish", Type: "ProductFinishEnum"},
+				{Id: 1, Name: "PrimaryColor", Type: "ColorEnum", Nullable: true},
+			}},
+		},
+	},
+	CLUSTER_ID_LocalizationConfiguration: {
+		Attributes: map[int]AttributeInfo{
+			0x0000: {Field: Field{Id: 0x0000, Name: "ActiveLocale", Type: "string"}, ReadPrivilege: "view", WritePrivilege: "manage", Writable: true},
+			0x0001: {Field: Field{Id: 0x0001, Name: "SupportedLocales", Type: "string", List: true}, ReadPrivilege: "view"},
+		},
+	},
+	CLUSTER_ID_TimeFormatLocalization: {
+		Attributes: map[int]AttributeInfo{
+			0x0000: {Field: Field{Id: 0x0000, Name: "HourFormat", Type: "HourFormatEnum"}, ReadPrivilege: "view", WritePrivilege: "manage", Writable: true},
+			0x0001: {Field: Field{Id: 0x0001, Name: "ActiveCalendarType", Type: "CalendarTypeEnum"}, ReadPrivilege: "view", WritePrivilege: "manage", Writable: true},
+			0x0002: {Field: Field{Id: 0x0002, Name: "SupportedCalendarTypes", Type: "CalendarTypeEnum", List: true}, ReadPrivilege: "view"},
+		},
+		Enums: map[string]EnumInfo{
+			"HourFormatEnum":   {0: "12hr", 1: "24hr", 255: "UseActiveLocale"},
+			"CalendarTypeEnum": {0: "Buddhist", 1: "Chinese", 2: "Coptic", 3: "Ethiopian", 4: "Gregorian", 5: "Hebrew", 6: "Indian", 7: "Islamic", 8: "Japanese", 9: "Korean", 10: "Persian", 11: "Taiwanese", 255: "UseActiveLocale"},
+		},
+	},
+	CLUSTER_ID_UnitLocalization: {
+		Attributes: map[int]AttributeInfo{
+			0x0000: {Field: Field{Id: 0x0000, Name: "TemperatureUnit", Type: "TempUnitEnum"}, ReadPrivilege: "view", WritePrivilege: "manage", Writable: true},
+		},
+		Enums: map[string]EnumInfo{
+			"TempUnitEnum": {0: "Fahrenheit", 1: "Celsius", 2: "Kelvin"},
+		},
+	},
+	CLUSTER_ID_PowerSourceConfiguration: {
+		Attributes: map[int]AttributeInfo{
+			0x0000: {Field: Field{Id: 0x0000, Name: "Sources", Type: "endpoint-no", List: true}, ReadPrivilege: "view"},
+		},
+	},
+	CLUSTER_ID_PowerSource: {
+		Attributes: map[int]AttributeInfo{
+			0x0000: {Field: Field{Id: 0x0000, Name: "Status", Type: "PowerSourceStatusEnum"}, ReadPrivilege: "view"},
+			0x0001: {Field: Field{Id: 0x0001, Name: "Order", Type: "uint8"}, ReadPrivilege: "view"},
+			0x0002: {Field: Field{Id: 0x0002, Name: "Description", Type: "string"}, ReadPrivilege: "view"},
+			0x0003: {Field: Field{Id: 0x0003, Name: "WiredAssessedInputVoltage", Type: "uint32", Nullable: true}, ReadPrivilege: "view"},
+			0x0004: {Field: Field{Id: 0x0004, Name: "WiredAssessedInputFrequency", Type: "uint16", Nullable: true}, ReadPrivilege: "view"},
+			0x0005: {Field: Field{Id: 0x0005, Name: "WiredCurrentType", Type: "WiredCurrentTypeEnum"}, ReadPrivilege: "view"},
+			0x0006: {Field: Field{Id: 0x0006, Name: "WiredAssessedCurrent", Type: "uint32", Nullable: true}, ReadPrivilege: "view"},
+			0x0007: {Field: Field{Id: 0x0007, Name: "WiredNominalVoltage", Type: "uint32"}, ReadPrivilege: "view"},
+			0x0008: {Field: Field{Id: 0x0008, Name: "WiredMaximumCurrent", Type: "uint32"}, ReadPrivilege: "view"},
+			0x0009: {Field: Field{Id: 0x0009, Name: "WiredPresent", Type: "bool"}, ReadPrivilege: "view"},
+			0x000a: {Field: Field{Id: 0x000a, Name: "ActiveWiredFaults", Type: "WiredFaultEnum", List: true}, ReadPrivilege: "view"},
+			0x000b: {Field: Field{Id: 0x000b, Name: "BatVoltage", Type: "uint32", Nullable: true}, ReadPrivilege: "view"},
+			0x000c: {Field: Field{Id: 0x000c, Name: "BatPercentRemaining", Type: "uint8", Nullable: true}, ReadPrivilege: "view"},
+			0x000d: {Field: Field{Id: 0x000d, Name: "BatTimeRemaining", Type: "uint32", Nullable: true}, ReadPrivilege: "view"},
+			0x000e: {Field: Field{Id: 0x000e, Name: "BatChargeLevel", Type: "BatChargeLevelEnum"}, ReadPrivilege: "view"},
+			0x000f: {Field: Field{Id: 0x000f, Name: "BatReplacementNeeded", Type: "bool"}, ReadPrivilege: "view"},
+			0x0010: {Field: Field{Id: 0x0010, Name: "BatReplaceability", Type: "BatReplaceabilityEnum"}, ReadPrivilege: "view"},
+			0x0011: {Field: Field{Id: 0x0011, Name: "BatPresent", Type: "bool"}, ReadPrivilege: "view"},
+			0x0012: {Field: Field{Id: 0x0012, Name: "ActiveBatFaults", Type: "BatFaultEnum", List: true}, ReadPrivilege: "view"},
+			0x0013: {Field: Field{Id: 0x0013, Name: "BatReplacementDescription", Type: "string"}, ReadPrivilege: "view"},
+			0x0014: {Field: Field{Id: 0x0014, Name: "BatCommonDesignation", Type: "BatCommonDesignationEnum"}, ReadPrivilege: "view"},
+			0x0015: {Field: Field{Id: 0x0015, Name: "BatANSIDesignation", Type: "string"}, ReadPrivilege: "view"},
+			0x0016: {Field: Field{Id: 0x0016, Name: "BatIECDesignation", Type: "string"}, ReadPrivilege: "view"},
+			0x0017: {Field: Field{Id: 0x0017, Name: "BatApprovedChemistry", Type: "BatApprovedChemistryEnum"}, ReadPrivilege: "view"},
+			0x0018: {Field: Field{Id: 0x0018, Name: "BatCapacity", Type: "uint32"}, ReadPrivilege: "view"},
+			0x0019: {Field: Field{Id: 0x0019, Name: "BatQuantity", Type: "uint8"}, ReadPrivilege: "view"},
+			0x001a: {Field: Field{Id: 0x001a, Name: "BatChargeState", Type: "BatChargeStateEnum"}, ReadPrivilege: "view"},
+			0x001b: {Field: Field{Id: 0x001b, Name: "BatTimeToFullCharge", Type: "uint32", Nullable: true}, ReadPrivilege: "view"},
+			0x001c: {Field: Field{Id: 0x001c, Name: "BatFunctionalWhileCharging", Type: "bool"}, ReadPrivilege: "view"},
+			0x001d: {Field: Field{Id: 0x001d, Name: "BatChargingCurrent", Type: "uint32", Nullable: true}, ReadPrivilege: "view"},
+			0x001e: {Field: Field{Id: 0x001e, Name: "ActiveBatChargeFaults", Type: "BatChargeFaultEnum", List: true}, ReadPrivilege: "view"},
+			0x001f: {Field: Field{Id: 0x001f, Name: "EndpointList", Type: "endpoint-no", List: true}, ReadPrivilege: "view"},
+		},
+		Events: map[int]EventInfo{
+			0x00: {Name: "WiredFaultChange", Fields: []Field{
+				{Id: 0, Name: "Current", Type: "WiredFaultEnum", List: true},
+				{Id: 1, Name: "Previous", Type: "WiredFaultEnum", List: true},
+			}, Priority: "info"},
+			0x01: {Name: "BatFaultChange", Fields: []Field{
+				{Id: 0, Name: "Current", Type: "BatFaultEnum", List: true},
+				{Id: 1, Name: "Previous", Type: "BatFaultEnum", List: true},
+			}, Priority: "info"},
+			0x02: {Name: "BatChargeFaultChange", Fields: []Field{
+				{Id: 0, Name: "Current", Type: "BatChargeFaultEnum", List: true},
+				{Id: 1, Name: "Previous", Type: "BatChargeFaultEnum", List: true},
+			}, Priority: "info"},
+		},
+		Enums: map[string]EnumInfo{
+			"PowerSourceStatusEnum":    {0: "Unspecified", 1: "Active", 2: "Standby", 3: "Unavailable"},
+			"WiredCurrentTypeEnum":     {0: "AC", 1: "DC"},
+			"WiredFaultEnum":           {0: "Unspecified", 1: "OverVoltage", 2: "UnderVoltage"},
+			"BatChargeLevelEnum":       {0: "OK", 1: "Warning", 2: "Critical"},
+			"BatReplaceabilityEnum":    {0: "Unspecified", 1: "NotReplaceable", 2: "UserReplaceable", 3: "FactoryReplaceable"},
+			"BatFaultEnum":             {0: "Unspecified", 1: "OverTemp", 2: "UnderTemp"},
+			"BatCommonDesignationEnum": {0: "Unspecified", 1: "AAA", 2: "AA", 3: "C", 4: "D", 5: "4v5", 6: "6v0", 7: "9v0", 8: "1_2AA", 9: "AAAA", 10: "A", 11: "B", 12: "F", 13: "N", 14: "No6", 15: "SubC", 16: "A23", 17: "A27", 18: "BA5800", 19: "Duplex", 20: "4SR44", 21: "523", 22: "531", 23: "15v0", 24: "22v5", 25: "30v0", 26: "45v0", 27: "67v5", 28: "J", 29: "CR123A", 30: "CR2", 31: "2CR5", 32: "CR_P2", 33: "CR_V3", 34: "SR41", 35: "SR43", 36: "SR44", 37: "SR45", 38: "SR48", 39: "SR54", 40: "SR55", 41: "SR57", 42: "SR58", 43: "SR59", 44: "SR60", 45: "SR63", 46: "SR64", 47: "SR65", 48: "SR66", 49: "SR67", 50: "SR68", 51: "SR69", 52: "SR516", 53: "SR731", 54: "SR712", 55: "LR932", 56: "A5", 57: "A10", 58: "A13", 59: "A312", 60: "A675", 61: "AC41E", 62: "10180", 63: "10280", 64: "10440", 65: "14250", 66: "14430", 67: "14500", 68: "14650", 69: "15270", 70: "16340", 71: "RCR123A", 72: "17500", 73: "17670", 74: "18350", 75: "18500", 76: "18650", 77: "19670", 78: "25500", 79: "26650", 80: "32600"},
+			"BatApprovedChemistryEnum": {0: "Unspecified", 1: "Alkaline", 2: "LithiumCarbonFluoride", 3: "LithiumChromiumOxide", 4: "LithiumCopperOxide", 5: "LithiumIronDisulfide", 6: "LithiumManganeseDioxide", 7: "LithiumThionylChloride", 8: "Magnesium", 9: "MercuryOxide", 10: "NickelOxyhydride", 11: "SilverOxide", 12: "ZincAir", 13: "ZincCarbon", 14: "ZincChloride", 15: "ZincManganeseDioxide", 16: "LeadAcid", 17: "LithiumCobaltOxide", 18: "LithiumIon", 19: "LithiumIonPolymer", 20: "LithiumIronPhosphate", 21: "LithiumSulfur", 22: "LithiumTitanate", 23: "NickelCadmium", 24: "NickelHydrogen", 25: "NickelIron", 26: "NickelMetalHydride", 27: "NickelZinc", 28: "SilverZinc", 29: "SodiumIon", 30: "SodiumSulfur", 31: "ZincBromide", 32: "ZincCerium"},
+			"BatChargeStateEnum":       {0: "Unknown", 1: "IsCharging", 2: "IsAtFullCharge", 3: "IsNotCharging"},
+			"BatChargeFaultEnum":       {0: "Unspecified", 1: "AmbientTooHot", 2: "AmbientTooCold", 3: "BatteryTooHot", 4: "BatteryTooCold", 5: "BatteryAbsent", 6: "BatteryOverVoltage", 7: "BatteryUnderVoltage", 8: "ChargerOverVoltage", 9: "ChargerUnderVoltage", 10: "SafetyTimeout"},
+		},
+	},
+	CLUSTER_ID_GeneralCommissioning: {
+		Attributes: map[int]AttributeInfo{
+			0x0000: {Field: Field{Id: 0x0000, Name: "Breadcrumb", Type: "uint64"}, ReadPrivilege: "view", WritePrivilege: "administer", Writable: true},
+			0x0001: {Field: Field{Id: 0x0001, Name: "BasicCommissioningInfo", Type: "BasicCommissioningInfo"}, ReadPrivilege: "view"},
+			0x0002: {Field: Field{Id: 0x0002, Name: "RegulatoryConfig", Type: "RegulatoryLocationTypeEnum"}, ReadPrivilege: "view"},
+			0x0003: {Field: Field{Id: 0x0003, Name: "LocationCapability", Type: "RegulatoryLocationTypeEnum"}, ReadPrivilege: "view"},
+			0x0004: {Field: Field{Id: 0x0004, Name: "SupportsConcurrentConnection", Type: "bool"}, ReadPrivilege: "view"},
+		},
+		Commands: map[int]CommandInfo{
+			0x00: {Id: 0x00, Name: "ArmFailSafe", Response: 0x01, Fields: []Field{
+				{Id: 0, Name: "ExpiryLengthSeconds", Type: "uint16"},
+				{Id: 1, Name: "Breadcrumb", Type: "uint64"},
+			}, InvokePrivilege: "administer"},
+			0x02: {Id: 0x02, Name: "SetRegulatoryConfig", Response: 0x03, Fields: []Field{
+				{Id: 0, Name: "NewRegulatoryConfig", Type: "RegulatoryLocationTypeEnum"},
+				{Id: 1, Name: "CountryCode", Type: "string"},
+				{Id: 2, Name: "Breadcrumb", Type: "uint64"},
+			}, InvokePrivilege: "administer"},
+			0x04: {Id: 0x04, Name: "CommissioningComplete", Response: 0x05, InvokePrivilege: "administer", FabricScoped: true},
+		},
+		Responses: map[int]CommandInfo{
+			0x01: {Id: 0x01, Name: "ArmFailSafeResponse", Response: ResponseNone, Fields: []Field{
+				{Id: 0, Name: "ErrorCode", Type: "CommissioningErrorEnum"},
+				{Id: 1, Name: "DebugText", Type: "string"},
+			}},
+			0x03: {Id: 0x03, Name: "SetRegulatoryConfigResponse", Response: ResponseNone, Fields: []Field{
+				{Id: 0, Name: "ErrorCode", Type: "CommissioningErrorEnum"},
+				{Id: 1, Name: "DebugText", Type: "string"},
+			}},
+			0x05: {Id: 0x05, Name: "CommissioningCompleteResponse", Response: ResponseNone, Fields: []Field{
+				{Id: 0, Name: "ErrorCode", Type: "CommissioningErrorEnum"},
+				{Id: 1, Name: "DebugText", Type: "string"},
+			}},
+		},
+		Enums: map[string]EnumInfo{
+			"CommissioningErrorEnum":     {0: "OK", 1: "ValueOutsideRange", 2: "InvalidAuthentication", 3: "NoFailSafe", 4: "BusyWithOtherAdmin"},
+			"RegulatoryLocationTypeEnum": {0: "Indoor", 1: "Outdoor", 2: "IndoorOutdoor"},
+		},
+		Structs: map[string]StructInfo{
+			"BasicCommissioningInfo": {Fields: []Field{
+				{Id: 0, Name: "FailSafeExpiryLengthSeconds", Type: "uint16"},
+				{Id: 1, Name: "MaxCumulativeFailsafeSeconds", Type: "uint16"},
+			}},
+		},
+	},
+	CLUSTER_ID_NetworkCommissioning: {
+		Attributes: map[int]AttributeInfo{
+			0x0000: {Field: Field{Id: 0x0000, Name: "MaxNetworks", Type: "uint8"}, ReadPrivilege: "administer"},
+			0x0001: {Field: Field{Id: 0x0001, Name: "Networks", Type: "NetworkInfoStruct", List: true}, ReadPrivilege: "administer"},
+			0x0002: {Field: Field{Id: 0x0002, Name: "ScanMaxTimeSeconds", Type: "uint8"}, ReadPrivilege: "view"},
+			0x0003: {Field: Field{Id: 0x0003, Name: "ConnectMaxTimeSeconds", Type: "uint8"}, ReadPrivilege: "view"},
+			0x0004: {Field: Field{Id: 0x0004, Name: "InterfaceEnabled", Type: "bool"}, ReadPrivilege: "view", WritePrivilege: "administer", Writable: true},
+			0x0005: {Field: Field{Id: 0x0005, Name: "LastNetworkingStatus", Type: "NetworkCommissioningStatusEnum", Nullable: true}, ReadPrivilege: "administer"},
+			0x0006: {Field: Field{Id: 0x0006, Name: "LastNetworkID", Type: "octstr", Nullable: true}, ReadPrivilege: "administer"},
+			0x0007: {Field: Field{Id: 0x0007, Name: "LastConnectErrorValue", Type: "int32", Nullable: true}, ReadPrivilege: "administer"},
+			0x0008: {Field: Field{Id: 0x0008, Name: "SupportedWiFiBands", Type: "WiFiBandEnum", List: true}, ReadPrivilege: "view"},
+			0x0009: {Field: Field{Id: 0x0009, Name: "SupportedThreadFeatures", Type: "ThreadCapabilitiesBitmap"}, ReadPrivilege: "view"},
+			0x000a: {Field: Field{Id: 0x000a, Name: "ThreadVersion", Type: "uint16"}, ReadPrivilege: "view"},
+		},
+		Commands: map[int]CommandInfo{
+			0x00: {Id: 0x00, Name: "ScanNetworks", Response: 0x01, Fields: []Field{
+				{Id: 0, Name: "SSID", Type: "octstr", Nullable: true},
+				{Id: 1, Name: "Breadcrumb", Type: "uint64"},
+			}, InvokePrivilege: "administer"},
+			0x02: {Id: 0x02, Name: "AddOrUpdateWiFiNetwork", Response: 0x05, Fields: []Field{
+				{Id: 0, Name: "SSID", Type: "octstr"},
+				{Id: 1, Name: "Credentials", Type: "octstr"},
+				{Id: 2, Name: "Breadcrumb", Type: "uint64"},
+			}, InvokePrivilege: "administer"},
+			0x03: {Id: 0x03, Name: "AddOrUpdateThreadNetwork", Response: 0x05, Fields: []Field{
+				{Id: 0, Name: "OperationalDataset", Type: "octstr"},
+				{Id: 1, Name: "Breadcrumb", Type: "uint64"},
+			}, InvokePrivilege: "administer"},
+			0x04: {Id: 0x04, Name: "RemoveNetwork", Response: 0x05, Fields: []Field{
+				{Id: 0, Name: "NetworkID", Type: "octstr"},
+				{Id: 1, Name: "Breadcrumb", Type: "uint64"},
+			}, InvokePrivilege: "administer"},
+			0x06: {Id: 0x06, Name: "ConnectNetwork", Response: 0x07, Fields: []Field{
+				{Id: 0, Name: "NetworkID", Type: "octstr"},
+				{Id: 1, Name: "Breadcrumb", Type: "uint64"},
+			}, InvokePrivilege: "administer"},
+			0x08: {Id: 0x08, Name: "ReorderNetwork", Response: 0x05, Fields: []Field{
+				{Id: 0, Name: "NetworkID", Type: "octstr"},
+				{Id: 1, Name: "NetworkIndex", Type: "uint8"},
+				{Id: 2, Name: "Breadcrumb", Type: "uint64"},
+			}, InvokePrivilege: "administer"},
+		},
+		Responses: map[int]CommandInfo{
+			0x01: {Id: 0x01, Name: "ScanNetworksResponse", Response: ResponseNone, Fields: []Field{
+				{Id: 0, Name: "NetworkingStatus", Type: "NetworkCommissioningStatusEnum"},
+				{Id: 1, Name: "DebugText", Type: "string"},
+				{Id: 2, Name: "WiFiScanResults", Type: "WiFiInterfaceScanResultStruct", List: true},
+				{Id: 3, Name: "ThreadScanResults", Type: "ThreadInterfaceScanResultStruct", List: true},
+			}},
+			0x05: {Id: 0x05, Name: "NetworkConfigResponse", Response: ResponseNone, Fields: []Field{
+				{Id: 0, Name: "NetworkingStatus", Type: "NetworkCommissioningStatusEnum"},
+				{Id: 1, Name: "DebugText", Type: "string"},
+				{Id: 2, Name: "NetworkIndex", Type: "uint8"},
+			}},
+			0x07: {Id: 0x07, Name: "ConnectNetworkResponse", Response: ResponseNone, Fields: []Field{
+				{Id: 0, Name: "NetworkingStatus", Type: "NetworkCommissioningStatusEnum"},
+				{Id: 1, Name: "DebugText", Type: "string"},
+				{Id: 2, Name: "ErrorValue", Type: "int32", Nullable: true},
+			}},
+		},
+		Enums: map[string]EnumInfo{
+			"NetworkCommissioningStatusEnum": {0: "Success", 1: "OutOfRange", 2: "BoundsExceeded", 3: "NetworkIDNotFound", 4: "DuplicateNetworkID", 5: "NetworkNotFound", 6: "RegulatoryError", 7: "AuthFailure", 8: "UnsupportedSecurity", 9: "OtherConnectionFailure", 10: "IPV6Failed", 11: "IPBindFailed", 12: "UnknownError"},
+			"WiFiBandEnum":                   {0: "2G4", 1: "3G65", 2: "5G", 3: "6G", 4: "60G", 5: "1G"},
+		},
+		Bitmaps: map[string]BitmapInfo{
+			"WiFiSecurityBitmap":       {0: "Unencrypted", 1: "WEP", 2: "WPAPersonal", 3: "WPA2Personal", 4: "WPA3Personal"},
+			"ThreadCapabilitiesBitmap": {0: "IsBorderRouterCapable", 1: "IsRouterCapable", 2: "IsSleepyEndDeviceCapable", 3: "IsFullThreadDevice", 4: "IsSynchronizedSleepyEndDeviceCapable"},
+		},
+		Structs: map[string]StructInfo{
+			"NetworkInfoStruct": {Fields: []Field{
+				{Id: 0, Name: "NetworkID", Type: "octstr"},
+				{Id: 1, Name: "Connected", Type: "bool"},
+			}},
+			"WiFiInterfaceScanResultStruct": {Fields: []Field{
+				{Id: 0, Name: "Security", Type: "WiFiSecurityBitmap"},
+				{Id: 1, Name: "SSID", Type: "octstr"},
+				{Id: 2, Name: "BSSID", Type: "octstr"},
+				{Id: 3, Name: "Channel", Type: "uint16"},
+				{Id: 4, Name: "WiFiBand", Type: "WiFiBandEnum"},
+				{Id: 5, Name: "RSSI", Type: "int8"},
+			}},
+			"ThreadInterfaceScanResultStruct": {Fields: []Field{
+				{Id: 0, Name: "PanId", Type: "uint16"},
+				{Id: 1, Name: "ExtendedPanId", Type: "uint64"},
+				{Id: 2, Name: "NetworkName", Type: "string"},
+				{Id: 3, Name: "Channel", Type: "uint16"},
+				{Id: 4, Name: "Version", Type: "uint8"},
+				{Id: 5, Name: "ExtendedAddress", Type: "hwadr"},
+				{Id: 6, Name: "RSSI", Type: "int8"},
+				{Id: 7, Name: "LQI", Type: "uint8"},
+			}},
+		},
+	},
+	CLUSTER_ID_DiagnosticLogs: {
+		Commands: map[int]CommandInfo{
+			0x00: {Id: 0x00, Name: "RetrieveLogsRequest", Response: 0x01, Fields: []Field{
+				{Id: 0, Name: "Intent", Type: "IntentEnum"},
+				{Id: 1, Name: "RequestedProtocol", Type: "TransferProtocolEnum"},
+				{Id: 2, Name: "TransferFileDesignator", Type: "string"},
+			}, InvokePrivilege: "operate"},
+		},
+		Responses: map[int]CommandInfo{
+			0x01: {Id: 0x01, Name: "RetrieveLogsResponse", Response: ResponseNone, Fields: []Field{
+				{Id: 0, Name: "Status", Type: "StatusEnum"},
+				{Id: 1, Name: "LogContent", Type: "octstr"},
+				{Id: 2, Name: "UTCTimeStamp", Type: "epoch-us"},
+				{Id: 3, Name: "TimeSinceBoot", Type: "systime-us"},
+			}},
+		},
+		Enums: map[string]EnumInfo{
+			"IntentEnum":           {0: "EndUserSupport", 1: "NetworkDiag", 2: "CrashLogs"},
+			"StatusEnum":           {0: "Success", 1: "Exhausted", 2: "NoLogs", 3: "Busy", 4: "Denied"},
+			"TransferProtocolEnum": {0: "ResponsePayload", 1: "BDX"},
+		},
+	},
+	CLUSTER_ID_GeneralDiagnostics: {
+		Attributes: map[int]AttributeInfo{
+			0x0000: {Field: Field{Id: 0x0000, Name: "NetworkInterfaces", Type: "NetworkInterface", List: true}, ReadPrivilege: "view"},
+			0x0001: {Field: Field{Id: 0x0001, Name: "RebootCount", Type: "uint16"}, ReadPrivilege: "view"},
+			0x0002: {Field: Field{Id: 0x0002, Name: "UpTime", Type: "uint64"}, ReadPrivilege: "view"},
+			0x0003: {Field: Field{Id: 0x0003, Name: "TotalOperationalHours", Type: "uint32"}, ReadPrivilege: "view"},
+			0x0004: {Field: Field{Id: 0x0004, Name: "BootReason", Type: "BootReasonEnum"}, ReadPrivilege: "view"},
+			0x0005: {Field: Field{Id: 0x0005, Name: "ActiveHardwareFaults", Type: "HardwareFaultEnum", List: true}, ReadPrivilege: "view"},
+			0x0006: {Field: Field{Id: 0x0006, Name: "ActiveRadioFaults", Type: "RadioFaultEnum", List: true}, ReadPrivilege: "view"},
+			0x0007: {Field: Field{Id: 0x0007, Name: "ActiveNetworkFaults", Type: "NetworkFaultEnum", List: true}, ReadPrivilege: "view"},
+			0x0008: {Field: Field{Id: 0x0008, Name: "TestEventTriggersEnabled", Type: "bool"}, ReadPrivilege: "view"},
+		},
+		Commands: map[int]CommandInfo{
+			0x00: {Id: 0x00, Name: "TestEventTrigger", Response: ResponseStatus, Fields: []Field{
+				{Id: 0, Name: "EnableKey", Type: "octstr"},
+				{Id: 1, Name: "EventTrigger", Type: "uint64"},
+			}, InvokePrivilege: "manage"},
+			0x01: {Id: 0x01, Name: "TimeSnapshot", Response: 0x02, InvokePrivilege: "operate"},
+		},
+		Responses: map[int]CommandInfo{
+			0x02: {Id: 0x02, Name: "TimeSnapshotResponse", Response: ResponseNone, Fields: []Field{
+				{Id: 0, Name: "SystemTimeMs", Type: "systime-ms"},
+				{Id: 1, Name: "PosixTimeMs", Type: "posix-ms", Nullable: true},
+			}},
+		},
+		Events: map[int]EventInfo{
+			0x00: {Name: "HardwareFaultChange", Fields: []Field{
+				{Id: 0, Name: "Current", Type: "HardwareFaultEnum", List: true},
+				{Id: 1, Name: "Previous", Type: "HardwareFaultEnum", List: true},
+			}, Priority: "critical"},
+			0x01: {Name: "RadioFaultChange", Fields: []Field{
+				{Id: 0, Name: "Current", Type: "RadioFaultEnum", List: true},
+				{Id: 1, Name: "Previous", Type: "RadioFaultEnum", List: true},
+			}, Priority: "critical"},
+			0x02: {Name: "NetworkFaultChange", Fields: []Field{
+				{Id: 0, Name: "Current", Type: "NetworkFaultEnum", List: true},
+				{Id: 1, Name: "Previous", Type: "NetworkFaultEnum", List: true},
+			}, Priority: "critical"},
+			0x03: {Name: "BootReason", Fields: []Field{
+				{Id: 0, Name: "BootReason", Type: "BootReasonEnum"},
+			}, Priority: "critical"},
+		},
+		Enums: map[string]EnumInfo{
+			"HardwareFaultEnum": {0: "Unspecified", 1: "Radio", 2: "Sensor", 3: "ResettableOverTemp", 4: "NonResettableOverTemp", 5: "PowerSource", 6: "VisualDisplayFault", 7: "AudioOutputFault", 8: "UserInterfaceFault", 9: "NonVolatileMemoryError", 10: "TamperDetected"},
+			"RadioFaultEnum":    {0: "Unspecified", 1: "WiFiFault", 2: "CellularFault", 3: "ThreadFault", 4: "NFCFault", 5: "BLEFault", 6: "EthernetFault"},
+			"NetworkFaultEnum":  {0: "Unspecified", 1: "HardwareFailure", 2: "NetworkJammed", 3: "ConnectionFailed"},
+			"InterfaceTypeEnum": {0: "Unspecified", 1: "WiFi", 2: "Ethernet", 3: "Cellular", 4: "Thread"},
+			"BootReasonEnum":    {0: "Unspecified", 1: "PowerOnReboot", 2: "BrownOutReset", 3: "SoftwareWatchdogReset", 4: "HardwareWatchdogReset", 5: "SoftwareUpdateCompleted", 6: "SoftwareReset"},
+		},
+		Structs: map[string]StructInfo{
+			"NetworkInterface": {Fields: []Field{
+				{Id: 0, Name: "Name", Type: "string"},
+				{Id: 1, Name: "IsOperational", Type: "bool"},
+				{Id: 2, Name: "OffPremiseServicesReachableIPv4", Type: "bool", Nullable: true},
+				{Id: 3, Name: "OffPremiseServicesReachableIPv6", Type: "bool", Nullable: true},
+				{Id: 4, Name: "HardwareAddress", Type: "hwadr"},
+				{Id: 5, Name: "IPv4Addresses", Type: "ipv4adr", List: true},
+				{Id: 6, Name: "IPv6Addresses", Type: "ipv6adr", List: true},
+				{Id: 7, Name: "Type", Type: "InterfaceTypeEnum"},
+			}},
+		},
+	},
+	CLUSTER_ID_SoftwareDiagnostics: {
+		Attributes: map[int]AttributeInfo{
+			0x0000: {Field: Field{Id: 0x0000, Name: "ThreadMetrics", Type: "ThreadMetricsStruct", List: true}, ReadPrivilege: "view"},
+			0x0001: {Field: Field{Id: 0x0001, Name: "CurrentHeapFree", Type: "uint64"}, ReadPrivilege: "view"},
+			0x0002: {Field: Field{Id: 0x0002, Name: "CurrentHeapUsed", Type: "uint64"}, ReadPrivilege: "view"},
+			0x0003: {Field: Field{Id: 0x0003, Name: "CurrentHeapHighWatermark", Type: "uint64"}, ReadPrivilege: "view"},
+		},
+		Commands: map[int]CommandInfo{
+			0x00: {Id: 0x00, Name: "ResetWatermarks", Response: ResponseStatus, InvokePrivilege: "manage"},
+		},
+		Events: map[int]EventInfo{
+			0x00: {Name: "SoftwareFault", Fields: []Field{
+				{Id: 0, Name: "ID", Type: "uint64"},
+				{Id: 1, Name: "Name", Type: "string"},
+				{Id: 2, Name: "FaultRecording", Type: "octstr"},
+			}, Priority: "info"},
+		},
+		Structs: map[string]StructInfo{
+			"ThreadMetricsStruct": {Fields: []Field{
+				{Id: 0, Name: "ID", Type: "uint64"},
+				{Id: 1, Name: "Name", Type: "string"},
+				{Id: 2, Name: "StackFreeCurrent", Type: "uint32"},
+				{Id: 3, Name: "StackFreeMinimum", Type: "uint32"},
+				{Id: 4, Name: "StackSize", Type: "uint32"},
+			}},
+		},
+	},
+	CLUSTER_ID_ThreadNetworkDiagnostics: {
+		Attributes: map[int]AttributeInfo{
+			0x0000: {Field: Field{Id: 0x0000, Name: "Channel", Type: "uint16", Nullable: true}, ReadPrivilege: "view"},
+			0x0001: {Field: Field{Id: 0x0001, Name: "RoutingRole", Type: "RoutingRoleEnum", Nullable: true}, ReadPrivilege: "view"},
+			0x0002: {Field: Field{Id: 0x0002, Name: "NetworkName", Type: "string", Nullable: true}, ReadPrivilege: "view"},
+			0x0003: {Field: Field{Id: 0x0003, Name: "PanId", Type: "uint16", Nullable: true}, ReadPrivilege: "view"},
+			0x0004: {Field: Field{Id: 0x0004, Name: "ExtendedPanId", Type: "uint64", Nullable: true}, ReadPrivilege: "view"},
+			0x0005: {Field: Field{Id: 0x0005, Name: "MeshLocalPrefix", Type: "ipv6pre", Nullable: true}, ReadPrivilege: "view"},
+			0x0006: {Field: Field{Id: 0x0006, Name: "OverrunCount", Type: "uint64"}, ReadPrivilege: "view"},
+			0x0007: {Field: Field{Id: 0x0007, Name: "NeighborTable", Type: "NeighborTableStruct", List: true}, ReadPrivilege: "view"},
+			0x0008: {Field: Field{Id: 0x0008, Name: "RouteTable", Type: "RouteTableStruct", List: true}, ReadPrivilege: "view"},
+			0x0009: {Field: Field{Id: 0x0009, Name: "PartitionId", Type: "uint32", Nullable: true}, ReadPrivilege: "view"},
+			0x000a: {Field: Field{Id: 0x000a, Name: "Weighting", Type: "uint16", Nullable: true}, ReadPrivilege: "view"},
+			0x000b: {Field: Field{Id: 0x000b, Name: "DataVersion", Type: "uint16", Nullable: true}, ReadPrivilege: "view"},
+			0x000c: {Field: Field{Id: 0x000c, Name: "StableDataVersion", Type: "uint16", Nullable: true}, ReadPrivilege: "view"},
+			0x000d: {Field: Field{Id: 0x000d, Name: "LeaderRouterId", Type: "uint8", Nullable: true}, ReadPrivilege: "view"},
+			0x000e: {Field: Field{Id: 0x000e, Name: "DetachedRoleCount", Type: "uint16"}, ReadPrivilege: "view"},
+			0x000f: {Field: Field{Id: 0x000f, Name: "ChildRoleCount", Type: "uint16"}, ReadPrivilege: "view"},
+			0x0010: {Field: Field{Id: 0x0010, Name: "RouterRoleCount", Type: "uint16"}, ReadPrivilege: "view"},
+			0x0011: {Field: Field{Id: 0x0011, Name: "LeaderRoleCount", Type: "uint16"}, ReadPrivilege: "view"},
+			0x0012: {Field: Field{Id: 0x0012, Name: "AttachAttemptCount", Type: "uint16"}, ReadPrivilege: "view"},
+			0x0013: {Field: Field{Id: 0x0013, Name: "PartitionIdChangeCount", Type: "uint16"}, ReadPrivilege: "view"},
+			0x0014: {Field: Field{Id: 0x0014, Name: "BetterPartitionAttachAttemptCount", Type: "uint16"}, ReadPrivilege: "view"},
+			0x0015: {Field: Field{Id: 0x0015, Name: "ParentChangeCount", Type: "uint16"}, ReadPrivilege: "view"},
+			0x0016: {Field: Field{Id: 0x0016, Name: "TxTotalCount", Type: "uint32"}, ReadPrivilege: "view"},
+			0x0017: {Field: Field{Id: 0x0017, Name: "TxUnicastCount", Type: "uint32"}, ReadPrivilege: "view"},
+			0x0018: {Field: Field{Id: 0x0018, Name: "TxBroadcastCount", Type: "uint32"}, ReadPrivilege: "view"},
+			0x0019: {Field: Field{Id: 0x0019, Name: "TxAckRequestedCount", Type: "uint32"}, ReadPrivilege: "view"},
+			0x001a: {Field: Field{Id: 0x001a, Name: "TxAckedCount", Type: "uint32"}, ReadPrivilege: "view"},
+			0x001b: {Field: Field{Id: 0x001b, Name: "TxNoAckRequestedCount", Type: "uint32"}, ReadPrivilege: "view"},
+			0x001c: {Field: Field{Id: 0x001c, Name: "TxDataCount", Type: "uint32"}, ReadPrivilege: "view"},
+			0x001d: {Field: Field{Id: 0x001d, Name: "TxDataPollCount", Type: "uint32"}, ReadPrivilege: "view"},
+			0x001e: {Field: Field{Id: 0x001e, Name: "TxBeaconCount", Type: "uint32"}, ReadPrivilege: "view"},
+			0x001f: {Field: Field{Id: 0x001f, Name: "TxBeaconRequestCount", Type: "uint32"}, ReadPrivilege: "view"},
+			0x0020: {Field: Field{Id: 0x0020, Name: "TxOtherCount", Type: "uint32"}, ReadPrivilege: "view"},
+			0x0021: {Field: Field{Id: 0x0021, Name: "TxRetryCount", Type: "uint32"}, ReadPrivilege: "view"},
+			0x0022: {Field: Field{Id: 0x0022, Name: "TxDirectMaxRetryExpiryCount", Type: "uint32"}, ReadPrivilege: "view"},
+			0x0023: {Field: Field{Id: 0x0023, Name: "TxIndirectMaxRetryExpiryCount", Type: "uint32"}, ReadPrivilege: "view"},
+			0x0024: {Field: Field{Id: 0x0024, Name: "TxErrCcaCount", Type: "uint32"}, ReadPrivilege: "view"},
+			0x0025: {Field: Field{Id: 0x0025, Name: "TxErrAbortCount", Type: "uint32"}, ReadPrivilege: "view"},
+			0x0026: {Field: Field{Id: 0x0026, Name: "TxErrBusyChannelCount", Type: "uint32"}, ReadPrivilege: "view"},
+			0x0027: {Field: Field{Id: 0x0027, Name: "RxTotalCount", Type: "uint32"}, ReadPrivilege: "view"},
+			0x0028: {Field: Field{Id: 0x0028, Name: "RxUnicastCount", Type: "uint32"}, ReadPrivilege: "view"},
+			0x0029: {Field: Field{Id: 0x0029, Name: "RxBroadcastCount", Type: "uint32"}, ReadPrivilege: "view"},
+			0x002a: {Field: Field{Id: 0x002a, Name: "RxDataCount", Type: "uint32"}, ReadPrivilege: "view"},
+			0x002b: {Field: Field{Id: 0x002b, Name: "RxDataPollCount", Type: "uint32"}, ReadPrivilege: "view"},
+			0x002c: {Field: Field{Id: 0x002c, Name: "RxBeaconCount", Type: "uint32"}, ReadPrivilege: "view"},
+			0x002d: {Field: Field{Id: 0x002d, Name: "RxBeaconRequestCount", Type: "uint32"}, ReadPrivilege: "view"},
+			0x002e: {Field: Field{Id: 0x002e, Name: "RxOtherCount", Type: "uint32"}, ReadPrivilege: "view"},
+			0x002f: {Field: Field{Id: 0x002f, Name: "RxAddressFilteredCount", Type: "uint32"}, ReadPrivilege: "view"},
+			0x0030: {Field: Field{Id: 0x0030, Name: "RxDestAddrFilteredCount", Type: "uint32"}, ReadPrivilege: "view"},
+			0x0031: {Field: Field{Id: 0x0031, Name: "RxDuplicatedCount", Type: "uint32"}, ReadPrivilege: "view"},
+			0x0032: {Field: Field{Id: 0x0032, Name: "RxErrNoFrameCount", Type: "uint32"}, ReadPrivilege: "view"},
+			0x0033: {Field: Field{Id: 0x0033, Name: "RxErrUnknownNeighborCount", Type: "uint32"}, ReadPrivilege: "view"},
+			0x0034: {Field: Field{Id: 0x0034, Name: "RxErrInvalidSrcAddrCount", Type: "uint32"}, ReadPrivilege: "view"},
+			0x0035: {Field: Field{Id: 0x0035, Name: "RxErrSecCount", Type: "uint32"}, ReadPrivilege: "view"},
+			0x0036: {Field: Field{Id: 0x0036, Name: "RxErrFcsCount", Type: "uint32"}, ReadPrivilege: "view"},
+			0x0037: {Field: Field{Id: 0x0037, Name: "RxErrOtherCount", Type: "uint32"}, ReadPrivilege: "view"},
+			0x0038: {Field: Field{Id: 0x0038, Name: "ActiveTimestamp", Type: "uint64", Nullable: true}, ReadPrivilege: "view"},
+			0x0039: {Field: Field{Id: 0x0039, Name: "PendingTimestamp", Type: "uint64", Nullable: true}, ReadPrivilege: "view"},
+			0x003a: {Field: Field{Id: 0x003a, Name: "Delay", Type: "uint32", Nullable: true}, ReadPrivilege: "view"},
+			0x003b: {Field: Field{Id: 0x003b, Name: "SecurityPolicy", Type: "SecurityPolicy", Nullable: true}, ReadPrivilege: "view"},
+			0x003c: {Field: Field{Id: 0x003c, Name: "ChannelPage0Mask", Type: "octstr", Nullable: true}, ReadPrivilege: "view"},
+			0x003d: {Field: Field{Id: 0x003d, Name: "OperationalDatasetComponents", Type: "OperationalDatasetComponents", Nullable: true}, ReadPrivilege: "view"},
+			0x003e: {Field: Field{Id: 0x003e, Name: "ActiveNetworkFaults", Type: "NetworkFaultEnum", List: true}, ReadPrivilege: "view"},
+		},
+		Commands: map[int]CommandInfo{
+			0x00: {Id: 0x00, Name: "ResetCounts", Response: ResponseStatus, InvokePrivilege: "manage"},
+		},
+		Events: map[int]EventInfo{
+			0x00: {Name: "ConnectionStatus", Fields: []Field{
+				{Id: 0, Name: "ConnectionStatus", Type: "ConnectionStatusEnum"},
+			}, Priority: "info"},
+			0x01: {Name: "NetworkFaultChange", Fields: []Field{
+				{Id: 0, Name: "Current", Type: "NetworkFaultEnum", List: true},
+				{Id: 1, Name: "Previous", Type: "NetworkFaultEnum", List: true},
+			}, Priority: "info"},
+		},
+		Enums: map[string]EnumInfo{
+			"NetworkFaultEnum":     {0: "Unspecified", 1: "LinkDown", 2: "HardwareFailure", 3: "NetworkJammed"},
+			"ConnectionStatusEnum": {0: "Connected", 1: "NotConnected"},
+			"RoutingRoleEnum":      {0: "Unspecified", 1: "Unassigned", 2: "SleepyEndDevice", 3: "EndDevice", 4: "REED", 5: "Router", 6: "Leader"},
+		},
+		Structs: map[string]StructInfo{
+			"NeighborTableStruct": {Fields: []Field{
+				{Id: 0, Name: "ExtAddress", Type: "uint64"},
+				{Id: 1, Name: "Age", Type: "uint32"},
+				{Id: 2, Name: "Rloc16", Type: "uint16"},
+				{Id: 3, Name: "LinkFrameCounter", Type: "uint32"},
+				{Id: 4, Name: "MleFrameCounter", Type: "uint32"},
+				{Id: 5, Name: "LQI", Type: "uint8"},
+				{Id: 6, Name: "AverageRssi", Type: "int8", Nullable: true},
+				{Id: 7, Name: "LastRssi", Type: "int8", Nullable: true},
+				{Id: 8, Name: "FrameErrorRate", Type: "uint8"},
+				{Id: 9, Name: "MessageErrorRate", Type: "uint8"},
+				{Id: 10, Name: "RxOnWhenIdle", Type: "bool"},
+				{Id: 11, Name: "FullThreadDevice", Type: "bool"},
+				{Id: 12, Name: "FullNetworkData", Type: "bool"},
+				{Id: 13, Name: "IsChild", Type: "bool"},
+			}},
+			"RouteTableStruct": {Fields: []Field{
+				{Id: 0, Name: "ExtAddress", Type: "uint64"},
+				{Id: 1, Name: "Rloc16", Type: "uint16"},
+				{Id: 2, Name: "RouterId", Type: "uint8"},
+				{Id: 3, Name: "NextHop", Type: "uint8"},
+				{Id: 4, Name: "PathCost", Type: "uint8"},
+				{Id: 5, Name: "LQIIn", Type: "uint8"},
+				{Id: 6, Name: "LQIOut", Type: "uint8"},
+				{Id: 7, Name: "Age", Type: "uint8"},
+				{Id: 8, Name: "Allocated", Type: "bool"},
+				{Id: 9, Name: "LinkEstablished", Type: "bool"},
+			}},
+			"SecurityPolicy": {Fields: []Field{
+				{Id: 0, Name: "RotationTime", Type: "uint16"},
+				{Id: 1, Name: "Flags", Type: "uint16"},
+			}},
+			"OperationalDatasetComponents": {Fields: []Field{
+				{Id: 0, Name: "ActiveTimestampPresent", Type: "bool"},
+				{Id: 1, Name: "PendingTimestampPresent", Type: "bool"},
+				{Id: 2, Name: "MasterKeyPresent", Type: "bool"},
+				{Id: 3, Name: "NetworkNamePresent", Type: "bool"},
+				{Id: 4, Name: "ExtendedPanIdPresent", Type: "bool"},
+				{Id: 5, Name: "MeshLocalPrefixPresent", Type: "bool"},
+				{Id: 6, Name: "DelayPresent", Type: "bool"},
+				{Id: 7, Name: "PanIdPresent", Type: "bool"},
+				{Id: 8, Name: "ChannelPresent", Type: "bool"},
+				{Id: 9, Name: "PskcPresent", Type: "bool"},
+				{Id: 10, Name: "SecurityPolicyPresent", Type: "bool"},
+				{Id: 11, Name: "ChannelMaskPresent", Type: "bool"},
+			}},
+		},
+	},
+	CLUSTER_ID_WiFiNetworkDiagnostics: {
+		Attributes: map[int]AttributeInfo{
+			0x0000: {Field: Field{Id: 0x0000, Name: "BSSID", Type: "octstr", Nullable: true}, ReadPrivilege: "view"},
+			0x0001: {Field: Field{Id: 0x0001, Name: "SecurityType", Type: "SecurityTypeEnum", Nullable: true}, ReadPrivilege: "view"},
+			0x0002: {Field: Field{Id: 0x0002, Name: "WiFiVersion", Type: "WiFiVersionEnum", Nullable: true}, ReadPrivilege: "view"},
+			0x0003: {Field: Field{Id: 0x0003, Name: "ChannelNumber", Type: "uint16", Nullable: true}, ReadPrivilege: "view"},
+			0x0004: {Field: Field{Id: 0x0004, Name: "RSSI", Type: "int8", Nullable: true}, ReadPrivilege: "view"},
+			0x0005: {Field: Field{Id: 0x0005, Name: "BeaconLostCount", Type: "uint32", Nullable: true}, ReadPrivilege: "view"},
+			0x0006: {Field: Field{Id: 0x0006, Name: "BeaconRxCount", Type: "uint32", Nullable: true}, ReadPrivilege: "view"},
+			0x0007: {Field: Field{Id: 0x0007, Name: "PacketMulticastRxCount", Type: "uint32", Nullable: true}, ReadPrivilege: "view"},
+			0x0008: {Field: Field{Id: 0x0008, Name: "PacketMulticastTxCount", Type: "uint32", Nullable: true}, ReadPrivilege: "view"},
+			0x0009: {Field: Field{Id: 0x0009, Name: "PacketUnicastRxCount", Type: "uint32", Nullable: true}, ReadPrivilege: "view"},
+			0x000a: {Field: Field{Id: 0x000a, Name: "PacketUnicastTxCount", Type: "uint32", Nullable: true}, ReadPrivilege: "view"},
+			0x000b: {Field: Field{Id: 0x000b, Name: "CurrentMaxRate", Type: "uint64", Nullable: true}, ReadPrivilege: "view"},
+			0x000c: {Field: Field{Id: 0x000c, Name: "OverrunCount", Type: "uint64", Nullable: true}, ReadPrivilege: "view"},
+		},
+		Commands: map[int]CommandInfo{
+			0x00: {Id: 0x00, Name: "ResetCounts", Response: ResponseStatus, InvokePrivilege: "operate"},
+		},
+		Events: map[int]EventInfo{
+			0x00: {Name: "Disconnection", Fields: []Field{
+				{Id: 0, Name: "ReasonCode", Type: "uint16"},
+			}, Priority: "info"},
+			0x01: {Name: "AssociationFailure", Fields: []Field{
+				{Id: 0, Name: "AssociationFailureCause", Type: "AssociationFailureCauseEnum"},
+				{Id: 1, Name: "Status", Type: "uint16"},
+			}, Priority: "info"},
+			0x02: {Name: "ConnectionStatus", Fields: []Field{
+				{Id: 0, Name: "ConnectionStatus", Type: "ConnectionStatusEnum"},
+			}, Priority: "info"},
+		},
+		Enums: map[string]EnumInfo{
+			"SecurityTypeEnum":            {0: "Unspecified", 1: "None", 2: "WEP", 3: "WPA", 4: "WPA2", 5: "WPA3"},
+			"WiFiVersionEnum":             {0: "a", 1: "b", 2: "g", 3: "n", 4: "ac", 5: "ax", 6: "ah"},
+			"AssociationFailureCauseEnum": {0: "Unknown", 1: "AssociationFailed", 2: "AuthenticationFailed", 3: "SsidNotFound"},
+			"ConnectionStatusEnum":        {0: "Connected", 1: "NotConnected"},
+		},
+	},
+	CLUSTER_ID_EthernetNetworkDiagnostics: {
+		Attributes: map[int]AttributeInfo{
+			0x0000: {Field: Field{Id: 0x0000, Name: "PHYRate", Type: "PHYRateEnum", Nullable: true}, ReadPrivilege: "view"},
+			0x0001: {Field: Field{Id: 0x0001, Name: "FullDuplex", Type: "bool", Nullable: true}, ReadPrivilege: "view"},
+			0x0002: {Field: Field{Id: 0x0002, Name: "PacketRxCount", Type: "uint64"}, ReadPrivilege: "view"},
+			0x0003: {Field: Field{Id: 0x0003, Name: "PacketTxCount", Type: "uint64"}, ReadPrivilege: "view"},
+			0x0004: {Field: Field{Id: 0x0004, Name: "TxErrCount", Type: "uint64"}, ReadPrivilege: "view"},
+			0x0005: {Field: Field{Id: 0x0005, Name: "CollisionCount", Type: "uint64"}, ReadPrivilege: "view"},
+			0x0006: {Field: Field{Id: 0x0006, Name: "OverrunCount", Type: "uint64"}, ReadPrivilege: "view"},
+			0x0007: {Field: Field{Id: 0x0007, Name: "CarrierDetect", Type: "bool", Nullable: true}, ReadPrivilege: "view"},
+			0x0008: {Field: Field{Id: 0x0008, Name: "TimeSinceReset", Type: "uint64"}, ReadPrivilege: "view"},
+		},
+		Commands: map[int]CommandInfo{
+			0x00: {Id: 0x00, Name: "ResetCounts", Response: ResponseStatus, InvokePrivilege: "manage"},
+		},
+		Enums: map[string]EnumInfo{
+			"PHYRateEnum": {0: "Rate10M", 1: "Rate100M", 2: "Rate1G", 3: "Rate2_5G", 4: "Rate5G", 5: "Rate10G", 6: "Rate40G", 7: "Rate100G", 8: "Rate200G", 9: "Rate400G"},
+		},
+	},
+	CLUSTER_ID_TimeSync: {
+		Attributes: map[int]AttributeInfo{
+			0x0000: {Field: Field{Id: 0x0000, Name: "UTCTime", Type: "epoch-us", Nullable: true}, ReadPrivilege: "view"},
+			0x0001: {Field: Field{Id: 0x0001, Name: "Granularity", Type: "GranularityEnum"}, ReadPrivilege: "view"},
+			0x0002: {Field: Field{Id: 0x0002, Name: "TimeSource", Type: "TimeSourceEnum"}, ReadPrivilege: "view"},
+			0x0003: {Field: Field{Id: 0x0003, Name: "TrustedTimeSource", Type: "TrustedTimeSourceStruct", Nullable: true}, ReadPrivilege: "view"},
+			0x0004: {Field: Field{Id: 0x0004, Name: "DefaultNTP", Type: "string", Nullable: true}, ReadPrivilege: "view"},
+			0x0005: {Field: Field{Id: 0x0005, Name: "TimeZone", Type: "TimeZoneStruct", List: true}, ReadPrivilege: "view"},
+			0x0006: {Field: Field{Id: 0x0006, Name: "DSTOffset", Type: "DSTOffsetStruct", List: true}, ReadPrivilege: "view"},
+			0x0007: {Field: Field{Id: 0x0007, Name: "LocalTime", Type: "epoch-us", Nullable: true}, ReadPrivilege: "view"},
+			0x0008: {Field: Field{Id: 0x0008, Name: "TimeZoneDatabase", Type: "TimeZoneDatabaseEnum"}, ReadPrivilege: "view"},
+			0x0009: {Field: Field{Id: 0x0009, Name: "NTPServerAvailable", Type: "bool"}, ReadPrivilege: "view"},
+			0x000a: {Field: Field{Id: 0x000a, Name: "TimeZoneListMaxSize", Type: "uint8"}, ReadPrivilege: "view"},
+			0x000b: {Field: Field{Id: 0x000b, Name: "DSTOffsetListMaxSize", Type: "uint8"}, ReadPrivilege: "view"},
+			0x000c: {Field: Field{Id: 0x000c, Name: "SupportsDNSResolve", Type: "bool"}, ReadPrivilege: "view"},
+		},
+		Commands: map[int]CommandInfo{
+			0x00: {Id: 0x00, Name: "SetUTCTime", Response: ResponseStatus, Fields: []Field{
+				{Id: 0, Name: "UTCTime", Type: "epoch-us"},
+				{Id: 1, Name: "Granularity", Type: "GranularityEnum"},
+				{Id: 2, Name: "TimeSource", Type: "TimeSourceEnum"},
+			}, InvokePrivilege: "administer"},
+			0x01: {Id: 0x01, Name: "SetTrustedTimeSource", Response: ResponseStatus, Fields: []Field{
+				{Id: 0, Name: "TrustedTimeSource", Type: "FabricScopedTrustedTimeSourceStruct", Nullable: true},
+			}, InvokePrivilege: "administer", FabricScoped: true},
+			0x02: {Id: 0x02, Name: "SetTimeZone", Response: 0x03, Fields: []Field{
+				{Id: 0, Name: "TimeZone", Type: "TimeZoneStruct", List: true},
+			}, InvokePrivilege: "manage"},
+			0x04: {Id: 0x04, Name: "SetDSTOffset", Response: ResponseStatus, Fields: []Field{
+				{Id: 0, Name: "DSTOffset", Type: "DSTOffsetStruct", List: true},
+			}, InvokePrivilege: "manage"},
+			0x05: {Id: 0x05, Name: "SetDefaultNTP", Response: ResponseStatus, Fields: []Field{
+				{Id: 0, Name: "DefaultNTP", Type: "string", Nullable: true},
+			}, InvokePrivilege: "administer"},
+		},
+		Responses: map[int]CommandInfo{
+			0x03: {Id: 0x03, Name: "SetTimeZoneResponse", Response: ResponseNone, Fields: []Field{
+				{Id: 0, Name: "DSTOffsetRequired", Type: "bool"},
+			}},
+		},
+		Events: map[int]EventInfo{
+			0x00: {Name: "DSTTableEmpty", Priority: "info"},
+			0x01: {Name: "DSTStatus", Fields: []Field{
+				{Id: 0, Name: "DSTOffsetActive", Type: "bool"},
+			}, Priority: "info"},
+			0x02: {Name: "TimeZoneStatus", Fields: []Field{
+				{Id: 0, Name: "Offset", Type: "int32"},
+				{Id: 1, Name: "Name", Type: "string"},
+			}, Priority: "info"},
+			0x03: {Name: "TimeFailure", Priority: "info"},
+			0x04: {Name: "MissingTrustedTimeSource", Priority: "info"},
+		},
+		Enums: map[string]EnumInfo{
+			"GranularityEnum":      {0: "NoTimeGranularity", 1: "MinutesGranularity", 2: "SecondsGranularity", 3: "MillisecondsGranularity", 4: "MicrosecondsGranularity"},
+			"TimeSourceEnum":       {0: "None", 1: "Unknown", 2: "Admin", 3: "NodeTimeCluster", 4: "NonMatterSNTP", 5: "NonMatterNTP", 6: "MatterSNTP", 7: "MatterNTP", 8: "MixedNTP", 9: "NonMatterSNTPNTS", 10: "NonMatterNTPNTS", 11: "MatterSNTPNTS", 12: "MatterNTPNTS", 13: "MixedNTPNTS", 14: "CloudSource", 15: "PTP", 16: "GNSS"},
+			"TimeZoneDatabaseEnum": {0: "Full", 1: "Partial", 2: "None"},
+			"StatusCode":           {2: "TimeNotAccepted"},
+		},
+		Structs: map[string]StructInfo{
+			"FabricScopedTrustedTimeSourceStruct": {Fields: []Field{
+				{Id: 0, Name: "NodeID", Type: "node-id"},
+				{Id: 1, Name: "Endpoint", Type: "endpoint-no"},
+			}},
+			"TrustedTimeSourceStruct": {Fields: []Field{
+				{Id: 0, Name: "FabricIndex", Type: "fabric-idx"},
+				{Id: 1, Name: "NodeID", Type: "node-id"},
+				{Id: 2, Name: "Endpoint", Type: "endpoint-no"},
+			}},
+			"TimeZoneStruct": {Fields: []Field{
+				{Id: 0, Name: "Offset", Type: "int32"},
+				{Id: 1, Name: "ValidAt", Type: "epoch-us"},
+				{Id: 2, Name: "Name", Type: "string"},
+			}},
+			"DSTOffsetStruct": {Fields: []Field{
+				{Id: 0, Name: "Offset", Type: "int32"},
+				{Id: 1, Name: "ValidStarting", Type: "epoch-us"},
+				{Id: 2, Name: "ValidUntil", Type: "epoch-us", Nullable: true},
+			}},
+		},
+	},
+	CLUSTER_ID_BridgedDeviceBasicInformation: {
+		Attributes: map[int]AttributeInfo{
+			0x0001: {Field: Field{Id: 0x0001, Name: "VendorName", Type: "string"}, ReadPrivilege: "view"},
+			0x0002: {Field: Field{Id: 0x0002, Name: "VendorID", Type: "vendor-id"}, ReadPrivilege: "view"},
+			0x0003: {Field: Field{Id: 0x0003, Name: "ProductName", Type: "string"}, ReadPrivilege: "view"},
+			0x0004: {Field: Field{Id: 0x0004, Name: "ProductID", Type: "uint16"}, ReadPrivilege: "view"},
+			0x0005: {Field: Field{Id: 0x0005, Name: "NodeLabel", Type: "string"}, ReadPrivilege: "view", WritePrivilege: "manage", Writable: true},
+			0x0007: {Field: Field{Id: 0x0007, Name: "HardwareVersion", Type: "uint16"}, ReadPrivilege: "view"},
+			0x0008: {Field: Field{Id: 0x0008, Name: "HardwareVersionString", Type: "string"}, ReadPrivilege: "view"},
+			0x0009: {Field: Field{Id: 0x0009, Name: "SoftwareVersion", Type: "uint32"}, ReadPrivilege: "view"},
+			0x000a: {Field: Field{Id: 0x000a, Name: "SoftwareVersionString", Type: "string"}, ReadPrivilege: "view"},
+			0x000b: {Field: Field{Id: 0x000b, Name: "ManufacturingDate", Type: "string"}, ReadPrivilege: "view"},
+			0x000c: {Field: Field{Id: 0x000c, Name: "PartNumber", Type: "string"}, ReadPrivilege: "view"},
+			0x000d: {Field: Field{Id: 0x000d, Name: "ProductURL", Type: "string"}, ReadPrivilege: "view"},
+			0x000e: {Field: Field{Id: 0x000e, Name: "ProductLabel", Type: "string"}, ReadPrivilege: "view"},
+			0x000f: {Field: Field{Id: 0x000f, Name: "SerialNumber", Type: "string"}, ReadPrivilege: "view"},
+			0x0011: {Field: Field{Id: 0x0011, Name: "Reachable", Type: "bool"}, ReadPrivilege: "view"},
+			0x0012: {Field: Field{Id: 0x0012, Name: "UniqueID", Type: "string"}, ReadPrivilege: "view"},
+			0x0014: {Field: Field{Id: 0x0014, Name: "ProductAppearance", Type: "ProductAppearanceStruct"}, ReadPrivilege: "view"},
+		},
+		Events: map[int]EventInfo{
+			0x00: {Name: "StartUp", Fields: []Field{
+				{Id: 0, Name: "SoftwareVersion", Type: "uint32"},
+			}, Priority: "critical"},
+			0x01: {Name: "ShutDown", Priority: "critical"},
+			0x02: {Name: "Leave", Priority: "info"},
+			0x03: {Name: "ReachableChanged", Fields: []Field{
+				{Id: 0, Name: "ReachableNewValue", Type: "bool"},
+			}, Priority: "info"},
+		},
+		Enums: map[string]EnumInfo{
+			"ColorEnum":         {0: "Black", 1: "Navy", 2: "Green", 3: "Teal", 4: "Maroon", 5: "Purple", 6: "Olive", 7: "Gray", 8: "Blue", 9: "Lime", 10: "Aqua", 11: "Red", 12: "Fuchsia", 13: "Yellow", 14: "White", 15: "Nickel", 16: "Chrome", 17: "Brass", 18: "Copper", 19: "Silver", 20: "Gold"},
+			"ProductFinishEnum": {0: "Other", 1: "Matte", 2: "Satin", 3: "Polished", 4: "Rugged", 5: "Fabric"},
+		},
+		Structs: map[string]StructInfo{
+			"ProductAppearanceStruct": {Fields: []Field{
+				{Id: 0, Name: "Finish", Type: "ProductFinishEnum"},
+				{Id: 1, Name: "PrimaryColor", Type: "ColorEnum", Nullable: true},
+			}},
+		},
+	},
+	CLUSTER_ID_Switch: {
+		Attributes: map[int]AttributeInfo{
+			0x0000: {Field: Field{Id: 0x0000, Name: "NumberOfPositions", Type: "uint8"}, ReadPrivilege: "view"},
+			0x0001: {Field: Field{Id: 0x0001, Name: "CurrentPosition", Type: "uint8"}, ReadPrivilege: "view"},
+			0x0002: {Field: Field{Id: 0x0002, Name: "MultiPressMax", Type: "uint8"}, ReadPrivilege: "view"},
+		},
+		Events: map[int]EventInfo{
+			0x00: {Name: "SwitchLatched", Fields: []Field{
+				{Id: 0, Name: "NewPosition", Type: "uint8"},
+			}, Priority: "info"},
+			0x01: {Name: "InitialPress", Fields: []Field{
+				{Id: 0, Name: "NewPosition", Type: "uint8"},
+			}, Priority: "info"},
+			0x02: {Name: "LongPress", Fields: []Field{
+				{Id: 0, Name: "NewPosition", Type: "uint8"},
+			}, Priority: "info"},
+			0x03: {Name: "ShortRelease", Fields: []Field{
+				{Id: 0, Name: "PreviousPosition", Type: "uint8"},
+			}, Priority: "info"},
+			0x04: {Name: "LongRelease", Fields: []Field{
+				{Id: 0, Name: "PreviousPosition", Type: "uint8"},
+			}, Priority: "info"},
+			0x05: {Name: "MultiPressOngoing", Fields: []Field{
+				{Id: 0, Name: "NewPosition", Type: "uint8"},
+				{Id: 1, Name: "CurrentNumberOfPressesCounted", Type: "uint8"},
+			}, Priority: "info"},
+			0x06: {Name: "MultiPressComplete", Fields: []Field{
+				{Id: 0, Name: "PreviousPosition", Type: "uint8"},
+				{Id: 1, Name: "TotalNumberOfPressesCounted", Type: "uint8"},
+			}, Priority: "info"},
+		},
+	},
+	CLUSTER_ID_AdministratorCommissioning: {
+		Attributes: map[int]AttributeInfo{
+			0x0000: {Field: Field{Id: 0x0000, Name: "WindowStatus", Type: "CommissioningWindowStatusEnum"}, ReadPrivilege: "view"},
+			0x0001: {Field: Field{Id: 0x0001, Name: "AdminFabricIndex", Type: "fabric-idx", Nullable: true}, ReadPrivilege: "view"},
+			0x0002: {Field: Field{Id: 0x0002, Name: "AdminVendorId", Type: "vendor-id", Nullable: true}, ReadPrivilege: "view"},
+		},
+		Commands: map[int]CommandInfo{
+			0x00: {Id: 0x00, Name: "OpenCommissioningWindow", Response: ResponseStatus, Fields: []Field{
+				{Id: 0, Name: "CommissioningTimeout", Type: "uint16"},
+				{Id: 1, Name: "PAKEPasscodeVerifier", Type: "octstr"},
+				{Id: 2, Name: "Discriminator", Type: "uint16"},
+				{Id: 3, Name: "Iterations", Type: "uint32"},
+				{Id: 4, Name: "Salt", Type: "octstr"},
+			}, InvokePrivilege: "administer", Timed: true},
+			0x01: {Id: 0x01, Name: "OpenBasicCommissioningWindow", Response: ResponseStatus, Fields: []Field{
+				{Id: 0, Name: "CommissioningTimeout", Type: "uint16"},
+			}, InvokePrivilege: "administer", Timed: true},
+			0x02: {Id: 0x02, Name: "RevokeCommissioning", Response: ResponseStatus, InvokePrivilege: "administer", Timed: true},
+		},
+		Enums: map[string]EnumInfo{
+			"CommissioningWindowStatusEnum": {0: "WindowNotOpen", 1: "EnhancedWindowOpen", 2: "BasicWindowOpen"},
+			"StatusCode":                    {2: "Busy", 3: "PAKEParameterError", 4: "WindowNotOpen"},
+		},
+	},
+	CLUSTER_ID_OperationalCredentials: {
+		Attributes: map[int]AttributeInfo{
+			0x0000: {Field: Field{Id: 0x0000, Name: "NOCs", Type: "NOCStruct", List: true}, ReadPrivilege: "administer", FabricScoped: true, FabricSensitive: true},
+			0x0001: {Field: Field{Id: 0x0001, Name: "Fabrics", Type: "FabricDescriptorStruct", List: true}, ReadPrivilege: "view", FabricScoped: true},
+			0x0002: {Field: Field{Id: 0x0002, Name: "SupportedFabrics", Type: "uint8"}, ReadPrivilege: "view"},
+			0x0003: {Field: Field{Id: 0x0003, Name: "CommissionedFabrics", Type: "uint8"}, ReadPrivilege: "view"},
+			0x0004: {Field: Field{Id: 0x0004, Name: "TrustedRootCertificates", Type: "octstr", List: true}, ReadPrivilege: "view"},
+			0x0005: {Field: Field{Id: 0x0005, Name: "CurrentFabricIndex", Type: "fabric-idx"}, ReadPrivilege: "view"},
+		},
+		Commands: map[int]CommandInfo{
+			0x00: {Id: 0x00, Name: "AttestationRequest", Response: 0x01, Fields: []Field{
+				{Id: 0, Name: "AttestationNonce", Type: "octstr"},
+			}, InvokePrivilege: "administer"},
+			0x02: {Id: 0x02, Name: "CertificateChainRequest", Response: 0x03, Fields: []Field{
+				{Id: 0, Name: "CertificateType", Type: "CertificateChainTypeEnum"},
+			}, InvokePrivilege: "administer"},
+			0x04: {Id: 0x04, Name: "CSRRequest", Response: 0x05, Fields: []Field{
+				{Id: 0, Name: "CSRNonce", Type: "octstr"},
+				{Id: 1, Name: "IsForUpdateNOC", Type: "bool"},
+			}, InvokePrivilege: "administer"},
+			0x06: {Id: 0x06, Name: "AddNOC", Response: 0x08, Fields: []Field{
+				{Id: 0, Name: "NOCValue", Type: "octstr"},
+				{Id: 1, Name: "ICACValue", Type: "octstr"},
+				{Id: 2, Name: "IPKValue", Type: "octstr"},
+				{Id: 3, Name: "CaseAdminSubject", Type: "subject-id"},
+				{Id: 4, Name: "AdminVendorId", Type: "vendor-id"},
+			}, InvokePrivilege: "administer"},
+			0x07: {Id: 0x07, Name: "UpdateNOC", Response: 0x08, Fields: []Field{
+				{Id: 0, Name: "NOCValue", Type: "octstr"},
+				{Id: 1, Name: "ICACValue", Type: "octstr"},
+			}, InvokePrivilege: "administer", FabricScoped: true},
+			0x09: {Id: 0x09, Name: "UpdateFabricLabel", Response: 0x08, Fields: []Field{
+				{Id: 0, Name: "Label", Type: "string"},
+			}, InvokePrivilege: "administer", FabricScoped: true},
+			0x0a: {Id: 0x0a, Name: "RemoveFabric", Response: 0x08, Fields: []Field{
+				{Id: 0, Name: "FabricIndex", Type: "fabric-idx"},
+			}, InvokePrivilege: "administer"},
+			0x0b: {Id: 0x0b, Name: "AddTrustedRootCertificate", Response: ResponseStatus, Fields: []Field{
+				{Id: 0, Name: "RootCACertificate", Type: "octstr"},
+			}, InvokePrivilege: "administer"},
+		},
+		Responses: map[int]CommandInfo{
+			0x01: {Id: 0x01, Name: "AttestationResponse", Response: ResponseNone, Fields: []Field{
+				{Id: 0, Name: "AttestationElements", Type: "octstr"},
+				{Id: 1, Name: "AttestationSignature", Type: "octstr"},
+			}},
+			0x03: {Id: 0x03, Name: "CertificateChainResponse", Response: ResponseNone, Fields: []Field{
+				{Id: 0, Name: "Certificate", Type: "octstr"},
+			}},
+			0x05: {Id: 0x05, Name: "CSRResponse", Response: ResponseNone, Fields: []Field{
+				{Id: 0, Name: "NOCSRElements", Type: "octstr"},
+				{Id: 1, Name: "AttestationSignature", Type: "octstr"},
+			}},
+			0x08: {Id: 0x08, Name: "NOCResponse", Response: ResponseNone, Fields: []Field{
+				{Id: 0, Name: "StatusCode", Type: "NodeOperationalCertStatusEnum"},
+				{Id: 1, Name: "FabricIndex", Type: "fabric-idx"},
+				{Id: 2, Name: "DebugText", Type: "string"},
+			}},
+		},
+		Enums: map[string]EnumInfo{
+			"CertificateChainTypeEnum":      {1: "DACCertificate", 2: "PAICertificate"},
+			"NodeOperationalCertStatusEnum": {0: "OK", 1: "InvalidPublicKey", 2: "InvalidNodeOpId", 3: "InvalidNOC", 4: "MissingCsr", 5: "TableFull", 6: "InvalidAdminSubject", 9: "FabricConflict", 10: "LabelConflict", 11: "InvalidFabricIndex"},
+		},
+		Structs: map[string]StructInfo{
+			"NOCStruct": {Fields: []Field{
+				{Id: 1, Name: "NOC", Type: "octstr"},
+				{Id: 2, Name: "ICAC", Type: "octstr", Nullable: true},
+				{Id: 254, Name: "FabricIndex", Type: "fabric-idx"},
+			}, FabricScoped: true},
+			"FabricDescriptorStruct": {Fields: []Field{
+				{Id: 1, Name: "RootPublicKey", Type: "octstr"},
+				{Id: 2, Name: "VendorID", Type: "vendor-id"},
+				{Id: 3, Name: "FabricID", Type: "fabric-id"},
+				{Id: 4, Name: "NodeID", Type: "node-id"},
+				{Id: 5, Name: "Label", Type: "string"},
+				{Id: 254, Name: "FabricIndex", Type: "fabric-idx"},
+			}, FabricScoped: true},
+		},
+	},
+	CLUSTER_ID_GroupKeyManagement: {
+		Attributes: map[int]AttributeInfo{
+			0x0000: {Field: Field{Id: 0x0000, Name: "GroupKeyMap", Type: "GroupKeyMapStruct", List: true}, ReadPrivilege: "view", WritePrivilege: "manage", Writable: true, FabricScoped: true},
+			0x0001: {Field: Field{Id: 0x0001, Name: "GroupTable", Type: "GroupInfoMapStruct", List: true}, ReadPrivilege: "view", FabricScoped: true},
+			0x0002: {Field: Field{Id: 0x0002, Name: "MaxGroupsPerFabric", Type: "uint16"}, ReadPrivilege: "view"},
+			0x0003: {Field: Field{Id: 0x0003, Name: "MaxGroupKeysPerFabric", Type: "uint16"}, ReadPrivilege: "view"},
+		},
+		Commands: map[int]CommandInfo{
+			0x00: {Id: 0x00, Name: "KeySetWrite", Response: ResponseStatus, Fields: []Field{
+				{Id: 0, Name: "GroupKeySet", Type: "GroupKeySetStruct"},
+			}, InvokePrivilege: "administer", FabricScoped: true},
+			0x01: {Id: 0x01, Name: "KeySetRead", Response: 0x02, Fields: []Field{
+				{Id: 0, Name: "GroupKeySetID", Type: "uint16"},
+			}, InvokePrivilege: "administer", FabricScoped: true},
+			0x03: {Id: 0x03, Name: "KeySetRemove", Response: ResponseStatus, Fields: []Field{
+				{Id: 0, Name: "GroupKeySetID", Type: "uint16"},
+			}, InvokePrivilege: "administer", FabricScoped: true},
+			0x04: {Id: 0x04, Name: "KeySetReadAllIndices", Response: 0x05, InvokePrivilege: "administer", FabricScoped: true},
+		},
+		Responses: map[int]CommandInfo{
+			0x02: {Id: 0x02, Name: "KeySetReadResponse", Response: ResponseNone, Fields: []Field{
+				{Id: 0, Name: "GroupKeySet", Type: "GroupKeySetStruct"},
+			}},
+			0x05: {Id: 0x05, Name: "KeySetReadAllIndicesResponse", Response: ResponseNone, Fields: []Field{
+				{Id: 0, Name: "GroupKeySetIDs", Type: "uint16", List: true},
+			}},
+		},
+		Enums: map[string]EnumInfo{
+			"GroupKeySecurityPolicyEnum": {0: "TrustFirst", 1: "CacheAndSync"},
+		},
+		Structs: map[string]StructInfo{
+			"GroupKeyMapStruct": {Fields: []Field{
+				{Id: 1, Name: "GroupId", Type: "group-id"},
+				{Id: 2, Name: "GroupKeySetID", Type: "uint16"},
+				{Id: 254, Name: "FabricIndex", Type: "fabric-idx"},
+			}, FabricScoped: true},
+			"GroupInfoMapStruct": {Fields: []Field{
+				{Id: 1, Name: "GroupId", Type: "group-id"},
+				{Id: 2, Name: "Endpoints", Type: "endpoint-no", List: true},
+				{Id: 3, Name: "GroupName", Type: "string"},
+				{Id: 254, Name: "FabricIndex", Type: "fabric-idx"},
+			}, FabricScoped: true},
+			"GroupKeySetStruct": {Fields: []Field{
+				{Id: 0, Name: "GroupKeySetID", Type: "uint16"},
+				{Id: 1, Name: "GroupKeySecurityPolicy", Type: "GroupKeySecurityPolicyEnum"},
+				{Id: 2, Name: "EpochKey0", Type: "octstr", Nullable: true},
+				{Id: 3, Name: "EpochStartTime0", Type: "epoch-us", Nullable: true},
+				{Id: 4, Name: "EpochKey1", Type: "octstr", Nullable: true},
+				{Id: 5, Name: "EpochStartTime1", Type: "epoch-us", Nullable: true},
+				{Id: 6, Name: "EpochKey2", Type: "octstr", Nullable: true},
+				{Id: 7, Name: "EpochStartTime2", Type: "epoch-us", Nullable: true},
+			}},
+		},
+	},
+	CLUSTER_ID_FixedLabel: {
+		Attributes: map[int]AttributeInfo{
+			0x0000: {Field: Field{Id: 0x0000, Name: "LabelList", Type: "LabelStruct", List: true}, ReadPrivilege: "view"},
+		},
+		Structs: map[string]StructInfo{
+			"LabelStruct": {Fields: []Field{
+				{Id: 0, Name: "Label", Type: "string"},
+				{Id: 1, Name: "Value", Type: "string"},
+			}},
+		},
+	},
+	CLUSTER_ID_UserLabel: {
+		Attributes: map[int]AttributeInfo{
+			0x0000: {Field: Field{Id: 0x0000, Name: "LabelList", Type: "LabelStruct", List: true}, ReadPrivilege: "view", WritePrivilege: "manage", Writable: true},
+		},
+		Structs: map[string]StructInfo{
+			"LabelStruct": {Fields: []Field{
+				{Id: 0, Name: "Label", Type: "string"},
+				{Id: 1, Name: "Value", Type: "string"},
+			}},
+		},
+	},
+	CLUSTER_ID_BooleanState: {
+		Attributes: map[int]AttributeInfo{
+			0x0000: {Field: Field{Id: 0x0000, Name: "StateValue", Type: "bool"}, ReadPrivilege: "view"},
+		},
+		Events: map[int]EventInfo{
+			0x00: {Name: "StateChange", Fields: []Field{
+				{Id: 0, Name: "StateValue", Type: "bool"},
+			}, Priority: "info"},
+		},
+	},
+	CLUSTER_ID_Timer: {
+		Attributes: map[int]AttributeInfo{
+			0x0000: {Field: Field{Id: 0x0000, Name: "SetTime", Type: "elapsed-s"}, ReadPrivilege: "view"},
+			0x0001: {Field: Field{Id: 0x0001, Name: "TimeRemaining", Type: "elapsed-s"}, ReadPrivilege: "view"},
+			0x0002: {Field: Field{Id: 0x0002, Name: "TimerState", Type: "TimerStatusEnum"}, ReadPrivilege: "view"},
+		},
+		Commands: map[int]CommandInfo{
+			0x00: {Id: 0x00, Name: "SetTimer", Response: ResponseStatus, Fields: []Field{
+				{Id: 0, Name: "NewTime", Type: "elapsed-s"},
+			}, InvokePrivilege: "operate"},
+			0x01: {Id: 0x01, Name: "ResetTimer", Response: ResponseStatus, InvokePrivilege: "operate"},
+			0x02: {Id: 0x02, Name: "AddTime", Response: ResponseStatus, Fields: []Field{
+				{Id: 0, Name: "AdditionalTime", Type: "elapsed-s"},
+			}, InvokePrivilege: "operate"},
+			0x03: {Id: 0x03, Name: "ReduceTime", Response: ResponseStatus, Fields: []Field{
+				{Id: 0, Name: "TimeReduction", Type: "elapsed-s"},
+			}, InvokePrivilege: "operate"},
+		},
+		Enums: map[string]EnumInfo{
+			"TimerStatusEnum": {0: "Running", 1: "Paused", 2: "Expired", 3: "Ready"},
+		},
+	},
+	CLUSTER_ID_OvenCavityOperationalState: {
+		Attributes: map[int]AttributeInfo{
+			0x0000: {Field: Field{Id: 0x0000, Name: "PhaseList", Type: "string", List: true, Nullable: true}, ReadPrivilege: "view"},
+			0x0001: {Field: Field{Id: 0x0001, Name: "CurrentPhase", Type: "uint8", Nullable: true}, ReadPrivilege: "view"},
+			0x0002: {Field: Field{Id: 0x0002, Name: "CountdownTime", Type: "elapsed-s", Nullable: true}, ReadPrivilege: "view"},
+			0x0003: {Field: Field{Id: 0x0003, Name: "OperationalStateList", Type: "OperationalStateStruct", List: true}, ReadPrivilege: "view"},
+			0x0004: {Field: Field{Id: 0x0004, Name: "OperationalState", Type: "OperationalStateEnum"}, ReadPrivilege: "view"},
+			0x0005: {Field: Field{Id: 0x0005, Name: "OperationalError", Type: "ErrorStateStruct"}, ReadPrivilege: "view"},
+		},
+		Commands: map[int]CommandInfo{
+			0x00: {Id: 0x00, Name: "Pause", Response: 0x04, InvokePrivilege: "operate"},
+			0x01: {Id: 0x01, Name: "Stop", Response: 0x04, InvokePrivilege: "operate"},
+			0x02: {Id: 0x02, Name: "Start", Response: 0x04, InvokePrivilege: "operate"},
+			0x03: {Id: 0x03, Name: "Resume", Response: 0x04, InvokePrivilege: "operate"},
+		},
+		Responses: map[int]CommandInfo{
+			0x04: {Id: 0x04, Name: "OperationalCommandResponse", Response: ResponseNone, Fields: []Field{
+				{Id: 0, Name: "CommandResponseState", Type: "ErrorStateStruct"},
+			}},
+		},
+		Events: map[int]EventInfo{
+			0x00: {Name: "OperationalError", Fields: []Field{
+				{Id: 0, Name: "ErrorState", Type: "ErrorStateStruct"},
+			}, Priority: "critical"},
+			0x01: {Name: "OperationCompletion", Fields: []Field{
+				{Id: 0, Name: "CompletionErrorCode", Type: "uint8"},
+				{Id: 1, Name: "TotalOperationalTime", Type: "elapsed-s", Nullable: true},
+				{Id: 2, Name: "PausedTime", Type: "elapsed-s", Nullable: true},
+			}, Priority: "info"},
+		},
+		Enums: map[string]EnumInfo{
+			"OperationalStateEnum": {0: "Stopped", 1: "Running", 2: "Paused", 3: "Error"},
+			"ErrorStateEnum":       {0: "NoError", 1: "UnableToStartOrResume", 2: "UnableToCompleteOperation", 3: "CommandInvalidInState"},
+		},
+		Structs: map[string]StructInfo{
+			"ErrorStateStruct": {Fields: []Field{
+				{Id: 0, Name: "ErrorStateID", Type: "ErrorStateEnum"},
+				{Id: 1, Name: "ErrorStateLabel", Type: "string"},
+				{Id: 2, Name: "ErrorStateDetails", Type: "string"},
+			}},
+			"OperationalStateStruct": {Fields: []Field{
+				{Id: 0, Name: "OperationalStateID", Type: "OperationalStateEnum"},
+				{Id: 1, Name: "OperationalStateLabel", Type: "string"},
+			}},
+		},
+	},
+	CLUSTER_ID_OvenMode: {
+		Attributes: map[int]AttributeInfo{
+			0x0000: {Field: Field{Id: 0x0000, Name: "SupportedModes", Type: "ModeOptionStruct", List: true}, ReadPrivilege: "view"},
+			0x0001: {Field: Field{Id: 0x0001, Name: "CurrentMode", Type: "uint8"}, ReadPrivilege: "view"},
+			0x0002: {Field: Field{Id: 0x0002, Name: "StartUpMode", Type: "uint8", Nullable: true}, ReadPrivilege: "view", WritePrivilege: "operate", Writable: true},
+			0x0003: {Field: Field{Id: 0x0003, Name: "OnMode", Type: "uint8", Nullable: true}, ReadPrivilege: "view", WritePrivilege: "operate", Writable: true},
+		},
+		Commands: map[int]CommandInfo{
+			0x00: {Id: 0x00, Name: "ChangeToMode", Response: 0x01, Fields: []Field{
+				{Id: 0, Name: "NewMode", Type: "uint8"},
+			}, InvokePrivilege: "operate"},
+		},
+		Responses: map[int]CommandInfo{
+			0x01: {Id: 0x01, Name: "ChangeToModeResponse", Response: ResponseNone, Fields: []Field{
+				{Id: 0, Name: "Status", Type: "StatusCode"},
+				{Id: 1, Name: "StatusText", Type: "string"},
+			}},
+		},
+		Enums: map[string]EnumInfo{
+			"ModeTag":    {0: "Auto", 1: "Quick", 2: "Quiet", 3: "LowNoise", 4: "LowEnergy", 5: "Vacation", 6: "Min", 7: "Max", 8: "Night", 9: "Day", 16384: "Bake", 16385: "Convection", 16386: "Grill", 16387: "Roast", 16388: "Clean", 16389: "ConvectionBake", 16390: "ConvectionRoast", 16391: "Warming", 16392: "Proofing"},
+			"StatusCode": {0: "Success", 1: "UnsupportedMode", 2: "GenericFailure", 3: "InvalidInMode"},
+		},
+		Structs: map[string]StructInfo{
+			"ModeTagStruct": {Fields: []Field{
+				{Id: 0, Name: "MfgCode", Type: "vendor-id"},
+				{Id: 1, Name: "Value", Type: "ModeTag"},
+			}},
+			"ModeOptionStruct": {Fields: []Field{
+				{Id: 0, Name: "Label", Type: "string"},
+				{Id: 1, Name: "Mode", Type: "uint8"},
+				{Id: 2, Name: "ModeTags", Type: "ModeTagStruct", List: true},
+			}},
+		},
+	},
+	CLUSTER_ID_LaundryDryerControls: {
+		Attributes: map[int]AttributeInfo{
+			0x0000: {Field: Field{Id: 0x0000, Name: "SupportedDrynessLevels", Type: "DrynessLevelEnum", List: true}, ReadPrivilege: "view"},
+			0x0001: {Field: Field{Id: 0x0001, Name: "SelectedDrynessLevel", Type: "DrynessLevelEnum", Nullable: true}, ReadPrivilege: "view", WritePrivilege: "operate", Writable: true},
+		},
+		Enums: map[string]EnumInfo{
+			"DrynessLevelEnum": {0: "Low", 1: "Normal", 2: "Extra", 3: "Max"},
+		},
+	},
+	CLUSTER_ID_ModeSelect: {
+		Attributes: map[int]AttributeInfo{
+			0x0000: {Field: Field{Id: 0x0000, Name: "Description", Type: "string"}, ReadPrivilege: "view"},
+			0x0001: {Field: Field{Id: 0x0001, Name: "StandardNamespace", Type: "enum16", Nullable: true}, ReadPrivilege: "view"},
+			0x0002: {Field: Field{Id: 0x0002, Name: "SupportedModes", Type: "ModeOptionStruct", List: true}, ReadPrivilege: "view"},
+			0x0003: {Field: Field{Id: 0x0003, Name: "CurrentMode", Type: "uint8"}, ReadPrivilege: "view"},
+			0x0004: {Field: Field{Id: 0x0004, Name: "StartUpMode", Type: "uint8", Nullable: true}, ReadPrivilege: "view", WritePrivilege: "operate", Writable: true},
+			0x0005: {Field: Field{Id: 0x0005, Name: "OnMode", Type: "uint8", Nullable: true}, ReadPrivilege: "view", WritePrivilege: "operate", Writable: true},
+		},
+		Commands: map[int]CommandInfo{
+			0x00: {Id: 0x00, Name: "ChangeToMode", Response: ResponseStatus, Fields: []Field{
+				{Id: 0, Name: "NewMode", Type: "uint8"},
+			}, InvokePrivilege: "operate"},
+		},
+		Structs: map[string]StructInfo{
+			"SemanticTagStruct": {Fields: []Field{
+				{Id: 0, Name: "MfgCode", Type: "vendor-id"},
+				{Id: 1, Name: "Value", Type: "enum16"},
+			}},
+			"ModeOptionStruct": {Fields: []Field{
+				{Id: 0, Name: "Label", Type: "string"},
+				{Id: 1, Name: "Mode", Type: "uint8"},
+				{Id: 2, Name: "SemanticTags", Type: "SemanticTagStruct", List: true},
+			}},
+		},
+	},
+	CLUSTER_ID_LaundryWasherMode: {
+		Attributes: map[int]AttributeInfo{
+			0x0000: {Field: Field{Id: 0x0000, Name: "SupportedModes", Type: "ModeOptionStruct", List: true}, ReadPrivilege: "view"},
+			0x0001: {Field: Field{Id: 0x0001, Name: "CurrentMode", Type: "uint8"}, ReadPrivilege: "view"},
+			0x0002: {Field: Field{Id: 0x0002, Name: "StartUpMode", Type: "uint8", Nullable: true}, ReadPrivilege: "view", WritePrivilege: "operate", Writable: true},
+			0x0003: {Field: Field{Id: 0x0003, Name: "OnMode", Type: "uint8", Nullable: true}, ReadPrivilege: "view", WritePrivilege: "operate", Writable: true},
+		},
+		Commands: map[int]CommandInfo{
+			0x00: {Id: 0x00, Name: "ChangeToMode", Response: 0x01, Fields: []Field{
+				{Id: 0, Name: "NewMode", Type: "uint8"},
+			}, InvokePrivilege: "operate"},
+		},
+		Responses: map[int]CommandInfo{
+			0x01: {Id: 0x01, Name: "ChangeToModeResponse", Response: ResponseNone, Fields: []Field{
+				{Id: 0, Name: "Status", Type: "StatusCode"},
+				{Id: 1, Name: "StatusText", Type: "string"},
+			}},
+		},
+		Enums: map[string]EnumInfo{
+			"ModeTag":    {0: "Auto", 1: "Quick", 2: "Quiet", 3: "LowNoise", 4: "LowEnergy", 5: "Vacation", 6: "Min", 7: "Max", 8: "Night", 9: "Day", 16384: "Normal", 16385: "Delicate", 16386: "Heavy", 16387: "Whites"},
+			"StatusCode": {0: "Success", 1: "UnsupportedMode", 2: "GenericFailure", 3: "InvalidInMode"},
+		},
+		Structs: map[string]StructInfo{
+			"ModeTagStruct": {Fields: []Field{
+				{Id: 0, Name: "MfgCode", Type: "vendor-id"},
+				{Id: 1, Name: "Value", Type: "ModeTag"},
+			}},
+			"ModeOptionStruct": {Fields: []Field{
+				{Id: 0, Name: "Label", Type: "string"},
+				{Id: 1, Name: "Mode", Type: "uint8"},
+				{Id: 2, Name: "ModeTags", Type: "ModeTagStruct", List: true},
+			}},
+		},
+	},
+	CLUSTER_ID_RefrigeratorAndTemperatureControlledCabinetMode: {
+		Attributes: map[int]AttributeInfo{
+			0x0000: {Field: Field{Id: 0x0000, Name: "SupportedModes", Type: "ModeOptionStruct", List: true}, ReadPrivilege: "view"},
+			0x0001: {Field: Field{Id: 0x0001, Name: "CurrentMode", Type: "uint8"}, ReadPrivilege: "view"},
+			0x0002: {Field: Field{Id: 0x0002, Name: "StartUpMode", Type: "uint8", Nullable: true}, ReadPrivilege: "view", WritePrivilege: "operate", Writable: true},
+			0x0003: {Field: Field{Id: 0x0003, Name: "OnMode", Type: "uint8", Nullable: true}, ReadPrivilege: "view", WritePrivilege: "operate", Writable: true},
+		},
+		Commands: map[int]CommandInfo{
+			0x00: {Id: 0x00, Name: "ChangeToMode", Response: 0x01, Fields: []Field{
+				{Id: 0, Name: "NewMode", Type: "uint8"},
+			}, InvokePrivilege: "operate"},
+		},
+		Responses: map[int]CommandInfo{
+			0x01: {Id: 0x01, Name: "ChangeToModeResponse", Response: ResponseNone, Fields: []Field{
+				{Id: 0, Name: "Status", Type: "StatusCode"},
+				{Id: 1, Name: "StatusText", Type: "string"},
+			}},
+		},
+		Enums: map[string]EnumInfo{
+			"ModeTag":    {0: "Auto", 1: "Quick", 2: "Quiet", 3: "LowNoise", 4: "LowEnergy", 5: "Vacation", 6: "Min", 7: "Max", 8: "Night", 9: "Day", 16384: "RapidCool", 16385: "RapidFreeze"},
+			"StatusCode": {0: "Success", 1: "UnsupportedMode", 2: "GenericFailure", 3: "InvalidInMode"},
+		},
+		Structs: map[string]StructInfo{
+			"ModeTagStruct": {Fields: []Field{
+				{Id: 0, Name: "MfgCode", Type: "vendor-id"},
+				{Id: 1, Name: "Value", Type: "ModeTag"},
+			}},
+			"ModeOptionStruct": {Fields: []Field{
+				{Id: 0, Name: "Label", Type: "string"},
+				{Id: 1, Name: "Mode", Type: "uint8"},
+				{Id: 2, Name: "ModeTags", Type: "ModeTagStruct", List: true},
+			}},
+		},
+	},
+	CLUSTER_ID_LaundryWasherControls: {
+		Attributes: map[int]AttributeInfo{
+			0x0000: {Field: Field{Id: 0x0000, Name: "SpinSpeeds", Type: "string", List: true}, ReadPrivilege: "view"},
+			0x0001: {Field: Field{Id: 0x0001, Name: "SpinSpeedCurrent", Type: "uint8", Nullable: true}, ReadPrivilege: "view", WritePrivilege: "operate", Writable: true},
+			0x0002: {Field: Field{Id: 0x0002, Name: "NumberOfRinses", Type: "NumberOfRinsesEnum"}, ReadPrivilege: "view", WritePrivilege: "operate", Writable: true},
+			0x0003: {Field: Field{Id: 0x0003, Name: "SupportedRinses", Type: "NumberOfRinsesEnum", List: true}, ReadPrivilege: "view"},
+		},
+		Enums: map[string]EnumInfo{
+			"NumberOfRinsesEnum": {0: "None", 1: "Normal", 2: "Extra", 3: "Max"},
+		},
+	},
+	CLUSTER_ID_RVCRunMode: {
+		Attributes: map[int]AttributeInfo{
+			0x0000: {Field: Field{Id: 0x0000, Name: "SupportedModes", Type: "ModeOptionStruct", List: true}, ReadPrivilege: "view"},
+			0x0001: {Field: Field{Id: 0x0001, Name: "CurrentMode", Type: "uint8"}, ReadPrivilege: "view"},
+			0x0002: {Field: Field{Id: 0x0002, Name: "StartUpMode", Type: "uint8", Nullable: true}, ReadPrivilege: "view", WritePrivilege: "operate", Writable: true},
+			0x0003: {Field: Field{Id: 0x0003, Name: "OnMode", Type: "uint8", Nullable: true}, ReadPrivilege: "view", WritePrivilege: "operate", Writable: true},
+		},
+		Commands: map[int]CommandInfo{
+			0x00: {Id: 0x00, Name: "ChangeToMode", Response: 0x01, Fields: []Field{
+				{Id: 0, Name: "NewMode", Type: "uint8"},
+			}, InvokePrivilege: "operate"},
+		},
+		Responses: map[int]CommandInfo{
+			0x01: {Id: 0x01, Name: "ChangeToModeResponse", Response: ResponseNone, Fields: []Field{
+				{Id: 0, Name: "Status", Type: "StatusCode"},
+				{Id: 1, Name: "StatusText", Type: "string"},
+			}},
+		},
+		Enums: map[string]EnumInfo{
+			"ModeTag":    {0: "Auto", 1: "Quick", 2: "Quiet", 3: "LowNoise", 4: "LowEnergy", 5: "Vacation", 6: "Min", 7: "Max", 8: "Night", 9: "Day", 16384: "Idle", 16385: "Cleaning", 16386: "Mapping"},
+			"StatusCode": {0: "Success", 1: "UnsupportedMode", 2: "GenericFailure", 3: "InvalidInMode", 64: "Stuck", 65: "DustBinMissing", 66: "DustBinFull", 67: "WaterTankEmpty", 68: "WaterTankMissing", 69: "WaterTankLidOpen", 70: "MopCleaningPadMissing", 71: "BatteryLow"},
+		},
+		Structs: map[string]StructInfo{
+			"ModeTagStruct": {Fields: []Field{
+				{Id: 0, Name: "MfgCode", Type: "vendor-id"},
+				{Id: 1, Name: "Value", Type: "ModeTag"},
+			}},
+			"ModeOptionStruct": {Fields: []Field{
+				{Id: 0, Name: "Label", Type: "string"},
+				{Id: 1, Name: "Mode", Type: "uint8"},
+				{Id: 2, Name: "ModeTags", Type: "ModeTagStruct", List: true},
+			}},
+		},
+	},
+	CLUSTER_ID_RVCCleanMode: {
+		Attributes: map[int]AttributeInfo{
+			0x0000: {Field: Field{Id: 0x0000, Name: "SupportedModes", Type: "ModeOptionStruct", List: true}, ReadPrivilege: "view"},
+			0x0001: {Field: Field{Id: 0x0001, Name: "CurrentMode", Type: "uint8"}, ReadPrivilege: "view"},
+			0x0002: {Field: Field{Id: 0x0002, Name: "StartUpMode", Type: "uint8", Nullable: true}, ReadPrivilege: "view", WritePrivilege: "operate", Writable: true},
+			0x0003: {Field: Field{Id: 0x0003, Name: "OnMode", Type: "uint8", Nullable: true}, ReadPrivilege: "view", WritePrivilege: "operate", Writable: true},
+		},
+		Commands: map[int]CommandInfo{
+			0x00: {Id: 0x00, Name: "ChangeToMode", Response: 0x01, Fields: []Field{
+				{Id: 0, Name: "NewMode", Type: "uint8"},
+			}, InvokePrivilege: "operate"},
+		},
+		Responses: map[int]CommandInfo{
+			0x01: {Id: 0x01, Name: "ChangeToModeResponse", Response: ResponseNone, Fields: []Field{
+				{Id: 0, Name: "Status", Type: "StatusCode"},
+				{Id: 1, Name: "StatusText", Type: "string"},
+			}},
+		},
+		Enums: map[string]EnumInfo{
+			"ModeTag":    {0: "Auto", 1: "Quick", 2: "Quiet", 3: "LowNoise", 4: "LowEnergy", 5: "Vacation", 6: "Min", 7: "Max", 8: "Night", 9: "Day", 16384: "DeepClean", 16385: "Vacuum", 16386: "Mop"},
+			"StatusCode": {0: "Success", 1: "UnsupportedMode", 2: "GenericFailure", 3: "InvalidInMode", 64: "CleaningInProgress"},
+		},
+		Structs: map[string]StructInfo{
+			"ModeTagStruct": {Fields: []Field{
+				{Id: 0, Name: "MfgCode", Type: "vendor-id"},
+				{Id: 1, Name: "Value", Type: "ModeTag"},
+			}},
+			"ModeOptionStruct": {Fields: []Field{
+				{Id: 0, Name: "Label", Type: "string"},
+				{Id: 1, Name: "Mode", Type: "uint8"},
+				{Id: 2, Name: "ModeTags", Type: "ModeTagStruct", List: true},
+			}},
+		},
+	},
+	CLUSTER_ID_TemperatureControl: {
+		Attributes: map[int]AttributeInfo{
+			0x0000: {Field: Field{Id: 0x0000, Name: "TemperatureSetpoint", Type: "temperature"}, ReadPrivilege: "view"},
+			0x0001: {Field: Field{Id: 0x0001, Name: "MinTemperature", Type: "temperature"}, ReadPrivilege: "view"},
+			0x0002: {Field: Field{Id: 0x0002, Name: "MaxTemperature", Type: "temperature"}, ReadPrivilege: "view"},
+			0x0003: {Field: Field{Id: 0x0003, Name: "Step", Type: "temperature"}, ReadPrivilege: "view"},
+			0x0004: {Field: Field{Id: 0x0004, Name: "SelectedTemperatureLevel", Type: "uint8"}, ReadPrivilege: "view"},
+			0x0005: {Field: Field{Id: 0x0005, Name: "SupportedTemperatureLevels", Type: "string", List: true}, ReadPrivilege: "view"},
+		},
+		Commands: map[int]CommandInfo{
+			0x00: {Id: 0x00, Name: "SetTemperature", Response: ResponseStatus, Fields: []Field{
+				{Id: 0, Name: "TargetTemperature", Type: "temperature"},
+				{Id: 1, Name: "TargetTemperatureLevel", Type: "uint8"},
+			}, InvokePrivilege: "operate"},
+		},
+	},
+	CLUSTER_ID_RefrigeratorAlarm: {
+		Attributes: map[int]AttributeInfo{
+			0x0000: {Field: Field{Id: 0x0000, Name: "Mask", Type: "AlarmBitmap"}, ReadPrivilege: "view"},
+			0x0001: {Field: Field{Id: 0x0001, Name: "Latch", Type: "AlarmBitmap"}, ReadPrivilege: "view"},
+			0x0002: {Field: Field{Id: 0x0002, Name: "State", Type: "AlarmBitmap"}, ReadPrivilege: "view"},
+			0x0003: {Field: Field{Id: 0x0003, Name: "Supported", Type: "AlarmBitmap"}, ReadPrivilege: "view"},
+		},
+		Commands: map[int]CommandInfo{
+			0x00: {Id: 0x00, Name: "Reset", Response: ResponseStatus, Fields: []Field{
+				{Id: 0, Name: "Alarms", Type: "AlarmBitmap"},
+			}, InvokePrivilege: "operate"},
+			0x01: {Id: 0x01, Name: "ModifyEnabledAlarms", Response: ResponseStatus, Fields: []Field{
+				{Id: 0, Name: "Mask", Type: "AlarmBitmap"},
+			}, InvokePrivilege: "operate"},
+		},
+		Events: map[int]EventInfo{
+			0x00: {Name: "Notify", Fields: []Field{
+				{Id: 0, Name: "Active", Type: "AlarmBitmap"},
+				{Id: 1, Name: "Inactive", Type: "AlarmBitmap"},
+				{Id: 2, Name: "State", Type: "AlarmBitmap"},
+				{Id: 3, Name: "Mask", Type: "AlarmBitmap"},
+			}, Priority: "info"},
+		},
+		Bitmaps: map[string]BitmapInfo{
+			"AlarmBitmap": {0: "DoorOpen"},
+		},
+	},
+	CLUSTER_ID_DishwasherMode: {
+		Attributes: map[int]AttributeInfo{
+			0x0000: {Field: Field{Id: 0x0000, Name: "SupportedModes", Type: "ModeOptionStruct", List: true}, ReadPrivilege: "view"},
+			0x0001: {Field: Field{Id: 0x0001, Name: "CurrentMode", Type: "uint8"}, ReadPrivilege: "view"},
+			0x0002: {Field: Field{Id: 0x0002, Name: "StartUpMode", Type: "uint8", Nullable: true}, ReadPrivilege: "view", WritePrivilege: "operate", Writable: true},
+			0x0003: {Field: Field{Id: 0x0003, Name: "OnMode", Type: "uint8", Nullable: true}, ReadPrivilege: "view", WritePrivilege: "operate", Writable: true},
+		},
+		Commands: map[int]CommandInfo{
+			0x00: {Id: 0x00, Name: "ChangeToMode", Response: 0x01, Fields: []Field{
+				{Id: 0, Name: "NewMode", Type: "uint8"},
+			}, InvokePrivilege: "operate"},
+		},
+		Responses: map[int]CommandInfo{
+			0x01: {Id: 0x01, Name: "ChangeToModeResponse", Response: ResponseNone, Fields: []Field{
+				{Id: 0, Name: "Status", Type: "StatusCode"},
+				{Id: 1, Name: "StatusText", Type: "string"},
+			}},
+		},
+		Enums: map[string]EnumInfo{
+			"ModeTag":    {0: "Auto", 1: "Quick", 2: "Quiet", 3: "LowNoise", 4: "LowEnergy", 5: "Vacation", 6: "Min", 7: "Max", 8: "Night", 9: "Day", 16384: "Normal", 16385: "Heavy", 16386: "Light"},
+			"StatusCode": {0: "Success", 1: "UnsupportedMode", 2: "GenericFailure", 3: "InvalidInMode"},
+		},
+		Structs: map[string]StructInfo{
+			"ModeTagStruct": {Fields: []Field{
+				{Id: 0, Name: "MfgCode", Type: "vendor-id"},
+				{Id: 1, Name: "Value", Type: "ModeTag"},
+			}},
+			"ModeOptionStruct": {Fields: []Field{
+				{Id: 0, Name: "Label", Type: "string"},
+				{Id: 1, Name: "Mode", Type: "uint8"},
+				{Id: 2, Name: "ModeTags", Type: "ModeTagStruct", List: true},
+			}},
+		},
+	},
+	CLUSTER_ID_AirQuality: {
+		Attributes: map[int]AttributeInfo{
+			0x0000: {Field: Field{Id: 0x0000, Name: "AirQuality", Type: "AirQualityEnum"}, ReadPrivilege: "view"},
+		},
+		Enums: map[string]EnumInfo{
+			"AirQualityEnum": {0: "Unknown", 1: "Good", 2: "Fair", 3: "Moderate", 4: "Poor", 5: "VeryPoor", 6: "ExtremelyPoor"},
+		},
+	},
+	CLUSTER_ID_SmokeCOAlarm: {
+		Attributes: map[int]AttributeInfo{
+			0x0000: {Field: Field{Id: 0x0000, Name: "ExpressedState", Type: "ExpressedStateEnum"}, ReadPrivilege: "view"},
+			0x0001: {Field: Field{Id: 0x0001, Name: "SmokeState", Type: "AlarmStateEnum"}, ReadPrivilege: "view"},
+			0x0002: {Field: Field{Id: 0x0002, Name: "COState", Type: "AlarmStateEnum"}, ReadPrivilege: "view"},
+			0x0003: {Field: Field{Id: 0x0003, Name: "BatteryAlert", Type: "AlarmStateEnum"}, ReadPrivilege: "view"},
+			0x0004: {Field: Field{Id: 0x0004, Name: "DeviceMuted", Type: "MuteStateEnum"}, ReadPrivilege: "view"},
+			0x0005: {Field: Field{Id: 0x0005, Name: "TestInProgress", Type: "bool"}, ReadPrivilege: "view"},
+			0x0006: {Field: Field{Id: 0x0006, Name: "HardwareFaultAlert", Type: "bool"}, ReadPrivilege: "view"},
+			0x0007: {Field: Field{Id: 0x0007, Name: "EndOfServiceAlert", Type: "EndOfServiceEnum"}, ReadPrivilege: "view"},
+			0x0008: {Field: Field{Id: 0x0008, Name: "InterconnectSmokeAlarm", Type: "AlarmStateEnum"}, ReadPrivilege: "view"},
+			0x0009: {Field: Field{Id: 0x0009, Name: "InterconnectCOAlarm", Type: "AlarmStateEnum"}, ReadPrivilege: "view"},
+			0x000a: {Field: Field{Id: 0x000a, Name: "ContaminationState", Type: "ContaminationStateEnum"}, ReadPrivilege: "view"},
+			0x000b: {Field: Field{Id: 0x000b, Name: "SmokeSensitivityLevel", Type: "SensitivityEnum"}, ReadPrivilege: "view", WritePrivilege: "manage", Writable: true},
+			0x000c: {Field: Field{Id: 0x000c, Name: "ExpiryDate", Type: "epoch-s"}, ReadPrivilege: "view"},
+		},
+		Commands: map[int]CommandInfo{
+			0x00: {Id: 0x00, Name: "SelfTestRequest", Response: ResponseStatus, InvokePrivilege: "operate"},
+		},
+		Events: map[int]EventInfo{
+			0x00: {Name: "SmokeAlarm", Fields: []Field{
+				{Id: 0, Name: "AlarmSeverityLevel", Type: "AlarmStateEnum"},
+			}, Priority: "critical"},
+			0x01: {Name: "COAlarm", Fields: []Field{
+				{Id: 0, Name: "AlarmSeverityLevel", Type: "AlarmStateEnum"},
+			}, Priority: "critical"},
+			0x02: {Name: "LowBattery", Fields: []Field{
+				{Id: 0, Name: "AlarmSeverityLevel", Type: "AlarmStateEnum"},
+			}, Priority: "info"},
+			0x03: {Name: "HardwareFault", Priority: "info"},
+			0x04: {Name: "EndOfService", Priority: "info"},
+			0x05: {Name: "SelfTestComplete", Priority: "info"},
+			0x06: {Name: "AlarmMuted", Priority: "info"},
+			0x07: {Name: "MuteEnded", Priority: "info"},
+			0x08: {Name: "InterconnectSmokeAlarm", Fields: []Field{
+				{Id: 0, Name: "AlarmSeverityLevel", Type: "AlarmStateEnum"},
+			}, Priority: "critical"},
+			0x09: {Name: "InterconnectCOAlarm", Fields: []Field{
+				{Id: 0, Name: "AlarmSeverityLevel", Type: "AlarmStateEnum"},
+			}, Priority: "critical"},
+			0x0a: {Name: "AllClear", Priority: "info"},
+		},
+		Enums: map[string]EnumInfo{
+			"AlarmStateEnum":         {0: "Normal", 1: "Warning", 2: "Critical"},
+			"SensitivityEnum":        {0: "High", 1: "Standard", 2: "Low"},
+			"ExpressedStateEnum":     {0: "Normal", 1: "SmokeAlarm", 2: "COAlarm", 3: "BatteryAlert", 4: "Testing", 5: "HardwareFault", 6: "EndOfService", 7: "InterconnectSmoke", 8: "InterconnectCO"},
+			"MuteStateEnum":          {0: "NotMuted", 1: "Muted"},
+			"EndOfServiceEnum":       {0: "Normal", 1: "Expired"},
+			"ContaminationStateEnum": {0: "Normal", 1: "Low", 2: "Warning", 3: "Critical"},
+		},
+	},
+	CLUSTER_ID_DishwasherAlarm: {
+		Attributes: map[int]AttributeInfo{
+			0x0000: {Field: Field{Id: 0x0000, Name: "Mask", Type: "AlarmBitmap"}, ReadPrivilege: "view"},
+			0x0001: {Field: Field{Id: 0x0001, Name: "Latch", Type: "AlarmBitmap"}, ReadPrivilege: "view"},
+			0x0002: {Field: Field{Id: 0x0002, Name: "State", Type: "AlarmBitmap"}, ReadPrivilege: "view"},
+			0x0003: {Field: Field{Id: 0x0003, Name: "Supported", Type: "AlarmBitmap"}, ReadPrivilege: "view"},
+		},
+		Commands: map[int]CommandInfo{
+			0x00: {Id: 0x00, Name: "Reset", Response: ResponseStatus, Fields: []Field{
+				{Id: 0, Name: "Alarms", Type: "AlarmBitmap"},
+			}, InvokePrivilege: "operate"},
+			0x01: {Id: 0x01, Name: "ModifyEnabledAlarms", Response: ResponseStatus, Fields: []Field{
+				{Id: 0, Name: "Mask", Type: "AlarmBitmap"},
+			}, InvokePrivilege: "operate"},
+		},
+		Events: map[int]EventInfo{
+			0x00: {Name: "Notify", Fields: []Field{
+				{Id: 0, Name: "Active", Type: "AlarmBitmap"},
+				{Id: 1, Name: "Inactive", Type: "AlarmBitmap"},
+				{Id: 2, Name: "State", Type: "AlarmBitmap"},
+				{Id: 3, Name: "Mask", Type: "AlarmBitmap"},
+			}, Priority: "info"},
+		},
+		Bitmaps: map[string]BitmapInfo{
+			"AlarmBitmap": {0: "InflowError", 1: "DrainError", 2: "DoorError", 3: "TempTooLow", 4: "TempTooHigh", 5: "WaterLevelError"},
+		},
+	},
+	CLUSTER_ID_MicrowaveOvenMode: {
+		Attributes: map[int]AttributeInfo{
+			0x0000: {Field: Field{Id: 0x0000, Name: "SupportedModes", Type: "ModeOptionStruct", List: true}, ReadPrivilege: "view"},
+			0x0001: {Field: Field{Id: 0x0001, Name: "CurrentMode", Type: "uint8"}, ReadPrivilege: "view"},
+			0x0002: {Field: Field{Id: 0x0002, Name: "StartUpMode", Type: "uint8", Nullable: true}, ReadPrivilege: "view", WritePrivilege: "operate", Writable: true},
+			0x0003: {Field: Field{Id: 0x0003, Name: "OnMode", Type: "uint8", Nullable: true}, ReadPrivilege: "view", WritePrivilege: "operate", Writable: true},
+		},
+		Commands: map[int]CommandInfo{
+			0x00: {Id: 0x00, Name: "ChangeToMode", Response: 0x01, Fields: []Field{
+				{Id: 0, Name: "NewMode", Type: "uint8"},
+			}, InvokePrivilege: "operate"},
+		},
+		Responses: map[int]CommandInfo{
+			0x01: {Id: 0x01, Name: "ChangeToModeResponse", Response: ResponseNone, Fields: []Field{
+				{Id: 0, Name: "Status", Type: "StatusCode"},
+				{Id: 1, Name: "StatusText", Type: "string"},
+			}},
+		},
+		Enums: map[string]EnumInfo{
+			"ModeTag":    {0: "Auto", 1: "Quick", 2: "Quiet", 3: "LowNoise", 4: "LowEnergy", 5: "Vacation", 6: "Min", 7: "Max", 8: "Night", 9: "Day", 16384: "Normal", 16385: "Defrost"},
+			"StatusCode": {0: "Success", 1: "UnsupportedMode", 2: "GenericFailure", 3: "InvalidInMode"},
+		},
+		Structs: map[string]StructInfo{
+			"ModeTagStruct": {Fields: []Field{
+				{Id: 0, Name: "MfgCode", Type: "vendor-id"},
+				{Id: 1, Name: "Value", Type: "ModeTag"},
+			}},
+			"ModeOptionStruct": {Fields: []Field{
+				{Id: 0, Name: "Label", Type: "string"},
+				{Id: 1, Name: "Mode", Type: "uint8"},
+				{Id: 2, Name: "ModeTags", Type: "ModeTagStruct", List: true},
+			}},
+		},
+	},
+	CLUSTER_ID_OperationalState: {
+		Attributes: map[int]AttributeInfo{
+			0x0000: {Field: Field{Id: 0x0000, Name: "PhaseList", Type: "string", List: true, Nullable: true}, ReadPrivilege: "view"},
+			0x0001: {Field: Field{Id: 0x0001, Name: "CurrentPhase", Type: "uint8", Nullable: true}, ReadPrivilege: "view"},
+			0x0002: {Field: Field{Id: 0x0002, Name: "CountdownTime", Type: "elapsed-s", Nullable: true}, ReadPrivilege: "view"},
+			0x0003: {Field: Field{Id: 0x0003, Name: "OperationalStateList", Type: "OperationalStateStruct", List: true}, ReadPrivilege: "view"},
+			0x0004: {Field: Field{Id: 0x0004, Name: "OperationalState", Type: "OperationalStateEnum"}, ReadPrivilege: "view"},
+			0x0005: {Field: Field{Id: 0x0005, Name: "OperationalError", Type: "ErrorStateStruct"}, ReadPrivilege: "view"},
+		},
+		Commands: map[int]CommandInfo{
+			0x00: {Id: 0x00, Name: "Pause", Response: 0x04, InvokePrivilege: "operate"},
+			0x01: {Id: 0x01, Name: "Stop", Response: 0x04, InvokePrivilege: "operate"},
+			0x02: {Id: 0x02, Name: "Start", Response: 0x04, InvokePrivilege: "operate"},
+			0x03: {Id: 0x03, Name: "Resume", Response: 0x04, InvokePrivilege: "operate"},
+		},
+		Responses: map[int]CommandInfo{
+			0x04: {Id: 0x04, Name: "OperationalCommandResponse", Response: ResponseNone, Fields: []Field{
+				{Id: 0, Name: "CommandResponseState", Type: "ErrorStateStruct"},
+			}},
+		},
+		Events: map[int]EventInfo{
+			0x00: {Name: "OperationalError", Fields: []Field{
+				{Id: 0, Name: "ErrorState", Type: "ErrorStateStruct"},
+			}, Priority: "critical"},
+			0x01: {Name: "OperationCompletion", Fields: []Field{
+				{Id: 0, Name: "CompletionErrorCode", Type: "uint8"},
+				{Id: 1, Name: "TotalOperationalTime", Type: "elapsed-s", Nullable: true},
+				{Id: 2, Name: "PausedTime", Type: "elapsed-s", Nullable: true},
+			}, Priority: "info"},
+		},
+		Enums: map[string]EnumInfo{
+			"OperationalStateEnum": {0: "Stopped", 1: "Running", 2: "Paused", 3: "Error"},
+			"ErrorStateEnum":       {0: "NoError", 1: "UnableToStartOrResume", 2: "UnableToCompleteOperation", 3: "CommandInvalidInState"},
+		},
+		Structs: map[string]StructInfo{
+			"ErrorStateStruct": {Fields: []Field{
+				{Id: 0, Name: "ErrorStateID", Type: "ErrorStateEnum"},
+				{Id: 1, Name: "ErrorStateLabel", Type: "string"},
+				{Id: 2, Name: "ErrorStateDetails", Type: "string"},
+			}},
+			"OperationalStateStruct": {Fields: []Field{
+				{Id: 0, Name: "OperationalStateID", Type: "OperationalStateEnum"},
+				{Id: 1, Name: "OperationalStateLabel", Type: "string"},
+			}},
+		},
+	},
+	CLUSTER_ID_RVCOperationalState: {
+		Attributes: map[int]AttributeInfo{
+			0x0000: {Field: Field{Id: 0x0000, Name: "PhaseList", Type: "string", List: true, Nullable: true}, ReadPrivilege: "view"},
+			0x0001: {Field: Field{Id: 0x0001, Name: "CurrentPhase", Type: "uint8", Nullable: true}, ReadPrivilege: "view"},
+			0x0002: {Field: Field{Id: 0x0002, Name: "CountdownTime", Type: "elapsed-s", Nullable: true}, ReadPrivilege: "view"},
+			0x0003: {Field: Field{Id: 0x0003, Name: "OperationalStateList", Type: "OperationalStateStruct", List: true}, ReadPrivilege: "view"},
+			0x0004: {Field: Field{Id: 0x0004, Name: "OperationalState", Type: "OperationalStateEnum"}, ReadPrivilege: "view"},
+			0x0005: {Field: Field{Id: 0x0005, Name: "OperationalError", Type: "ErrorStateStruct"}, ReadPrivilege: "view"},
+		},
+		Commands: map[int]CommandInfo{
+			0x00: {Id: 0x00, Name: "Pause", Response: 0x04, InvokePrivilege: "operate"},
+			0x01: {Id: 0x01, Name: "Stop", Response: 0x04, InvokePrivilege: "operate"},
+			0x02: {Id: 0x02, Name: "Start", Response: 0x04, InvokePrivilege: "operate"},
+			0x03: {Id: 0x03, Name: "Resume", Response: 0x04, InvokePrivilege: "operate"},
+			0x80: {Id: 0x80, Name: "GoHome", Response: 0x04, InvokePrivilege: "operate"},
+		},
+		Responses: map[int]CommandInfo{
+			0x04: {Id: 0x04, Name: "OperationalCommandResponse", Response: ResponseNone, Fields: []Field{
+				{Id: 0, Name: "CommandResponseState", Type: "ErrorStateStruct"},
+			}},
+		},
+		Events: map[int]EventInfo{
+			0x00: {Name: "OperationalError", Fields: []Field{
+				{Id: 0, Name: "ErrorState", Type: "ErrorStateStruct"},
+			}, Priority: "critical"},
+			0x01: {Name: "OperationCompletion", Fields: []Field{
+				{Id: 0, Name: "CompletionErrorCode", Type: "uint8"},
+				{Id: 1, Name: "TotalOperationalTime", Type: "elapsed-s", Nullable: true},
+				{Id: 2, Name: "PausedTime", Type: "elapsed-s", Nullable: true},
+			}, Priority: "info"},
+		},
+		Enums: map[string]EnumInfo{
+			"OperationalStateEnum": {0: "Stopped", 1: "Running", 2: "Paused", 3: "Error", 64: "SeekingCharger", 65: "Charging", 66: "Docked"},
+			"ErrorStateEnum":       {0: "NoError", 1: "UnableToStartOrResume", 2: "UnableToCompleteOperation", 3: "CommandInvalidInState", 64: "FailedToFindChargingDock", 65: "Stuck", 66: "DustBinMissing", 67: "DustBinFull", 68: "WaterTankEmpty", 69: "WaterTankMissing", 70: "WaterTankLidOpen", 71: "MopCleaningPadMissing"},
+		},
+		Structs: map[string]StructInfo{
+			"ErrorStateStruct": {Fields: []Field{
+				{Id: 0, Name: "ErrorStateID", Type: "ErrorStateEnum"},
+				{Id: 1, Name: "ErrorStateLabel", Type: "string"},
+				{Id: 2, Name: "ErrorStateDetails", Type: "string"},
+			}},
+			"OperationalStateStruct": {Fields: []Field{
+				{Id: 0, Name: "OperationalStateID", Type: "OperationalStateEnum"},
+				{Id: 1, Name: "OperationalStateLabel", Type: "string"},
+			}},
+		},
+	},
+	CLUSTER_ID_BooleanSensorConfiguration: {
+		Attributes: map[int]AttributeInfo{
+			0x0000: {Field: Field{Id: 0x0000, Name: "CurrentSensitivityLevel", Type: "uint8"}, ReadPrivilege: "view", WritePrivilege: "operate", Writable: true},
+			0x0001: {Field: Field{Id: 0x0001, Name: "SupportedSensitivityLevels", Type: "uint8"}, ReadPrivilege: "view"},
+			0x0002: {Field: Field{Id: 0x0002, Name: "DefaultSensitivityLevel", Type: "uint8"}, ReadPrivilege: "view"},
+			0x0003: {Field: Field{Id: 0x0003, Name: "AlarmsActive", Type: "AlarmModeBitmap"}, ReadPrivilege: "view"},
+			0x0004: {Field: Field{Id: 0x0004, Name: "AlarmsSuppressed", Type: "AlarmModeBitmap"}, ReadPrivilege: "view"},
+			0x0005: {Field: Field{Id: 0x0005, Name: "AlarmsEnabled", Type: "AlarmModeBitmap"}, ReadPrivilege: "view"},
+			0x0006: {Field: Field{Id: 0x0006, Name: "AlarmsSupported", Type: "AlarmModeBitmap"}, ReadPrivilege: "view"},
+			0x0007: {Field: Field{Id: 0x0007, Name: "SensorFault", Type: "SensorFaultBitmap"}, ReadPrivilege: "view"},
+		},
+		Commands: map[int]CommandInfo{
+			0x00: {Id: 0x00, Name: "SuppressAlarm", Response: ResponseStatus, Fields: []Field{
+				{Id: 0, Name: "AlarmsToSuppress", Type: "AlarmModeBitmap"},
+			}, InvokePrivilege: "operate"},
+			0x01: {Id: 0x01, Name: "EnableDisableAlarm", Response: ResponseStatus, Fields: []Field{
+				{Id: 0, Name: "AlarmsToEnableDisable", Type: "AlarmModeBitmap"},
+			}, InvokePrivilege: "operate"},
+		},
+		Events: map[int]EventInfo{
+			0x00: {Name: "AlarmsStateChanged", Fields: []Field{
+				{Id: 0, Name: "AlarmsActive", Type: "AlarmModeBitmap"},
+				{Id: 1, Name: "AlarmsSuppressed", Type: "AlarmModeBitmap"},
+			}, Priority: "info"},
+			0x01: {Name: "SensorFault", Fields: []Field{
+				{Id: 0, Name: "SensorFault", Type: "SensorFaultBitmap"},
+			}, Priority: "info"},
+		},
+		Bitmaps: map[string]BitmapInfo{
+			"AlarmModeBitmap":   {0: "Visual", 1: "Audible"},
+			"SensorFaultBitmap": {0: "GeneralFault"},
+		},
+	},
+	CLUSTER_ID_ValveConfigurationandControl: {
+		Attributes: map[int]AttributeInfo{
+			0x0000: {Field: Field{Id: 0x0000, Name: "OpenDuration", Type: "elapsed-s", Nullable: true}, ReadPrivilege: "view"},
+			0x0001: {Field: Field{Id: 0x0001, Name: "DefaultOpenDuration", Type: "elapsed-s", Nullable: true}, ReadPrivilege: "view", WritePrivilege: "operate", Writable: true},
+			0x0002: {Field: Field{Id: 0x0002, Name: "AutoCloseTime", Type: "epoch-us", Nullable: true}, ReadPrivilege: "view"},
+			0x0003: {Field: Field{Id: 0x0003, Name: "RemainingDuration", Type: "elapsed-s", Nullable: true}, ReadPrivilege: "view"},
+			0x0004: {Field: Field{Id: 0x0004, Name: "CurrentState", Type: "ValveStateEnum", Nullable: true}, ReadPrivilege: "view"},
+			0x0005: {Field: Field{Id: 0x0005, Name: "TargetState", Type: "ValveStateEnum", Nullable: true}, ReadPrivilege: "view"},
+			0x0006: {Field: Field{Id: 0x0006, Name: "CurrentLevel", Type: "percent", Nullable: true}, ReadPrivilege: "view"},
+			0x0007: {Field: Field{Id: 0x0007, Name: "TargetLevel", Type: "percent", Nullable: true}, ReadPrivilege: "view"},
+			0x0008: {Field: Field{Id: 0x0008, Name: "DefaultOpenLevel", Type: "percent"}, ReadPrivilege: "view", WritePrivilege: "operate", Writable: true},
+			0x0009: {Field: Field{Id: 0x0009, Name: "ValveFault", Type: "ValveFaultBitmap"}, ReadPrivilege: "view"},
+			0x000a: {Field: Field{Id: 0x000a, Name: "LevelStep", Type: "uint8"}, ReadPrivilege: "view"},
+		},
+		Commands: map[int]CommandInfo{
+			0x00: {Id: 0x00, Name: "Open", Response: ResponseStatus, Fields: []Field{
+				{Id: 0, Name: "OpenDuration", Type: "elapsed-s", Nullable: true},
+				{Id: 1, Name: "TargetLevel", Type: "percent"},
+			}, InvokePrivilege: "operate"},
+			0x01: {Id: 0x01, Name: "Close", Response: ResponseStatus, InvokePrivilege: "operate"},
+		},
+		Events: map[int]EventInfo{
+			0x00: {Name: "ValveStateChanged", Fields: []Field{
+				{Id: 0, Name: "ValveState", Type: "ValveStateEnum"},
+				{Id: 1, Name: "ValveLevel", Type: "percent"},
+			}, Priority: "info"},
+			0x01: {Name: "ValveFault", Fields: []Field{
+				{Id: 0, Name: "ValveFault", Type: "ValveFaultBitmap"},
+			}, Priority: "info"},
+		},
+		Enums: map[string]EnumInfo{
+			"ValveStateEnum": {0: "Closed", 1: "Open", 2: "Transitioning"},
+			"StatusCodeEnum": {2: "FailureDueToFault"},
+		},
+		Bitmaps: map[string]BitmapInfo{
+			"ValveFaultBitmap": {0: "GeneralFault", 1: "Blocked", 2: "Leaking", 3: "NotConnected", 4: "ShortCircuit", 5: "CurrentExceeded"},
+		},
+	},
+	CLUSTER_ID_ElectricalPowerMeasurement: {
+		Attributes: map[int]AttributeInfo{
+			0x0000: {Field: Field{Id: 0x0000, Name: "PowerMode", Type: "PowerModeEnum"}, ReadPrivilege: "view"},
+			0x0001: {Field: Field{Id: 0x0001, Name: "NumberOfMeasurementTypes", Type: "uint8"}, ReadPrivilege: "view"},
+			0x0002: {Field: Field{Id: 0x0002, Name: "Accuracy", Type: "MeasurementAccuracyStruct", List: true}, ReadPrivilege: "view"},
+			0x0003: {Field: Field{Id: 0x0003, Name: "Ranges", Type: "MeasurementRangeStruct", List: true}, ReadPrivilege: "view"},
+			0x0004: {Field: Field{Id: 0x0004, Name: "Voltage", Type: "voltage-mV", Nullable: true}, ReadPrivilege: "view"},
+			0x0005: {Field: Field{Id: 0x0005, Name: "ActiveCurrent", Type: "amperage-mA", Nullable: true}, ReadPrivilege: "view"},
+			0x0006: {Field: Field{Id: 0x0006, Name: "ReactiveCurrent", Type: "amperage-mA", Nullable: true}, ReadPrivilege: "view"},
+			0x0007: {Field: Field{Id: 0x0007, Name: "ApparentCurrent", Type: "amperage-mA", Nullable: true}, ReadPrivilege: "view"},
+			0x0008: {Field: Field{Id: 0x0008, Name: "ActivePower", Type: "power-mW", Nullable: true}, ReadPrivilege: "view"},
+			0x0009: {Field: Field{Id: 0x0009, Name: "ReactivePower", Type: "power-mW", Nullable: true}, ReadPrivilege: "view"},
+			0x000a: {Field: Field{Id: 0x000a, Name: "ApparentPower", Type: "power-mW", Nullable: true}, ReadPrivilege: "view"},
+			0x000b: {Field: Field{Id: 0x000b, Name: "RMSVoltage", Type: "voltage-mV", Nullable: true}, ReadPrivilege: "view"},
+			0x000c: {Field: Field{Id: 0x000c, Name: "RMSCurrent", Type: "amperage-mA", Nullable: true}, ReadPrivilege: "view"},
+			0x000d: {Field: Field{Id: 0x000d, Name: "RMSPower", Type: "power-mW", Nullable: true}, ReadPrivilege: "view"},
+			0x000e: {Field: Field{Id: 0x000e, Name: "Frequency", Type: "int64", Nullable: true}, ReadPrivilege: "view"},
+			0x000f: {Field: Field{Id: 0x000f, Name: "HarmonicCurrents", Type: "HarmonicMeasurementStruct", List: true, Nullable: true}, ReadPrivilege: "view"},
+			0x0010: {Field: Field{Id: 0x0010, Name: "HarmonicPhases", Type: "HarmonicMeasurementStruct", List: true, Nullable: true}, ReadPrivilege: "view"},
+			0x0011: {Field: Field{Id: 0x0011, Name: "PowerFactor", Type: "int64", Nullable: true}, ReadPrivilege: "view"},
+			0x0012: {Field: Field{Id: 0x0012, Name: "NeutralCurrent", Type: "amperage-mA", Nullable: true}, ReadPrivilege: "view"},
+		},
+		Events: map[int]EventInfo{
+			0x00: {Name: "MeasurementPeriodRanges", Fields: []Field{
+				{Id: 0, Name: "Ranges", Type: "MeasurementRangeStruct", List: true},
+			}, Priority: "info"},
+		},
+		Enums: map[string]EnumInfo{
+			"PowerModeEnum":       {0: "Unknown", 1: "DC", 2: "AC"},
+			"MeasurementTypeEnum": {0: "Unspecified", 1: "Voltage", 2: "ActiveCurrent", 3: "ReactiveCurrent", 4: "ApparentCurrent", 5: "ActivePower", 6: "ReactivePower", 7: "ApparentPower", 8: "RMSVoltage", 9: "RMSCurrent", 10: "RMSPower", 11: "Frequency", 12: "PowerFactor", 13: "NeutralCurrent", 14: "ElectricalEnergy"},
+		},
+		Structs: map[string]StructInfo{
+			"MeasurementAccuracyRangeStruct": {Fields: []Field{
+				{Id: 0, Name: "RangeMin", Type: "int64"},
+				{Id: 1, Name: "RangeMax", Type: "int64"},
+				{Id: 2, Name: "PercentMax", Type: "percent100ths"},
+				{Id: 3, Name: "PercentMin", Type: "percent100ths"},
+				{Id: 4, Name: "PercentTypical", Type: "percent100ths"},
+				{Id: 5, Name: "FixedMax", Type: "uint64"},
+				{Id: 6, Name: "FixedMin", Type: "uint64"},
+				{Id: 7, Name: "FixedTypical", Type: "uint64"},
+			}},
+			"MeasurementAccuracyStruct": {Fields: []Field{
+				{Id: 0, Name: "MeasurementType", Type: "MeasurementTypeEnum"},
+				{Id: 1, Name: "Measured", Type: "bool"},
+				{Id: 2, Name: "MinMeasuredValue", Type: "int64"},
+				{Id: 3, Name: "MaxMeasuredValue", Type: "int64"},
+				{Id: 4, Name: "AccuracyRanges", Type: "MeasurementAccuracyRangeStruct", List: true},
+			}},
+			"MeasurementRangeStruct": {Fields: []Field{
+				{Id: 0, Name: "MeasurementType", Type: "MeasurementTypeEnum"},
+				{Id: 1, Name: "Min", Type: "int64"},
+				{Id: 2, Name: "Max", Type: "int64"},
+				{Id: 3, Name: "StartTimestamp", Type: "epoch-s"},
+				{Id: 4, Name: "EndTimestamp", Type: "epoch-s"},
+				{Id: 5, Name: "MinTimestamp", Type: "epoch-s"},
+				{Id: 6, Name: "MaxTimestamp", Type: "epoch-s"},
+				{Id: 7, Name: "StartSystime", Type: "systime-ms"},
+				{Id: 8, Name: "EndSystime", Type: "systime-ms"},
+				{Id: 9, Name: "MinSystime", Type: "systime-ms"},
+				{Id: 10, Name: "MaxSystime", Type: "systime-ms"},
+			}},
+			"HarmonicMeasurementStruct": {Fields: []Field{
+				{Id: 0, Name: "Order", Type: "uint8"},
+				{Id: 1, Name: "Measurement", Type: "int64", Nullable: true},
+			}},
+		},
+	},
+	CLUSTER_ID_ElectricalEnergyMeasurement: {
+		Attributes: map[int]AttributeInfo{
+			0x0000: {Field: Field{Id: 0x0000, Name: "Accuracy", Type: "MeasurementAccuracyStruct"}, ReadPrivilege: "view"},
+			0x0001: {Field: Field{Id: 0x0001, Name: "CumulativeEnergyImported", Type: "EnergyMeasurementStruct", Nullable: true}, ReadPrivilege: "view"},
+			0x0002: {Field: Field{Id: 0x0002, Name: "CumulativeEnergyExported", Type: "EnergyMeasurementStruct", Nullable: true}, ReadPrivilege: "view"},
+			0x0003: {Field: Field{Id: 0x0003, Name: "PeriodicEnergyImported", Type: "EnergyMeasurementStruct", Nullable: true}, ReadPrivilege: "view"},
+			0x0004: {Field: Field{Id: 0x0004, Name: "PeriodicEnergyExported", Type: "EnergyMeasurementStruct", Nullable: true}, ReadPrivilege: "view"},
+			0x0005: {Field: Field{Id: 0x0005, Name: "CumulativeEnergyReset", Type: "CumulativeEnergyResetStruct", Nullable: true}, ReadPrivilege: "view"},
+		},
+		Events: map[int]EventInfo{
+			0x00: {Name: "CumulativeEnergyMeasured", Fields: []Field{
+				{Id: 0, Name: "EnergyImported", Type: "EnergyMeasurementStruct"},
+				{Id: 1, Name: "EnergyExported", Type: "EnergyMeasurementStruct"},
+			}, Priority: "info"},
+			0x01: {Name: "PeriodicEnergyMeasured", Fields: []Field{
+				{Id: 0, Name: "EnergyImported", Type: "EnergyMeasurementStruct"},
+				{Id: 1, Name: "EnergyExported", Type: "EnergyMeasurementStruct"},
+			}, Priority: "info"},
+		},
+		Enums: map[string]EnumInfo{
+			"MeasurementTypeEnum": {0: "Unspecified", 1: "Voltage", 2: "ActiveCurrent", 3: "ReactiveCurrent", 4: "ApparentCurrent", 5: "ActivePower", 6: "ReactivePower", 7: "ApparentPower", 8: "RMSVoltage", 9: "RMSCurrent", 10: "RMSPower", 11: "Frequency", 12: "PowerFactor", 13: "NeutralCurrent", 14: "ElectricalEnergy"},
+		},
+		Structs: map[string]StructInfo{
+			"MeasurementAccuracyRangeStruct": {Fields: []Field{
+				{Id: 0, Name: "RangeMin", Type: "int64"},
+				{Id: 1, Name: "RangeMax", Type: "int64"},
+				{Id: 2, Name: "PercentMax", Type: "percent100ths"},
+				{Id: 3, Name: "PercentMin", Type: "percent100ths"},
+				{Id: 4, Name: "PercentTypical", Type: "percent100ths"},
+				{Id: 5, Name: "FixedMax", Type: "uint64"},
+				{Id: 6, Name: "FixedMin", Type: "uint64"},
+				{Id: 7, Name: "FixedTypical", Type: "uint64"},
+			}},
+			"MeasurementAccuracyStruct": {Fields: []Field{
+				{Id: 0, Name: "MeasurementType", Type: "MeasurementTypeEnum"},
+				{Id: 1, Name: "Measured", Type: "bool"},
+				{Id: 2, Name: "MinMeasuredValue", Type: "int64"},
+				{Id: 3, Name: "MaxMeasuredValue", Type: "int64"},
+				{Id: 4, Name: "AccuracyRanges", Type: "MeasurementAccuracyRangeStruct", List: true},
+			}},
+			"EnergyMeasurementStruct": {Fields: []Field{
+				{Id: 0, Name: "Energy", Type: "energy-mWh"},
+				{Id: 1, Name: "StartTimestamp", Type: "epoch-s"},
+				{Id: 2, Name: "EndTimestamp", Type: "epoch-s"},
+				{Id: 3, Name: "StartSystime", Type: "systime-ms"},
+				{Id: 4, Name: "EndSystime", Type: "systime-ms"},
+			}},
+			"CumulativeEnergyResetStruct": {Fields: []Field{
+				{Id: 0, Name: "ImportedResetTimestamp", Type: "epoch-s", Nullable: true},
+				{Id: 1, Name: "ExportedResetTimestamp", Type: "epoch-s", Nullable: true},
+				{Id: 2, Name: "ImportedResetSystime", Type: "systime-ms", Nullable: true},
+				{Id: 3, Name: "ExportedResetSystime", Type: "systime-ms", Nullable: true},
+			}},
+		},
+	},
+	CLUSTER_ID_WaterHeaterManagement: {
+		Attributes: map[int]AttributeInfo{
+			0x0000: {Field: Field{Id: 0x0000, Name: "HeaterTypes", Type: "WaterHeaterHeatSourceBitmap"}, ReadPrivilege: "view"},
+			0x0001: {Field: Field{Id: 0x0001, Name: "HeatDemand", Type: "WaterHeaterHeatSourceBitmap"}, ReadPrivilege: "view"},
+			0x0002: {Field: Field{Id: 0x0002, Name: "TankVolume", Type: "uint16"}, ReadPrivilege: "view"},
+			0x0003: {Field: Field{Id: 0x0003, Name: "EstimatedHeatRequired", Type: "energy-mWh"}, ReadPrivilege: "view"},
+			0x0004: {Field: Field{Id: 0x0004, Name: "TankPercentage", Type: "percent"}, ReadPrivilege: "view"},
+			0x0005: {Field: Field{Id: 0x0005, Name: "BoostState", Type: "BoostStateEnum"}, ReadPrivilege: "view"},
+		},
+		Commands: map[int]CommandInfo{
+			0x00: {Id: 0x00, Name: "Boost", Response: ResponseStatus, Fields: []Field{
+				{Id: 0, Name: "BoostInfo", Type: "WaterHeaterBoostInfoStruct"},
+			}, InvokePrivilege: "operate"},
+			0x01: {Id: 0x01, Name: "CancelBoost", Response: ResponseStatus, InvokePrivilege: "operate"},
+		},
+		Events: map[int]EventInfo{
+			0x00: {Name: "BoostStarted", Fields: []Field{
+				{Id: 0, Name: "BoostInfo", Type: "WaterHeaterBoostInfoStruct"},
+			}, Priority: "info"},
+			0x01: {Name: "BoostEnded", Priority: "info"},
+		},
+		Enums: map[string]EnumInfo{
+			"BoostStateEnum": {0: "Inactive", 1: "Active"},
+		},
+		Bitmaps: map[string]BitmapInfo{
+			"WaterHeaterHeatSourceBitmap": {0: "ImmersionElement1", 1: "ImmersionElement2", 2: "HeatPump", 3: "Boiler", 4: "Other"},
+		},
+		Structs: map[string]StructInfo{
+			"WaterHeaterBoostInfoStruct": {Fields: []Field{
+				{Id: 0, Name: "Duration", Type: "elapsed-s"},
+				{Id: 1, Name: "OneShot", Type: "bool"},
+				{Id: 2, Name: "EmergencyBoost", Type: "bool"},
+				{Id: 3, Name: "TemporarySetpoint", Type: "temperature"},
+				{Id: 4, Name: "TargetPercentage", Type: "percent"},
+				{Id: 5, Name: "TargetReheat", Type: "percent"},
+			}},
+		},
+	},
+	CLUSTER_ID_EnergyPrice: {
+		Attributes: map[int]AttributeInfo{
+			0x0000: {Field: Field{Id: 0x0000, Name: "UnitOfMeasure", Type: "uint8"}, ReadPrivilege: "view"},
+			0x0001: {Field: Field{Id: 0x0001, Name: "CurrentPrice", Type: "PriceStruct", Nullable: true}, ReadPrivilege: "view"},
+			0x0002: {Field: Field{Id: 0x0002, Name: "PriceForecast", Type: "EnergyPriceStruct", List: true}, ReadPrivilege: "view"},
+		},
+		Commands: map[int]CommandInfo{
+			0x00: {Id: 0x00, Name: "GetDetailedPriceRequest", Response: 0x01, Fields: []Field{
+				{Id: 0, Name: "Details", Type: "uint8"},
+			}, InvokePrivilege: "operate"},
+			0x02: {Id: 0x02, Name: "GetDetailedForecastRequest", Response: 0x03, Fields: []Field{
+				{Id: 0, Name: "Details", Type: "uint8"},
+			}, InvokePrivilege: "operate"},
+		},
+		Responses: map[int]CommandInfo{
+			0x01: {Id: 0x01, Name: "GetDetailedPriceResponse", Response: ResponseNone, Fields: []Field{
+				{Id: 0, Name: "CurrentPrice", Type: "EnergyPriceStruct", Nullable: true},
+			}},
+			0x03: {Id: 0x03, Name: "GetDetailedForecastResponse", Response: ResponseNone, Fields: []Field{
+				{Id: 0, Name: "PriceForecast", Type: "EnergyPriceStruct", List: true},
+			}},
+		},
+		Events: map[int]EventInfo{
+			0x00: {Name: "PriceChange", Fields: []Field{
+				{Id: 0, Name: "CurrentPrice", Type: "PriceStruct", Nullable: true},
+			}, Priority: "info"},
+		},
+		Structs: map[string]StructInfo{
+			"CurrencyStruct": {Fields: []Field{
+				{Id: 0, Name: "Currency", Type: "uint16"},
+				{Id: 1, Name: "DecimalPoints", Type: "uint8"},
+			}},
+			"PriceStruct": {Fields: []Field{
+				{Id: 0, Name: "Amount", Type: "money"},
+				{Id: 1, Name: "Currency", Type: "CurrencyStruct"},
+			}},
+			"EnergyPriceStruct": {Fields: []Field{
+				{Id: 0, Name: "StartTime", Type: "epoch-s"},
+				{Id: 1, Name: "EndTime", Type: "epoch-s"},
+				{Id: 2, Name: "Price", Type: "PriceStruct", Nullable: true},
+				{Id: 3, Name: "Description", Type: "string"},
+			}},
+		},
+	},
+	CLUSTER_ID_DemandResponseandLoadControl: {
+		Attributes: map[int]AttributeInfo{
+			0x0000: {Field: Field{Id: 0x0000, Name: "LoadControlPrograms", Type: "LoadControlProgramStruct", List: true}, ReadPrivilege: "view"},
+			0x0001: {Field: Field{Id: 0x0001, Name: "NumberOfLoadControlPrograms", Type: "uint8"}, ReadPrivilege: "view"},
+			0x0002: {Field: Field{Id: 0x0002, Name: "Events", Type: "LoadControlEventStruct", List: true}, ReadPrivilege: "view"},
+			0x0003: {Field: Field{Id: 0x0003, Name: "ActiveEvents", Type: "LoadControlEventStruct", List: true}, ReadPrivilege: "view"},
+			0x0004: {Field: Field{Id: 0x0004, Name: "NumberOfEventsPerProgram", Type: "uint8"}, ReadPrivilege: "view"},
+			0x0005: {Field: Field{Id: 0x0005, Name: "NumberOfTransitions", Type: "uint8"}, ReadPrivilege: "view"},
+			0x0006: {Field: Field{Id: 0x0006, Name: "DefaultRandomStart", Type: "uint8"}, ReadPrivilege: "view", WritePrivilege: "operate", Writable: true},
+			0x0007: {Field: Field{Id: 0x0007, Name: "DefaultRandomDuration", Type: "uint8"}, ReadPrivilege: "view", WritePrivilege: "operate", Writable: true},
+		},
+		Commands: map[int]CommandInfo{
+			0x00: {Id: 0x00, Name: "RegisterLoadControlProgramRequest", Response: ResponseStatus, Fields: []Field{
+				{Id: 0, Name: "LoadControlProgram", Type: "LoadControlProgramStruct"},
+			}, InvokePrivilege: "operate"},
+			0x01: {Id: 0x01, Name: "UnregisterLoadControlProgramRequest", Response: ResponseStatus, Fields: []Field{
+				{Id: 0, Name: "LoadControlProgramID", Type: "octstr"},
+			}, InvokePrivilege: "operate"},
+			0x02: {Id: 0x02, Name: "AddLoadControlEventRequest", Response: ResponseStatus, Fields: []Field{
+				{Id: 0, Name: "Event", Type: "LoadControlEventStruct"},
+			}, InvokePrivilege: "operate"},
+			0x03: {Id: 0x03, Name: "RemoveLoadControlEventRequest", Response: ResponseStatus, Fields: []Field{
+				{Id: 0, Name: "EventID", Type: "octstr"},
+				{Id: 1, Name: "CancelControl", Type: "CancelControlBitmap"},
+			}, InvokePrivilege: "operate"},
+			0x04: {Id: 0x04, Name: "ClearLoadControlEventsRequest", Response: ResponseStatus, InvokePrivilege: "operate"},
+		},
+		Events: map[int]EventInfo{
+			0x00: {Name: "LoadControlEventStatusChange", Fields: []Field{
+				{Id: 0, Name: "EventID", Type: "octstr"},
+				{Id: 1, Name: "TransitionIndex", Type: "uint8", Nullable: true},
+				{Id: 2, Name: "Status", Type: "LoadControlEventStatusEnum"},
+				{Id: 3, Name: "Criticality", Type: "CriticalityLevelEnum"},
+				{Id: 4, Name: "Control", Type: "EventControlBitmap"},
+			}, Priority: "info"},
+		},
+		Enums: map[string]EnumInfo{
+			"LoadControlEventStatusEnum": {0: "Unknown", 1: "Received", 2: "InProgress", 3: "Completed", 4: "OptedOut", 5: "OptedIn", 6: "Canceled", 7: "Superseded", 8: "PartialOptedOut", 9: "PartialOptedIn", 10: "NoParticipation", 11: "Unavailable", 12: "Failed"},
+			"CriticalityLevelEnum":       {0: "Unknown", 1: "Green", 2: "Level1", 3: "Level2", 4: "Level3", 5: "Level4", 6: "Level5", 7: "Emergency", 8: "PlannedOutage", 9: "ServiceDisconnect"},
+		},
+		Bitmaps: map[string]BitmapInfo{
+			"DeviceClassBitmap":            {0: "HVAC", 1: "StripHeater", 2: "WaterHeater", 3: "PoolPump", 4: "SmartAppliance", 5: "IrrigationPump", 6: "CommercialLoad", 7: "ResidentialLoad", 8: "ExteriorLighting", 9: "InteriorLighting", 10: "EV", 11: "GenerationSystem", 12: "SmartInverter", 13: "EVSE", 14: "RESU", 15: "EMS", 16: "SEM"},
+			"EventControlBitmap":           {0: "RandomStart"},
+			"EventTransitionControlBitmap": {0: "RandomDuration", 1: "IgnoreOptOut"},
+			"CancelControlBitmap":          {0: "RandomEnd"},
+		},
+		Structs: map[string]StructInfo{
+			"LoadControlProgramStruct": {Fields: []Field{
+				{Id: 0, Name: "ProgramID", Type: "octstr"},
+				{Id: 1, Name: "Name", Type: "string"},
+				{Id: 2, Name: "EnrollmentGroup", Type: "uint8", Nullable: true},
+				{Id: 3, Name: "RandomStartMinutes", Type: "uint8", Nullable: true},
+				{Id: 4, Name: "RandomDurationMinutes", Type: "uint8", Nullable: true},
+			}},
+			"LoadControlEventTransitionStruct": {Fields: []Field{
+				{Id: 0, Name: "Duration", Type: "uint16"},
+				{Id: 1, Name: "Control", Type: "EventTransitionControlBitmap"},
+				{Id: 2, Name: "AverageLoadAdjustmentPercentage", Type: "int8", Nullable: true},
+				{Id: 3, Name: "DutyCycle", Type: "percent", Nullable: true},
+				{Id: 4, Name: "PowerSavings", Type: "power-mW", Nullable: true},
+			}},
+			"LoadControlEventStruct": {Fields: []Field{
+				{Id: 0, Name: "EventID", Type: "octstr"},
+				{Id: 1, Name: "ProgramID", Type: "octstr", Nullable: true},
+				{Id: 2, Name: "Control", Type: "EventControlBitmap"},
+				{Id: 3, Name: "DeviceClass", Type: "DeviceClassBitmap"},
+				{Id: 4, Name: "EnrollmentGroup", Type: "uint8", Nullable: true},
+				{Id: 5, Name: "Criticality", Type: "CriticalityLevelEnum"},
+				{Id: 6, Name: "StartTime", Type: "epoch-s", Nullable: true},
+				{Id: 7, Name: "Transitions", Type: "LoadControlEventTransitionStruct", List: true},
+			}},
+		},
+	},
+	CLUSTER_ID_Messages: {
+		Attributes: map[int]AttributeInfo{
+			0x0000: {Field: Field{Id: 0x0000, Name: "Messages", Type: "MessageStruct", List: true}, ReadPrivilege: "view", FabricScoped: true},
+			0x0001: {Field: Field{Id: 0x0001, Name: "ActiveMessageIDs", Type: "octstr", List: true}, ReadPrivilege: "view"},
+		},
+		Commands: map[int]CommandInfo{
+			0x00: {Id: 0x00, Name: "PresentMessagesRequest", Response: ResponseStatus, Fields: []Field{
+				{Id: 0, Name: "MessageID", Type: "octstr"},
+				{Id: 1, Name: "Priority", Type: "MessagePriorityEnum"},
+				{Id: 2, Name: "MessageControl", Type: "MessageControlBitmap"},
+				{Id: 3, Name: "StartTime", Type: "epoch-s", Nullable: true},
+				{Id: 4, Name: "Duration", Type: "uint64", Nullable: true},
+				{Id: 5, Name: "MessageText", Type: "string"},
+				{Id: 6, Name: "Responses", Type: "MessageResponseOptionStruct", List: true},
+			}, InvokePrivilege: "operate", FabricScoped: true},
+			0x01: {Id: 0x01, Name: "CancelMessagesRequest", Response: ResponseStatus, Fields: []Field{
+				{Id: 0, Name: "MessageIDs", Type: "octstr", List: true},
+			}, InvokePrivilege: "operate", FabricScoped: true},
+		},
+		Events: map[int]EventInfo{
+			0x00: {Name: "MessageQueued", Fields: []Field{
+				{Id: 0, Name: "MessageID", Type: "octstr"},
+			}, Priority: "info"},
+			0x01: {Name: "MessagePresented", Fields: []Field{
+				{Id: 0, Name: "MessageID", Type: "octstr"},
+			}, Priority: "info"},
+			0x02: {Name: "MessageComplete", Fields: []Field{
+				{Id: 0, Name: "MessageID", Type: "octstr"},
+				{Id: 1, Name: "ResponseID", Type: "uint32", Nullable: true},
+				{Id: 2, Name: "Reply", Type: "string", Nullable: true},
+				{Id: 3, Name: "FutureMessagesPreference", Type: "FutureMessagePreferenceEnum", Nullable: true},
+			}, Priority: "info"},
+		},
+		Enums: map[string]EnumInfo{
+			"FutureMessagePreferenceEnum": {0: "Allowed", 1: "Increased", 2: "Reduced", 3: "Disallowed", 4: "Banned"},
+			"MessagePriorityEnum":         {0: "Low", 1: "Medium", 2: "High", 3: "Critical"},
+		},
+		Bitmaps: map[string]BitmapInfo{
+			"MessageControlBitmap": {0: "ConfirmationRequired", 1: "ResponseRequired", 2: "ReplyMessage", 3: "MessageConfirmed", 4: "MessageProtected"},
+		},
+		Structs: map[string]StructInfo{
+			"MessageResponseOptionStruct": {Fields: []Field{
+				{Id: 0, Name: "MessageResponseID", Type: "uint32"},
+				{Id: 1, Name: "Label", Type: "string"},
+			}},
+			"MessageStruct": {Fields: []Field{
+				{Id: 0, Name: "MessageID", Type: "octstr"},
+				{Id: 1, Name: "Priority", Type: "MessagePriorityEnum"},
+				{Id: 2, Name: "MessageControl", Type: "MessageControlBitmap"},
+				{Id: 3, Name: "StartTime", Type: "epoch-s", Nullable: true},
+				{Id: 4, Name: "Duration", Type: "uint64", Nullable: true},
+				{Id: 5, Name: "MessageText", Type: "string"},
+				{Id: 6, Name: "Responses", Type: "MessageResponseOptionStruct", List: true},
+				{Id: 254, Name: "FabricIndex", Type: "fabric-idx"},
+			}, FabricScoped: true},
+		},
+	},
+	CLUSTER_ID_DeviceEnergyManagement: {
+		Attributes: map[int]AttributeInfo{
+			0x0000: {Field: Field{Id: 0x0000, Name: "ESAType", Type: "ESATypeEnum"}, ReadPrivilege: "view"},
+			0x0001: {Field: Field{Id: 0x0001, Name: "ESACanGenerate", Type: "bool"}, ReadPrivilege: "view"},
+			0x0002: {Field: Field{Id: 0x0002, Name: "ESAState", Type: "ESAStateEnum"}, ReadPrivilege: "view"},
+			0x0003: {Field: Field{Id: 0x0003, Name: "AbsMinPower", Type: "power-mW"}, ReadPrivilege: "view"},
+			0x0004: {Field: Field{Id: 0x0004, Name: "AbsMaxPower", Type: "power-mW"}, ReadPrivilege: "view"},
+			0x0005: {Field: Field{Id: 0x0005, Name: "PowerAdjustmentCapability", Type: "PowerAdjustCapabilityStruct", Nullable: true}, ReadPrivilege: "view"},
+			0x0006: {Field: Field{Id: 0x0006, Name: "Forecast", Type: "ForecastStruct", Nullable: true}, ReadPrivilege: "view"},
+			0x0007: {Field: Field{Id: 0x0007, Name: "OptOutState", Type: "OptOutStateEnum"}, ReadPrivilege: "view"},
+		},
+		Commands: map[int]CommandInfo{
+			0x00: {Id: 0x00, Name: "PowerAdjustRequest", Response: ResponseStatus, Fields: []Field{
+				{Id: 0, Name: "Power", Type: "power-mW"},
+				{Id: 1, Name: "Duration", Type: "elapsed-s"},
+				{Id: 2, Name: "Cause", Type: "AdjustmentCauseEnum"},
+			}, InvokePrivilege: "operate"},
+			0x01: {Id: 0x01, Name: "CancelPowerAdjustRequest", Response: ResponseStatus, InvokePrivilege: "operate"},
+			0x02: {Id: 0x02, Name: "StartTimeAdjustRequest", Response: ResponseStatus, Fields: []Field{
+				{Id: 0, Name: "RequestedStartTime", Type: "epoch-s"},
+				{Id: 1, Name: "Cause", Type: "AdjustmentCauseEnum"},
+			}, InvokePrivilege: "operate"},
+			0x03: {Id: 0x03, Name: "PauseRequest", Response: ResponseStatus, Fields: []Field{
+				{Id: 0, Name: "Duration", Type: "elapsed-s"},
+				{Id: 1, Name: "Cause", Type: "AdjustmentCauseEnum"},
+			}, InvokePrivilege: "operate"},
+			0x04: {Id: 0x04, Name: "ResumeRequest", Response: ResponseStatus, InvokePrivilege: "operate"},
+			0x05: {Id: 0x05, Name: "ModifyForecastRequest", Response: ResponseStatus, Fields: []Field{
+				{Id: 0, Name: "ForecastID", Type: "uint32"},
+				{Id: 1, Name: "SlotAdjustments", Type: "SlotAdjustmentStruct", List: true},
+				{Id: 2, Name: "Cause", Type: "AdjustmentCauseEnum"},
+			}, InvokePrivilege: "operate"},
+			0x06: {Id: 0x06, Name: "RequestConstraintBasedForecast", Response: ResponseStatus, Fields: []Field{
+				{Id: 0, Name: "Constraints", Type: "ConstraintsStruct", List: true},
+				{Id: 1, Name: "Cause", Type: "AdjustmentCauseEnum"},
+			}, InvokePrivilege: "operate"},
+			0x07: {Id: 0x07, Name: "CancelRequest", Response: ResponseStatus, InvokePrivilege: "operate"},
+		},
+		Events: map[int]EventInfo{
+			0x00: {Name: "PowerAdjustStart", Priority: "info"},
+			0x01: {Name: "PowerAdjustEnd", Fields: []Field{
+				{Id: 0, Name: "Cause", Type: "CauseEnum"},
+				{Id: 1, Name: "Duration", Type: "elapsed-s"},
+				{Id: 2, Name: "EnergyUse", Type: "energy-mWh"},
+			}, Priority: "info"},
+			0x02: {Name: "Paused", Priority: "info"},
+			0x03: {Name: "Resumed", Fields: []Field{
+				{Id: 0, Name: "Cause", Type: "CauseEnum"},
+			}, Priority: "info"},
+		},
+		Enums: map[string]EnumInfo{
+			"ESATypeEnum":              {0: "EVSE", 1: "SpaceHeating", 2: "WaterHeating", 3: "SpaceCooling", 4: "SpaceHeatingCooling", 5: "BatteryStorage", 6: "SolarPV", 7: "FridgeFreezer", 8: "WashingMachine", 9: "Dishwasher", 10: "Cooking", 11: "HomeWaterPump", 12: "IrrigationWaterPump", 13: "PoolPump", 255: "Other"},
+			"ESAStateEnum":             {0: "Offline", 1: "Online", 2: "Fault", 3: "PowerAdjustActive", 4: "Paused"},
+			"OptOutStateEnum":          {0: "NoOptOut", 1: "LocalOptOut", 2: "GridOptOut", 3: "OptOut"},
+			"CauseEnum":                {0: "NormalCompletion", 1: "Offline", 2: "Fault", 3: "UserOptOut", 4: "Cancelled"},
+			"AdjustmentCauseEnum":      {0: "LocalOptimization", 1: "GridOptimization"},
+			"ForecastUpdateReasonEnum": {0: "InternalOptimization", 1: "LocalOptimization", 2: "GridOptimization"},
+			"PowerAdjustReasonEnum":    {0: "NoAdjustment", 1: "LocalOptimizationAdjustment", 2: "GridOptimizationAdjustment"},
+		},
+		Structs: map[string]StructInfo{
+			"PowerAdjustStruct": {Fields: []Field{
+				{Id: 0, Name: "MinPower", Type: "power-mW"},
+				{Id: 1, Name: "MaxPower", Type: "power-mW"},
+				{Id: 2, Name: "MinDuration", Type: "elapsed-s"},
+				{Id: 3, Name: "MaxDuration", Type: "elapsed-s"},
+			}},
+			"PowerAdjustCapabilityStruct": {Fields: []Field{
+				{Id: 0, Name: "PowerAdjustCapability", Type: "PowerAdjustStruct", List: true, Nullable: true},
+				{Id: 1, Name: "Cause", Type: "PowerAdjustReasonEnum"},
+			}},
+			"SlotStruct": {Fields: []Field{
+				{Id: 0, Name: "MinDuration", Type: "elapsed-s"},
+				{Id: 1, Name: "MaxDuration", Type: "elapsed-s"},
+				{Id: 2, Name: "DefaultDuration", Type: "elapsed-s"},
+				{Id: 3, Name: "ElapsedSlotTime", Type: "elapsed-s"},
+				{Id: 4, Name: "RemainingSlotTime", Type: "elapsed-s"},
+				{Id: 5, Name: "SlotIsPausable", Type: "bool"},
+				{Id: 6, Name: "MinPauseDuration", Type: "elapsed-s"},
+				{Id: 7, Name: "MaxPauseDuration", Type: "elapsed-s"},
+				{Id: 8, Name: "ManufacturerESAState", Type: "uint16"},
+				{Id: 9, Name: "NominalPower", Type: "power-mW"},
+				{Id: 10, Name: "MinPower", Type: "power-mW"},
+				{Id: 11, Name: "MaxPower", Type: "power-mW"},
+				{Id: 12, Name: "NominalEnergy", Type: "energy-mWh"},
+			}},
+			"ForecastStruct": {Fields: []Field{
+				{Id: 0, Name: "ForecastID", Type: "uint32"},
+				{Id: 1, Name: "ActiveSlotNumber", Type: "uint16", Nullable: true},
+				{Id: 2, Name: "StartTime", Type: "epoch-s"},
+				{Id: 3, Name: "EndTime", Type: "epoch-s"},
+				{Id: 4, Name: "EarliestStartTime", Type: "epoch-s", Nullable: true},
+				{Id: 5, Name: "LatestEndTime", Type: "epoch-s"},
+				{Id: 6, Name: "IsPausable", Type: "bool"},
+				{Id: 7, Name: "Slots", Type: "SlotStruct", List: true},
+				{Id: 8, Name: "ForecastUpdateReason", Type: "ForecastUpdateReasonEnum"},
+			}},
+			"SlotAdjustmentStruct": {Fields: []Field{
+				{Id: 0, Name: "SlotIndex", Type: "uint8"},
+				{Id: 1, Name: "NominalPower", Type: "power-mW"},
+				{Id: 2, Name: "Duration", Type: "elapsed-s"},
+			}},
+			"ConstraintsStruct": {Fields: []Field{
+				{Id: 0, Name: "StartTime", Type: "epoch-s"},
+				{Id: 1, Name: "Duration", Type: "elapsed-s"},
+				{Id: 2, Name: "NominalPower", Type: "power-mW"},
+				{Id: 3, Name: "MaximumEnergy", Type: "energy-mWh"},
+				{Id: 4, Name: "LoadControl", Type: "int8"},
+			}},
+		},
+	},
+	CLUSTER_ID_EnergyCalendar: {
+		Attributes: map[int]AttributeInfo{
+			0x0000: {Field: Field{Id: 0x0000, Name: "CalendarID", Type: "uint32", Nullable: true}, ReadPrivilege: "view"},
+			0x0001: {Field: Field{Id: 0x0001, Name: "Name", Type: "string", Nullable: true}, ReadPrivilege: "view"},
+			0x0002: {Field: Field{Id: 0x0002, Name: "ProviderID", Type: "uint32", Nullable: true}, ReadPrivilege: "view"},
+			0x0003: {Field: Field{Id: 0x0003, Name: "EventID", Type: "uint32", Nullable: true}, ReadPrivilege: "view"},
+			0x0004: {Field: Field{Id: 0x0004, Name: "StartDate", Type: "epoch-s", Nullable: true}, ReadPrivilege: "view"},
+			0x0005: {Field: Field{Id: 0x0005, Name: "CalendarPeriods", Type: "CalendarPeriodStruct", List: true}, ReadPrivilege: "view"},
+			0x0006: {Field: Field{Id: 0x0006, Name: "SpecialDays", Type: "DayStruct", List: true}, ReadPrivilege: "view"},
+			0x0007: {Field: Field{Id: 0x0007, Name: "CurrentDay", Type: "DayStruct", Nullable: true}, ReadPrivilege: "view"},
+			0x0008: {Field: Field{Id: 0x0008, Name: "NextDay", Type: "DayStruct", Nullable: true}, ReadPrivilege: "view"},
+			0x0009: {Field: Field{Id: 0x0009, Name: "CurrentTransition", Type: "TransitionStruct", Nullable: true}, ReadPrivilege: "view"},
+			0x000a: {Field: Field{Id: 0x000a, Name: "CurrentPeakPeriod", Type: "PeakPeriodStruct", Nullable: true}, ReadPrivilege: "view"},
+			0x000b: {Field: Field{Id: 0x000b, Name: "NextPeakPeriod", Type: "PeakPeriodStruct", Nullable: true}, ReadPrivilege: "view"},
+		},
+		Enums: map[string]EnumInfo{
+			"PeakPeriodSeverityEnum": {0: "Unused", 1: "Low", 2: "Medium", 3: "High"},
+		},
+		Bitmaps: map[string]BitmapInfo{
+			"TransitionDayOfWeekBitmap": {0: "Sunday", 1: "Monday", 2: "Tuesday", 3: "Wednesday", 4: "Thursday", 5: "Friday", 6: "Saturday", 7: "Away"},
+		},
+		Structs: map[string]StructInfo{
+			"TransitionStruct": {Fields: []Field{
+				{Id: 0, Name: "TransitionTime", Type: "uint16"},
+				{Id: 1, Name: "PriceTier", Type: "uint32", Nullable: true},
+				{Id: 2, Name: "FriendlyCredit", Type: "bool", Nullable: true},
+				{Id: 3, Name: "AuxiliaryLoad", Type: "uint8", Nullable: true},
+			}},
+			"DayStruct": {Fields: []Field{
+				{Id: 0, Name: "Date", Type: "epoch-s", Nullable: true},
+				{Id: 1, Name: "DaysOfWeek", Type: "TransitionDayOfWeekBitmap", Nullable: true},
+				{Id: 2, Name: "Transitions", Type: "TransitionStruct", List: true},
+				{Id: 3, Name: "CalendarID", Type: "uint32", Nullable: true},
+			}},
+			"CalendarPeriodStruct": {Fields: []Field{
+				{Id: 0, Name: "StartDate", Type: "epoch-s", Nullable: true},
+				{Id: 1, Name: "Days", Type: "DayStruct", List: true},
+			}},
+			"PeakPeriodStruct": {Fields: []Field{
+				{Id: 0, Name: "Severity", Type: "PeakPeriodSeverityEnum"},
+				{Id: 1, Name: "PeakPeriod", Type: "uint16"},
+				{Id: 2, Name: "StartTime", Type: "epoch-s", Nullable: true},
+				{Id: 3, Name: "EndTime", Type: "epoch-s", Nullable: true},
+			}},
+		},
+	},
+	CLUSTER_ID_EnergyPreference: {
+		Attributes: map[int]AttributeInfo{
+			0x0000: {Field: Field{Id: 0x0000, Name: "EnergyBalances", Type: "BalanceStruct", List: true}, ReadPrivilege: "view"},
+			0x0001: {Field: Field{Id: 0x0001, Name: "CurrentEnergyBalance", Type: "uint8"}, ReadPrivilege: "view", WritePrivilege: "operate", Writable: true},
+			0x0002: {Field: Field{Id: 0x0002, Name: "EnergyPriorities", Type: "EnergyPriorityEnum", List: true}, ReadPrivilege: "view"},
+			0x0003: {Field: Field{Id: 0x0003, Name: "LowPowerModeSensitivities", Type: "BalanceStruct", List: true}, ReadPrivilege: "view"},
+			0x0004: {Field: Field{Id: 0x0004, Name: "CurrentLowPowerModeSensitivity", Type: "uint8"}, ReadPrivilege: "view", WritePrivilege: "operate", Writable: true},
+		},
+		Enums: map[string]EnumInfo{
+			"EnergyPriorityEnum": {0: "Comfort", 1: "Speed", 2: "Efficiency", 3: "WaterConsumption"},
+		},
+		Structs: map[string]StructInfo{
+			"BalanceStruct": {Fields: []Field{
+				{Id: 0, Name: "Step", Type: "percent"},
+				{Id: 1, Name: "Label", Type: "string"},
+			}},
+		},
+	},
+	CLUSTER_ID_DoorLock: {
+		Attributes: map[int]AttributeInfo{
+			0x0000: {Field: Field{Id: 0x0000, Name: "LockState", Type: "LockStateEnum", Nullable: true}, ReadPrivilege: "view"},
+			0x0001: {Field: Field{Id: 0x0001, Name: "LockType", Type: "LockTypeEnum"}, ReadPrivilege: "view"},
+			0x0002: {Field: Field{Id: 0x0002, Name: "ActuatorEnabled", Type: "bool"}, ReadPrivilege: "view"},
+			0x0003: {Field: Field{Id: 0x0003, Name: "DoorState", Type: "DoorStateEnum", Nullable: true}, ReadPrivilege: "view"},
+			0x0004: {Field: Field{Id: 0x0004, Name: "DoorOpenEvents", Type: "uint32"}, ReadPrivilege: "view", WritePrivilege: "manage", Writable: true},
+			0x0005: {Field: Field{Id: 0x0005, Name: "DoorClosedEvents", Type: "uint32"}, ReadPrivilege: "view", WritePrivilege: "manage", Writable: true},
+			0x0006: {Field: Field{Id: 0x0006, Name: "OpenPeriod", Type: "uint16"}, ReadPrivilege: "view", WritePrivilege: "manage", Writable: true},
+			0x0011: {Field: Field{Id: 0x0011, Name: "NumberOfTotalUsersSupported", Type: "uint16"}, ReadPrivilege: "view"},
+			0x0012: {Field: Field{Id: 0x0012, Name: "NumberOfPINUsersSupported", Type: "uint16"}, ReadPrivilege: "view"},
+			0x0013: {Field: Field{Id: 0x0013, Name: "NumberOfRFIDUsersSupported", Type: "uint16"}, ReadPrivilege: "view"},
+			0x0014: {Field: Field{Id: 0x0014, Name: "NumberOfWeekDaySchedulesSupportedPerUser", Type: "uint8"}, ReadPrivilege: "view"},
+			0x0015: {Field: Field{Id: 0x0015, Name: "NumberOfYearDaySchedulesSupportedPerUser", Type: "uint8"}, ReadPrivilege: "view"},
+			0x0016: {Field: Field{Id: 0x0016, Name: "NumberOfHolidaySchedulesSupported", Type: "uint8"}, ReadPrivilege: "view"},
+			0x0017: {Field: Field{Id: 0x0017, Name: "MaxPINCodeLength", Type: "uint8"}, ReadPrivilege: "view"},
+			0x0018: {Field: Field{Id: 0x0018, Name: "MinPINCodeLength", Type: "uint8"}, ReadPrivilege: "view"},
+			0x0019: {Field: Field{Id: 0x0019, Name: "MaxRFIDCodeLength", Type: "uint8"}, ReadPrivilege: "view"},
+			0x001a: {Field: Field{Id: 0x001a, Name: "MinRFIDCodeLength", Type: "uint8"}, ReadPrivilege: "view"},
+			0x001b: {Field: Field{Id: 0x001b, Name: "CredentialRulesSupport", Type: "CredentialRulesBitmap"}, ReadPrivilege: "view"},
+			0x001c: {Field: Field{Id: 0x001c, Name: "NumberOfCredentialsSupportedPerUser", Type: "uint8"}, ReadPrivilege: "view"},
+			0x0021: {Field: Field{Id: 0x0021, Name: "Language", Type: "string"}, ReadPrivilege: "view", WritePrivilege: "manage", Writable: true},
+			0x0022: {Field: Field{Id: 0x0022, Name: "LEDSettings", Type: "LEDSettingEnum"}, ReadPrivilege: "view", WritePrivilege: "manage", Writable: true},
+			0x0023: {Field: Field{Id: 0x0023, Name: "AutoRelockTime", Type: "uint32"}, ReadPrivilege: "view", WritePrivilege: "manage", Writable: true},
+			0x0024: {Field: Field{Id: 0x0024, Name: "SoundVolume", Type: "SoundVolumeEnum"}, ReadPrivilege: "view", WritePrivilege: "manage", Writable: true},
+			0x0025: {Field: Field{Id: 0x0025, Name: "OperatingMode", Type: "OperatingModeEnum"}, ReadPrivilege: "view", WritePrivilege: "manage", Writable: true},
+			0x0026: {Field: Field{Id: 0x0026, Name: "SupportedOperatingModes", Type: "OperatingModesBitmap"}, ReadPrivilege: "view"},
+			0x0027: {Field: Field{Id: 0x0027, Name: "DefaultConfigurationRegister", Type: "ConfigurationRegisterBitmap"}, ReadPrivilege: "view"},
+			0x0028: {Field: Field{Id: 0x0028, Name: "EnableLocalProgramming", Type: "bool"}, ReadPrivilege: "view", WritePrivilege: "administer", Writable: true},
+			0x0029: {Field: Field{Id: 0x0029, Name: "EnableOneTouchLocking", Type: "bool"}, ReadPrivilege: "view", WritePrivilege: "manage", Writable: true},
+			0x002a: {Field: Field{Id: 0x002a, Name: "EnableInsideStatusLED", Type: "bool"}, ReadPrivilege: "view", WritePrivilege: "manage", Writable: true},
+			0x002b: {Field: Field{Id: 0x002b, Name: "EnablePrivacyModeButton", Type: "bool"}, ReadPrivilege: "view", WritePrivilege: "manage", Writable: true},
+			0x002c: {Field: Field{Id: 0x002c, Name: "LocalProgrammingFeatures", Type: "LocalProgrammingFeaturesBitmap"}, ReadPrivilege: "view", WritePrivilege: "administer", Writable: true},
+			0x0030: {Field: Field{Id: 0x0030, Name: "WrongCodeEntryLimit", Type: "uint8"}, ReadPrivilege: "view", WritePrivilege: "administer", Writable: true},
+			0x0031: {Field: Field{Id: 0x0031, Name: "UserCodeTemporaryDisableTime", Type: "uint8"}, ReadPrivilege: "view", WritePrivilege: "administer", Writable: true},
+			0x0032: {Field: Field{Id: 0x0032, Name: "SendPINOverTheAir", Type: "bool"}, ReadPrivilege: "view", WritePrivilege: "administer", Writable: true},
+			0x0033: {Field: Field{Id: 0x0033, Name: "RequirePINforRemoteOperation", Type: "bool"}, ReadPrivilege: "view", WritePrivilege: "administer", Writable: true},
+			0x0035: {Field: Field{Id: 0x0035, Name: "ExpiringUserTimeout", Type: "uint16"}, ReadPrivilege: "view", WritePrivilege: "administer", Writable: true},
+		},
+		Commands: map[int]CommandInfo{
+			0x00: {Id: 0x00, Name: "LockDoor", Response: ResponseStatus, Fields: []Field{
+				{Id: 0, Name: "PINCode", Type: "octstr"},
+			}, InvokePrivilege: "operate", Timed: true},
+			0x01: {Id: 0x01, Name: "UnlockDoor", Response: ResponseStatus, Fields: []Field{
+				{Id: 0, Name: "PINCode", Type: "octstr"},
+			}, InvokePrivilege: "operate", Timed: true},
+			0x03: {Id: 0x03, Name: "UnlockWithTimeout", Response: ResponseStatus, Fields: []Field{
+				{Id: 0, Name: "Timeout", Type: "uint16"},
+				{Id: 1, Name: "PINCode", Type: "octstr"},
+			}, InvokePrivilege: "operate", Timed: true},
+			0x0b: {Id: 0x0b, Name: "SetWeekDaySchedule", Response: ResponseStatus, Fields: []Field{
+				{Id: 0, Name: "WeekDayIndex", Type: "uint8"},
+				{Id: 1, Name: "UserIndex", Type: "uint16"},
+				{Id: 2, Name: "DaysMask", Type: "DaysMaskBitmap"},
+				{Id: 3, Name: "StartHour", Type: "uint8"},
+				{Id: 4, Name: "StartMinute", Type: "uint8"},
+				{Id: 5, Name: "EndHour", Type: "uint8"},
+				{Id: 6, Name: "EndMinute", Type: "uint8"},
+			}, InvokePrivilege: "administer"},
+			0x0c: {Id: 0x0c, Name: "GetWeekDaySchedule", Response: 0x0c, Fields: []Field{
+				{Id: 0, Name: "WeekDayIndex", Type: "uint8"},
+				{Id: 1, Name: "UserIndex", Type: "uint16"},
+			}, InvokePrivilege: "administer"},
+			0x0d: {Id: 0x0d, Name: "ClearWeekDaySchedule", Response: ResponseStatus, Fields: []Field{
+				{Id: 0, Name: "WeekDayIndex", Type: "uint8"},
+				{Id: 1, Name: "UserIndex", Type: "uint16"},
+			}, InvokePrivilege: "administer"},
+			0x0e: {Id: 0x0e, Name: "SetYearDaySchedule", Response: ResponseStatus, Fields: []Field{
+				{Id: 0, Name: "YearDayIndex", Type: "uint8"},
+				{Id: 1, Name: "UserIndex", Type: "uint16"},
+				{Id: 2, Name: "LocalStartTime", Type: "epoch-s"},
+				{Id: 3, Name: "LocalEndTime", Type: "epoch-s"},
+			}, InvokePrivilege: "administer"},
+			0x0f: {Id: 0x0f, Name: "GetYearDaySchedule", Response: 0x0f, Fields: []Field{
+				{Id: 0, Name: "YearDayIndex", Type: "uint8"},
+				{Id: 1, Name: "UserIndex", Type: "uint16"},
+			}, InvokePrivilege: "administer"},
+			0x10: {Id: 0x10, Name: "ClearYearDaySchedule", Response: ResponseStatus, Fields: []Field{
+				{Id: 0, Name: "YearDayIndex", Type: "uint8"},
+				{Id: 1, Name: "UserIndex", Type: "uint16"},
+			}, InvokePrivilege: "administer"},
+			0x11: {Id: 0x11, Name: "SetHolidaySchedule", Response: ResponseStatus, Fields: []Field{
+				{Id: 0, Name: "HolidayIndex", Type: "uint8"},
+				{Id: 1, Name: "LocalStartTime", Type: "epoch-s"},
+				{Id: 2, Name: "LocalEndTime", Type: "epoch-s"},
+				{Id: 3, Name: "OperatingMode", Type: "OperatingModeEnum"},
+			}, InvokePrivilege: "administer"},
+			0x12: {Id: 0x12, Name: "GetHolidaySchedule", Response: 0x12, Fields: []Field{
+				{Id: 0, Name: "HolidayIndex", Type: "uint8"},
+			}, InvokePrivilege: "administer"},
+			0x13: {Id: 0x13, Name: "ClearHolidaySchedule", Response: ResponseStatus, Fields: []Field{
+				{Id: 0, Name: "HolidayIndex", Type: "uint8"},
+			}, InvokePrivilege: "administer"},
+			0x1a: {Id: 0x1a, Name: "SetUser", Response: ResponseStatus, Fields: []Field{
+				{Id: 0, Name: "OperationType", Type: "DataOperationTypeEnum"},
+				{Id: 1, Name: "UserIndex", Type: "uint16"},
+				{Id: 2, Name: "UserName", Type: "string", Nullable: true},
+				{Id: 3, Name: "UserUniqueID", Type: "uint32", Nullable: true},
+				{Id: 4, Name: "UserStatus", Type: "UserStatusEnum", Nullable: true},
+				{Id: 5, Name: "UserType", Type: "UserTypeEnum", Nullable: true},
+				{Id: 6, Name: "CredentialRule", Type: "CredentialRuleEnum", Nullable: true},
+			}, InvokePrivilege: "administer", Timed: true},
+			0x1b: {Id: 0x1b, Name: "GetUser", Response: 0x1c, Fields: []Field{
+				{Id: 0, Name: "UserIndex", Type: "uint16"},
+			}, InvokePrivilege: "administer"},
+			0x1d: {Id: 0x1d, Name: "ClearUser", Response: ResponseStatus, Fields: []Field{
+				{Id: 0, Name: "UserIndex", Type: "uint16"},
+			}, InvokePrivilege: "administer", Timed: true},
+			0x22: {Id: 0x22, Name: "SetCredential", Response: 0x23, Fields: []Field{
+				{Id: 0, Name: "OperationType", Type: "DataOperationTypeEnum"},
+				{Id: 1, Name: "Credential", Type: "CredentialStruct"},
+				{Id: 2, Name: "CredentialData", Type: "octstr"},
+				{Id: 3, Name: "UserIndex", Type: "uint16", Nullable: true},
+				{Id: 4, Name: "UserStatus", Type: "UserStatusEnum", Nullable: true},
+				{Id: 5, Name: "UserType", Type: "UserTypeEnum", Nullable: true},
+			}, InvokePrivilege: "administer", Timed: true},
+			0x24: {Id: 0x24, Name: "GetCredentialStatus", Response: 0x25, Fields: []Field{
+				{Id: 0, Name: "Credential", Type: "CredentialStruct"},
+			}, InvokePrivilege: "administer"},
+			0x26: {Id: 0x26, Name: "ClearCredential", Response: ResponseStatus, Fields: []Field{
+				{Id: 0, Name: "Credential", Type: "CredentialStruct", Nullable: true},
+			}, InvokePrivilege: "administer", Timed: true},
+			0x27: {Id: 0x27, Name: "UnboltDoor", Response: ResponseStatus, Fields: []Field{
+				{Id: 0, Name: "PINCode", Type: "octstr"},
+			}, InvokePrivilege: "operate", Timed: true},
+		},
+		Responses: map[int]CommandInfo{
+			0x0c: {Id: 0x0c, Name: "GetWeekDayScheduleResponse", Response: ResponseNone, Fields: []Field{
+				{Id: 0, Name: "WeekDayIndex", Type: "uint8"},
+				{Id: 1, Name: "UserIndex", Type: "uint16"},
+				{Id: 2, Name: "Status", Type: "status"},
+				{Id: 3, Name: "DaysMask", Type: "DaysMaskBitmap"},
+				{Id: 4, Name: "StartHour", Type: "uint8"},
+				{Id: 5, Name: "StartMinute", Type: "uint8"},
+				{Id: 6, Name: "EndHour", Type: "uint8"},
+				{Id: 7, Name: "EndMinute", Type: "uint8"},
+			}},
+			0x0f: {Id: 0x0f, Name: "GetYearDayScheduleResponse", Response: ResponseNone, Fields: []Field{
+				{Id: 0, Name: "YearDayIndex", Type: "uint8"},
+				{Id: 1, Name: "UserIndex", Type: "uint16"},
+				{Id: 2, Name: "Status", Type: "status"},
+				{Id: 3, Name: "LocalStartTime", Type: "epoch-s"},
+				{Id: 4, Name: "LocalEndTime", Type: "epoch-s"},
+			}},
+			0x12: {Id: 0x12, Name: "GetHolidayScheduleResponse", Response: ResponseNone, Fields: []Field{
+				{Id: 0, Name: "HolidayIndex", Type: "uint8"},
+				{Id: 1, Name: "Status", Type: "status"},
+				{Id: 2, Name: "LocalStartTime", Type: "epoch-s"},
+				{Id: 3, Name: "LocalEndTime", Type: "epoch-s"},
+				{Id: 4, Name: "OperatingMode", Type: "OperatingModeEnum"},
+			}},
+			0x1c: {Id: 0x1c, Name: "GetUserResponse", Response: ResponseNone, Fields: []Field{
+				{Id: 0, Name: "UserIndex", Type: "uint16"},
+				{Id: 1, Name: "UserName", Type: "string", Nullable: true},
+				{Id: 2, Name: "UserUniqueID", Type: "uint32", Nullable: true},
+				{Id: 3, Name: "UserStatus", Type: "UserStatusEnum", Nullable: true},
+				{Id: 4, Name: "UserType", Type: "UserTypeEnum", Nullable: true},
+				{Id: 5, Name: "CredentialRule", Type: "CredentialRuleEnum", Nullable: true},
+				{Id: 6, Name: "Credentials", Type: "CredentialStruct", List: true, Nullable: true},
+				{Id: 7, Name: "CreatorFabricIndex", Type: "fabric-idx", Nullable: true},
+				{Id: 8, Name: "LastModifiedFabricIndex", Type: "fabric-idx", Nullable: true},
+				{Id: 9, Name: "NextUserIndex", Type: "uint16", Nullable: true},
+			}},
+			0x23: {Id: 0x23, Name: "SetCredentialResponse", Response: ResponseNone, Fields: []Field{
+				{Id: 0, Name: "Status", Type: "status"},
+				{Id: 1, Name: "UserIndex", Type: "uint16", Nullable: true},
+				{Id: 2, Name: "NextCredentialIndex", Type: "uint16", Nullable: true},
+			}},
+			0x25: {Id: 0x25, Name: "GetCredentialStatusResponse", Response: ResponseNone, Fields: []Field{
+				{Id: 0, Name: "CredentialExists", Type: "bool"},
+				{Id: 1, Name: "UserIndex", Type: "uint16", Nullable: true},
+				{Id: 2, Name: "CreatorFabricIndex", Type: "fabric-idx", Nullable: true},
+				{Id: 3, Name: "LastModifiedFabricIndex", Type: "fabric-idx", Nullable: true},
+				{Id: 4, Name: "NextCredentialIndex", Type: "uint16", Nullable: true},
+			}},
+		},
+		Events: map[int]EventInfo{
+			0x00: {Name: "DoorLockAlarm", Fields: []Field{
+				{Id: 0, Name: "AlarmCode", Type: "AlarmCodeEnum"},
+			}, Priority: "critical"},
+			0x01: {Name: "DoorStateChange", Fields: []Field{
+				{Id: 0, Name: "DoorState", Type: "DoorStateEnum"},
+			}, Priority: "critical"},
+			0x02: {Name: "LockOperation", Fields: []Field{
+				{Id: 0, Name: "LockOperationType", Type: "LockOperationTypeEnum"},
+				{Id: 1, Name: "OperationSource", Type: "OperationSourceEnum"},
+				{Id: 2, Name: "UserIndex", Type: "uint16", Nullable: true},
+				{Id: 3, Name: "FabricIndex", Type: "fabric-idx", Nullable: true},
+				{Id: 4, Name: "SourceNode", Type: "node-id", Nullable: true},
+				{Id: 5, Name: "Credentials", Type: "CredentialStruct", List: true, Nullable: true},
+			}, Priority: "critical"},
+			0x03: {Name: "LockOperationError", Fields: []Field{
+				{Id: 0, Name: "LockOperationType", Type: "LockOperationTypeEnum"},
+				{Id: 1, Name: "OperationSource", Type: "OperationSourceEnum"},
+				{Id: 2, Name: "OperationError", Type: "OperationErrorEnum"},
+				{Id: 3, Name: "UserIndex", Type: "uint16", Nullable: true},
+				{Id: 4, Name: "FabricIndex", Type: "fabric-idx", Nullable: true},
+				{Id: 5, Name: "SourceNode", Type: "node-id", Nullable: true},
+				{Id: 6, Name: "Credentials", Type: "CredentialStruct", List: true, Nullable: true},
+			}, Priority: "critical"},
+			0x04: {Name: "LockUserChange", Fields: []Field{
+				{Id: 0, Name: "LockDataType", Type: "LockDataTypeEnum"},
+				{Id: 1, Name: "DataOperationType", Type: "DataOperationTypeEnum"},
+				{Id: 2, Name: "OperationSource", Type: "OperationSourceEnum"},
+				{Id: 3, Name: "UserIndex", Type: "uint16", Nullable: true},
+				{Id: 4, Name: "FabricIndex", Type: "fabric-idx", Nullable: true},
+				{Id: 5, Name: "SourceNode", Type: "node-id", Nullable: true},
+				{Id: 6, Name: "DataIndex", Type: "uint16", Nullable: true},
+			}, Priority: "info"},
+		},
+		Enums: map[string]EnumInfo{
+			"AlarmCodeEnum":         {0: "LockJammed", 1: "LockFactoryReset", 3: "LockRadioPowerCycled", 4: "WrongCodeEntryLimit", 5: "FrontEsceutcheonRemoved", 6: "DoorForcedOpen", 7: "DoorAjar", 8: "ForcedUser"},
+			"CredentialRuleEnum":    {0: "Single", 1: "Dual", 2: "Tri"},
+			"CredentialTypeEnum":    {0: "ProgrammingPIN", 1: "PIN", 2: "RFID", 3: "Fingerprint", 4: "FingerVein", 5: "Face"},
+			"DataOperationTypeEnum": {0: "Add", 1: "Clear", 2: "Modify"},
+			"DoorStateEnum":         {0: "DoorOpen", 1: "DoorClosed", 2: "DoorJammed", 3: "DoorForcedOpen", 4: "DoorUnspecifiedError", 5: "DoorAjar"},
+			"LockDataTypeEnum":      {0: "Unspecified", 1: "ProgrammingCode", 2: "UserIndex", 3: "WeekDaySchedule", 4: "YearDaySchedule", 5: "HolidaySchedule", 6: "PIN", 7: "RFID", 8: "Fingerprint", 9: "FingerVein", 10: "Face"},
+			"LockOperationTypeEnum": {0: "Lock", 1: "Unlock", 2: "NonAccessUserEvent", 3: "ForcedUserEvent", 4: "Unlatch"},
+			"OperationErrorEnum":    {0: "Unspecified", 1: "InvalidCredential", 2: "DisabledUserDenied", 3: "Restricted", 4: "InsufficientBattery"},
+			"OperatingModeEnum":     {0: "Normal", 1: "Vacation", 2: "Privacy", 3: "NoRemoteLockUnlock", 4: "Passage"},
+			"OperationSourceEnum":   {0: "Unspecified", 1: "Manual", 2: "ProprietaryRemote", 3: "Keypad", 4: "Auto", 5: "Button", 6: "Schedule", 7: "Remote", 8: "RFID", 9: "Biometric"},
+			"UserStatusEnum":        {0: "Available", 1: "OccupiedEnabled", 3: "OccupiedDisabled"},
+			"UserTypeEnum":          {0: "UnrestrictedUser", 1: "YearDayScheduleUser", 2: "WeekDayScheduleUser", 3: "ProgrammingUser", 4: "NonAccessUser", 5: "ForcedUser", 6: "DisposableUser", 7: "ExpiringUser", 8: "ScheduleRestrictedUser", 9: "RemoteOnlyUser"},
+			"LockStateEnum":         {0: "NotFullyLocked", 1: "Locked", 2: "Unlocked", 3: "Unlatched"},
+			"LockTypeEnum":          {0: "DeadBolt", 1: "Magnetic", 2: "Other", 3: "Mortise", 4: "Rim", 5: "LatchBolt", 6: "CylindricalLock", 7: "TubularLock", 8: "InterconnectedLock", 9: "DeadLatch", 10: "DoorFurniture", 11: "Eurocylinder"},
+			"LEDSettingEnum":        {0: "NoLEDSignal", 1: "NoLEDSignalAccessAllowed", 2: "LEDSignalAll"},
+			"SoundVolumeEnum":       {0: "Silent", 1: "Low", 2: "High", 3: "Medium"},
+			"StatusCodeEnum":        {2: "Duplicate", 3: "Occupied"},
+		},
+		Bitmaps: map[string]BitmapInfo{
+			"DaysMaskBitmap":                 {0: "Sunday", 1: "Monday", 2: "Tuesday", 3: "Wednesday", 4: "Thursday", 5: "Friday", 6: "Saturday"},
+			"CredentialRulesBitmap":          {0: "Single", 1: "Dual", 2: "Tri"},
+			"OperatingModesBitmap":           {0: "Normal", 1: "Vacation", 2: "Privacy", 3: "NoRemoteLockUnlock", 4: "Passage"},
+			"ConfigurationRegisterBitmap":    {0: "LocalProgramming", 1: "KeypadInterface", 2: "RemoteInterface", 5: "SoundVolume", 6: "AutoRelockTime", 7: "LEDSettings"},
+			"LocalProgrammingFeaturesBitmap": {0: "AddUsersCredentialsSchedules", 1: "ModifyUsersCredentials", 2: "ClearUsersCredentialsSchedules", 3: "AdjustSettings"},
+		},
+		Structs: map[string]StructInfo{
+			"CredentialStruct": {Fields: []Field{
+				{Id: 0, Name: "CredentialType", Type: "CredentialTypeEnum"},
+				{Id: 1, Name: "CredentialIndex", Type: "uint16"},
+			}},
+		},
+	},
+	CLUSTER_ID_WindowCovering: {
+		Attributes: map[int]AttributeInfo{
+			0x0000: {Field: Field{Id: 0x0000, Name: "Type", Type: "TypeEnum"}, ReadPrivilege: "view"},
+			0x0001: {Field: Field{Id: 0x0001, Name: "PhysicalClosedLimitLift", Type: "uint16"}, ReadPrivilege: "view"},
+			0x0002: {Field: Field{Id: 0x0002, Name: "PhysicalClosedLimitTilt", Type: "uint16"}, ReadPrivilege: "view"},
+			0x0003: {Field: Field{Id: 0x0003, Name: "CurrentPositionLift", Type: "uint16", Nullable: true}, ReadPrivilege: "view"},
+			0x0004: {Field: Field{Id: 0x0004, Name: "CurrentPositionTilt", Type: "uint16", Nullable: true}, ReadPrivilege: "view"},
+			0x0005: {Field: Field{Id: 0x0005, Name: "NumberOfActuationsLift", Type: "uint16"}, ReadPrivilege: "view"},
+			0x0006: {Field: Field{Id: 0x0006, Name: "NumberOfActuationsTilt", Type: "uint16"}, ReadPrivilege: "view"},
+			0x0007: {Field: Field{Id: 0x0007, Name: "ConfigStatus", Type: "ConfigStatusBitmap"}, ReadPrivilege: "view"},
+			0x0008: {Field: Field{Id: 0x0008, Name: "CurrentPositionLiftPercentage", Type: "percent", Nullable: true}, ReadPrivilege: "view"},
+			0x0009: {Field: Field{Id: 0x0009, Name: "CurrentPositionTiltPercentage", Type: "percent", Nullable: true}, ReadPrivilege: "view"},
+			0x000a: {Field: Field{Id: 0x000a, Name: "OperationalStatus", Type: "OperationalStatusBitmap"}, ReadPrivilege: "view"},
+			0x000b: {Field: Field{Id: 0x000b, Name: "TargetPositionLiftPercent100ths", Type: "percent100ths", Nullable: true}, ReadPrivilege: "view"},
+			0x000c: {Field: Field{Id: 0x000c, Name: "TargetPositionTiltPercent100ths", Type: "percent100ths", Nullable: true}, ReadPrivilege: "view"},
+			0x000d: {Field: Field{Id: 0x000d, Name: "EndProductType", Type: "EndProductTypeEnum"}, ReadPrivilege: "view"},
+			0x000e: {Field: Field{Id: 0x000e, Name: "CurrentPositionLiftPercent100ths", Type: "percent100ths", Nullable: true}, ReadPrivilege: "view"},
+			0x000f: {Field: Field{Id: 0x000f, Name: "CurrentPositionTiltPercent100ths", Type: "percent100ths", Nullable: true}, ReadPrivilege: "view"},
+			0x0010: {Field: Field{Id: 0x0010, Name: "InstalledOpenLimitLift", Type: "uint16"}, ReadPrivilege: "view"},
+			0x0011: {Field: Field{Id: 0x0011, Name: "InstalledClosedLimitLift", Type: "uint16"}, ReadPrivilege: "view"},
+			0x0012: {Field: Field{Id: 0x0012, Name: "InstalledOpenLimitTilt", Type: "uint16"}, ReadPrivilege: "view"},
+			0x0013: {Field: Field{Id: 0x0013, Name: "InstalledClosedLimitTilt", Type: "uint16"}, ReadPrivilege: "view"},
+			0x0017: {Field: Field{Id: 0x0017, Name: "Mode", Type: "ModeBitmap"}, ReadPrivilege: "view", WritePrivilege: "manage", Writable: true},
+			0x001a: {Field: Field{Id: 0x001a, Name: "SafetyStatus", Type: "SafetyStatusBitmap"}, ReadPrivilege: "view"},
+		},
+		Commands: map[int]CommandInfo{
+			0x00: {Id: 0x00, Name: "UpOrOpen", Response: ResponseStatus, InvokePrivilege: "operate"},
+			0x01: {Id: 0x01, Name: "DownOrClose", Response: ResponseStatus, InvokePrivilege: "operate"},
+			0x02: {Id: 0x02, Name: "StopMotion", Response: ResponseStatus, InvokePrivilege: "operate"},
+			0x04: {Id: 0x04, Name: "GoToLiftValue", Response: ResponseStatus, Fields: []Field{
+				{Id: 0, Name: "LiftValue", Type: "uint16"},
+			}, InvokePrivilege: "operate"},
+			0x05: {Id: 0x05, Name: "GoToLiftPercentage", Response: ResponseStatus, Fields: []Field{
+				{Id: 0, Name: "LiftPercent100thsValue", Type: "percent100ths"},
+			}, InvokePrivilege: "operate"},
+			0x07: {Id: 0x07, Name: "GoToTiltValue", Response: ResponseStatus, Fields: []Field{
+				{Id: 0, Name: "TiltValue", Type: "uint16"},
+			}, InvokePrivilege: "operate"},
+			0x08: {Id: 0x08, Name: "GoToTiltPercentage", Response: ResponseStatus, Fields: []Field{
+				{Id: 0, Name: "TiltPercent100thsValue", Type: "percent100ths"},
+			}, InvokePrivilege: "operate"},
+		},
+		Enums: map[string]EnumInfo{
+			"TypeEnum":           {0: "Rollershade", 1: "Rollershade2Motor", 2: "RollershadeExterior", 3: "RollershadeExterior2Motor", 4: "Drapery", 5: "Awning", 6: "Shutter", 7: "TiltBlindTiltOnly", 8: "TiltBlindLift", 9: "ProjectorScreen", 255: "Unknown"},
+			"EndProductTypeEnum": {0: "RollerShade", 1: "RomanShade", 2: "BalloonShade", 3: "WovenWood", 4: "PleatedShade", 5: "CellularShade", 6: "LayeredShade", 7: "LayeredShade2D", 8: "SheerShade", 9: "TiltOnlyInteriorBlind", 10: "InteriorBlind", 11: "VerticalBlindStripCurtain", 12: "InteriorVenetianBlind", 13: "ExteriorVenetianBlind", 14: "LateralLeftCurtain", 15: "LateralRightCurtain", 16: "CentralCurtain", 17: "RollerShutter", 18: "ExteriorVerticalScreen", 19: "AwningTerracePatio", 20: "AwningVerticalScreen", 21: "TiltOnlyPergola", 22: "SwingingShutter", 23: "SlidingShutter", 255: "Unknown"},
+		},
+		Bitmaps: map[string]BitmapInfo{
+			"ConfigStatusBitmap":      {0: "Operational", 1: "OnlineReserved", 2: "LiftMovementReversed", 3: "LiftPositionAware", 4: "TiltPositionAware", 5: "LiftEncoderControlled", 6: "TiltEncoderControlled"},
+			"ModeBitmap":              {0: "MotorDirectionReversed", 1: "CalibrationMode", 2: "MaintenanceMode", 3: "LedFeedback"},
+			"OperationalStatusBitmap": {0: "Global", 2: "Lift", 4: "Tilt"},
+			"SafetyStatusBitmap":      {0: "RemoteLockout", 1: "TamperDetection", 2: "FailedCommunication", 3: "PositionFailure", 4: "ThermalProtection", 5: "ObstacleDetected", 6: "Power", 7: "StopInput", 8: "MotorJammed", 9: "HardwareFailure", 10: "ManualOperation", 11: "Protection"},
+		},
+	},
+	CLUSTER_ID_PumpConfigurationandControl: {
+		Attributes: map[int]AttributeInfo{
+			0x0000: {Field: Field{Id: 0x0000, Name: "MaxPressure", Type: "int16", Nullable: true}, ReadPrivilege: "view"},
+			0x0001: {Field: Field{Id: 0x0001, Name: "MaxSpeed", Type: "uint16", Nullable: true}, ReadPrivilege: "view"},
+			0x0002: {Field: Field{Id: 0x0002, Name: "MaxFlow", Type: "uint16", Nullable: true}, ReadPrivilege: "view"},
+			0x0003: {Field: Field{Id: 0x0003, Name: "MinConstPressure", Type: "int16", Nullable: true}, ReadPrivilege: "view"},
+			0x0004: {Field: Field{Id: 0x0004, Name: "MaxConstPressure", Type: "int16", Nullable: true}, ReadPrivilege: "view"},
+			0x0005: {Field: Field{Id: 0x0005, Name: "MinCompPressure", Type: "int16", Nullable: true}, ReadPrivilege: "view"},
+			0x0006: {Field: Field{Id: 0x0006, Name: "MaxCompPressure", Type: "int16", Nullable: true}, ReadPrivilege: "view"},
+			0x0007: {Field: Field{Id: 0x0007, Name: "MinConstSpeed", Type: "uint16", Nullable: true}, ReadPrivilege: "view"},
+			0x0008: {Field: Field{Id: 0x0008, Name: "MaxConstSpeed", Type: "uint16", Nullable: true}, ReadPrivilege: "view"},
+			0x0009: {Field: Field{Id: 0x0009, Name: "MinConstFlow", Type: "uint16", Nullable: true}, ReadPrivilege: "view"},
+			0x000a: {Field: Field{Id: 0x000a, Name: "MaxConstFlow", Type: "uint16", Nullable: true}, ReadPrivilege: "view"},
+			0x000b: {Field: Field{Id: 0x000b, Name: "MinConstTemp", Type: "int16", Nullable: true}, ReadPrivilege: "view"},
+			0x000c: {Field: Field{Id: 0x000c, Name: "MaxConstTemp", Type: "int16", Nullable: true}, ReadPrivilege: "view"},
+			0x0010: {Field: Field{Id: 0x0010, Name: "PumpStatus", Type: "PumpStatusBitmap"}, ReadPrivilege: "view"},
+			0x0011: {Field: Field{Id: 0x0011, Name: "EffectiveOperationMode", Type: "OperationModeEnum"}, ReadPrivilege: "view"},
+			0x0012: {Field: Field{Id: 0x0012, Name: "EffectiveControlMode", Type: "ControlModeEnum"}, ReadPrivilege: "view"},
+			0x0013: {Field: Field{Id: 0x0013, Name: "Capacity", Type: "int16", Nullable: true}, ReadPrivilege: "view"},
+			0x0014: {Field: Field{Id: 0x0014, Name: "Speed", Type: "uint16", Nullable: true}, ReadPrivilege: "view"},
+			0x0015: {Field: Field{Id: 0x0015, Name: "LifetimeRunningHours", Type: "uint24", Nullable: true}, ReadPrivilege: "view", WritePrivilege: "manage", Writable: true},
+			0x0016: {Field: Field{Id: 0x0016, Name: "Power", Type: "uint24", Nullable: true}, ReadPrivilege: "view"},
+			0x0017: {Field: Field{Id: 0x0017, Name: "LifetimeEnergyConsumed", Type: "uint32", Nullable: true}, ReadPrivilege: "view", WritePrivilege: "manage", Writable: true},
+			0x0020: {Field: Field{Id: 0x0020, Name: "OperationMode", Type: "OperationModeEnum"}, ReadPrivilege: "view", WritePrivilege: "manage", Writable: true},
+			0x0021: {Field: Field{Id: 0x0021, Name: "ControlMode", Type: "ControlModeEnum"}, ReadPrivilege: "view", WritePrivilege: "manage", Writable: true},
+		},
+		Events: map[int]EventInfo{
+			0x00: {Name: "SupplyVoltageLow", Priority: "info"},
+			0x01: {Name: "SupplyVoltageHigh", Priority: "info"},
+			0x02: {Name: "PowerMissingPhase", Priority: "info"},
+			0x03: {Name: "SystemPressureLow", Priority: "info"},
+			0x04: {Name: "SystemPressureHigh", Priority: "info"},
+			0x05: {Name: "DryRunning", Priority: "critical"},
+			0x06: {Name: "MotorTemperatureHigh", Priority: "info"},
+			0x07: {Name: "PumpMotorFatalFailure", Priority: "critical"},
+			0x08: {Name: "ElectronicTemperatureHigh", Priority: "info"},
+			0x09: {Name: "PumpBlocked", Priority: "critical"},
+			0x0a: {Name: "SensorFailure", Priority: "info"},
+			0x0b: {Name: "ElectronicNonFatalFailure", Priority: "info"},
+			0x0c: {Name: "ElectronicFatalFailure", Priority: "critical"},
+			0x0d: {Name: "GeneralFault", Priority: "info"},
+			0x0e: {Name: "Leakage", Priority: "info"},
+			0x0f: {Name: "AirDetection", Priority: "info"},
+			0x10: {Name: "TurbineOperation", Priority: "info"},
+		},
+		Enums: map[string]EnumInfo{
+			"OperationModeEnum": {0: "Normal", 1: "Minimum", 2: "Maximum", 3: "Local"},
+			"ControlModeEnum":   {0: "ConstantSpeed", 1: "ConstantPressure", 2: "ProportionalPressure", 3: "ConstantFlow", 5: "ConstantTemperature", 7: "Automatic"},
+		},
+		Bitmaps: map[string]BitmapInfo{
+			"PumpStatusBitmap": {0: "DeviceFault", 1: "SupplyFault", 2: "SpeedLow", 3: "SpeedHigh", 4: "LocalOverride", 5: "Running", 6: "RemotePressure", 7: "RemoteFlow", 8: "RemoteTemperature"},
+		},
+	},
+	CLUSTER_ID_Thermostat: {
+		Attributes: map[int]AttributeInfo{
+			0x0000: {Field: Field{Id: 0x0000, Name: "LocalTemperature", Type: "temperature", Nullable: true}, ReadPrivilege: "view"},
+			0x0001: {Field: Field{Id: 0x0001, Name: "OutdoorTemperature", Type: "temperature", Nullable: true}, ReadPrivilege: "view"},
+			0x0002: {Field: Field{Id: 0x0002, Name: "Occupancy", Type: "OccupancyBitmap"}, ReadPrivilege: "view"},
+			0x0003: {Field: Field{Id: 0x0003, Name: "AbsMinHeatSetpointLimit", Type: "temperature"}, ReadPrivilege: "view"},
+			0x0004: {Field: Field{Id: 0x0004, Name: "AbsMaxHeatSetpointLimit", Type: "temperature"}, ReadPrivilege: "view"},
+			0x0005: {Field: Field{Id: 0x0005, Name: "AbsMinCoolSetpointLimit", Type: "temperature"}, ReadPrivilege: "view"},
+			0x0006: {Field: Field{Id: 0x0006, Name: "AbsMaxCoolSetpointLimit", Type: "temperature"}, ReadPrivilege: "view"},
+			0x0007: {Field: Field{Id: 0x0007, Name: "PICoolingDemand", Type: "percent"}, ReadPrivilege: "view"},
+			0x0008: {Field: Field{Id: 0x0008, Name: "PIHeatingDemand", Type: "percent"}, ReadPrivilege: "view"},
+			0x0009: {Field: Field{Id: 0x0009, Name: "HVACSystemTypeConfiguration", Type: "HVACSystemTypeBitmap"}, ReadPrivilege: "view", WritePrivilege: "manage", Writable: true},
+			0x0010: {Field: Field{Id: 0x0010, Name: "LocalTemperatureCalibration", Type: "int8"}, ReadPrivilege: "view", WritePrivilege: "manage", Writable: true},
+			0x0011: {Field: Field{Id: 0x0011, Name: "OccupiedCoolingSetpoint", Type: "temperature"}, ReadPrivilege: "view", WritePrivilege: "operate", Writable: true},
+			0x0012: {Field: Field{Id: 0x0012, Name: "OccupiedHeatingSetpoint", Type: "temperature"}, ReadPrivilege: "view", WritePrivilege: "operate", Writable: true},
+			0x0013: {Field: Field{Id: 0x0013, Name: "UnoccupiedCoolingSetpoint", Type: "temperature"}, ReadPrivilege: "view", WritePrivilege: "operate", Writable: true},
+			0x0014: {Field: Field{Id: 0x0014, Name: "UnoccupiedHeatingSetpoint", Type: "temperature"}, ReadPrivilege: "view", WritePrivilege: "operate", Writable: true},
+			0x0015: {Field: Field{Id: 0x0015, Name: "MinHeatSetpointLimit", Type: "temperature"}, ReadPrivilege: "view", WritePrivilege: "manage", Writable: true},
+			0x0016: {Field: Field{Id: 0x0016, Name: "MaxHeatSetpointLimit", Type: "temperature"}, ReadPrivilege: "view", WritePrivilege: "manage", Writable: true},
+			0x0017: {Field: Field{Id: 0x0017, Name: "MinCoolSetpointLimit", Type: "temperature"}, ReadPrivilege: "view", WritePrivilege: "manage", Writable: true},
+			0x0018: {Field: Field{Id: 0x0018, Name: "MaxCoolSetpointLimit", Type: "temperature"}, ReadPrivilege: "view", WritePrivilege: "manage", Writable: true},
+			0x0019: {Field: Field{Id: 0x0019, Name: "MinSetpointDeadBand", Type: "int8"}, ReadPrivilege: "view", WritePrivilege: "manage", Writable: true},
+			0x001a: {Field: Field{Id: 0x001a, Name: "RemoteSensing", Type: "RemoteSensingBitmap"}, ReadPrivilege: "view", WritePrivilege: "manage", Writable: true},
+			0x001b: {Field: Field{Id: 0x001b, Name: "ControlSequenceOfOperation", Type: "ControlSequenceOfOperationEnum"}, ReadPrivilege: "view", WritePrivilege: "manage", Writable: true},
+			0x001c: {Field: Field{Id: 0x001c, Name: "SystemMode", Type: "SystemModeEnum"}, ReadPrivilege: "view", WritePrivilege: "operate", Writable: true},
+			0x001e: {Field: Field{Id: 0x001e, Name: "ThermostatRunningMode", Type: "ThermostatRunningModeEnum"}, ReadPrivilege: "view"},
+			0x0020: {Field: Field{Id: 0x0020, Name: "StartOfWeek", Type: "StartOfWeekEnum"}, ReadPrivilege: "view"},
+			0x0021: {Field: Field{Id: 0x0021, Name: "NumberOfWeeklyTransitions", Type: "uint8"}, ReadPrivilege: "view"},
+			0x0022: {Field: Field{Id: 0x0022, Name: "NumberOfDailyTransitions", Type: "uint8"}, ReadPrivilege: "view"},
+			0x0023: {Field: Field{Id: 0x0023, Name: "TemperatureSetpointHold", Type: "TemperatureSetpointHoldEnum"}, ReadPrivilege: "view", WritePrivilege: "manage", Writable: true},
+			0x0024: {Field: Field{Id: 0x0024, Name: "TemperatureSetpointHoldDuration", Type: "uint16", Nullable: true}, ReadPrivilege: "view", WritePrivilege: "manage", Writable: true},
+			0x0025: {Field: Field{Id: 0x0025, Name: "ThermostatProgrammingOperationMode", Type: "ProgrammingOperationModeBitmap"}, ReadPrivilege: "view", WritePrivilege: "manage", Writable: true},
+			0x0029: {Field: Field{Id: 0x0029, Name: "ThermostatRunningState", Type: "RelayStateBitmap"}, ReadPrivilege: "view"},
+			0x0030: {Field: Field{Id: 0x0030, Name: "SetpointChangeSource", Type: "SetpointChangeSourceEnum"}, ReadPrivilege: "view"},
+			0x0031: {Field: Field{Id: 0x0031, Name: "SetpointChangeAmount", Type: "int16", Nullable: true}, ReadPrivilege: "view"},
+			0x0032: {Field: Field{Id: 0x0032, Name: "SetpointChangeSourceTimestamp", Type: "epoch-s"}, ReadPrivilege: "view"},
+			0x0034: {Field: Field{Id: 0x0034, Name: "OccupiedSetback", Type: "uint8", Nullable: true}, ReadPrivilege: "view", WritePrivilege: "manage", Writable: true},
+			0x0035: {Field: Field{Id: 0x0035, Name: "OccupiedSetbackMin", Type: "uint8", Nullable: true}, ReadPrivilege: "view"},
+			0x0036: {Field: Field{Id: 0x0036, Name: "OccupiedSetbackMax", Type: "uint8", Nullable: true}, ReadPrivilege: "view"},
+			0x0037: {Field: Field{Id: 0x0037, Name: "UnoccupiedSetback", Type: "uint8", Nullable: true}, ReadPrivilege: "view", WritePrivilege: "manage", Writable: true},
+			0x0038: {Field: Field{Id: 0x0038, Name: "UnoccupiedSetbackMin", Type: "uint8", Nullable: true}, ReadPrivilege: "view"},
+			0x0039: {Field: Field{Id: 0x0039, Name: "UnoccupiedSetbackMax", Type: "uint8", Nullable: true}, ReadPrivilege: "view"},
+			0x003a: {Field: Field{Id: 0x003a, Name: "EmergencyHeatDelta", Type: "uint8"}, ReadPrivilege: "view", WritePrivilege: "manage", Writable: true},
+			0x0040: {Field: Field{Id: 0x0040, Name: "ACType", Type: "ACTypeEnum"}, ReadPrivilege: "view", WritePrivilege: "manage", Writable: true},
+			0x0041: {Field: Field{Id: 0x0041, Name: "ACCapacity", Type: "uint16"}, ReadPrivilege: "view", WritePrivilege: "manage", Writable: true},
+			0x0042: {Field: Field{Id: 0x0042, Name: "ACRefrigerantType", Type: "ACRefrigerantTypeEnum"}, ReadPrivilege: "view", WritePrivilege: "manage", Writable: true},
+			0x0043: {Field: Field{Id: 0x0043, Name: "ACCompressorType", Type: "ACCompressorTypeEnum"}, ReadPrivilege: "view", WritePrivilege: "manage", Writable: true},
+			0x0044: {Field: Field{Id: 0x0044, Name: "ACErrorCode", Type: "ACErrorCodeBitmap"}, ReadPrivilege: "view", WritePrivilege: "manage", Writable: true},
+			0x0045: {Field: Field{Id: 0x0045, Name: "ACLouverPosition", Type: "ACLouverPositionEnum"}, ReadPrivilege: "view", WritePrivilege: "manage", Writable: true},
+			0x0046: {Field: Field{Id: 0x0046, Name: "ACCoilTemperature", Type: "temperature", Nullable: true}, ReadPrivilege: "view"},
+			0x0047: {Field: Field{Id: 0x0047, Name: "ACCapacityformat", Type: "ACCapacityFormatEnum"}, ReadPrivilege: "view", WritePrivilege: "manage", Writable: true},
+		},
+		Commands: map[int]CommandInfo{
+			0x00: {Id: 0x00, Name: "SetpointRaiseLower", Response: ResponseStatus, Fields: []Field{
+				{Id: 0, Name: "Mode", Type: "SetpointRaiseLowerModeEnum"},
+				{Id: 1, Name: "Amount", Type: "int8"},
+			}, InvokePrivilege: "operate"},
+			0x01: {Id: 0x01, Name: "SetWeeklySchedule", Response: ResponseStatus, Fields: []Field{
+				{Id: 0, Name: "NumberOfTransitionsForSequence", Type: "uint8"},
+				{Id: 1, Name: "DayOfWeekForSequence", Type: "ScheduleDayOfWeekBitmap"},
+				{Id: 2, Name: "ModeForSequence", Type: "ScheduleModeBitmap"},
+				{Id: 3, Name: "Transitions", Type: "WeeklyScheduleTransitionStruct", List: true},
+			}, InvokePrivilege: "manage"},
+			0x02: {Id: 0x02, Name: "GetWeeklySchedule", Response: 0x00, Fields: []Field{
+				{Id: 0, Name: "DaysToReturn", Type: "ScheduleDayOfWeekBitmap"},
+				{Id: 1, Name: "ModeToReturn", Type: "ScheduleModeBitmap"},
+			}, InvokePrivilege: "operate"},
+			0x03: {Id: 0x03, Name: "ClearWeeklySchedule", Response: ResponseStatus, InvokePrivilege: "manage"},
+		},
+		Responses: map[int]CommandInfo{
+			0x00: {Id: 0x00, Name: "GetWeeklyScheduleResponse", Response: ResponseNone, Fields: []Field{
+				{Id: 0, Name: "NumberOfTransitionsForSequence", Type: "uint8"},
+				{Id: 1, Name: "DayOfWeekForSequence", Type: "ScheduleDayOfWeekBitmap"},
+				{Id: 2, Name: "ModeForSequence", Type: "ScheduleModeBitmap"},
+				{Id: 3, Name: "Transitions", Type: "WeeklyScheduleTransitionStruct", List: true},
+			}},
+		},
+		Enums: map[string]EnumInfo{
+			"ACCapacityFormatEnum":           {0: "BTUh"},
+			"ACCompressorTypeEnum":           {0: "Unknown", 1: "T1", 2: "T2", 3: "T3"},
+			"ACLouverPositionEnum":           {1: "Closed", 2: "Open", 3: "Quarter", 4: "Half", 5: "ThreeQuarters"},
+			"ACRefrigerantTypeEnum":          {0: "Unknown", 1: "R22", 2: "R410a", 3: "R407c"},
+			"ACTypeEnum":                     {0: "Unknown", 1: "CoolingFixed", 2: "HeatPumpFixed", 3: "CoolingInverter", 4: "HeatPumpInverter"},
+			"ControlSequenceOfOperationEnum": {0: "CoolingOnly", 1: "CoolingWithReheat", 2: "HeatingOnly", 3: "HeatingWithReheat", 4: "CoolingAndHeating", 5: "CoolingAndHeatingWithReheat"},
+			"SetpointChangeSourceEnum":       {0: "Manual", 1: "Schedule", 2: "External"},
+			"SetpointRaiseLowerModeEnum":     {0: "Heat", 1: "Cool", 2: "Both"},
+			"StartOfWeekEnum":                {0: "Sunday", 1: "Monday", 2: "Tuesday", 3: "Wednesday", 4: "Thursday", 5: "Friday", 6: "Saturday"},
+			"SystemModeEnum":                 {0: "Off", 1: "Auto", 3: "Cool", 4: "Heat", 5: "EmergencyHeat", 6: "Precooling", 7: "FanOnly", 8: "Dry", 9: "Sleep"},
+			"TemperatureSetpointHoldEnum":    {0: "SetpointHoldOff", 1: "SetpointHoldOn"},
+			"ThermostatRunningModeEnum":      {0: "Off", 3: "Cool", 4: "Heat"},
+		},
+		Bitmaps: map[string]BitmapInfo{
+			"ACErrorCodeBitmap":              {0: "CompressorFail", 1: "RoomSensorFail", 2: "OutdoorSensorFail", 3: "CoilSensorFail", 4: "FanFail"},
+			"HVACSystemTypeBitmap":           {0: "CoolingStage", 2: "HeatingStage", 4: "HeatingIsHeatPump", 5: "HeatingUsesFuel"},
+			"OccupancyBitmap":                {0: "Occupied"},
+			"ProgrammingOperationModeBitmap": {0: "ScheduleActive", 1: "AutoRecovery", 2: "Economy"},
+			"RelayStateBitmap":               {0: "Heat", 1: "Cool", 2: "Fan", 3: "HeatStage2", 4: "CoolStage2", 5: "FanStage2", 6: "FanStage3"},
+			"RemoteSensingBitmap":            {0: "LocalTemperature", 1: "OutdoorTemperature", 2: "Occupancy"},
+			"ScheduleDayOfWeekBitmap":        {0: "Sunday", 1: "Monday", 2: "Tuesday", 3: "Wednesday", 4: "Thursday", 5: "Friday", 6: "Saturday", 7: "Away"},
+			"ScheduleModeBitmap":             {0: "HeatSetpointPresent", 1: "CoolSetpointPresent"},
+		},
+		Structs: map[string]StructInfo{
+			"WeeklyScheduleTransitionStruct": {Fields: []Field{
+				{Id: 0, Name: "TransitionTime", Type: "uint16"},
+				{Id: 1, Name: "HeatSetpoint", Type: "temperature", Nullable: true},
+				{Id: 2, Name: "CoolSetpoint", Type: "temperature", Nullable: true},
+			}},
+		},
+	},
+	CLUSTER_ID_FanControl: {
+		Attributes: map[int]AttributeInfo{
+			0x0000: {Field: Field{Id: 0x0000, Name: "FanMode", Type: "FanModeEnum"}, ReadPrivilege: "view", WritePrivilege: "operate", Writable: true},
+			0x0001: {Field: Field{Id: 0x0001, Name: "FanModeSequence", Type: "FanModeSequenceEnum"}, ReadPrivilege: "view", WritePrivilege: "operate", Writable: true},
+			0x0002: {Field: Field{Id: 0x0002, Name: "PercentSetting", Type: "percent", Nullable: true}, ReadPrivilege: "view", WritePrivilege: "operate", Writable: true},
+			0x0003: {Field: Field{Id: 0x0003, Name: "PercentCurrent", Type: "percent"}, ReadPrivilege: "view"},
+			0x0004: {Field: Field{Id: 0x0004, Name: "SpeedMax", Type: "uint8"}, ReadPrivilege: "view"},
+			0x0005: {Field: Field{Id: 0x0005, Name: "SpeedSetting", Type: "uint8", Nullable: true}, ReadPrivilege: "view", WritePrivilege: "operate", Writable: true},
+			0x0006: {Field: Field{Id: 0x0006, Name: "SpeedCurrent", Type: "uint8"}, ReadPrivilege: "view"},
+			0x0007: {Field: Field{Id: 0x0007, Name: "RockSupport", Type: "RockBitmap"}, ReadPrivilege: "view"},
+			0x0008: {Field: Field{Id: 0x0008, Name: "RockSetting", Type: "RockBitmap"}, ReadPrivilege: "view", WritePrivilege: "operate", Writable: true},
+			0x0009: {Field: Field{Id: 0x0009, Name: "WindSupport", Type: "WindBitmap"}, ReadPrivilege: "view"},
+			0x000a: {Field: Field{Id: 0x000a, Name: "WindSetting", Type: "WindBitmap"}, ReadPrivilege: "view", WritePrivilege: "operate", Writable: true},
+			0x000b: {Field: Field{Id: 0x000b, Name: "AirflowDirection", Type: "AirflowDirectionEnum"}, ReadPrivilege: "view", WritePrivilege: "operate", Writable: true},
+		},
+		Commands: map[int]CommandInfo{
+			0x00: {Id: 0x00, Name: "Step", Response: ResponseStatus, Fields: []Field{
+				{Id: 0, Name: "Direction", Type: "StepDirectionEnum"},
+				{Id: 1, Name: "Wrap", Type: "bool"},
+				{Id: 2, Name: "LowestOff", Type: "bool"},
+			}, InvokePrivilege: "operate"},
+		},
+		Enums: map[string]EnumInfo{
+			"FanModeEnum":          {0: "Off", 1: "Low", 2: "Medium", 3: "High", 4: "On", 5: "Auto", 6: "Smart"},
+			"FanModeSequenceEnum":  {0: "OffLowMedHigh", 1: "OffLowHigh", 2: "OffLowMedHighAuto", 3: "OffLowHighAuto", 4: "OffHighAuto", 5: "OffHigh"},
+			"AirflowDirectionEnum": {0: "Forward", 1: "Reverse"},
+			"StepDirectionEnum":    {0: "Increase", 1: "Decrease"},
+		},
+		Bitmaps: map[string]BitmapInfo{
+			"RockBitmap": {0: "RockLeftRight", 1: "RockUpDown", 2: "RockRound"},
+			"WindBitmap": {0: "SleepWind", 1: "NaturalWind"},
+		},
+	},
+	CLUSTER_ID_ThermostatUserInterfaceConfiguration: {
+		Attributes: map[int]AttributeInfo{
+			0x0000: {Field: Field{Id: 0x0000, Name: "TemperatureDisplayMode", Type: "TemperatureDisplayModeEnum"}, ReadPrivilege: "view", WritePrivilege: "operate", Writable: true},
+			0x0001: {Field: Field{Id: 0x0001, Name: "KeypadLockout", Type: "KeypadLockoutEnum"}, ReadPrivilege: "view", WritePrivilege: "manage", Writable: true},
+			0x0002: {Field: Field{Id: 0x0002, Name: "ScheduleProgrammingVisibility", Type: "ScheduleProgrammingVisibilityEnum"}, ReadPrivilege: "view", WritePrivilege: "manage", Writable: true},
+		},
+		Enums: map[string]EnumInfo{
+			"TemperatureDisplayModeEnum":        {0: "Celsius", 1: "Fahrenheit"},
+			"KeypadLockoutEnum":                 {0: "NoLockout", 1: "Lockout1", 2: "Lockout2", 3: "Lockout3", 4: "Lockout4", 5: "Lockout5"},
+			"ScheduleProgrammingVisibilityEnum": {0: "ScheduleProgrammingPermitted", 1: "ScheduleProgrammingDenied"},
+		},
+	},
+	CLUSTER_ID_ColorControl: {
+		Attributes: map[int]AttributeInfo{
+			0x0000: {Field: Field{Id: 0x0000, Name: "CurrentHue", Type: "uint8"}, ReadPrivilege: "view"},
+			0x0001: {Field: Field{Id: 0x0001, Name: "CurrentSaturation", Type: "uint8"}, ReadPrivilege: "view"},
+			0x0002: {Field: Field{Id: 0x0002, Name: "RemainingTime", Type: "uint16"}, ReadPrivilege: "view"},
+			0x0003: {Field: Field{Id: 0x0003, Name: "CurrentX", Type: "uint16"}, ReadPrivilege: "view"},
+			0x0004: {Field: Field{Id: 0x0004, Name: "CurrentY", Type: "uint16"}, ReadPrivilege: "view"},
+			0x0005: {Field: Field{Id: 0x0005, Name: "DriftCompensation", Type: "DriftCompensationEnum"}, ReadPrivilege: "view"},
+			0x0006: {Field: Field{Id: 0x0006, Name: "CompensationText", Type: "string"}, ReadPrivilege: "view"},
+			0x0007: {Field: Field{Id: 0x0007, Name: "ColorTemperatureMireds", Type: "uint16"}, ReadPrivilege: "view"},
+			0x0008: {Field: Field{Id: 0x0008, Name: "ColorMode", Type: "ColorModeEnum"}, ReadPrivilege: "view"},
+			0x000f: {Field: Field{Id: 0x000f, Name: "Options", Type: "OptionsBitmap"}, ReadPrivilege: "view", WritePrivilege: "operate", Writable: true},
+			0x0010: {Field: Field{Id: 0x0010, Name: "NumberOfPrimaries", Type: "uint8", Nullable: true}, ReadPrivilege: "view"},
+			0x0011: {Field: Field{Id: 0x0011, Name: "Primary1X", Type: "uint16"}, ReadPrivilege: "view"},
+			0x0012: {Field: Field{Id: 0x0012, Name: "Primary1Y", Type: "uint16"}, ReadPrivilege: "view"},
+			0x0013: {Field: Field{Id: 0x0013, Name: "Primary1Intensity", Type: "uint8", Nullable: true}, ReadPrivilege: "view"},
+			0x0015: {Field: Field{Id: 0x0015, Name: "Primary2X", Type: "uint16"}, ReadPrivilege: "view"},
+			0x0016: {Field: Field{Id: 0x0016, Name: "Primary2Y", Type: "uint16"}, ReadPrivilege: "view"},
+			0x0017: {Field: Field{Id: 0x0017, Name: "Primary2Intensity", Type: "uint8", Nullable: true}, ReadPrivilege: "view"},
+			0x0019: {Field: Field{Id: 0x0019, Name: "Primary3X", Type: "uint16"}, ReadPrivilege: "view"},
+			0x001a: {Field: Field{Id: 0x001a, Name: "Primary3Y", Type: "uint16"}, ReadPrivilege: "view"},
+			0x001b: {Field: Field{Id: 0x001b, Name: "Primary3Intensity", Type: "uint8", Nullable: true}, ReadPrivilege: "view"},
+			0x0020: {Field: Field{Id: 0x0020, Name: "Primary4X", Type: "uint16"}, ReadPrivilege: "view"},
+			0x0021: {Field: Field{Id: 0x0021, Name: "Primary4Y", Type: "uint16"}, ReadPrivilege: "view"},
+			0x0022: {Field: Field{Id: 0x0022, Name: "Primary4Intensity", Type: "uint8", Nullable: true}, ReadPrivilege: "view"},
+			0x0024: {Field: Field{Id: 0x0024, Name: "Primary5X", Type: "uint16"}, ReadPrivilege: "view"},
+			0x0025: {Field: Field{Id: 0x0025, Name: "Primary5Y", Type: "uint16"}, ReadPrivilege: "view"},
+			0x0026: {Field: Field{Id: 0x0026, Name: "Primary5Intensity", Type: "uint8", Nullable: true}, ReadPrivilege: "view"},
+			0x0028: {Field: Field{Id: 0x0028, Name: "Primary6X", Type: "uint16"}, ReadPrivilege: "view"},
+			0x0029: {Field: Field{Id: 0x0029, Name: "Primary6Y", Type: "uint16"}, ReadPrivilege: "view"},
+			0x002a: {Field: Field{Id: 0x002a, Name: "Primary6Intensity", Type: "uint8", Nullable: true}, ReadPrivilege: "view"},
+			0x0030: {Field: Field{Id: 0x0030, Name: "WhitePointX", Type: "uint16"}, ReadPrivilege: "view", WritePrivilege: "manage", Writable: true},
+			0x0031: {Field: Field{Id: 0x0031, Name: "WhitePointY", Type: "uint16"}, ReadPrivilege: "view", WritePrivilege: "manage", Writable: true},
+			0x0032: {Field: Field{Id: 0x0032, Name: "ColorPointRX", Type: "uint16"}, ReadPrivilege: "view", WritePrivilege: "manage", Writable: true},
+			0x0033: {Field: Field{Id: 0x0033, Name: "ColorPointRY", Type: "uint16"}, ReadPrivilege: "view", WritePrivilege: "manage", Writable: true},
+			0x0034: {Field: Field{Id: 0x0034, Name: "ColorPointRIntensity", Type: "uint8", Nullable: true}, ReadPrivilege: "view", WritePrivilege: "manage", Writable: true},
+			0x0036: {Field: Field{Id: 0x0036, Name: "ColorPointGX", Type: "uint16"}, ReadPrivilege: "view", WritePrivilege: "manage", Writable: true},
+			0x0037: {Field: Field{Id: 0x0037, Name: "ColorPointGY", Type: "uint16"}, ReadPrivilege: "view", WritePrivilege: "manage", Writable: true},
+			0x0038: {Field: Field{Id: 0x0038, Name: "ColorPointGIntensity", Type: "uint8", Nullable: true}, ReadPrivilege: "view", WritePrivilege: "manage", Writable: true},
+			0x003a: {Field: Field{Id: 0x003a, Name: "ColorPointBX", Type: "uint16"}, ReadPrivilege: "view", WritePrivilege: "manage", Writable: true},
+			0x003b: {Field: Field{Id: 0x003b, Name: "ColorPointBY", Type: "uint16"}, ReadPrivilege: "view", WritePrivilege: "manage", Writable: true},
+			0x003c: {Field: Field{Id: 0x003c, Name: "ColorPointBIntensity", Type: "uint8", Nullable: true}, ReadPrivilege: "view", WritePrivilege: "manage", Writable: true},
+			0x4000: {Field: Field{Id: 0x4000, Name: "EnhancedCurrentHue", Type: "uint16"}, ReadPrivilege: "view"},
+			0x4001: {Field: Field{Id: 0x4001, Name: "EnhancedColorMode", Type: "EnhancedColorModeEnum"}, ReadPrivilege: "view"},
+			0x4002: {Field: Field{Id: 0x4002, Name: "ColorLoopActive", Type: "uint8"}, ReadPrivilege: "view"},
+			0x4003: {Field: Field{Id: 0x4003, Name: "ColorLoopDirection", Type: "ColorLoopDirectionEnum"}, ReadPrivilege: "view"},
+			0x4004: {Field: Field{Id: 0x4004, Name: "ColorLoopTime", Type: "uint16"}, ReadPrivilege: "view"},
+			0x4005: {Field: Field{Id: 0x4005, Name: "ColorLoopStartEnhancedHue", Type: "uint16"}, ReadPrivilege: "view"},
+			0x4006: {Field: Field{Id: 0x4006, Name: "ColorLoopStoredEnhancedHue", Type: "uint16"}, ReadPrivilege: "view"},
+			0x400a: {Field: Field{Id: 0x400a, Name: "ColorCapabilities", Type: "ColorCapabilitiesBitmap"}, ReadPrivilege: "view"},
+			0x400b: {Field: Field{Id: 0x400b, Name: "ColorTempPhysicalMinMireds", Type: "uint16"}, ReadPrivilege: "view"},
+			0x400c: {Field: Field{Id: 0x400c, Name: "ColorTempPhysicalMaxMireds", Type: "uint16"}, ReadPrivilege: "view"},
+			0x400d: {Field: Field{Id: 0x400d, Name: "CoupleColorTempToLevelMinMireds", Type: "uint16"}, ReadPrivilege: "view"},
+			0x4010: {Field: Field{Id: 0x4010, Name: "StartUpColorTemperatureMireds", Type: "uint16", Nullable: true}, ReadPrivilege: "view", WritePrivilege: "manage", Writable: true},
+		},
+		Commands: map[int]CommandInfo{
+			0x00: {Id: 0x00, Name: "MoveToHue", Response: ResponseStatus, Fields: []Field{
+				{Id: 0, Name: "Hue", Type: "uint8"},
+				{Id: 1, Name: "Direction", Type: "DirectionEnum"},
+				{Id: 2, Name: "TransitionTime", Type: "uint16"},
+				{Id: 3, Name: "OptionsMask", Type: "OptionsBitmap"},
+				{Id: 4, Name: "OptionsOverride", Type: "OptionsBitmap"},
+			}, InvokePrivilege: "operate"},
+			0x01: {Id: 0x01, Name: "MoveHue", Response: ResponseStatus, Fields: []Field{
+				{Id: 0, Name: "MoveMode", Type: "MoveModeEnum"},
+				{Id: 1, Name: "Rate", Type: "uint8"},
+				{Id: 2, Name: "OptionsMask", Type: "OptionsBitmap"},
+				{Id: 3, Name: "OptionsOverride", Type: "OptionsBitmap"},
+			}, InvokePrivilege: "operate"},
+			0x02: {Id: 0x02, Name: "StepHue", Response: ResponseStatus, Fields: []Field{
+				{Id: 0, Name: "StepMode", Type: "StepModeEnum"},
+				{Id: 1, Name: "StepSize", Type: "uint8"},
+				{Id: 2, Name: "TransitionTime", Type: "uint8"},
+				{Id: 3, Name: "OptionsMask", Type: "OptionsBitmap"},
+				{Id: 4, Name: "OptionsOverride", Type: "OptionsBitmap"},
+			}, InvokePrivilege: "operate"},
+			0x03: {Id: 0x03, Name: "MoveToSaturation", Response: ResponseStatus, Fields: []Field{
+				{Id: 0, Name: "Saturation", Type: "uint8"},
+				{Id: 1, Name: "TransitionTime", Type: "uint16"},
+				{Id: 2, Name: "OptionsMask", Type: "OptionsBitmap"},
+				{Id: 3, Name: "OptionsOverride", Type: "OptionsBitmap"},
+			}, InvokePrivilege: "operate"},
+			0x04: {Id: 0x04, Name: "MoveSaturation", Response: ResponseStatus, Fields: []Field{
+				{Id: 0, Name: "MoveMode", Type: "MoveModeEnum"},
+				{Id: 1, Name: "Rate", Type: "uint8"},
+				{Id: 2, Name: "OptionsMask", Type: "OptionsBitmap"},
+				{Id: 3, Name: "OptionsOverride", Type: "OptionsBitmap"},
+			}, InvokePrivilege: "operate"},
+			0x05: {Id: 0x05, Name: "StepSaturation", Response: ResponseStatus, Fields: []Field{
+				{Id: 0, Name: "StepMode", Type: "StepModeEnum"},
+				{Id: 1, Name: "StepSize", Type: "uint8"},
+				{Id: 2, Name: "TransitionTime", Type: "uint8"},
+				{Id: 3, Name: "OptionsMask", Type: "OptionsBitmap"},
+				{Id: 4, Name: "OptionsOverride", Type: "OptionsBitmap"},
+			}, InvokePrivilege: "operate"},
+			0x06: {Id: 0x06, Name: "MoveToHueAndSaturation", Response: ResponseStatus, Fields: []Field{
+				{Id: 0, Name: "Hue", Type: "uint8"},
+				{Id: 1, Name: "Saturation", Type: "uint8"},
+				{Id: 2, Name: "TransitionTime", Type: "uint16"},
+				{Id: 3, Name: "OptionsMask", Type: "OptionsBitmap"},
+				{Id: 4, Name: "OptionsOverride", Type: "OptionsBitmap"},
+			}, InvokePrivilege: "operate"},
+			0x07: {Id: 0x07, Name: "MoveToColor", Response: ResponseStatus, Fields: []Field{
+				{Id: 0, Name: "ColorX", Type: "uint16"},
+				{Id: 1, Name: "ColorY", Type: "uint16"},
+				{Id: 2, Name: "TransitionTime", Type: "uint16"},
+				{Id: 3, Name: "OptionsMask", Type: "OptionsBitmap"},
+				{Id: 4, Name: "OptionsOverride", Type: "OptionsBitmap"},
+			}, InvokePrivilege: "operate"},
+			0x08: {Id: 0x08, Name: "MoveColor", Response: ResponseStatus, Fields: []Field{
+				{Id: 0, Name: "RateX", Type: "int16"},
+				{Id: 1, Name: "RateY", Type: "int16"},
+				{Id: 2, Name: "OptionsMask", Type: "OptionsBitmap"},
+				{Id: 3, Name: "OptionsOverride", Type: "OptionsBitmap"},
+			}, InvokePrivilege: "operate"},
+			0x09: {Id: 0x09, Name: "StepColor", Response: ResponseStatus, Fields: []Field{
+				{Id: 0, Name: "StepX", Type: "int16"},
+				{Id: 1, Name: "StepY", Type: "int16"},
+				{Id: 2, Name: "TransitionTime", Type: "uint16"},
+				{Id: 3, Name: "OptionsMask", Type: "OptionsBitmap"},
+				{Id: 4, Name: "OptionsOverride", Type: "OptionsBitmap"},
+			}, InvokePrivilege: "operate"},
+			0x0a: {Id: 0x0a, Name: "MoveToColorTemperature", Response: ResponseStatus, Fields: []Field{
+				{Id: 0, Name: "ColorTemperatureMireds", Type: "uint16"},
+				{Id: 1, Name: "TransitionTime", Type: "uint16"},
+				{Id: 2, Name: "OptionsMask", Type: "OptionsBitmap"},
+				{Id: 3, Name: "OptionsOverride", Type: "OptionsBitmap"},
+			}, InvokePrivilege: "operate"},
+			0x40: {Id: 0x40, Name: "EnhancedMoveToHue", Response: ResponseStatus, Fields: []Field{
+				{Id: 0, Name: "EnhancedHue", Type: "uint16"},
+				{Id: 1, Name: "Direction", Type: "DirectionEnum"},
+				{Id: 2, Name: "TransitionTime", Type: "uint16"},
+				{Id: 3, Name: "OptionsMask", Type: "OptionsBitmap"},
+				{Id: 4, Name: "OptionsOverride", Type: "OptionsBitmap"},
+			}, InvokePrivilege: "operate"},
+			0x41: {Id: 0x41, Name: "EnhancedMoveHue", Response: ResponseStatus, Fields: []Field{
+				{Id: 0, Name: "MoveMode", Type: "MoveModeEnum"},
+				{Id: 1, Name: "Rate", Type: "uint16"},
+				{Id: 2, Name: "OptionsMask", Type: "OptionsBitmap"},
+				{Id: 3, Name: "OptionsOverride", Type: "OptionsBitmap"},
+			}, InvokePrivilege: "operate"},
+			0x42: {Id: 0x42, Name: "EnhancedStepHue", Response: ResponseStatus, Fields: []Field{
+				{Id: 0, Name: "StepMode", Type: "StepModeEnum"},
+				{Id: 1, Name: "StepSize", Type: "uint16"},
+				{Id: 2, Name: "TransitionTime", Type: "uint16"},
+				{Id: 3, Name: "OptionsMask", Type: "OptionsBitmap"},
+				{Id: 4, Name: "OptionsOverride", Type: "OptionsBitmap"},
+			}, InvokePrivilege: "operate"},
+			0x43: {Id: 0x43, Name: "EnhancedMoveToHueAndSaturation", Response: ResponseStatus, Fields: []Field{
+				{Id: 0, Name: "EnhancedHue", Type: "uint16"},
+				{Id: 1, Name: "Saturation", Type: "uint8"},
+				{Id: 2, Name: "TransitionTime", Type: "uint16"},
+				{Id: 3, Name: "OptionsMask", Type: "OptionsBitmap"},
+				{Id: 4, Name: "OptionsOverride", Type: "OptionsBitmap"},
+			}, InvokePrivilege: "operate"},
+			0x44: {Id: 0x44, Name: "ColorLoopSet", Response: ResponseStatus, Fields: []Field{
+				{Id: 0, Name: "UpdateFlags", Type: "UpdateFlagsBitmap"},
+				{Id: 1, Name: "Action", Type: "ColorLoopActionEnum"},
+				{Id: 2, Name: "Direction", Type: "ColorLoopDirectionEnum"},
+				{Id: 3, Name: "Time", Type: "uint16"},
+				{Id: 4, Name: "StartHue", Type: "uint16"},
+				{Id: 5, Name: "OptionsMask", Type: "OptionsBitmap"},
+				{Id: 6, Name: "OptionsOverride", Type: "OptionsBitmap"},
+			}, InvokePrivilege: "operate"},
+			0x47: {Id: 0x47, Name: "StopMoveStep", Response: ResponseStatus, Fields: []Field{
+				{Id: 0, Name: "OptionsMask", Type: "OptionsBitmap"},
+				{Id: 1, Name: "OptionsOverride", Type: "OptionsBitmap"},
+			}, InvokePrivilege: "operate"},
+			0x4b: {Id: 0x4b, Name: "MoveColorTemperature", Response: ResponseStatus, Fields: []Field{
+				{Id: 0, Name: "MoveMode", Type: "MoveModeEnum"},
+				{Id: 1, Name: "Rate", Type: "uint16"},
+				{Id: 2, Name: "ColorTemperatureMinimumMireds", Type: "uint16"},
+				{Id: 3, Name: "ColorTemperatureMaximumMireds", Type: "uint16"},
+				{Id: 4, Name: "OptionsMask", Type: "OptionsBitmap"},
+				{Id: 5, Name: "OptionsOverride", Type: "OptionsBitmap"},
+			}, InvokePrivilege: "operate"},
+			0x4c: {Id: 0x4c, Name: "StepColorTemperature", Response: ResponseStatus, Fields: []Field{
+				{Id: 0, Name: "StepMode", Type: "StepModeEnum"},
+				{Id: 1, Name: "StepSize", Type: "uint16"},
+				{Id: 2, Name: "TransitionTime", Type: "uint16"},
+				{Id: 3, Name: "ColorTemperatureMinimumMireds", Type: "uint16"},
+				{Id: 4, Name: "ColorTemperatureMaximumMireds", Type: "uint16"},
+				{Id: 5, Name: "OptionsMask", Type: "OptionsBitmap"},
+				{Id: 6, Name: "OptionsOverride", Type: "OptionsBitmap"},
+			}, InvokePrivilege: "operate"},
+		},
+		Enums: map[string]EnumInfo{
+			"ColorModeEnum":          {0: "CurrentHueAndCurrentSaturation", 1: "CurrentXAndCurrentY", 2: "ColorTemperatureMireds"},
+			"EnhancedColorModeEnum":  {0: "CurrentHueAndCurrentSaturation", 1: "CurrentXAndCurrentY", 2: "ColorTemperatureMireds", 3: "EnhancedCurrentHueAndCurrentSaturation"},
+			"DriftCompensationEnum":  {0: "None", 1: "OtherOrUnknown", 2: "TemperatureMonitoring", 3: "OpticalLuminanceMonitoringAndFeedback", 4: "OpticalColorMonitoringAndFeedback"},
+			"ColorLoopActionEnum":    {0: "Deactivate", 1: "ActivateFromColorLoopStartEnhancedHue", 2: "ActivateFromEnhancedCurrentHue"},
+			"ColorLoopDirectionEnum": {0: "Decrement", 1: "Increment"},
+			"DirectionEnum":          {0: "Shortest", 1: "Longest", 2: "Up", 3: "Down"},
+			"MoveModeEnum":           {0: "Stop", 1: "Up", 3: "Down"},
+			"StepModeEnum":           {1: "Up", 3: "Down"},
+		},
+		Bitmaps: map[string]BitmapInfo{
+			"ColorCapabilitiesBitmap": {0: "HueSaturation", 1: "EnhancedHue", 2: "ColorLoop", 3: "XY", 4: "ColorTemperature"},
+			"OptionsBitmap":           {0: "ExecuteIfOff"},
+			"UpdateFlagsBitmap":       {0: "UpdateAction", 1: "UpdateDirection", 2: "UpdateTime", 3: "UpdateStartHue"},
+		},
+	},
+	CLUSTER_ID_BallastConfiguration: {
+		Attributes: map[int]AttributeInfo{
+			0x0000: {Field: Field{Id: 0x0000, Name: "PhysicalMinLevel", Type: "uint8"}, ReadPrivilege: "view"},
+			0x0001: {Field: Field{Id: 0x0001, Name: "PhysicalMaxLevel", Type: "uint8"}, ReadPrivilege: "view"},
+			0x0002: {Field: Field{Id: 0x0002, Name: "BallastStatus", Type: "BallastStatusBitmap"}, ReadPrivilege: "view"},
+			0x0010: {Field: Field{Id: 0x0010, Name: "MinLevel", Type: "uint8"}, ReadPrivilege: "view", WritePrivilege: "manage", Writable: true},
+			0x0011: {Field: Field{Id: 0x0011, Name: "MaxLevel", Type: "uint8"}, ReadPrivilege: "view", WritePrivilege: "manage", Writable: true},
+			0x0014: {Field: Field{Id: 0x0014, Name: "IntrinsicBallastFactor", Type: "uint8", Nullable: true}, ReadPrivilege: "view", WritePrivilege: "manage", Writable: true},
+			0x0015: {Field: Field{Id: 0x0015, Name: "BallastFactorAdjustment", Type: "uint8", Nullable: true}, ReadPrivilege: "view", WritePrivilege: "manage", Writable: true},
+			0x0020: {Field: Field{Id: 0x0020, Name: "LampQuantity", Type: "uint8"}, ReadPrivilege: "view"},
+			0x0030: {Field: Field{Id: 0x0030, Name: "LampType", Type: "string"}, ReadPrivilege: "view", WritePrivilege: "manage", Writable: true},
+			0x0031: {Field: Field{Id: 0x0031, Name: "LampManufacturer", Type: "string"}, ReadPrivilege: "view", WritePrivilege: "manage", Writable: true},
+			0x0032: {Field: Field{Id: 0x0032, Name: "LampRatedHours", Type: "uint24", Nullable: true}, ReadPrivilege: "view", WritePrivilege: "manage", Writable: true},
+			0x0033: {Field: Field{Id: 0x0033, Name: "LampBurnHours", Type: "uint24", Nullable: true}, ReadPrivilege: "view", WritePrivilege: "manage", Writable: true},
+			0x0034: {Field: Field{Id: 0x0034, Name: "LampAlarmMode", Type: "LampAlarmModeBitmap"}, ReadPrivilege: "view", WritePrivilege: "manage", Writable: true},
+			0x0035: {Field: Field{Id: 0x0035, Name: "LampBurnHoursTripPoint", Type: "uint24", Nullable: true}, ReadPrivilege: "view", WritePrivilege: "manage", Writable: true},
+		},
+		Bitmaps: map[string]BitmapInfo{
+			"BallastStatusBitmap": {0: "BallastNonOperational", 1: "LampFailure"},
+			"LampAlarmModeBitmap": {0: "LampBurnHours"},
+		},
+	},
+	CLUSTER_ID_IlluminanceMeasurement: {
+		Attributes: map[int]AttributeInfo{
+			0x0000: {Field: Field{Id: 0x0000, Name: "MeasuredValue", Type: "uint16", Nullable: true}, ReadPrivilege: "view"},
+			0x0001: {Field: Field{Id: 0x0001, Name: "MinMeasuredValue", Type: "uint16", Nullable: true}, ReadPrivilege: "view"},
+			0x0002: {Field: Field{Id: 0x0002, Name: "MaxMeasuredValue", Type: "uint16", Nullable: true}, ReadPrivilege: "view"},
+			0x0003: {Field: Field{Id: 0x0003, Name: "Tolerance", Type: "uint16"}, ReadPrivilege: "view"},
+			0x0004: {Field: Field{Id: 0x0004, Name: "LightSensorType", Type: "LightSensorTypeEnum", Nullable: true}, ReadPrivilege: "view"},
+		},
+		Enums: map[string]EnumInfo{
+			"LightSensorTypeEnum": {0: "Photodiode", 1: "CMOS"},
+		},
+	},
+	CLUSTER_ID_TemperatureMeasurement: {
+		Attributes: map[int]AttributeInfo{
+			0x0000: {Field: Field{Id: 0x0000, Name: "MeasuredValue", Type: "temperature", Nullable: true}, ReadPrivilege: "view"},
+			0x0001: {Field: Field{Id: 0x0001, Name: "MinMeasuredValue", Type: "temperature", Nullable: true}, ReadPrivilege: "view"},
+			0x0002: {Field: Field{Id: 0x0002, Name: "MaxMeasuredValue", Type: "temperature", Nullable: true}, ReadPrivilege: "view"},
+			0x0003: {Field: Field{Id: 0x0003, Name: "Tolerance", Type: "uint16"}, ReadPrivilege: "view"},
+		},
+	},
+	CLUSTER_ID_PressureMeasurement: {
+		Attributes: map[int]AttributeInfo{
+			0x0000: {Field: Field{Id: 0x0000, Name: "MeasuredValue", Type: "int16", Nullable: true}, ReadPrivilege: "view"},
+			0x0001: {Field: Field{Id: 0x0001, Name: "MinMeasuredValue", Type: "int16", Nullable: true}, ReadPrivilege: "view"},
+			0x0002: {Field: Field{Id: 0x0002, Name: "MaxMeasuredValue", Type: "int16", Nullable: true}, ReadPrivilege: "view"},
+			0x0003: {Field: Field{Id: 0x0003, Name: "Tolerance", Type: "uint16"}, ReadPrivilege: "view"},
+			0x0010: {Field: Field{Id: 0x0010, Name: "ScaledValue", Type: "int16", Nullable: true}, ReadPrivilege: "view"},
+			0x0011: {Field: Field{Id: 0x0011, Name: "MinScaledValue", Type: "int16", Nullable: true}, ReadPrivilege: "view"},
+			0x0012: {Field: Field{Id: 0x0012, Name: "MaxScaledValue", Type: "int16", Nullable: true}, ReadPrivilege: "view"},
+			0x0013: {Field: Field{Id: 0x0013, Name: "ScaledTolerance", Type: "uint16"}, ReadPrivilege: "view"},
+			0x0014: {Field: Field{Id: 0x0014, Name: "Scale", Type: "int8"}, ReadPrivilege: "view"},
+		},
+	},
+	CLUSTER_ID_FlowMeasurement: {
+		Attributes: map[int]AttributeInfo{
+			0x0000: {Field: Field{Id: 0x0000, Name: "MeasuredValue", Type: "uint16", Nullable: true}, ReadPrivilege: "view"},
+			0x0001: {Field: Field{Id: 0x0001, Name: "MinMeasuredValue", Type: "uint16", Nullable: true}, ReadPrivilege: "view"},
+			0x0002: {Field: Field{Id: 0x0002, Name: "MaxMeasuredValue", Type: "uint16", Nullable: true}, ReadPrivilege: "view"},
+			0x0003: {Field: Field{Id: 0x0003, Name: "Tolerance", Type: "uint16"}, ReadPrivilege: "view"},
+		},
+	},
+	CLUSTER_ID_OccupancySensing: {
+		Attributes: map[int]AttributeInfo{
+			0x0000: {Field: Field{Id: 0x0000, Name: "Occupancy", Type: "OccupancyBitmap"}, ReadPrivilege: "view"},
+			0x0001: {Field: Field{Id: 0x0001, Name: "OccupancySensorType", Type: "OccupancySensorTypeEnum"}, ReadPrivilege: "view"},
+			0x0002: {Field: Field{Id: 0x0002, Name: "OccupancySensorTypeBitmap", Type: "OccupancySensorTypeBitmap"}, ReadPrivilege: "view"},
+			0x0010: {Field: Field{Id: 0x0010, Name: "PIROccupiedToUnoccupiedDelay", Type: "uint16"}, ReadPrivilege: "view", WritePrivilege: "manage", Writable: true},
+			0x0011: {Field: Field{Id: 0x0011, Name: "PIRUnoccupiedToOccupiedDelay", Type: "uint16"}, ReadPrivilege: "view", WritePrivilege: "manage", Writable: true},
+			0x0012: {Field: Field{Id: 0x0012, Name: "PIRUnoccupiedToOccupiedThreshold", Type: "uint8"}, ReadPrivilege: "view", WritePrivilege: "manage", Writable: true},
+			0x0020: {Field: Field{Id: 0x0020, Name: "UltrasonicOccupiedToUnoccupiedDelay", Type: "uint16"}, ReadPrivilege: "view", WritePrivilege: "manage", Writable: true},
+			0x0021: {Field: Field{Id: 0x0021, Name: "UltrasonicUnoccupiedToOccupiedDelay", Type: "uint16"}, ReadPrivilege: "view", WritePrivilege: "manage", Writable: true},
+			0x0022: {Field: Field{Id: 0x0022, Name: "UltrasonicUnoccupiedToOccupiedThreshold", Type: "uint8"}, ReadPrivilege: "view", WritePrivilege: "manage", Writable: true},
+			0x0030: {Field: Field{Id: 0x0030, Name: "PhysicalContactOccupiedToUnoccupiedDelay", Type: "uint16"}, ReadPrivilege: "view", WritePrivilege: "manage", Writable: true},
+			0x0031: {Field: Field{Id: 0x0031, Name: "PhysicalContactUnoccupiedToOccupiedDelay", Type: "uint16"}, ReadPrivilege: "view", WritePrivilege: "manage", Writable: true},
+			0x0032: {Field: Field{Id: 0x0032, Name: "PhysicalContactUnoccupiedToOccupiedThreshold", Type: "uint8"}, ReadPrivilege: "view", WritePrivilege: "manage", Writable: true},
+		},
+		Enums: map[string]EnumInfo{
+			"OccupancySensorTypeEnum": {0: "PIR", 1: "Ultrasonic", 2: "PIRAndUltrasonic", 3: "PhysicalContact"},
+		},
+		Bitmaps: map[string]BitmapInfo{
+			"OccupancyBitmap":           {0: "Occupied"},
+			"OccupancySensorTypeBitmap": {0: "PIR", 1: "Ultrasonic", 2: "PhysicalContact"},
+		},
+	},
+	CLUSTER_ID_WakeonLAN: {
+		Attributes: map[int]AttributeInfo{
+			0x0000: {Field: Field{Id: 0x0000, Name: "MACAddress", Type: "string"}, ReadPrivilege: "view"},
+			0x0001: {Field: Field{Id: 0x0001, Name: "LinkLocalAddress", Type: "ipv6adr"}, ReadPrivilege: "view"},
+		},
+	},
+	CLUSTER_ID_Channel: {
+		Attributes: map[int]AttributeInfo{
+			0x0000: {Field: Field{Id: 0x0000, Name: "ChannelList", Type: "ChannelInfoStruct", List: true}, ReadPrivilege: "view"},
+			0x0001: {Field: Field{Id: 0x0001, Name: "Lineup", Type: "LineupInfoStruct", Nullable: true}, ReadPrivilege: "view"},
+			0x0002: {Field: Field{Id: 0x0002, Name: "CurrentChannel", Type: "ChannelInfoStruct", Nullable: true}, ReadPrivilege: "view"},
+		},
+		Commands: map[int]CommandInfo{
+			0x00: {Id: 0x00, Name: "ChangeChannel", Response: 0x01, Fields: []Field{
+				{Id: 0, Name: "Match", Type: "string"},
+			}, InvokePrivilege: "operate"},
+			0x02: {Id: 0x02, Name: "ChangeChannelByNumber", Response: ResponseStatus, Fields: []Field{
+				{Id: 0, Name: "MajorNumber", Type: "uint16"},
+				{Id: 1, Name: "MinorNumber", Type: "uint16"},
+			}, InvokePrivilege: "operate"},
+			0x03: {Id: 0x03, Name: "SkipChannel", Response: ResponseStatus, Fields: []Field{
+				{Id: 0, Name: "Count", Type: "int16"},
+			}, InvokePrivilege: "operate"},
+		},
+		Responses: map[int]CommandInfo{
+			0x01: {Id: 0x01, Name: "ChangeChannelResponse", Response: ResponseNone, Fields: []Field{
+				{Id: 0, Name: "Status", Type: "StatusEnum"},
+				{Id: 1, Name: "Data", Type: "string"},
+			}},
+		},
+		Enums: map[string]EnumInfo{
+			"LineupInfoTypeEnum": {0: "MSO"},
+			"StatusEnum":         {0: "Success", 1: "MultipleMatches", 2: "NoMatches"},
+		},
+		Structs: map[string]StructInfo{
+			"ChannelInfoStruct": {Fields: []Field{
+				{Id: 0, Name: "MajorNumber", Type: "uint16"},
+				{Id: 1, Name: "MinorNumber", Type: "uint16"},
+				{Id: 2, Name: "Name", Type: "string"},
+				{Id: 3, Name: "CallSign", Type: "string"},
+				{Id: 4, Name: "AffiliateCallSign", Type: "string"},
+			}},
+			"LineupInfoStruct": {Fields: []Field{
+				{Id: 0, Name: "OperatorName", Type: "string"},
+				{Id: 1, Name: "LineupName", Type: "string"},
+				{Id: 2, Name: "PostalCode", Type: "string"},
+				{Id: 3, Name: "LineupInfoType", Type: "LineupInfoTypeEnum"},
+			}},
+		},
+	},
+	CLUSTER_ID_TargetNavigator: {
+		Attributes: map[int]AttributeInfo{
+			0x0000: {Field: Field{Id: 0x0000, Name: "TargetList", Type: "TargetInfoStruct", List: true}, ReadPrivilege: "view"},
+			0x0001: {Field: Field{Id: 0x0001, Name: "CurrentTarget", Type: "uint8"}, ReadPrivilege: "view"},
+		},
+		Commands: map[int]CommandInfo{
+			0x00: {Id: 0x00, Name: "NavigateTarget", Response: 0x01, Fields: []Field{
+				{Id: 0, Name: "Target", Type: "uint8"},
+				{Id: 1, Name: "Data", Type: "string"},
+			}, InvokePrivilege: "operate"},
+		},
+		Responses: map[int]CommandInfo{
+			0x01: {Id: 0x01, Name: "NavigateTargetResponse", Response: ResponseNone, Fields: []Field{
+				{Id: 0, Name: "Status", Type: "StatusEnum"},
+				{Id: 1, Name: "Data", Type: "string"},
+			}},
+		},
+		Enums: map[string]EnumInfo{
+			"StatusEnum": {0: "Success", 1: "TargetNotFound", 2: "NotAllowed"},
+		},
+		Structs: map[string]StructInfo{
+			"TargetInfoStruct": {Fields: []Field{
+				{Id: 0, Name: "Identifier", Type: "uint8"},
+				{Id: 1, Name: "Name", Type: "string"},
+			}},
+		},
+	},
+	CLUSTER_ID_MediaPlayback: {
+		Attributes: map[int]AttributeInfo{
+			0x0000: {Field: Field{Id: 0x0000, Name: "CurrentState", Type: "PlaybackStateEnum"}, ReadPrivilege: "view"},
+			0x0001: {Field: Field{Id: 0x0001, Name: "StartTime", Type: "epoch-us", Nullable: true}, ReadPrivilege: "view"},
+			0x0002: {Field: Field{Id: 0x0002, Name: "Duration", Type: "uint64", Nullable: true}, ReadPrivilege: "view"},
+			0x0003: {Field: Field{Id: 0x0003, Name: "SampledPosition", Type: "PlaybackPositionStruct", Nullable: true}, ReadPrivilege: "view"},
+			0x0004: {Field: Field{Id: 0x0004, Name: "PlaybackSpeed", Type: "single"}, ReadPrivilege: "view"},
+			0x0005: {Field: Field{Id: 0x0005, Name: "SeekRangeEnd", Type: "uint64", Nullable: true}, ReadPrivilege: "view"},
+			0x0006: {Field: Field{Id: 0x0006, Name: "SeekRangeStart", Type: "uint64", Nullable: true}, ReadPrivilege: "view"},
+		},
+		Commands: map[int]CommandInfo{
+			0x00: {Id: 0x00, Name: "Play", Response: 0x0a, InvokePrivilege: "operate"},
+			0x01: {Id: 0x01, Name: "Pause", Response: 0x0a, InvokePrivilege: "operate"},
+			0x02: {Id: 0x02, Name: "Stop", Response: 0x0a, InvokePrivilege: "operate"},
+			0x03: {Id: 0x03, Name: "StartOver", Response: 0x0a, InvokePrivilege: "operate"},
+			0x04: {Id: 0x04, Name: "Previous", Response: 0x0a, InvokePrivilege: "operate"},
+			0x05: {Id: 0x05, Name: "Next", Response: 0x0a, InvokePrivilege: "operate"},
+			0x06: {Id: 0x06, Name: "Rewind", Response: 0x0a, InvokePrivilege: "operate"},
+			0x07: {Id: 0x07, Name: "FastForward", Response: 0x0a, InvokePrivilege: "operate"},
+			0x08: {Id: 0x08, Name: "SkipForward", Response: 0x0a, Fields: []Field{
+				{Id: 0, Name: "DeltaPositionMilliseconds", Type: "uint64"},
+			}, InvokePrivilege: "operate"},
+			0x09: {Id: 0x09, Name: "SkipBackward", Response: 0x0a, Fields: []Field{
+				{Id: 0, Name: "DeltaPositionMilliseconds", Type: "uint64"},
+			}, InvokePrivilege: "operate"},
+			0x0b: {Id: 0x0b, Name: "Seek", Response: 0x0a, Fields: []Field{
+				{Id: 0, Name: "Position", Type: "uint64"},
+			}, InvokePrivilege: "operate"},
+		},
+		Responses: map[int]CommandInfo{
+			0x0a: {Id: 0x0a, Name: "PlaybackResponse", Response: ResponseNone, Fields: []Field{
+				{Id: 0, Name: "Status", Type: "StatusEnum"},
+				{Id: 1, Name: "Data", Type: "string"},
+			}},
+		},
+		Enums: map[string]EnumInfo{
+			"PlaybackStateEnum": {0: "Playing", 1: "Paused", 2: "NotPlaying", 3: "Buffering"},
+			"StatusEnum":        {0: "Success", 1: "InvalidStateForCommand", 2: "NotAllowed", 3: "NotActive", 4: "SpeedOutOfRange", 5: "SeekOutOfRange"},
+		},
+		Structs: map[string]StructInfo{
+			"PlaybackPositionStruct": {Fields: []Field{
+				{Id: 0, Name: "UpdatedAt", Type: "epoch-us"},
+				{Id: 1, Name: "Position", Type: "uint64", Nullable: true},
+			}},
+		},
+	},
+	CLUSTER_ID_MediaInput: {
+		Attributes: map[int]AttributeInfo{
+			0x0000: {Field: Field{Id: 0x0000, Name: "InputList", Type: "InputInfoStruct", List: true}, ReadPrivilege: "view"},
+			0x0001: {Field: Field{Id: 0x0001, Name: "CurrentInput", Type: "uint8"}, ReadPrivilege: "view"},
+		},
+		Commands: map[int]CommandInfo{
+			0x00: {Id: 0x00, Name: "SelectInput", Response: ResponseStatus, Fields: []Field{
+				{Id: 0, Name: "Index", Type: "uint8"},
+			}, InvokePrivilege: "operate"},
+			0x01: {Id: 0x01, Name: "ShowInputStatus", Response: ResponseStatus, InvokePrivilege: "operate"},
+			0x02: {Id: 0x02, Name: "HideInputStatus", Response: ResponseStatus, InvokePrivilege: "operate"},
+			0x03: {Id: 0x03, Name: "RenameInput", Response: ResponseStatus, Fields: []Field{
+				{Id: 0, Name: "Index", Type: "uint8"},
+				{Id: 1, Name: "Name", Type: "string"},
+			}, InvokePrivilege: "manage"},
+		},
+		Enums: map[string]EnumInfo{
+			"InputTypeEnum": {0: "Internal", 1: "Aux", 2: "Coax", 3: "Composite", 4: "HDMI", 5: "Input", 6: "Line", 7: "Optical", 8: "Video", 9: "SCART", 10: "USB", 11: "Other"},
+		},
+		Structs: map[string]StructInfo{
+			"InputInfoStruct": {Fields: []Field{
+				{Id: 0, Name: "Index", Type: "uint8"},
+				{Id: 1, Name: "InputType", Type: "InputTypeEnum"},
+				{Id: 2, Name: "Name", Type: "string"},
+				{Id: 3, Name: "Description", Type: "string"},
+			}},
+		},
+	},
+	CLUSTER_ID_LowPower: {
+		Commands: map[int]CommandInfo{
+			0x00: {Id: 0x00, Name: "Sleep", Response: ResponseStatus, InvokePrivilege: "operate"},
+		},
+	},
+	CLUSTER_ID_KeypadInput: {
+		Commands: map[int]CommandInfo{
+			0x00: {Id: 0x00, Name: "SendKey", Response: 0x01, Fields: []Field{
+				{Id: 0, Name: "KeyCode", Type: "CECKeyCodeEnum"},
+			}, InvokePrivilege: "operate"},
+		},
+		Responses: map[int]CommandInfo{
+			0x01: {Id: 0x01, Name: "SendKeyResponse", Response: ResponseNone, Fields: []Field{
+				{Id: 0, Name: "Status", Type: "StatusEnum"},
+			}},
+		},
+		Enums: map[string]EnumInfo{
+			"CECKeyCodeEnum": {0: "Select", 1: "Up", 2: "Down", 3: "Left", 4: "Right", 5: "RightUp", 6: "RightDown", 7: "LeftUp", 8: "LeftDown", 9: "RootMenu", 10: "SetupMenu", 11: "ContentsMenu", 12: "FavoriteMenu", 13: "Exit", 16: "MediaTopMenu", 17: "MediaContextSensitiveMenu", 29: "NumberEntryMode", 30: "Number11", 31: "Number12", 32: "Number0OrNumber10", 33: "Numbers1", 34: "Numbers2", 35: "Numbers3", 36: "Numbers4", 37: "Numbers5", 38: "Numbers6", 39: "Numbers7", 40: "Numbers8", 41: "Numbers9", 42: "Dot", 43: "Enter", 44: "Clear", 47: "NextFavorite", 48: "ChannelUp", 49: "ChannelDown", 50: "PreviousChannel", 51: "SoundSelect", 52: "InputSelect", 53: "DisplayInformation", 54: "Help", 55: "PageUp", 56: "PageDown", 64: "Power", 65: "VolumeUp", 66: "VolumeDown", 67: "Mute", 68: "Play", 69: "Stop", 70: "Pause", 71: "Record", 72: "Rewind", 73: "FastForward", 74: "Eject", 75: "Forward", 76: "Backward", 77: "StopRecord", 78: "PauseRecord", 80: "Angle", 81: "SubPicture", 82: "VideoOnDemand", 83: "ElectronicProgramGuide", 84: "TimerProgramming", 85: "InitialConfiguration", 86: "SelectBroadcastType", 87: "SelectSoundPresentation", 96: "PlayFunction", 97: "PausePlayFunction", 98: "RecordFunction", 99: "PauseRecordFunction", 100: "StopFunction", 101: "MuteFunction", 102: "RestoreVolumeFunction", 103: "TuneFunction", 104: "SelectMediaFunction", 105: "SelectAvInputFunction", 106: "SelectAudioInputFunction", 107: "PowerToggleFunction", 108: "PowerOffFunction", 109: "PowerOnFunction", 113: "F1Blue", 114: "F2Red", 115: "F3Green", 116: "F4Yellow", 117: "F5", 118: "Data"},
+			"StatusEnum":     {0: "Success", 1: "UnsupportedKey", 2: "InvalidKeyInCurrentState"},
+		},
+	},
+	CLUSTER_ID_ContentLauncher: {
+		Attributes: map[int]AttributeInfo{
+			0x0000: {Field: Field{Id: 0x0000, Name: "AcceptHeader", Type: "string", List: true}, ReadPrivilege: "view"},
+			0x0001: {Field: Field{Id: 0x0001, Name: "SupportedStreamingProtocols", Type: "SupportedProtocolsBitmap"}, ReadPrivilege: "view", WritePrivilege: "operate", Writable: true},
+		},
+		Commands: map[int]CommandInfo{
+			0x00: {Id: 0x00, Name: "LaunchContent", Response: 0x02, Fields: []Field{
+				{Id: 0, Name: "Search", Type: "ContentSearchStruct"},
+				{Id: 1, Name: "AutoPlay", Type: "bool"},
+				{Id: 2, Name: "Data", Type: "string"},
+			}, InvokePrivilege: "operate"},
+			0x01: {Id: 0x01, Name: "LaunchURL", Response: 0x02, Fields: []Field{
+				{Id: 0, Name: "ContentURL", Type: "string"},
+				{Id: 1, Name: "DisplayString", Type: "string"},
+				{Id: 2, Name: "BrandingInformation", Type: "BrandingInformationStruct"},
+			}, InvokePrivilege: "operate"},
+		},
+		Responses: map[int]CommandInfo{
+			0x02: {Id: 0x02, Name: "LauncherResponse", Response: ResponseNone, Fields: []Field{
+				{Id: 0, Name: "Status", Type: "StatusEnum"},
+				{Id: 1, Name: "Data", Type: "string"},
+			}},
+		},
+		Enums: map[string]EnumInfo{
+			"StatusEnum":     {0: "Success", 1: "URLNotAvailable", 2: "AuthFailed"},
+			"MetricTypeEnum": {0: "Pixels", 1: "Percentage"},
+			"ParameterEnum":  {0: "Actor", 1: "Channel", 2: "Character", 3: "Director", 4: "Event", 5: "Franchise", 6: "Genre", 7: "League", 8: "Popularity", 9: "Provider", 10: "Sport", 11: "SportsTeam", 12: "Type", 13: "Video"},
+		},
+		Bitmaps: map[string]BitmapInfo{
+			"SupportedProtocolsBitmap": {0: "DASH", 1: "HLS"},
+		},
+		Structs: map[string]StructInfo{
+			"DimensionStruct": {Fields: []Field{
+				{Id: 0, Name: "Width", Type: "double"},
+				{Id: 1, Name: "Height", Type: "double"},
+				{Id: 2, Name: "Metric", Type: "MetricTypeEnum"},
+			}},
+			"AdditionalInfoStruct": {Fields: []Field{
+				{Id: 0, Name: "Name", Type: "string"},
+				{Id: 1, Name: "Value", Type: "string"},
+			}},
+			"ParameterStruct": {Fields: []Field{
+				{Id: 0, Name: "Type", Type: "ParameterEnum"},
+				{Id: 1, Name: "Value", Type: "string"},
+				{Id: 2, Name: "ExternalIDList", Type: "AdditionalInfoStruct", List: true},
+			}},
+			"ContentSearchStruct": {Fields: []Field{
+				{Id: 0, Name: "ParameterList", Type: "ParameterStruct", List: true},
+			}},
+			"StyleInformationStruct": {Fields: []Field{
+				{Id: 0, Name: "ImageURL", Type: "string"},
+				{Id: 1, Name: "Color", Type: "string"},
+				{Id: 2, Name: "Size", Type: "DimensionStruct"},
+			}},
+			"BrandingInformationStruct": {Fields: []Field{
+				{Id: 0, Name: "ProviderName", Type: "string"},
+				{Id: 1, Name: "Background", Type: "StyleInformationStruct"},
+				{Id: 2, Name: "Logo", Type: "StyleInformationStruct"},
+				{Id: 3, Name: "ProgressBar", Type: "StyleInformationStruct"},
+				{Id: 4, Name: "Splash", Type: "StyleInformationStruct"},
+				{Id: 5, Name: "WaterMark", Type: "StyleInformationStruct"},
+			}},
+		},
+	},
+	CLUSTER_ID_AudioOutput: {
+		Attributes: map[int]AttributeInfo{
+			0x0000: {Field: Field{Id: 0x0000, Name: "OutputList", Type: "OutputInfoStruct", List: true}, ReadPrivilege: "view"},
+			0x0001: {Field: Field{Id: 0x0001, Name: "CurrentOutput", Type: "uint8"}, ReadPrivilege: "view"},
+		},
+		Commands: map[int]CommandInfo{
+			0x00: {Id: 0x00, Name: "SelectOutput", Response: ResponseStatus, Fields: []Field{
+				{Id: 0, Name: "Index", Type: "uint8"},
+			}, InvokePrivilege: "operate"},
+			0x01: {Id: 0x01, Name: "RenameOutput", Response: ResponseStatus, Fields: []Field{
+				{Id: 0, Name: "Index", Type: "uint8"},
+				{Id: 1, Name: "Name", Type: "string"},
+			}, InvokePrivilege: "manage"},
+		},
+		Enums: map[string]EnumInfo{
+			"OutputTypeEnum": {0: "HDMI", 1: "BT", 2: "Optical", 3: "Headphone", 4: "Internal", 5: "Other"},
+		},
+		Structs: map[string]StructInfo{
+			"OutputInfoStruct": {Fields: []Field{
+				{Id: 0, Name: "Index", Type: "uint8"},
+				{Id: 1, Name: "OutputType", Type: "OutputTypeEnum"},
+				{Id: 2, Name: "Name", Type: "string"},
+			}},
+		},
+	},
+	CLUSTER_ID_ApplicationLauncher: {
+		Attributes: map[int]AttributeInfo{
+			0x0000: {Field: Field{Id: 0x0000, Name: "CatalogList", Type: "uint16", List: true}, ReadPrivilege: "view"},
+			0x0001: {Field: Field{Id: 0x0001, Name: "CurrentApp", Type: "ApplicationEPStruct", Nullable: true}, ReadPrivilege: "view"},
+		},
+		Commands: map[int]CommandInfo{
+			0x00: {Id: 0x00, Name: "LaunchApp", Response: 0x03, Fields: []Field{
+				{Id: 0, Name: "Application", Type: "ApplicationStruct"},
+				{Id: 1, Name: "Data", Type: "octstr"},
+			}, InvokePrivilege: "operate"},
+			0x01: {Id: 0x01, Name: "StopApp", Response: 0x03, Fields: []Field{
+				{Id: 0, Name: "Application", Type: "ApplicationStruct"},
+			}, InvokePrivilege: "operate"},
+			0x02: {Id: 0x02, Name: "HideApp", Response: 0x03, Fields: []Field{
+				{Id: 0, Name: "Application", Type: "ApplicationStruct"},
+			}, InvokePrivilege: "operate"},
+		},
+		Responses: map[int]CommandInfo{
+			0x03: {Id: 0x03, Name: "LauncherResponse", Response: ResponseNone, Fields: []Field{
+				{Id: 0, Name: "Status", Type: "StatusEnum"},
+				{Id: 1, Name: "Data", Type: "octstr"},
+			}},
+		},
+		Enums: map[string]EnumInfo{
+			"StatusEnum": {0: "Success", 1: "AppNotAvailable", 2: "SystemBusy"},
+		},
+		Structs: map[string]StructInfo{
+			"ApplicationStruct": {Fields: []Field{
+				{Id: 0, Name: "CatalogVendorID", Type: "uint16"},
+				{Id: 1, Name: "ApplicationID", Type: "string"},
+			}},
+			"ApplicationEPStruct": {Fields: []Field{
+				{Id: 0, Name: "Application", Type: "ApplicationStruct"},
+				{Id: 1, Name: "Endpoint", Type: "endpoint-no"},
+			}},
+		},
+	},
+	CLUSTER_ID_ApplicationBasic: {
+		Attributes: map[int]AttributeInfo{
+			0x0000: {Field: Field{Id: 0x0000, Name: "VendorName", Type: "string"}, ReadPrivilege: "view"},
+			0x0001: {Field: Field{Id: 0x0001, Name: "VendorID", Type: "vendor-id"}, ReadPrivilege: "view"},
+			0x0002: {Field: Field{Id: 0x0002, Name: "ApplicationName", Type: "string"}, ReadPrivilege: "view"},
+			0x0003: {Field: Field{Id: 0x0003, Name: "ProductID", Type: "uint16"}, ReadPrivilege: "view"},
+			0x0004: {Field: Field{Id: 0x0004, Name: "Application", Type: "ApplicationStruct"}, ReadPrivilege: "view"},
+			0x0005: {Field: Field{Id: 0x0005, Name: "Status", Type: "ApplicationStatusEnum"}, ReadPrivilege: "view"},
+			0x0006: {Field: Field{Id: 0x0006, Name: "ApplicationVersion", Type: "string"}, ReadPrivilege: "view"},
+			0x0007: {Field: Field{Id: 0x0007, Name: "AllowedVendorList", Type: "vendor-id", List: true}, ReadPrivilege: "administer"},
+		},
+		Enums: map[string]EnumInfo{
+			"ApplicationStatusEnum": {0: "Stopped", 1: "ActiveVisibleFocus", 2: "ActiveHidden", 3: "ActiveVisibleNotFocus"},
+		},
+		Structs: map[string]StructInfo{
+			"ApplicationStruct": {Fields: []Field{
+				{Id: 0, Name: "CatalogVendorID", Type: "uint16"},
+				{Id: 1, Name: "ApplicationID", Type: "string"},
+			}},
+		},
+	},
+	CLUSTER_ID_AccountLogin: {
+		Commands: map[int]CommandInfo{
+			0x00: {Id: 0x00, Name: "GetSetupPIN", Response: 0x01, Fields: []Field{
+				{Id: 0, Name: "TempAccountIdentifier", Type: "string"},
+			}, InvokePrivilege: "administer", Timed: true},
+			0x02: {Id: 0x02, Name: "Login", Response: ResponseStatus, Fields: []Field{
+				{Id: 0, Name: "TempAccountIdentifier", Type: "string"},
+				{Id: 1, Name: "SetupPIN", Type: "string"},
+			}, InvokePrivilege: "administer", Timed: true},
+			0x03: {Id: 0x03, Name: "Logout", Response: ResponseStatus, InvokePrivilege: "operate", Timed: true},
+		},
+		Responses: map[int]CommandInfo{
+			0x01: {Id: 0x01, Name: "GetSetupPINResponse", Response: ResponseNone, Fields: []Field{
+				{Id: 0, Name: "SetupPIN", Type: "string"},
+			}},
+		},
+		Events: map[int]EventInfo{
+			0x00: {Name: "LoggedOut", Fields: []Field{
+				{Id: 0, Name: "Node", Type: "node-id"},
+			}, Priority: "critical"},
+		},
+	},
+	CLUSTER_ID_MicrowaveOvenControl: {
+		Attributes: map[int]AttributeInfo{
+			0x0000: {Field: Field{Id: 0x0000, Name: "CookTime", Type: "elapsed-s"}, ReadPrivilege: "view"},
+			0x0001: {Field: Field{Id: 0x0001, Name: "MaxCookTime", Type: "elapsed-s"}, ReadPrivilege: "view"},
+			0x0002: {Field: Field{Id: 0x0002, Name: "PowerSetting", Type: "uint8"}, ReadPrivilege: "view"},
+			0x0003: {Field: Field{Id: 0x0003, Name: "MinPower", Type: "uint8"}, ReadPrivilege: "view"},
+			0x0004: {Field: Field{Id: 0x0004, Name: "MaxPower", Type: "uint8"}, ReadPrivilege: "view"},
+			0x0005: {Field: Field{Id: 0x0005, Name: "PowerStep", Type: "uint8"}, ReadPrivilege: "view"},
+			0x0006: {Field: Field{Id: 0x0006, Name: "SupportedWatts", Type: "uint16", List: true}, ReadPrivilege: "view"},
+			0x0007: {Field: Field{Id: 0x0007, Name: "SelectedWattIndex", Type: "uint8"}, ReadPrivilege: "view"},
+			0x0008: {Field: Field{Id: 0x0008, Name: "WattRating", Type: "uint16"}, ReadPrivilege: "view"},
+		},
+		Commands: map[int]CommandInfo{
+			0x00: {Id: 0x00, Name: "SetCookingParameters", Response: ResponseStatus, Fields: []Field{
+				{Id: 0, Name: "CookMode", Type: "uint8"},
+				{Id: 1, Name: "CookTime", Type: "elapsed-s"},
+				{Id: 2, Name: "PowerSetting", Type: "uint8"},
+				{Id: 3, Name: "WattSettingIndex", Type: "uint8"},
+				{Id: 4, Name: "StartAfterSetting", Type: "bool"},
+			}, InvokePrivilege: "operate"},
+			0x01: {Id: 0x01, Name: "AddMoreTime", Response: ResponseStatus, Fields: []Field{
+				{Id: 0, Name: "TimeToAdd", Type: "elapsed-s"},
+			}, InvokePrivilege: "operate"},
+		},
+	},
+	CLUSTER_ID_ContentAppObserver: {
+		Commands: map[int]CommandInfo{
+			0x00: {Id: 0x00, Name: "ContentAppMessage", Response: 0x01, Fields: []Field{
+				{Id: 0, Name: "Data", Type: "string"},
+				{Id: 1, Name: "EncodingHint", Type: "string"},
+			}, InvokePrivilege: "operate"},
+		},
+		Responses: map[int]CommandInfo{
+			0x01: {Id: 0x01, Name: "ContentAppMessageResponse", Response: ResponseNone, Fields: []Field{
+				{Id: 0, Name: "Status", Type: "StatusEnum"},
+				{Id: 1, Name: "Data", Type: "string"},
+				{Id: 2, Name: "EncodingHint", Type: "string"},
+			}},
+		},
+		Enums: map[string]EnumInfo{
+			"StatusEnum": {0: "Success", 1: "UnexpectedData"},
+		},
+	},
 }
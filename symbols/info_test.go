@@ -0,0 +1,82 @@
+package symbols
+
+import (
+	"testing"
+	"unicode"
+)
+
+// checkField reports field without type or with type which is neither data model type nor type of cluster.
+func checkField(t *testing.T, cluster string, where string, f Field, types *ClusterTypes) {
+	t.Helper()
+	if f.Type == "" {
+		t.Errorf("%s %s.%s: missing type", cluster, where, f.Name)
+		return
+	}
+	if !unicode.IsUpper([]rune(f.Type)[0]) {
+		return
+	}
+	_, enum := types.Enums[f.Type]
+	_, bitmap := types.Bitmaps[f.Type]
+	_, st := types.Structs[f.Type]
+	if !enum && !bitmap && !st {
+		t.Errorf("%s %s.%s: type %s is not defined by cluster", cluster, where, f.Name, f.Type)
+	}
+}
+
+func TestClusterTypes(t *testing.T) {
+	for id, name := range ClusterNameMap {
+		types := ClusterTypesMap[id]
+		if types == nil {
+			t.Errorf("%s: missing types", name)
+			continue
+		}
+		for aid, aname := range AttributeNameMap[id] {
+			attr, ok := types.Attributes[aid]
+			if !ok {
+				t.Errorf("%s: missing type of attribute %s", name, aname)
+				continue
+			}
+			checkField(t, name, "attribute", attr.Field, types)
+		}
+		for cid, cname := range CommandNameMap[id] {
+			cmd, ok := types.Commands[cid]
+			if !ok {
+				t.Errorf("%s: missing fields of command %s", name, cname)
+				continue
+			}
+			if cmd.Response >= 0 {
+				if _, ok := types.Responses[cmd.Response]; !ok {
+					t.Errorf("%s: missing response 0x%x of command %s", name, cmd.Response, cname)
+				}
+			}
+			for _, f := range cmd.Fields {
+				checkField(t, name, cname, f, types)
+			}
+		}
+		for rid, rname := range ResponseNameMap[id] {
+			rsp, ok := types.Responses[rid]
+			if !ok {
+				t.Errorf("%s: missing fields of response %s", name, rname)
+				continue
+			}
+			for _, f := range rsp.Fields {
+				checkField(t, name, rname, f, types)
+			}
+		}
+		for eid, ename := range EventNameMap[id] {
+			ev, ok := types.Events[eid]
+			if !ok {
+				t.Errorf("%s: missing fields of event %s", name, ename)
+				continue
+			}
+			for _, f := range ev.Fields {
+				checkField(t, name, ename, f, types)
+			}
+		}
+		for sname, st := range types.Structs {
+			for _, f := range st.Fields {
+				checkField(t, name, sname, f, types)
+			}
+		}
+	}
+}
@@ -0,0 +1,287 @@
+package symbols
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/finnigja/gomat/symbols/datamodel"
+)
+
+// Clusters which are not part of generated info.go (for example vendor specific clusters) can be registered
+// when program starts. Registered clusters are added into name and id maps (ClusterNameMap, ClusterIdMap,
+// AttributeNameMap, ...) and ClusterTypesMap, so they are known to all users of these maps.
+// Maps are not synchronized - register clusters before maps are used by other goroutines.
+
+func field(in datamodel.FieldInfo) Field {
+	return Field{Id: in.Id, Name: in.Name, Type: in.Type, List: in.List, Nullable: in.Nullable}
+}
+
+func fields(in []datamodel.FieldInfo) []Field {
+	if len(in) == 0 {
+		return nil
+	}
+	out := make([]Field, len(in))
+	for n := range in {
+		out[n] = field(in[n])
+	}
+	return out
+}
+
+// responseId returns value of CommandInfo.Response of command.
+func responseId(cluster datamodel.ClusterInfo, command datamodel.CommandInfo) int {
+	switch command.Response {
+	case "", "Y":
+		return ResponseStatus
+	case "N":
+		return ResponseNone
+	}
+	for _, response := range cluster.Commands {
+		if response.Name == command.Response && datamodel.IsResponse(response) {
+			return response.Id
+		}
+	}
+	return ResponseStatus
+}
+
+// clusterTypes converts data types of cluster. Same rules as in generator of ClusterTypesMap are used -
+// first element with given id wins and commands without direction are left out.
+func clusterTypes(cluster datamodel.ClusterInfo) *ClusterTypes {
+	out := &ClusterTypes{
+		Attributes: map[int]AttributeInfo{},
+		Commands:   map[int]CommandInfo{},
+		Responses:  map[int]CommandInfo{},
+		Events:     map[int]EventInfo{},
+		Enums:      map[string]EnumInfo{},
+		Bitmaps:    map[string]BitmapInfo{},
+		Structs:    map[string]StructInfo{},
+	}
+	for _, a := range cluster.Attributes {
+		if _, ok := out.Attributes[a.Id]; ok || a.Type == "" {
+			continue
+		}
+		out.Attributes[a.Id] = AttributeInfo{
+			Field:           field(a.FieldInfo),
+			ReadPrivilege:   a.ReadPrivilege,
+			WritePrivilege:  a.WritePrivilege,
+			Writable:        a.Writable,
+			FabricScoped:    a.FabricScoped,
+			FabricSensitive: a.FabricSensitive,
+			Timed:           a.Timed,
+		}
+	}
+	for _, c := range cluster.Commands {
+		if c.Direction == "" {
+			continue
+		}
+		command := CommandInfo{
+			Id:              c.Id,
+			Name:            c.Name,
+			Fields:          fields(c.Fields),
+			Response:        ResponseNone,
+			InvokePrivilege: c.InvokePrivilege,
+			Timed:           c.Timed,
+			FabricScoped:    c.FabricScoped,
+		}
+		target := out.Responses
+		if !datamodel.IsResponse(c) {
+			target = out.Commands
+			command.Response = responseId(cluster, c)
+		}
+		if _, ok := target[c.Id]; !ok {
+			target[c.Id] = command
+		}
+	}
+	for _, e := range cluster.Events {
+		out.Events[e.Id] = EventInfo{Name: e.Name, Fields: fields(e.Fields), Priority: e.Priority, FabricSensitive: e.FabricSensitive}
+	}
+	for _, e := range cluster.Enums {
+		enum := EnumInfo{}
+		for _, item := range e.Items {
+			if _, ok := enum[item.Value]; !ok {
+				enum[item.Value] = item.Name
+			}
+		}
+		out.Enums[e.Name] = enum
+	}
+	for _, b := range cluster.Bitmaps {
+		bitmap := BitmapInfo{}
+		for _, bit := range b.Bits {
+			if _, ok := bitmap[bit.Bit]; !ok {
+				bitmap[bit.Bit] = bit.Name
+			}
+		}
+		out.Bitmaps[b.Name] = bitmap
+	}
+	for _, s := range cluster.Structs {
+		out.Structs[s.Name] = StructInfo{Fields: fields(s.Fields), FabricScoped: s.FabricScoped}
+	}
+	return out
+}
+
+// nameMap returns map of names by id, first name of id wins.
+func nameMap(ids []int, names []string) map[int]string {
+	out := map[int]string{}
+	for n, id := range ids {
+		if _, ok := out[id]; !ok {
+			out[id] = names[n]
+		}
+	}
+	return out
+}
+
+// idMap returns map of ids by name, first id of name wins.
+func idMap(ids []int, names []string) map[string]int {
+	out := map[string]int{}
+	for n, name := range names {
+		if _, ok := out[name]; !ok {
+			out[name] = ids[n]
+		}
+	}
+	return out
+}
+
+// Register adds cluster into symbol maps. Previous definition of cluster with same id is replaced.
+func Register(cluster datamodel.ClusterInfo) {
+	if previous, ok := ClusterNameMap[cluster.Id]; ok {
+		delete(ClusterIdMap, previous)
+	}
+	ClusterNameMap[cluster.Id] = cluster.Name
+	ClusterIdMap[cluster.Name] = cluster.Id
+
+	ids, names := []int{}, []string{}
+	for _, a := range cluster.Attributes {
+		ids, names = append(ids, a.Id), append(names, a.Name)
+	}
+	AttributeNameMap[cluster.Id] = nameMap(ids, names)
+	AttributeIdMap[cluster.Id] = idMap(ids, names)
+
+	command_ids, command_names := []int{}, []string{}
+	response_ids, response_names := []int{}, []string{}
+	for _, c := range cluster.Commands {
+		if datamodel.IsResponse(c) {
+			response_ids, response_names = append(response_ids, c.Id), append(response_names, c.Name)
+		} else {
+			command_ids, command_names = append(command_ids, c.Id), append(command_names, c.Name)
+		}
+	}
+	CommandNameMap[cluster.Id] = nameMap(command_ids, command_names)
+	ResponseNameMap[cluster.Id] = nameMap(response_ids, response_names)
+	CommandIdMap[cluster.Id] = idMap(command_ids, command_names)
+
+	ids, names = []int{}, []string{}
+	for _, e := range cluster.Events {
+		ids, names = append(ids, e.Id), append(names, e.Name)
+	}
+	EventNameMap[cluster.Id] = nameMap(ids, names)
+	EventIdMap[cluster.Id] = idMap(ids, names)
+
+	ClusterTypesMap[cluster.Id] = clusterTypes(cluster)
+}
+
+// LoadXml registers cluster defined in Matter data model XML format.
+func LoadXml(data []byte) error {
+	cluster, err := datamodel.ParseClusterXml(data)
+	if err != nil {
+		return err
+	}
+	if cluster.Name == "" {
+		return fmt.Errorf("cluster definition without name")
+	}
+	Register(cluster)
+	return nil
+}
+
+// LoadJson registers all clusters of data in format of info.json.
+func LoadJson(data []byte) error {
+	var mi datamodel.MatterInfo
+	err := json.Unmarshal(data, &mi)
+	if err != nil {
+		return err
+	}
+	for _, cluster := range mi.Clusters {
+		Register(cluster)
+	}
+	return nil
+}
+
+// LoadFile registers clusters from .xml or .json file. When path is directory, all such files in it are loaded.
+func LoadFile(path string) error {
+	info, err := os.Stat(path)
+	if err != nil {
+		return err
+	}
+	if info.IsDir() {
+		entries, err := os.ReadDir(path)
+		if err != nil {
+			return err
+		}
+		for _, e := range entries {
+			ext := strings.ToLower(filepath.Ext(e.Name()))
+			if e.IsDir() || (ext != ".xml" && ext != ".json") {
+				continue
+			}
+			err = LoadFile(filepath.Join(path, e.Name()))
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return err
+	}
+	if strings.ToLower(filepath.Ext(path)) == ".json" {
+		err = LoadJson(data)
+	} else {
+		err = LoadXml(data)
+	}
+	if err != nil {
+		return fmt.Errorf("%s: %w", path, err)
+	}
+	return nil
+}
+
+// find returns id of name in ids. Name is matched case insensitively when there is no exact match.
+func find(ids map[string]int, name string) (int, bool) {
+	if id, ok := ids[name]; ok {
+		return id, true
+	}
+	for n, id := range ids {
+		if strings.EqualFold(n, name) {
+			return id, true
+		}
+	}
+	return 0, false
+}
+
+// FindCluster returns id of cluster with given name.
+func FindCluster(name string) (int, bool) {
+	return find(ClusterIdMap, name)
+}
+
+// FindCommand returns id of command of cluster with given name.
+func FindCommand(cluster int, name string) (int, bool) {
+	return find(CommandIdMap[cluster], name)
+}
+
+// FindAttribute returns id of attribute of cluster with given name. Global attributes are found in every cluster.
+func FindAttribute(cluster int, name string) (int, bool) {
+	if id, ok := find(AttributeIdMap[cluster], name); ok {
+		return id, true
+	}
+	for id, f := range GlobalAttributes {
+		if strings.EqualFold(f.Name, name) {
+			return id, true
+		}
+	}
+	return 0, false
+}
+
+// FindEvent returns id of event of cluster with given name.
+func FindEvent(cluster int, name string) (int, bool) {
+	return find(EventIdMap[cluster], name)
+}
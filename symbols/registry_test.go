@@ -0,0 +1,57 @@
+package symbols
+
+import (
+	"testing"
+)
+
+// unregister removes cluster registered by test.
+func unregister(id int) {
+	delete(ClusterIdMap, ClusterNameMap[id])
+	delete(ClusterNameMap, id)
+	delete(AttributeNameMap, id)
+	delete(AttributeIdMap, id)
+	delete(CommandNameMap, id)
+	delete(ResponseNameMap, id)
+	delete(CommandIdMap, id)
+	delete(EventNameMap, id)
+	delete(EventIdMap, id)
+	delete(ClusterTypesMap, id)
+}
+
+func TestLoadJsonWithoutDirection(t *testing.T) {
+	const id = 0xfff1fc01
+	t.Cleanup(func() { unregister(id) })
+	// info.json written before direction of commands was recorded
+	err := LoadJson([]byte(`{"Clusters": {"4294048769": {
+		"Name": "OldVendor", "Id": 4294048769,
+		"Commands": [
+			{"Name": "Ping", "Id": 0},
+			{"Name": "PingResponse", "Id": 0},
+			{"Name": "Reset", "Id": 1}
+		],
+		"Attributes": [{"Name": "Counter", "Id": 0, "Type": "uint16"}]
+	}}}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if cluster, ok := FindCluster("OldVendor"); !ok || cluster != id {
+		t.Fatalf("cluster not registered")
+	}
+	if CommandNameMap[id][0] != "Ping" || CommandNameMap[id][1] != "Reset" {
+		t.Fatalf("unexpected commands %v", CommandNameMap[id])
+	}
+	// response is recognized by its name
+	if ResponseNameMap[id][0] != "PingResponse" || len(ResponseNameMap[id]) != 1 {
+		t.Fatalf("unexpected responses %v", ResponseNameMap[id])
+	}
+	if command, ok := FindCommand(id, "reset"); !ok || command != 1 {
+		t.Fatalf("command not found by name")
+	}
+	if _, ok := FindCommand(id, "PingResponse"); ok {
+		t.Fatalf("response found as command")
+	}
+	if attribute, ok := FindAttribute(id, "Counter"); !ok || attribute != 0 {
+		t.Fatalf("attribute not found by name")
+	}
+}
@@ -0,0 +1,97 @@
+package symbols
+
+// Field describes value of attribute, member of struct or field of command or event.
+type Field struct {
+	Id       int
+	Name     string
+	Type     string // data model type (uint8, octstr, node-id, ...) or name of enum, bitmap or struct of cluster
+	List     bool   // value is list of Type
+	Nullable bool
+}
+
+// AttributeInfo describes attribute, its type and access.
+type AttributeInfo struct {
+	Field
+	ReadPrivilege   string // view, operate, manage or administer
+	WritePrivilege  string
+	Writable        bool
+	FabricScoped    bool
+	FabricSensitive bool
+	Timed           bool // write must be timed interaction
+}
+
+// values of CommandInfo.Response for commands without response command
+const (
+	ResponseStatus = -1 // device responds with status
+	ResponseNone   = -2 // device does not respond
+)
+
+// CommandInfo describes command and its fields.
+type CommandInfo struct {
+	Id              int
+	Name            string
+	Fields          []Field
+	Response        int // id of response command, ResponseStatus or ResponseNone
+	InvokePrivilege string
+	Timed           bool // command must be invoked as timed interaction
+	FabricScoped    bool
+}
+
+// EventInfo describes event and its fields.
+type EventInfo struct {
+	Name            string
+	Fields          []Field
+	Priority        string // debug, info or critical
+	FabricSensitive bool
+}
+
+// EnumInfo maps values of enum to names.
+type EnumInfo map[int]string
+
+// BitmapInfo maps bit numbers of bitmap to names.
+type BitmapInfo map[int]string
+
+// StructInfo lists members of struct.
+type StructInfo struct {
+	Fields       []Field
+	FabricScoped bool
+}
+
+// ClusterTypes describes data types used by cluster.
+type ClusterTypes struct {
+	Attributes map[int]AttributeInfo
+	Commands   map[int]CommandInfo // commands sent to server
+	Responses  map[int]CommandInfo // response commands sent by server
+	Events     map[int]EventInfo
+	Enums      map[string]EnumInfo
+	Bitmaps    map[string]BitmapInfo
+	Structs    map[string]StructInfo
+}
+
+// GlobalAttributes are attributes present in every cluster.
+var GlobalAttributes = map[int]Field{
+	0xfff8: {Id: 0xfff8, Name: "GeneratedCommandList", Type: "command-id", List: true},
+	0xfff9: {Id: 0xfff9, Name: "AcceptedCommandList", Type: "command-id", List: true},
+	0xfffa: {Id: 0xfffa, Name: "EventList", Type: "event-id", List: true},
+	0xfffb: {Id: 0xfffb, Name: "AttributeList", Type: "attrib-id", List: true},
+	0xfffc: {Id: 0xfffc, Name: "FeatureMap", Type: "bitmap32"},
+	0xfffd: {Id: 0xfffd, Name: "ClusterRevision", Type: "uint16"},
+}
+
+// AttributeType returns description of value of attribute or nil when it is not known.
+func AttributeType(cluster int, attribute int) *Field {
+	if f, ok := GlobalAttributes[attribute]; ok {
+		return &f
+	}
+	if types, ok := ClusterTypesMap[cluster]; ok {
+		if a, ok := types.Attributes[attribute]; ok {
+			return &a.Field
+		}
+	}
+	return nil
+}
+
+// EventName returns name of event or empty string when it is not known.
+func EventName(cluster int, event int) string {
+	return EventNameMap[cluster][event]
+}
@@ -0,0 +1,156 @@
+package gomat
+
+import (
+	"context"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"net"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// Transport delivers Matter messages between local and remote node.
+// It is implemented by UDP and TCP channels and by in-memory pair created by NewMemoryTransportPair.
+type Transport interface {
+	Send(data []byte) error
+	// SetDeadline sets deadline for following Receive calls. Zero value means no deadline.
+	// It can be called concurrently with blocked Receive.
+	SetDeadline(deadline time.Time)
+	Receive() ([]byte, error)
+	Close()
+	// Stream is true for transports which guarantee delivery. MRP is not used over them.
+	Stream() bool
+}
+
+// TransportType selects transport used to reach device.
+type TransportType int
+
+const (
+	TransportUdp TransportType = iota
+	TransportTcp
+	// TransportAuto selects TCP when device advertises TCP server and UDP otherwise.
+	TransportAuto
+)
+
+func (t TransportType) String() string {
+	switch t {
+	case TransportUdp:
+		return "udp"
+	case TransportTcp:
+		return "tcp"
+	case TransportAuto:
+		return "auto"
+	}
+	return fmt.Sprintf("TransportType(%d)", int(t))
+}
+
+// ParseTransportType converts name returned by TransportType.String back to TransportType.
+func ParseTransportType(name string) (TransportType, error) {
+	for _, t := range []TransportType{TransportUdp, TransportTcp, TransportAuto} {
+		if t.String() == name {
+			return t, nil
+		}
+	}
+	return TransportUdp, fmt.Errorf("unknown transport %s", name)
+}
+
+// SelectTransport resolves TransportAuto using TCP support advertised by device
+// (see discover.DiscoveredDevice.SupportsTcp). Other transport types override advertisement and are returned unchanged.
+func SelectTransport(requested TransportType, tcp_supported bool) TransportType {
+	if requested != TransportAuto {
+		return requested
+	}
+	if tcp_supported {
+		return TransportTcp
+	}
+	return TransportUdp
+}
+
+// maximal size of message accepted over TCP
+const tcpMaxMessageSize = 64000
+
+// tcpChannel sends messages over TCP connection. Every message is prefixed by its length
+// encoded as 4 byte little endian number.
+type tcpChannel struct {
+	conn  net.Conn
+	mutex sync.Mutex // serializes writes of timers and exchanges
+}
+
+func startTcpChannel(ctx context.Context, remote_ip net.IP, remote_port int) (*tcpChannel, error) {
+	address := net.JoinHostPort(remote_ip.String(), strconv.Itoa(remote_port))
+	dialer := net.Dialer{Timeout: 5 * time.Second}
+	conn, err := dialer.DialContext(ctx, "tcp", address)
+	if err != nil {
+		return nil, err
+	}
+	return &tcpChannel{conn: conn}, nil
+}
+
+func (ch *tcpChannel) Send(data []byte) error {
+	frame := make([]byte, 4+len(data))
+	binary.LittleEndian.PutUint32(frame, uint32(len(data)))
+	copy(frame[4:], data)
+	ch.mutex.Lock()
+	defer ch.mutex.Unlock()
+	_, err := ch.conn.Write(frame)
+	return err
+}
+
+func (ch *tcpChannel) SetDeadline(deadline time.Time) {
+	ch.conn.SetReadDeadline(deadline)
+}
+
+func (ch *tcpChannel) Receive() ([]byte, error) {
+	var length_buf [4]byte
+	_, err := io.ReadFull(ch.conn, length_buf[:])
+	if err != nil {
+		return []byte{}, err
+	}
+	length := binary.LittleEndian.Uint32(length_buf[:])
+	if length > tcpMaxMessageSize {
+		ch.conn.Close()
+		return []byte{}, fmt.Errorf("tcp message too long (%d bytes)", length)
+	}
+	data := make([]byte, length)
+	_, err = io.ReadFull(ch.conn, data)
+	if err != nil {
+		return []byte{}, err
+	}
+	return data, nil
+}
+
+func (ch *tcpChannel) Close() {
+	ch.conn.Close()
+}
+
+func (ch *tcpChannel) Stream() bool {
+	return true
+}
+
+// StartSecureChannelTcp initializes secure channel for plain unencrypted communication over TCP.
+// Secure channel becomes encrypted after encryption keys are supplied.
+func StartSecureChannelTcp(remote_ip net.IP, remote_port int) (SecureChannel, error) {
+	tcp, err := startTcpChannel(context.Background(), remote_ip, remote_port)
+	if err != nil {
+		return SecureChannel{}, err
+	}
+	return newSecureChannel(tcp, DefaultMrpParameters()), nil
+}
+
+// openSessionChannel allocates local session id and transport which receives messages of new session.
+// Transport which is not shared by SessionManager is reused for new session and random session id is used.
+func openSessionChannel(t Transport) (Transport, uint16) {
+	if udp, ok := t.(*udpChannel); ok && udp.manager != nil {
+		return udp.manager.sessionChannel(udp.Remote_address)
+	}
+	return t, uint16(randomIntn(0xfffe) + 1)
+}
+
+// registerSession marks session as established when its transport belongs to SessionManager.
+func registerSession(t Transport, local_session uint16, sc SecureChannel) {
+	if udp, ok := t.(*udpChannel); ok && udp.manager != nil {
+		udp.manager.register(local_session, sc)
+	}
+}
@@ -0,0 +1,142 @@
+package gomat
+
+import (
+	"bytes"
+	"encoding/binary"
+	"io"
+	"net"
+	"testing"
+	"time"
+)
+
+func newTcpPair() (*tcpChannel, net.Conn) {
+	local, remote := net.Pipe()
+	return &tcpChannel{conn: local}, remote
+}
+
+func frame(length uint32, data []byte) []byte {
+	out := make([]byte, 4, 4+len(data))
+	binary.LittleEndian.PutUint32(out, length)
+	return append(out, data...)
+}
+
+func TestTcpSendFraming(t *testing.T) {
+	ch, remote := newTcpPair()
+	defer ch.Close()
+	defer remote.Close()
+
+	payload := []byte{1, 2, 3, 4, 5}
+	go ch.Send(payload)
+	got := make([]byte, 4+len(payload))
+	if _, err := io.ReadFull(remote, got); err != nil {
+		t.Fatalf("can't read frame %s", err.Error())
+	}
+	if !bytes.Equal(got, []byte{5, 0, 0, 0, 1, 2, 3, 4, 5}) {
+		t.Fatalf("unexpected frame %x", got)
+	}
+}
+
+func TestTcpReceiveFraming(t *testing.T) {
+	long := bytes.Repeat([]byte{0xab}, 300)
+	tests := []struct {
+		name   string
+		chunks [][]byte
+		want   [][]byte
+	}{
+		{"single", [][]byte{frame(3, []byte{1, 2, 3})}, [][]byte{{1, 2, 3}}},
+		{"empty", [][]byte{frame(0, nil)}, [][]byte{{}}},
+		{"two frames in one write", [][]byte{append(frame(1, []byte{7}), frame(2, []byte{8, 9})...)}, [][]byte{{7}, {8, 9}}},
+		{"split length", [][]byte{{3}, {0, 0}, {0, 1, 2, 3}}, [][]byte{{1, 2, 3}}},
+		{"byte by byte", [][]byte{{2}, {0}, {0}, {0}, {4}, {5}}, [][]byte{{4, 5}}},
+		{"length above 255", [][]byte{frame(300, long[:100]), long[100:]}, [][]byte{long}},
+	}
+	for _, test := range tests {
+		ch, remote := newTcpPair()
+		go func() {
+			for _, chunk := range test.chunks {
+				remote.Write(chunk)
+				time.Sleep(time.Millisecond)
+			}
+		}()
+		for _, want := range test.want {
+			ch.SetDeadline(time.Now().Add(time.Second))
+			got, err := ch.Receive()
+			if err != nil {
+				t.Fatalf("%s: receive failed %s", test.name, err.Error())
+			}
+			if !bytes.Equal(got, want) {
+				t.Fatalf("%s: received %x, want %x", test.name, got, want)
+			}
+		}
+		ch.Close()
+		remote.Close()
+	}
+}
+
+func TestTcpReceiveOversized(t *testing.T) {
+	ch, remote := newTcpPair()
+	defer remote.Close()
+
+	go remote.Write(frame(tcpMaxMessageSize+1, nil))
+	ch.SetDeadline(time.Now().Add(time.Second))
+	_, err := ch.Receive()
+	if err == nil {
+		t.Fatalf("oversized message accepted")
+	}
+	// connection is closed because stream can't be resynchronized
+	if _, err := ch.conn.Write([]byte{0}); err == nil {
+		t.Fatalf("connection not closed after oversized message")
+	}
+
+	ch, remote = newTcpPair()
+	defer ch.Close()
+	defer remote.Close()
+	payload := bytes.Repeat([]byte{1}, tcpMaxMessageSize)
+	go remote.Write(frame(tcpMaxMessageSize, payload))
+	ch.SetDeadline(time.Now().Add(time.Second))
+	got, err := ch.Receive()
+	if err != nil || len(got) != tcpMaxMessageSize {
+		t.Fatalf("message of maximal size not received %v", err)
+	}
+}
+
+func TestTcpReceiveTruncated(t *testing.T) {
+	ch, remote := newTcpPair()
+	defer ch.Close()
+
+	go func() {
+		remote.Write(frame(10, []byte{1, 2, 3}))
+		remote.Close()
+	}()
+	ch.SetDeadline(time.Now().Add(time.Second))
+	if _, err := ch.Receive(); err == nil {
+		t.Fatalf("truncated message accepted")
+	}
+}
+
+func TestSelectTransport(t *testing.T) {
+	tests := []struct {
+		requested TransportType
+		tcp       bool
+		want      TransportType
+	}{
+		{TransportAuto, true, TransportTcp},
+		{TransportAuto, false, TransportUdp},
+		{TransportUdp, true, TransportUdp},
+		{TransportTcp, false, TransportTcp},
+	}
+	for _, test := range tests {
+		if got := SelectTransport(test.requested, test.tcp); got != test.want {
+			t.Errorf("SelectTransport(%s, %v) = %s, want %s", test.requested, test.tcp, got, test.want)
+		}
+	}
+	for _, name := range []string{"udp", "tcp", "auto"} {
+		tt, err := ParseTransportType(name)
+		if err != nil || tt.String() != name {
+			t.Errorf("ParseTransportType(%s) = %s, %v", name, tt, err)
+		}
+	}
+	if _, err := ParseTransportType("sctp"); err == nil {
+		t.Errorf("unknown transport accepted")
+	}
+}
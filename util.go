@@ -3,7 +3,6 @@ package gomat
 import (
 	"bytes"
 	"crypto/hmac"
-	"crypto/rand"
 	"crypto/sha256"
 	"encoding/binary"
 	"io"
@@ -13,7 +12,7 @@ import (
 
 func CreateRandomBytes(n int) []byte {
 	out := make([]byte, n)
-	rand.Read(out)
+	randReader.Read(out)
 	return out
 }
 
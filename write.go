@@ -0,0 +1,324 @@
+package gomat
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+
+	"github.com/finnigja/gomat/mattertlv"
+)
+
+// default limit of size of AttributeDataIBs sent in one WriteRequest message
+const writeChunkSize = 1000
+
+// WriteAttribute is value written to one attribute.
+type WriteAttribute struct {
+	Endpoint    uint16
+	Cluster     uint32
+	Attribute   uint32
+	DataVersion *uint32  // when set, device writes attribute only when data version of cluster matches
+	Value       []byte   // TLV encoded value, tag of encoded element is ignored
+	Items       [][]byte // TLV encoded items of list attribute, used instead of Value when not nil
+}
+
+// WriteRequest describes Interaction Model Write Request.
+type WriteRequest struct {
+	Attributes []WriteAttribute
+	Timeout    uint16 // when not 0 write is timed interaction and TimedRequest with this timeout (ms) is sent first
+	ChunkSize  int    // limit of size of attribute data in one message, 0 means default
+}
+
+// AttributeStatus is result of write of one attribute.
+type AttributeStatus struct {
+	Endpoint         uint16
+	Cluster          uint32
+	Attribute        uint32
+	Status           Status
+	ClusterStatus    uint8 // cluster specific status code, valid when HasClusterStatus is set
+	HasClusterStatus bool
+}
+
+// Err returns StatusError when write of attribute failed.
+func (s AttributeStatus) Err() error {
+	return statusError(s.Status, s.ClusterStatus, s.HasClusterStatus, &StatusPath{Kind: ElementAttribute, Endpoint: s.Endpoint, Cluster: s.Cluster, Id: s.Attribute})
+}
+
+// attributeData is one AttributeDataIB of WriteRequest.
+type attributeData struct {
+	endpoint     uint16
+	cluster      uint32
+	attribute    uint32
+	data_version *uint32
+	append_item  bool   // ListIndex is null - data is item appended to list
+	data         []byte // TLV element with tag 2
+}
+
+func (d attributeData) encode(tlv *mattertlv.TLVBuffer) {
+	tlv.WriteAnonStruct()
+	if d.data_version != nil {
+		tlv.WriteUInt32(0, *d.data_version)
+	}
+	tlv.WriteList(1)
+	tlv.WriteUInt16(2, d.endpoint)
+	tlv.WriteUInt32(3, d.cluster)
+	tlv.WriteUInt32(4, d.attribute)
+	if d.append_item {
+		tlv.WriteNull(5)
+	}
+	tlv.WriteStructEnd()
+	tlv.WriteRaw(d.data)
+	tlv.WriteStructEnd()
+}
+
+func (d attributeData) size() int {
+	var tlv mattertlv.TLVBuffer
+	d.encode(&tlv)
+	return len(tlv.Bytes())
+}
+
+// retag returns copy of encoded TLV element with its tag replaced by context tag. Anonymous tag is used when tag is negative.
+func retag(element []byte, tag int) ([]byte, error) {
+	if len(element) == 0 {
+		return nil, fmt.Errorf("empty TLV element")
+	}
+	tag_sizes := []int{0, 1, 2, 4, 2, 4, 6, 8}
+	skip := 1 + tag_sizes[element[0]>>5]
+	if len(element) < skip {
+		return nil, fmt.Errorf("truncated TLV element")
+	}
+	ctrl := element[0] & 0x1f
+	out := []byte{}
+	if tag < 0 {
+		out = append(out, ctrl)
+	} else {
+		out = append(out, ctrl|0x20, byte(tag))
+	}
+	return append(out, element[skip:]...), nil
+}
+
+// attributeDataList converts attributes into AttributeDataIBs. List which does not fit into chunk_size
+// is written as empty list followed by appends of its items.
+func attributeDataList(attributes []WriteAttribute, chunk_size int) ([]attributeData, error) {
+	out := []attributeData{}
+	for _, a := range attributes {
+		d := attributeData{
+			endpoint:     a.Endpoint,
+			cluster:      a.Cluster,
+			attribute:    a.Attribute,
+			data_version: a.DataVersion,
+		}
+		if a.Items == nil {
+			data, err := retag(a.Value, 2)
+			if err != nil {
+				return nil, err
+			}
+			d.data = data
+			out = append(out, d)
+			continue
+		}
+		items := [][]byte{}
+		var list mattertlv.TLVBuffer
+		list.WriteArray(2)
+		for _, item := range a.Items {
+			anon, err := retag(item, -1)
+			if err != nil {
+				return nil, err
+			}
+			items = append(items, anon)
+			list.WriteRaw(anon)
+		}
+		list.WriteStructEnd()
+		d.data = list.Bytes()
+		if (chunk_size <= 0) || (d.size() <= chunk_size) {
+			out = append(out, d)
+			continue
+		}
+		var empty mattertlv.TLVBuffer
+		empty.WriteArray(2)
+		empty.WriteStructEnd()
+		d.data = empty.Bytes()
+		out = append(out, d)
+		for _, item := range items {
+			data, _ := retag(item, 2)
+			out = append(out, attributeData{
+				endpoint:    a.Endpoint,
+				cluster:     a.Cluster,
+				attribute:   a.Attribute,
+				append_item: true,
+				data:        data,
+			})
+		}
+	}
+	return out, nil
+}
+
+func encodeWriteRequest(data []attributeData, timed bool, more bool, exchange uint16) []byte {
+	var tlv mattertlv.TLVBuffer
+	tlv.WriteAnonStruct()
+	tlv.WriteBool(0, false) // suppress response
+	tlv.WriteBool(1, timed)
+	tlv.WriteArray(2)
+	for _, d := range data {
+		d.encode(&tlv)
+	}
+	tlv.WriteStructEnd()
+	if more {
+		tlv.WriteBool(3, true)
+	}
+	tlv.WriteUInt(0xff, mattertlv.TYPE_UINT_1, 10)
+	tlv.WriteStructEnd()
+
+	var buffer bytes.Buffer
+	prot := ProtocolMessageHeader{
+		exchangeFlags: 5,
+		Opcode:        INTERACTION_OPCODE_WRITE_REQ,
+		ExchangeId:    exchange,
+		ProtocolId:    ProtocolIdInteraction,
+	}
+	prot.Encode(&buffer)
+	buffer.Write(tlv.Bytes())
+
+	return buffer.Bytes()
+}
+
+// EncodeIMWriteRequest encodes Interaction Model Write Request message which writes all attributes in one message.
+// When timed is set, message must follow TimedRequest (EncodeIMTimedRequest) sent in same exchange.
+func EncodeIMWriteRequest(attributes []WriteAttribute, timed bool, exchange uint16) ([]byte, error) {
+	data, err := attributeDataList(attributes, 0)
+	if err != nil {
+		return nil, err
+	}
+	return encodeWriteRequest(data, timed, false, exchange), nil
+}
+
+// ParseWriteResponse parses IM WriteResponse TLV into status of every written path.
+// Statuses of list item appends are merged into one status of list attribute.
+func ParseWriteResponse(resp *mattertlv.TlvItem) []AttributeStatus {
+	out := []AttributeStatus{}
+	responses := resp.GetItemWithTag(0)
+	if responses == nil {
+		return out
+	}
+	var decoder pathDecoder
+	for _, item := range responses.GetChild() {
+		var s AttributeStatus
+		if path := item.GetItemWithTag(0); path != nil {
+			p, _ := decoder.decode(path)
+			s.Endpoint = uint16(p.endpoint)
+			s.Cluster = uint32(p.cluster)
+			s.Attribute = uint32(p.attribute)
+		}
+		if status := item.GetItemWithTag(1); status != nil {
+			s.Status, s.ClusterStatus, s.HasClusterStatus = parseStatusIB(status)
+		}
+		out = mergeAttributeStatus(out, s)
+	}
+	return out
+}
+
+// mergeAttributeStatus adds status to list. When path is already present, first failure is kept.
+func mergeAttributeStatus(list []AttributeStatus, s AttributeStatus) []AttributeStatus {
+	for n, previous := range list {
+		if previous.Endpoint == s.Endpoint && previous.Cluster == s.Cluster && previous.Attribute == s.Attribute {
+			if previous.Err() == nil {
+				list[n] = s
+			}
+			return list
+		}
+	}
+	return append(list, s)
+}
+
+// timedRequest sends TimedRequest within exchange and waits for its StatusResponse.
+func timedRequest(ctx context.Context, messenger Messenger, exchange uint16, timeout uint16) error {
+	err := messenger.Send(EncodeIMTimedRequest(exchange, timeout))
+	if err != nil {
+		return err
+	}
+	resp, err := messenger.ReceiveContext(ctx)
+	if err != nil {
+		return err
+	}
+	if resp.ProtocolHeader.ProtocolId != ProtocolIdInteraction || resp.ProtocolHeader.Opcode != INTERACTION_OPCODE_STATUS_RSP {
+		return fmt.Errorf("unexpected response to TimedRequest (protocol:%d opcode:0x%x)", resp.ProtocolHeader.ProtocolId, resp.ProtocolHeader.Opcode)
+	}
+	return statusResponseError(resp, "TimedRequest")
+}
+
+// statusResponseError returns StatusError describing IM StatusResponse resp or nil when it reports success.
+func statusResponseError(resp DecodedGeneric, request string) error {
+	status := resp.Tlv.GetItemWithTag(0)
+	if status == nil {
+		return fmt.Errorf("%s status parse failed", request)
+	}
+	err := statusError(Status(status.GetInt()), 0, false, nil)
+	if err != nil {
+		return fmt.Errorf("%s failed: %w", request, err)
+	}
+	return nil
+}
+
+// Write writes attributes and returns status of every written attribute.
+// Request which does not fit into one message is sent in chunks, long lists are split into list item appends.
+// Messenger is SecureChannel or Exchange.
+func Write(messenger Messenger, request WriteRequest) ([]AttributeStatus, error) {
+	return WriteContext(context.Background(), messenger, request)
+}
+
+// WriteContext is Write which can be cancelled and limited by deadline of ctx.
+func WriteContext(ctx context.Context, messenger Messenger, request WriteRequest) ([]AttributeStatus, error) {
+	chunk_size := request.ChunkSize
+	if chunk_size <= 0 {
+		chunk_size = writeChunkSize
+	}
+	data, err := attributeDataList(request.Attributes, chunk_size)
+	if err != nil {
+		return nil, err
+	}
+	exchange := uint16(randomIntn(0xffff))
+	timed := request.Timeout > 0
+	if timed {
+		err = timedRequest(ctx, messenger, exchange, request.Timeout)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	out := []AttributeStatus{}
+	for len(data) > 0 {
+		count := 0
+		size := 0
+		for count < len(data) {
+			size += data[count].size()
+			if (count > 0) && (size > chunk_size) {
+				break
+			}
+			count++
+		}
+		more := count < len(data)
+		err = messenger.Send(encodeWriteRequest(data[:count], timed, more, exchange))
+		if err != nil {
+			return nil, err
+		}
+		data = data[count:]
+
+		resp, err := messenger.ReceiveContext(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if resp.ProtocolHeader.ProtocolId == ProtocolIdInteraction && resp.ProtocolHeader.Opcode == INTERACTION_OPCODE_STATUS_RSP {
+			err = statusResponseError(resp, "WriteRequest")
+			if err == nil {
+				err = fmt.Errorf("WriteRequest was answered by StatusResponse")
+			}
+			return nil, err
+		}
+		if resp.ProtocolHeader.ProtocolId != ProtocolIdInteraction || resp.ProtocolHeader.Opcode != INTERACTION_OPCODE_WRITE_RSP {
+			return nil, fmt.Errorf("unexpected response to WriteRequest (protocol:%d opcode:0x%x)", resp.ProtocolHeader.ProtocolId, resp.ProtocolHeader.Opcode)
+		}
+		for _, s := range ParseWriteResponse(&resp.Tlv) {
+			out = mergeAttributeStatus(out, s)
+		}
+	}
+	return out, nil
+}
@@ -0,0 +1,261 @@
+package gomat
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/finnigja/gomat/mattertlv"
+)
+
+func TestRetag(t *testing.T) {
+	var tlv mattertlv.TLVBuffer
+	tlv.WriteUInt16(5, 0x1234)
+	element := tlv.Bytes()
+
+	tagged, err := retag(element, 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(tagged, []byte{0x25, 2, 0x34, 0x12}) {
+		t.Fatalf("retag to context tag 2 gives %x", tagged)
+	}
+	anon, err := retag(element, -1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(anon, []byte{0x05, 0x34, 0x12}) {
+		t.Fatalf("retag to anonymous tag gives %x", anon)
+	}
+	back, err := retag(anon, 7)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(back, []byte{0x25, 7, 0x34, 0x12}) {
+		t.Fatalf("retag of anonymous element gives %x", back)
+	}
+
+	// fully qualified tag has 6 bytes
+	var profile mattertlv.TLVBuffer
+	profile.WriteTagged(mattertlv.FullyQualifiedTag(0xfff1, 1, 2), true)
+	tagged, err = retag(profile.Bytes(), 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(tagged, []byte{0x29, 2}) {
+		t.Fatalf("retag of fully qualified element gives %x", tagged)
+	}
+
+	if _, err := retag(nil, 2); err == nil {
+		t.Fatalf("empty element accepted")
+	}
+	if _, err := retag(profile.Bytes()[:3], 2); err == nil {
+		t.Fatalf("truncated element accepted")
+	}
+}
+
+// anonUInt8 encodes anonymous unsigned integer.
+func anonUInt8(value uint8) []byte {
+	var tlv mattertlv.TLVBuffer
+	tlv.WriteTagged(mattertlv.AnonymousTag(), value)
+	return tlv.Bytes()
+}
+
+// decodeAttributeData encodes d and decodes it back.
+func decodeAttributeData(t *testing.T, d attributeData) mattertlv.TlvItem {
+	var tlv mattertlv.TLVBuffer
+	d.encode(&tlv)
+	item, err := mattertlv.Decode(tlv.Bytes())
+	if err != nil {
+		t.Fatal(err)
+	}
+	return item
+}
+
+func TestAttributeDataList(t *testing.T) {
+	version := uint32(9)
+	attributes := []WriteAttribute{
+		{Endpoint: 1, Cluster: 6, Attribute: 0x4003, DataVersion: &version, Value: anonUInt8(1)},
+		{Endpoint: 0, Cluster: 0x1f, Attribute: 0, Items: [][]byte{anonUInt8(1), anonUInt8(2), anonUInt8(3)}},
+	}
+
+	// everything fits - list is written as whole
+	data, err := attributeDataList(attributes, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(data) != 2 {
+		t.Fatalf("%d AttributeDataIBs, want 2", len(data))
+	}
+	first := decodeAttributeData(t, data[0])
+	if v := first.GetItemWithTag(0); v == nil || v.GetInt() != 9 {
+		t.Fatalf("DataVersion missing")
+	}
+	if v := first.GetItemWithTag(2); v == nil || v.GetInt() != 1 {
+		t.Fatalf("value not written with tag 2")
+	}
+	list := decodeAttributeData(t, data[1]).GetItemWithTag(2)
+	if list == nil || len(list.GetChild()) != 3 {
+		t.Fatalf("list not written as whole")
+	}
+
+	// list which does not fit into chunk is replaced by empty list and appends of its items
+	data, err = attributeDataList(attributes, 20)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(data) != 5 {
+		t.Fatalf("%d AttributeDataIBs, want 5", len(data))
+	}
+	replace := decodeAttributeData(t, data[1])
+	if list := replace.GetItemWithTag(2); list == nil || list.Type != mattertlv.TypeList || len(list.GetChild()) != 0 {
+		t.Fatalf("list is not replaced by empty list")
+	}
+	if replace.GetItemRec([]int{1, 5}) != nil {
+		t.Fatalf("replace of list has ListIndex")
+	}
+	for n, d := range data[2:] {
+		item := decodeAttributeData(t, d)
+		index := item.GetItemRec([]int{1, 5})
+		if index == nil || index.Type != mattertlv.TypeNull {
+			t.Fatalf("append %d does not have null ListIndex", n)
+		}
+		if attribute := item.GetItemRec([]int{1, 3}); attribute == nil || attribute.GetInt() != 0x1f {
+			t.Fatalf("append %d has wrong path", n)
+		}
+		if value := item.GetItemWithTag(2); value == nil || value.GetInt() != n+1 {
+			t.Fatalf("append %d has wrong value", n)
+		}
+	}
+
+	if _, err := attributeDataList([]WriteAttribute{{Endpoint: 1, Cluster: 6}}, 0); err == nil {
+		t.Fatalf("attribute without value accepted")
+	}
+}
+
+func TestEncodeIMWriteRequestTimed(t *testing.T) {
+	attributes := []WriteAttribute{{Endpoint: 1, Cluster: 6, Attribute: 0x4003, Value: anonUInt8(2)}}
+	for _, timed := range []bool{false, true} {
+		encoded, err := EncodeIMWriteRequest(attributes, timed, 0x55)
+		if err != nil {
+			t.Fatal(err)
+		}
+		buffer := bytes.NewBuffer(encoded)
+		var prot ProtocolMessageHeader
+		prot.Decode(buffer)
+		if prot.Opcode != INTERACTION_OPCODE_WRITE_REQ || prot.ExchangeId != 0x55 {
+			t.Fatalf("unexpected header %+v", prot)
+		}
+		request, err := mattertlv.Decode(buffer.Bytes())
+		if err != nil {
+			t.Fatal(err)
+		}
+		if tlvFlag(&request, 1) != timed {
+			t.Fatalf("TimedRequest flag is not %v", timed)
+		}
+		if tlvFlag(&request, 3) {
+			t.Fatalf("single message has MoreChunkedMessages flag")
+		}
+		if writes := request.GetItemWithTag(2); writes == nil || len(writes.GetChild()) != 1 {
+			t.Fatalf("AttributeDataIB missing")
+		}
+	}
+}
+
+// writeStatusGen writes AttributeStatusIB with StatusIB. cluster_status < 0 is left out.
+func writeStatusGen(tlv *mattertlv.TLVBuffer, endpoint uint16, cluster uint32, attribute uint32, append_item bool, status Status, cluster_status int) {
+	tlv.WriteAnonStruct()
+	tlv.WriteList(0)
+	tlv.WriteUInt16(2, endpoint)
+	tlv.WriteUInt32(3, cluster)
+	tlv.WriteUInt32(4, attribute)
+	if append_item {
+		tlv.WriteNull(5)
+	}
+	tlv.WriteStructEnd()
+	tlv.WriteStruct(1)
+	tlv.WriteUInt8(0, byte(status))
+	if cluster_status >= 0 {
+		tlv.WriteUInt8(1, byte(cluster_status))
+	}
+	tlv.WriteStructEnd()
+	tlv.WriteStructEnd()
+}
+
+func TestParseWriteResponse(t *testing.T) {
+	var tlv mattertlv.TLVBuffer
+	tlv.WriteAnonStruct()
+	tlv.WriteArray(0)
+	writeStatusGen(&tlv, 0, 0x1f, 0, false, StatusSuccess, -1)
+	writeStatusGen(&tlv, 0, 0x1f, 0, true, StatusSuccess, -1)
+	writeStatusGen(&tlv, 0, 0x1f, 0, true, StatusConstraintError, 3)
+	writeStatusGen(&tlv, 0, 0x1f, 0, true, StatusResourceExhausted, -1)
+	writeStatusGen(&tlv, 1, 6, 0x4003, false, StatusSuccess, -1)
+	tlv.WriteStructEnd()
+	tlv.WriteUInt8(0xff, 11)
+	tlv.WriteStructEnd()
+	resp, err := mattertlv.Decode(tlv.Bytes())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	statuses := ParseWriteResponse(&resp)
+	if len(statuses) != 2 {
+		t.Fatalf("%d statuses, want 2: %+v", len(statuses), statuses)
+	}
+	// first failure of list item appends is reported for whole list
+	acl := statuses[0]
+	if acl.Cluster != 0x1f || acl.Status != StatusConstraintError || !acl.HasClusterStatus || acl.ClusterStatus != 3 {
+		t.Fatalf("unexpected status of list %+v", acl)
+	}
+	if acl.Err() == nil {
+		t.Fatalf("failed write has no error")
+	}
+	if s := statuses[1]; s.Endpoint != 1 || s.Cluster != 6 || s.Attribute != 0x4003 || s.Err() != nil {
+		t.Fatalf("unexpected status %+v", s)
+	}
+}
+
+func TestWriteTimed(t *testing.T) {
+	messenger := &fakeMessenger{respond: func(request []byte) DecodedGeneric {
+		var prot ProtocolMessageHeader
+		prot.Decode(bytes.NewBuffer(request))
+		if prot.Opcode == INTERACTION_OPCODE_TIMED_REQ {
+			msg := testMessage(prot.ExchangeId, 0, ProtocolIdInteraction, INTERACTION_OPCODE_STATUS_RSP)
+			msg.Tlv, _ = mattertlv.Decode(imStatusResponseGen(prot.ExchangeId, 0, 0)[6:])
+			return msg
+		}
+		var tlv mattertlv.TLVBuffer
+		tlv.WriteAnonStruct()
+		tlv.WriteArray(0)
+		writeStatusGen(&tlv, 1, 6, 0x4003, false, StatusSuccess, -1)
+		tlv.WriteStructEnd()
+		tlv.WriteStructEnd()
+		msg := testMessage(prot.ExchangeId, 0, ProtocolIdInteraction, INTERACTION_OPCODE_WRITE_RSP)
+		msg.Tlv, _ = mattertlv.Decode(tlv.Bytes())
+		return msg
+	}}
+	statuses, err := Write(messenger, WriteRequest{
+		Attributes: []WriteAttribute{{Endpoint: 1, Cluster: 6, Attribute: 0x4003, Value: anonUInt8(2)}},
+		Timeout:    500,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(statuses) != 1 || statuses[0].Err() != nil {
+		t.Fatalf("unexpected statuses %+v", statuses)
+	}
+	if len(messenger.sent) != 2 {
+		t.Fatalf("%d messages sent, want TimedRequest and WriteRequest", len(messenger.sent))
+	}
+	var timed, write ProtocolMessageHeader
+	timed.Decode(bytes.NewBuffer(messenger.sent[0]))
+	buffer := bytes.NewBuffer(messenger.sent[1])
+	write.Decode(buffer)
+	if timed.Opcode != INTERACTION_OPCODE_TIMED_REQ || write.Opcode != INTERACTION_OPCODE_WRITE_REQ || timed.ExchangeId != write.ExchangeId {
+		t.Fatalf("WriteRequest does not follow TimedRequest in same exchange")
+	}
+	request, _ := mattertlv.Decode(buffer.Bytes())
+	if !tlvFlag(&request, 1) {
+		t.Fatalf("WriteRequest after TimedRequest is not marked as timed")
+	}
+}